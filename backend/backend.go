@@ -0,0 +1,139 @@
+// Package backend is a small, backend-neutral facade over native call
+// libraries, so that bindings can be written once and run on goffi, purego or
+// cgo, selected per platform or per build tag.
+//
+// The facade deliberately covers only what all three backends share: loading
+// libraries, resolving symbols, calling functions with integer and pointer
+// arguments (like syscall.SyscallN) and turning Go functions into C
+// callbacks. Bindings that need float arguments, struct passing or variadic
+// calls should use package ffi directly.
+//
+// Goffi is the default. Building with cgo enabled and the goffi_cgo tag
+// (Unix only) swaps it for Cgo and keeps goffi out of the binary. Other
+// libraries are adapted with Funcs; purego, for example:
+//
+//	backend.Default = backend.Funcs{
+//	    BackendName: "purego",
+//	    Load: func(name string) (uintptr, error) {
+//	        return purego.Dlopen(name, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+//	    },
+//	    Symbol: purego.Dlsym,
+//	    Free:   purego.Dlclose,
+//	    CallFn: func(fn uintptr, args ...uintptr) (uintptr, error) {
+//	        r1, _, _ := purego.SyscallN(fn, args...)
+//	        return r1, nil
+//	    },
+//	    Callback: purego.NewCallback,
+//	}
+//
+// goffi does not depend on purego itself; the adapter lives in the
+// application, which already imports it.
+package backend
+
+import "errors"
+
+// Backend is a native call library.
+//
+// Library handles and function pointers are uintptr, as in purego and
+// package syscall, so they can be passed through Call unchanged.
+type Backend interface {
+	// Name identifies the backend ("goffi", "cgo", "purego", ...).
+	Name() string
+
+	// LoadLibrary loads a shared library and returns its handle.
+	LoadLibrary(name string) (uintptr, error)
+
+	// GetSymbol resolves a symbol in a library loaded by LoadLibrary.
+	GetSymbol(lib uintptr, name string) (uintptr, error)
+
+	// FreeLibrary releases a handle from LoadLibrary.
+	FreeLibrary(lib uintptr) error
+
+	// Call calls the C function fn with integer or pointer arguments and
+	// returns its integer or pointer result.
+	//
+	// A Go pointer passed as uintptr is invisible to the garbage collector,
+	// and //go:uintptrescapes cannot help through an interface method call.
+	// The caller must keep the referent alive until Call returns, with
+	// runtime.KeepAlive after the call, and pin it with runtime.Pinner if
+	// the callee keeps the pointer or calls back into Go:
+	//
+	//	str := []byte("goffi\x00")
+	//	n, err := b.Call(strlen, uintptr(unsafe.Pointer(&str[0])))
+	//	runtime.KeepAlive(str)
+	Call(fn uintptr, args ...uintptr) (uintptr, error)
+
+	// NewCallback returns a C function pointer that calls the Go function fn.
+	NewCallback(fn any) uintptr
+}
+
+// Default is the backend bindings should use unless told otherwise. It is
+// Goffi, or Cgo in builds with the goffi_cgo tag; applications may replace it
+// during initialization.
+var Default Backend = defaultBackend()
+
+// ErrNotImplemented is returned by Funcs methods whose function is nil.
+var ErrNotImplemented = errors.New("backend: operation not implemented by this backend")
+
+// Funcs adapts a set of plain functions to Backend. Nil functions make the
+// corresponding method fail with ErrNotImplemented (NewCallback panics).
+type Funcs struct {
+	BackendName string
+	Load        func(name string) (uintptr, error)
+	Symbol      func(lib uintptr, name string) (uintptr, error)
+	Free        func(lib uintptr) error
+	CallFn      func(fn uintptr, args ...uintptr) (uintptr, error)
+	Callback    func(fn any) uintptr
+}
+
+var _ Backend = Funcs{}
+
+// Name returns f.BackendName.
+func (f Funcs) Name() string {
+	return f.BackendName
+}
+
+// LoadLibrary calls f.Load.
+func (f Funcs) LoadLibrary(name string) (uintptr, error) {
+	if f.Load == nil {
+		return 0, ErrNotImplemented
+	}
+	return f.Load(name)
+}
+
+// GetSymbol calls f.Symbol.
+func (f Funcs) GetSymbol(lib uintptr, name string) (uintptr, error) {
+	if f.Symbol == nil {
+		return 0, ErrNotImplemented
+	}
+	return f.Symbol(lib, name)
+}
+
+// FreeLibrary calls f.Free.
+func (f Funcs) FreeLibrary(lib uintptr) error {
+	if f.Free == nil {
+		return ErrNotImplemented
+	}
+	return f.Free(lib)
+}
+
+// Call calls f.CallFn. Called on a Funcs value rather than through Backend,
+// it is marked //go:uintptrescapes like ffi.CallFunctionArgs, so
+// uintptr(unsafe.Pointer(p)) arguments in the call expression stay alive
+// until it returns.
+//
+//go:uintptrescapes
+func (f Funcs) Call(fn uintptr, args ...uintptr) (uintptr, error) {
+	if f.CallFn == nil {
+		return 0, ErrNotImplemented
+	}
+	return f.CallFn(fn, args...)
+}
+
+// NewCallback calls f.Callback.
+func (f Funcs) NewCallback(fn any) uintptr {
+	if f.Callback == nil {
+		panic(ErrNotImplemented)
+	}
+	return f.Callback(fn)
+}
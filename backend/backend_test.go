@@ -0,0 +1,71 @@
+package backend
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+func testLib() string {
+	switch runtime.GOOS {
+	case "windows":
+		return "msvcrt.dll"
+	case "darwin":
+		return "/usr/lib/libSystem.B.dylib"
+	case "freebsd":
+		return "libc.so.7"
+	default:
+		return "libc.so.6"
+	}
+}
+
+func testBackend(t *testing.T, b Backend) {
+	lib, err := b.LoadLibrary(testLib())
+	if err != nil {
+		t.Fatalf("%s: LoadLibrary failed: %v", b.Name(), err)
+	}
+	defer b.FreeLibrary(lib)
+
+	strlen, err := b.GetSymbol(lib, "strlen")
+	if err != nil {
+		t.Fatalf("%s: GetSymbol failed: %v", b.Name(), err)
+	}
+	str := []byte("goffi\x00")
+	n, err := b.Call(strlen, uintptr(unsafe.Pointer(&str[0])))
+	runtime.KeepAlive(str)
+	if err != nil {
+		t.Fatalf("%s: Call failed: %v", b.Name(), err)
+	}
+	if n != 5 {
+		t.Errorf("%s: strlen(\"goffi\") = %d, want 5", b.Name(), n)
+	}
+
+	if _, err := b.GetSymbol(lib, "goffi_no_such_symbol"); err == nil {
+		t.Errorf("%s: expected error for missing symbol", b.Name())
+	}
+}
+
+func TestDefault(t *testing.T) {
+	testBackend(t, Default)
+}
+
+func TestFuncs(t *testing.T) {
+	// A Funcs backend that forwards to Default behaves like it.
+	testBackend(t, Funcs{
+		BackendName: "forwarding",
+		Load:        Default.LoadLibrary,
+		Symbol:      Default.GetSymbol,
+		Free:        Default.FreeLibrary,
+		CallFn:      Default.Call,
+		Callback:    Default.NewCallback,
+	})
+
+	var empty Funcs
+	if _, err := empty.LoadLibrary("x"); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("LoadLibrary on empty Funcs = %v, want ErrNotImplemented", err)
+	}
+	if _, err := empty.Call(0); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("Call on empty Funcs = %v, want ErrNotImplemented", err)
+	}
+}
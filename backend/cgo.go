@@ -0,0 +1,113 @@
+//go:build cgo && goffi_cgo && (linux || darwin || freebsd)
+
+package backend
+
+/*
+#cgo linux LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdint.h>
+#include <stdlib.h>
+
+static uintptr_t goffi_backend_call(uintptr_t fn, uintptr_t *a, int n) {
+	switch (n) {
+	case 0: return ((uintptr_t (*)(void))fn)();
+	case 1: return ((uintptr_t (*)(uintptr_t))fn)(a[0]);
+	case 2: return ((uintptr_t (*)(uintptr_t, uintptr_t))fn)(a[0], a[1]);
+	case 3: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2]);
+	case 4: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3]);
+	case 5: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4]);
+	case 6: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5]);
+	case 7: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6]);
+	case 8: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7]);
+	case 9: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8]);
+	case 10: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9]);
+	case 11: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10]);
+	case 12: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11]);
+	case 13: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11], a[12]);
+	case 14: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11], a[12], a[13]);
+	case 15: return ((uintptr_t (*)(uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t, uintptr_t))fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11], a[12], a[13], a[14]);
+	}
+	return 0;
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// cgoMaxArgs is the largest argument count goffi_backend_call dispatches.
+const cgoMaxArgs = 15
+
+// Cgo is the Backend implemented with cgo: dlopen/dlsym from libdl and calls
+// through C function pointer casts.
+//
+// It does not import package ffi: goffi's dynamic import stubs need the
+// internal linker, while cgo code is linked externally, so a Cgo build must
+// be goffi-free. For the same reason Goffi is not available in such builds.
+// cgo can only export callbacks declared at compile time, so NewCallback
+// panics with ErrNotImplemented; wrap Cgo in Funcs to supply callbacks.
+var Cgo Backend = cgoBackend{}
+
+type cgoBackend struct{}
+
+func defaultBackend() Backend {
+	return Cgo
+}
+
+// dlerror returns the pending dl error for op on name.
+func dlerror(op, name string) error {
+	msg := "unknown error"
+	if cmsg := C.dlerror(); cmsg != nil {
+		msg = C.GoString(cmsg)
+	}
+	return fmt.Errorf("backend: %s %q: %s", op, name, msg)
+}
+
+func (cgoBackend) Name() string {
+	return "cgo"
+}
+
+func (cgoBackend) LoadLibrary(name string) (uintptr, error) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	lib := C.dlopen(cname, C.RTLD_NOW|C.RTLD_GLOBAL)
+	if lib == nil {
+		return 0, dlerror("dlopen", name)
+	}
+	return uintptr(lib), nil
+}
+
+func (cgoBackend) GetSymbol(lib uintptr, name string) (uintptr, error) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	C.dlerror()
+	sym := C.dlsym(*(*unsafe.Pointer)(unsafe.Pointer(&lib)), cname)
+	if sym == nil {
+		return 0, dlerror("dlsym", name)
+	}
+	return uintptr(sym), nil
+}
+
+func (cgoBackend) FreeLibrary(lib uintptr) error {
+	if C.dlclose(*(*unsafe.Pointer)(unsafe.Pointer(&lib))) != 0 {
+		return dlerror("dlclose", "<library handle>")
+	}
+	return nil
+}
+
+func (cgoBackend) Call(fn uintptr, args ...uintptr) (uintptr, error) {
+	if len(args) > cgoMaxArgs {
+		return 0, fmt.Errorf("backend: cgo call with %d arguments exceeds limit of %d", len(args), cgoMaxArgs)
+	}
+	var a [cgoMaxArgs]C.uintptr_t
+	for i, arg := range args {
+		a[i] = C.uintptr_t(arg)
+	}
+	return uintptr(C.goffi_backend_call(C.uintptr_t(fn), &a[0], C.int(len(args)))), nil
+}
+
+func (cgoBackend) NewCallback(fn any) uintptr {
+	panic(ErrNotImplemented)
+}
@@ -0,0 +1,85 @@
+//go:build !(cgo && goffi_cgo && (linux || darwin || freebsd))
+
+package backend
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Goffi is the Backend implemented by package ffi. It is left out of builds
+// using the Cgo backend, which must not link goffi's dynamic import stubs.
+var Goffi Backend = goffiBackend{}
+
+type goffiBackend struct{}
+
+func defaultBackend() Backend {
+	return Goffi
+}
+
+// callInterfaces caches one pointer-args, pointer-result CallInterface per
+// argument count.
+var callInterfaces struct {
+	mu   sync.Mutex
+	cifs map[int]*types.CallInterface
+}
+
+func callInterfaceFor(n int) (*types.CallInterface, error) {
+	callInterfaces.mu.Lock()
+	defer callInterfaces.mu.Unlock()
+	if cif, ok := callInterfaces.cifs[n]; ok {
+		return cif, nil
+	}
+	argTypes := make([]*types.TypeDescriptor, n)
+	for i := range argTypes {
+		argTypes[i] = types.PointerTypeDescriptor
+	}
+	cif := new(types.CallInterface)
+	if err := ffi.PrepareCallInterface(cif, types.DefaultCall, types.PointerTypeDescriptor, argTypes); err != nil {
+		return nil, err
+	}
+	if callInterfaces.cifs == nil {
+		callInterfaces.cifs = make(map[int]*types.CallInterface)
+	}
+	callInterfaces.cifs[n] = cif
+	return cif, nil
+}
+
+func (goffiBackend) Name() string {
+	return "goffi"
+}
+
+func (goffiBackend) LoadLibrary(name string) (uintptr, error) {
+	lib, err := ffi.LoadLibrary(name)
+	return uintptr(ffi.HandleOf(lib)), err
+}
+
+func (goffiBackend) GetSymbol(lib uintptr, name string) (uintptr, error) {
+	sym, err := ffi.GetSymbol(ffi.Handle(lib).Pointer(), name)
+	return uintptr(ffi.HandleOf(sym)), err
+}
+
+func (goffiBackend) FreeLibrary(lib uintptr) error {
+	return ffi.FreeLibrary(ffi.Handle(lib).Pointer())
+}
+
+func (goffiBackend) Call(fn uintptr, args ...uintptr) (uintptr, error) {
+	cif, err := callInterfaceFor(len(args))
+	if err != nil {
+		return 0, err
+	}
+	avalue := make([]unsafe.Pointer, len(args))
+	for i := range args {
+		avalue[i] = unsafe.Pointer(&args[i])
+	}
+	var ret uintptr
+	err = ffi.CallFunction(cif, ffi.Handle(fn).Pointer(), unsafe.Pointer(&ret), avalue)
+	return ret, err
+}
+
+func (goffiBackend) NewCallback(fn any) uintptr {
+	return ffi.NewCallback(fn)
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package main
+
+// api is the set of declarations read from a header or a clang AST.
+type api struct {
+	typedefs   map[string]*typedefDecl
+	records    map[string]*recordDecl // struct and union tags share a namespace
+	enums      map[string]*enumDecl
+	enumValues map[string]int64 // Enumerators and integer constants, by name
+
+	typedefOrder []*typedefDecl
+	recordOrder  []*recordDecl
+	enumOrder    []*enumDecl
+	consts       []*constDecl
+	funcs        []*funcDecl
+}
+
+func newAPI() *api {
+	return &api{
+		typedefs:   make(map[string]*typedefDecl),
+		records:    make(map[string]*recordDecl),
+		enums:      make(map[string]*enumDecl),
+		enumValues: make(map[string]int64),
+	}
+}
+
+// typedefDecl is typedef typ name;
+type typedefDecl struct {
+	name, file string
+	typ        *cType
+}
+
+// recordDecl is a struct or union.
+type recordDecl struct {
+	name, file string
+	union      bool
+	complete   bool   // Has a definition, not just a forward declaration
+	bad        string // Why the record cannot be mirrored in Go, or ""
+	fields     []field
+}
+
+// field is a member of a struct or union.
+type field struct {
+	name string
+	typ  *cType
+}
+
+// enumDecl is an enum with its enumerators. Anonymous enums have no name.
+type enumDecl struct {
+	name, file string
+	consts     []enumConst
+}
+
+// enumConst is an enumerator.
+type enumConst struct {
+	name  string
+	value int64
+}
+
+// constDecl is a static const integer variable, the way newer headers such
+// as webgpu.h define flag values, or an integer macro, which has no type.
+type constDecl struct {
+	name, file string
+	typ        *cType
+	value      int64
+	unsigned   bool // A negative value stands for one above math.MaxInt64
+}
+
+// funcDecl is a function declaration.
+type funcDecl struct {
+	name, file string
+	typ        *cType // kindFunc
+}
+
+func (a *api) addTypedef(d *typedefDecl) {
+	if _, ok := a.typedefs[d.name]; ok {
+		return
+	}
+	a.typedefs[d.name] = d
+	a.typedefOrder = append(a.typedefOrder, d)
+}
+
+// record returns the record tagged name, creating an incomplete one on
+// first use so that forward declarations and definitions share it.
+func (a *api) record(name, file string, union bool) *recordDecl {
+	if r, ok := a.records[name]; ok {
+		return r
+	}
+	r := &recordDecl{name: name, file: file, union: union}
+	a.records[name] = r
+	a.recordOrder = append(a.recordOrder, r)
+	return r
+}
+
+func (a *api) addEnum(d *enumDecl) {
+	if d.name != "" {
+		if _, ok := a.enums[d.name]; ok {
+			return
+		}
+		a.enums[d.name] = d
+	}
+	a.enumOrder = append(a.enumOrder, d)
+	for _, c := range d.consts {
+		a.enumValues[c.name] = c.value
+	}
+}
+
+func (a *api) addFunc(d *funcDecl) {
+	for _, f := range a.funcs {
+		if f.name == d.name {
+			return
+		}
+	}
+	a.funcs = append(a.funcs, d)
+}
+
+func (a *api) addConst(d *constDecl) {
+	if _, ok := a.enumValues[d.name]; ok {
+		return
+	}
+	a.enumValues[d.name] = d.value
+	a.consts = append(a.consts, d)
+}
+
+// nameNested names the anonymous records defined in the fields of r, as in
+// struct { struct { float r, g; } color; }, after r and the field.
+func (a *api) nameNested(r *recordDecl) {
+	for _, f := range r.fields {
+		t := f.typ
+		for t.kind == kindArray {
+			t = t.elem
+		}
+		if n := t.record; n != nil && n.name == "" {
+			n.name = r.name + "_" + f.name
+			t.name = n.name
+			a.records[n.name] = n
+			a.recordOrder = append(a.recordOrder, n)
+			a.nameNested(n)
+		}
+	}
+}
+
+// resolve follows typedef names to the type they stand for. Unknown names
+// are returned as they are.
+func (a *api) resolve(t *cType) *cType {
+	for seen := 0; t.kind == kindNamed && seen < 64; seen++ {
+		d, ok := a.typedefs[t.name]
+		if !ok {
+			break
+		}
+		t = d.typ
+	}
+	return t
+}
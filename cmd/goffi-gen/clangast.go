@@ -0,0 +1,363 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// astNode is a node of clang's JSON AST dump (clang -Xclang -ast-dump=json
+// -fsyntax-only), limited to the fields the generator reads.
+type astNode struct {
+	Kind               string          `json:"kind"`
+	Name               string          `json:"name"`
+	Loc                *astLoc         `json:"loc"`
+	Range              *astRange       `json:"range"`
+	IsImplicit         bool            `json:"isImplicit"`
+	Type               *astType        `json:"type"`
+	TagUsed            string          `json:"tagUsed"`
+	CompleteDefinition bool            `json:"completeDefinition"`
+	IsBitfield         bool            `json:"isBitfield"`
+	StorageClass       string          `json:"storageClass"`
+	Value              json.RawMessage `json:"value"`
+	Opcode             string          `json:"opcode"`
+	ReferencedDecl     *astNode        `json:"referencedDecl"`
+	OwnedTagDecl       *astNode        `json:"ownedTagDecl"`
+	Inner              []*astNode      `json:"inner"`
+}
+
+type astType struct {
+	QualType string `json:"qualType"`
+}
+
+// astLoc is a source location. The dump omits the file, and the line, when
+// they are the same as in the previous location it wrote, so they are
+// tracked while walking the nodes in order.
+type astLoc struct {
+	File         string  `json:"file"`
+	Line         int     `json:"line"`
+	IncludedFrom *astLoc `json:"includedFrom"`
+	SpellingLoc  *astLoc `json:"spellingLoc"`
+	ExpansionLoc *astLoc `json:"expansionLoc"`
+}
+
+type astRange struct {
+	Begin astLoc `json:"begin"`
+	End   astLoc `json:"end"`
+}
+
+// astReader turns an AST dump into an api.
+type astReader struct {
+	api      *api
+	warn     func(file string, line int, err error)
+	file     string // Current file, per the last location walked
+	line     int
+	mainFile string // The file that is not included from another one
+}
+
+// unnamedTag matches how clang spells anonymous records and enums in type
+// names: struct (unnamed struct at x.h:3:9), or (anonymous at x.h:3:9).
+var unnamedTag = regexp.MustCompile(`\((?:unnamed|anonymous)(?: struct| union| enum)? at [^)]*\)`)
+
+// readAST reads a clang JSON AST dump into a and returns the main file.
+func readAST(a *api, data []byte, warn func(file string, line int, err error)) (string, error) {
+	var root astNode
+	if err := json.Unmarshal(data, &root); err != nil {
+		return "", fmt.Errorf("reading clang AST: %w", err)
+	}
+	if root.Kind != "TranslationUnitDecl" {
+		return "", fmt.Errorf("reading clang AST: root is %q, not TranslationUnitDecl", root.Kind)
+	}
+	r := &astReader{api: a, warn: warn}
+	r.decls(root.Inner, nil)
+	return r.mainFile, nil
+}
+
+// track walks a location, updating the current file and line.
+func (r *astReader) track(l *astLoc) {
+	if l == nil {
+		return
+	}
+	r.track(l.SpellingLoc)
+	r.track(l.ExpansionLoc)
+	if l.File != "" {
+		r.file = l.File
+		if l.IncludedFrom == nil && r.mainFile == "" && !strings.HasPrefix(l.File, "<") {
+			r.mainFile = l.File
+		}
+	}
+	if l.Line != 0 {
+		r.line = l.Line
+	}
+}
+
+// position walks the location and range of n and returns the file and
+// line where n is declared.
+func (r *astReader) position(n *astNode) (string, int) {
+	r.track(n.Loc)
+	file, line := r.file, r.line
+	if n.Range != nil {
+		r.track(&n.Range.Begin)
+		r.track(&n.Range.End)
+	}
+	return file, line
+}
+
+// decls reads a list of declarations, and returns the fields among them
+// when parent, the record they are the members of, is not nil.
+func (r *astReader) decls(nodes []*astNode, parent *recordDecl) []field {
+	var fields []field
+	var anonRecord *recordDecl // Last anonymous struct or union, until named
+	var anonEnum *enumDecl
+	for _, n := range nodes {
+		file, line := r.position(n)
+		if n.IsImplicit {
+			if n.Kind == "FieldDecl" && parent != nil {
+				parent.setBad("anonymous members are not supported")
+			}
+			r.skip(n.Inner)
+			continue
+		}
+		switch n.Kind {
+		case "RecordDecl":
+			union := n.TagUsed == "union"
+			var d *recordDecl
+			if n.Name == "" {
+				d = &recordDecl{file: file, union: union}
+				anonRecord, anonEnum = d, nil
+			} else {
+				d = r.api.record(n.Name, file, union)
+			}
+			if n.CompleteDefinition {
+				d.file = file
+				d.fields = r.decls(n.Inner, d)
+				d.complete = true
+				if d.name != "" {
+					r.api.nameNested(d)
+				}
+			} else {
+				r.skip(n.Inner)
+			}
+		case "FieldDecl":
+			if parent == nil {
+				r.skip(n.Inner)
+				continue
+			}
+			r.skip(n.Inner)
+			t, err := r.fieldType(n.Type.QualType, anonRecord)
+			if err != nil {
+				parent.setBad(fmt.Sprintf("field %s: %v", n.Name, err))
+				continue
+			}
+			if n.IsBitfield {
+				parent.setBad("bit-fields are not supported")
+			}
+			fields = append(fields, field{name: n.Name, typ: t})
+		case "EnumDecl":
+			d := &enumDecl{name: n.Name, file: file, consts: []enumConst{}}
+			next := int64(0)
+			for _, c := range n.Inner {
+				r.position(c)
+				if c.Kind != "EnumConstantDecl" {
+					r.skip(c.Inner)
+					continue
+				}
+				value := next
+				if len(c.Inner) > 0 {
+					v, err := r.eval(c.Inner[0])
+					if err != nil {
+						r.warn(r.file, r.line, fmt.Errorf("enumerator %s: %v", c.Name, err))
+					}
+					value = v
+				}
+				d.consts = append(d.consts, enumConst{name: c.Name, value: value})
+				r.api.enumValues[c.Name] = value
+				next = value + 1
+			}
+			r.api.addEnum(d)
+			if n.Name == "" {
+				anonRecord, anonEnum = nil, d
+			}
+		case "TypedefDecl":
+			typeName := n.Type.QualType
+			if n.OwnedTagDecl != nil && n.OwnedTagDecl.Name == "" {
+				switch {
+				case anonRecord != nil:
+					anonRecord.name = n.Name
+					r.api.records[n.Name] = anonRecord
+					r.api.recordOrder = append(r.api.recordOrder, anonRecord)
+					r.api.nameNested(anonRecord)
+					anonRecord = nil
+				case anonEnum != nil:
+					anonEnum.name = n.Name
+					r.api.enums[n.Name] = anonEnum
+					anonEnum = nil
+				}
+				typeName = unnamedTag.ReplaceAllString(typeName, n.Name)
+			}
+			r.skip(n.Inner)
+			t, err := parseTypeName(r.api, typeName)
+			if err != nil {
+				r.warn(file, line, fmt.Errorf("typedef %s: %v", n.Name, err))
+				continue
+			}
+			r.api.addTypedef(&typedefDecl{name: n.Name, file: file, typ: t})
+		case "FunctionDecl":
+			params := r.params(n.Inner)
+			if n.StorageClass == "static" {
+				continue
+			}
+			t, err := parseTypeName(r.api, n.Type.QualType)
+			if err != nil || t.kind != kindFunc {
+				r.warn(file, line, fmt.Errorf("function %s: cannot parse type %q", n.Name, n.Type.QualType))
+				continue
+			}
+			for i := range t.params {
+				if i < len(params) {
+					t.params[i].name = params[i]
+				}
+			}
+			r.api.addFunc(&funcDecl{name: n.Name, file: file, typ: t})
+		case "VarDecl":
+			if n.StorageClass != "static" || len(n.Inner) == 0 {
+				r.skip(n.Inner)
+				continue
+			}
+			t, err := parseTypeName(r.api, n.Type.QualType)
+			if err != nil {
+				r.skip(n.Inner)
+				continue
+			}
+			v, err := r.eval(n.Inner[0])
+			if err != nil {
+				r.warn(file, line, fmt.Errorf("%s: %v", n.Name, err))
+				continue
+			}
+			r.api.addConst(&constDecl{name: n.Name, file: file, typ: t, value: v})
+		default:
+			r.skip(n.Inner)
+		}
+	}
+	return fields
+}
+
+// fieldType parses the type of a field, which may be the anonymous record
+// declared just before it, as in struct { int a; } inner;. That record is
+// named after the field by api.nameNested once its parent has a name.
+func (r *astReader) fieldType(typeName string, anon *recordDecl) (*cType, error) {
+	if anon == nil || !unnamedTag.MatchString(typeName) {
+		return parseTypeName(r.api, typeName)
+	}
+	const placeholder = "__goffi_gen_anonymous"
+	r.api.records[placeholder] = anon
+	defer delete(r.api.records, placeholder)
+	t, err := parseTypeName(r.api, unnamedTag.ReplaceAllString(typeName, placeholder))
+	if err != nil {
+		return nil, err
+	}
+	inner := t
+	for inner.kind == kindArray {
+		inner = inner.elem
+	}
+	if inner.name == placeholder {
+		inner.name, inner.record = "", anon
+	}
+	return t, nil
+}
+
+// params returns the parameter names of a function and walks its body.
+func (r *astReader) params(nodes []*astNode) []string {
+	var names []string
+	for _, n := range nodes {
+		r.position(n)
+		if n.Kind == "ParmVarDecl" {
+			names = append(names, n.Name)
+		}
+		r.skip(n.Inner)
+	}
+	return names
+}
+
+// skip walks nodes only to keep the current location up to date.
+func (r *astReader) skip(nodes []*astNode) {
+	for _, n := range nodes {
+		r.position(n)
+		r.skip(n.Inner)
+	}
+}
+
+// eval evaluates an integer constant expression node.
+func (r *astReader) eval(n *astNode) (int64, error) {
+	r.position(n)
+	switch n.Kind {
+	case "ConstantExpr", "IntegerLiteral", "CharacterLiteral":
+		if len(n.Value) > 0 {
+			r.skip(n.Inner)
+			var s string
+			if err := json.Unmarshal(n.Value, &s); err != nil {
+				s = string(n.Value) // CharacterLiteral values are numbers
+			}
+			if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return v, nil
+			}
+			if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+				return int64(v), nil
+			}
+			return 0, fmt.Errorf("invalid constant %s", s)
+		}
+	case "DeclRefExpr":
+		if d := n.ReferencedDecl; d != nil {
+			if v, ok := r.api.enumValues[d.Name]; ok {
+				return v, nil
+			}
+			return 0, fmt.Errorf("unknown identifier %s in constant expression", d.Name)
+		}
+	case "UnaryOperator":
+		if len(n.Inner) == 1 {
+			x, err := r.eval(n.Inner[0])
+			switch n.Opcode {
+			case "-":
+				x = -x
+			case "~":
+				x = ^x
+			case "!":
+				x = b2i(x == 0)
+			}
+			return x, err
+		}
+	case "BinaryOperator":
+		if len(n.Inner) == 2 {
+			x, err := r.eval(n.Inner[0])
+			if err != nil {
+				return 0, err
+			}
+			y, err := r.eval(n.Inner[1])
+			if err != nil {
+				return 0, err
+			}
+			return applyBinary(n.Opcode, x, y)
+		}
+	case "ConditionalOperator":
+		if len(n.Inner) == 3 {
+			c, err := r.eval(n.Inner[0])
+			if err != nil {
+				return 0, err
+			}
+			if c != 0 {
+				return r.eval(n.Inner[1])
+			}
+			return r.eval(n.Inner[2])
+		}
+	default:
+		// Casts and parentheses.
+		if len(n.Inner) == 1 {
+			return r.eval(n.Inner[0])
+		}
+	}
+	return 0, fmt.Errorf("unsupported %s in constant expression", n.Kind)
+}
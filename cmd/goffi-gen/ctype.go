@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// typeKind is the category of a C type.
+type typeKind int
+
+const (
+	kindVoid    typeKind = iota
+	kindScalar           // Arithmetic type; name is its canonical spelling
+	kindPointer          // elem is the pointee
+	kindArray            // elem is the element, length the element count
+	kindStruct           // name is the tag, or decl for an anonymous one
+	kindUnion            // Like kindStruct
+	kindEnum             // name is the tag
+	kindNamed            // name is a typedef name
+	kindFunc             // elem is the result, params the parameters
+)
+
+// cType is a C type as written in a declaration.
+type cType struct {
+	kind     typeKind
+	name     string
+	elem     *cType
+	length   int
+	params   []param
+	variadic bool
+	record   *recordDecl // Anonymous struct or union defined in place
+}
+
+// param is a function parameter.
+type param struct {
+	name string
+	typ  *cType
+}
+
+func (t *cType) String() string {
+	switch t.kind {
+	case kindVoid:
+		return "void"
+	case kindPointer:
+		return t.elem.String() + " *"
+	case kindArray:
+		return fmt.Sprintf("%s[%d]", t.elem, t.length)
+	case kindStruct:
+		return "struct " + t.name
+	case kindUnion:
+		return "union " + t.name
+	case kindEnum:
+		return "enum " + t.name
+	case kindFunc:
+		parts := make([]string, len(t.params))
+		for i, p := range t.params {
+			parts[i] = p.typ.String()
+		}
+		if t.variadic {
+			parts = append(parts, "...")
+		}
+		return fmt.Sprintf("%s (%s)", t.elem, strings.Join(parts, ", "))
+	}
+	return t.name
+}
+
+// scalarType describes how a C arithmetic type maps to Go.
+type scalarType struct {
+	goType string
+	desc   string // Go expression for the type descriptor
+}
+
+// scalarTypes maps canonical arithmetic type spellings, and the fixed-size
+// typedefs of <stdint.h> and <stddef.h>, to Go. C long is handled by
+// generator.scalar, since its size depends on the data model.
+var scalarTypes = map[string]scalarType{
+	"bool":               {"bool", "types.BoolTypeDescriptor"},
+	"char":               {"byte", "types.UInt8TypeDescriptor"},
+	"signed char":        {"int8", "types.SInt8TypeDescriptor"},
+	"unsigned char":      {"uint8", "types.UInt8TypeDescriptor"},
+	"short":              {"int16", "types.SInt16TypeDescriptor"},
+	"unsigned short":     {"uint16", "types.UInt16TypeDescriptor"},
+	"int":                {"int32", "types.SInt32TypeDescriptor"},
+	"unsigned int":       {"uint32", "types.UInt32TypeDescriptor"},
+	"long long":          {"int64", "types.SInt64TypeDescriptor"},
+	"unsigned long long": {"uint64", "types.UInt64TypeDescriptor"},
+	"float":              {"float32", "types.FloatTypeDescriptor"},
+	"double":             {"float64", "types.DoubleTypeDescriptor"},
+	"int8_t":             {"int8", "types.SInt8TypeDescriptor"},
+	"uint8_t":            {"uint8", "types.UInt8TypeDescriptor"},
+	"int16_t":            {"int16", "types.SInt16TypeDescriptor"},
+	"uint16_t":           {"uint16", "types.UInt16TypeDescriptor"},
+	"int32_t":            {"int32", "types.SInt32TypeDescriptor"},
+	"uint32_t":           {"uint32", "types.UInt32TypeDescriptor"},
+	"int64_t":            {"int64", "types.SInt64TypeDescriptor"},
+	"uint64_t":           {"uint64", "types.UInt64TypeDescriptor"},
+	"size_t":             {"uintptr", "types.SizeTTypeDescriptor"},
+	"uintptr_t":          {"uintptr", "types.UIntPtrTypeDescriptor"},
+	"ssize_t":            {"int", "types.SSizeTTypeDescriptor"},
+	"ptrdiff_t":          {"int", "types.SSizeTTypeDescriptor"},
+	"intptr_t":           {"int", "types.IntPtrTypeDescriptor"},
+}
+
+// canonicalScalar returns the canonical spelling of the arithmetic type
+// named by the specifier keywords words, in any order.
+func canonicalScalar(words []string) (string, error) {
+	var signed, unsigned, short bool
+	var longs int
+	var base string
+	for _, w := range words {
+		switch w {
+		case "signed":
+			signed = true
+		case "unsigned":
+			unsigned = true
+		case "short":
+			short = true
+		case "long":
+			longs++
+		case "int":
+			if base == "" {
+				base = "int"
+			}
+		case "_Bool", "bool":
+			base = "bool"
+		default:
+			if base != "" && base != "int" {
+				return "", fmt.Errorf("invalid type %q", strings.Join(words, " "))
+			}
+			base = w
+		}
+	}
+	switch base {
+	case "void", "float", "bool":
+		if signed || unsigned || short || longs > 0 {
+			return "", fmt.Errorf("invalid type %q", strings.Join(words, " "))
+		}
+		return base, nil
+	case "double":
+		if longs > 0 {
+			return "", fmt.Errorf("long double is not supported")
+		}
+		return base, nil
+	case "char":
+		switch {
+		case signed:
+			return "signed char", nil
+		case unsigned:
+			return "unsigned char", nil
+		}
+		return "char", nil
+	case "", "int":
+	default:
+		return "", fmt.Errorf("invalid type %q", strings.Join(words, " "))
+	}
+	name := "int"
+	switch {
+	case short:
+		name = "short"
+	case longs == 1:
+		name = "long"
+	case longs >= 2:
+		name = "long long"
+	}
+	if unsigned {
+		name = "unsigned " + name
+	}
+	return name, nil
+}
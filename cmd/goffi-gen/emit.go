@@ -0,0 +1,726 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	gotoken "go/token"
+	"math"
+	"slices"
+	"strings"
+)
+
+// options configures the generated bindings.
+type options struct {
+	pkg      string
+	source   string   // Input file, for the generated-code notice
+	mainFile string   // Declarations from this file are bound when prefixes is empty
+	prefixes []string // Bind the declarations whose names start with one of these
+	trim     []string // Prefixes removed from C names to form Go names
+	llp64    bool     // C long is 32-bit, as on Windows
+}
+
+// generator emits Go bindings for the declarations of an api.
+type generator struct {
+	api  *api
+	opts options
+	warn func(format string, args ...any)
+
+	tagTypedefs map[any]*typedefDecl         // Typedef that names a struct, union or enum
+	names       map[any]string               // Go names of declarations
+	used        map[string]bool              // Go names taken at package level
+	methods     map[string]bool              // Method names of Library
+	checks      map[*recordDecl]*recordCheck // Memoized checkRecord results
+
+	queue []any          // Declarations referenced, still to be emitted
+	code  map[any]string // Emitted declarations
+	funcs []string       // Emitted Library methods
+
+	sigs []string // Signature table entries, in function order
+}
+
+// recordCheck is why a record cannot be mirrored as a Go struct, if it
+// cannot.
+type recordCheck struct {
+	err error
+}
+
+// descriptorName keys the Go name of a record's type descriptor variable.
+type descriptorName struct{ r *recordDecl }
+
+// enumeratorName keys the Go name of an enumerator.
+type enumeratorName struct{ name string }
+
+func newGenerator(a *api, opts options, warn func(format string, args ...any)) *generator {
+	g := &generator{
+		api:         a,
+		opts:        opts,
+		warn:        warn,
+		tagTypedefs: make(map[any]*typedefDecl),
+		names:       make(map[any]string),
+		used:        map[string]bool{"Library": true, "Load": true},
+		methods:     map[string]bool{"Close": true},
+		checks:      make(map[*recordDecl]*recordCheck),
+		code:        make(map[any]string),
+	}
+	for _, td := range a.typedefOrder {
+		if tag := g.tagOf(td.typ); tag != nil && g.tagTypedefs[tag] == nil {
+			g.tagTypedefs[tag] = td
+		}
+	}
+	return g
+}
+
+// generate returns the formatted Go source of the bindings.
+func (g *generator) generate() ([]byte, error) {
+	for _, f := range g.api.funcs {
+		if g.selected(f.name, f.file) {
+			if err := g.function(f); err != nil {
+				g.warn("skipping function %s: %v", f.name, err)
+			}
+		}
+	}
+	for _, e := range g.api.enumOrder {
+		name := e.name
+		if name == "" && len(e.consts) > 0 {
+			name = e.consts[0].name
+		}
+		if g.selected(name, e.file) {
+			g.need(e)
+		}
+	}
+	for _, c := range g.api.consts {
+		if g.selected(c.name, c.file) {
+			g.need(c)
+		}
+	}
+	for _, td := range g.api.typedefOrder {
+		if !g.selected(td.name, td.file) {
+			continue
+		}
+		if tag := g.tagOf(td.typ); tag != nil && g.tagTypedefs[tag] == td {
+			if r, ok := tag.(*recordDecl); ok && !r.complete {
+				continue // Opaque type, used through pointers
+			}
+			g.need(tag)
+			continue
+		}
+		g.need(td)
+	}
+	for _, r := range g.api.recordOrder {
+		if r.complete && r.name != "" && g.selected(r.name, r.file) {
+			g.need(r)
+		}
+	}
+
+	for len(g.queue) > 0 {
+		d := g.queue[0]
+		g.queue = g.queue[1:]
+		code, err := g.emit(d)
+		if err != nil {
+			g.warn("skipping %s: %v", declString(d), err)
+			continue
+		}
+		g.code[d] = code
+	}
+	return g.file()
+}
+
+// selected reports whether the declaration named name, from file, is bound.
+func (g *generator) selected(name, file string) bool {
+	if len(g.opts.prefixes) > 0 {
+		for _, p := range g.opts.prefixes {
+			if strings.HasPrefix(name, p) {
+				return true
+			}
+		}
+		return false
+	}
+	return file == g.opts.mainFile
+}
+
+// need queues a declaration for emission.
+func (g *generator) need(d any) {
+	if _, ok := g.code[d]; ok {
+		return
+	}
+	g.code[d] = "" // Queued, or skipped with a warning
+	g.queue = append(g.queue, d)
+}
+
+// tagOf returns the record or enum a struct, union or enum type refers to.
+func (g *generator) tagOf(t *cType) any {
+	switch t.kind {
+	case kindStruct, kindUnion:
+		if t.record != nil {
+			return t.record
+		}
+		if r, ok := g.api.records[t.name]; ok {
+			return r
+		}
+	case kindEnum:
+		if e, ok := g.api.enums[t.name]; ok {
+			return e
+		}
+	}
+	return nil
+}
+
+func declString(d any) string {
+	switch d := d.(type) {
+	case *typedefDecl:
+		return "typedef " + d.name
+	case *recordDecl:
+		return d.kindName() + " " + d.name
+	case *enumDecl:
+		return "enum " + d.name
+	case *constDecl:
+		return d.name
+	}
+	return fmt.Sprint(d)
+}
+
+// exported turns a C name into an exported Go name, removing the first
+// matching trim prefix.
+func (g *generator) exported(cname string) string {
+	name := cname
+	for _, p := range g.opts.trim {
+		if rest, ok := strings.CutPrefix(name, p); ok && rest != "" && (rest[0] < '0' || rest[0] > '9') {
+			name = rest
+			break
+		}
+	}
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return "X" + cname
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// goName returns the package-level Go name of a declaration.
+func (g *generator) goName(d any) string {
+	if name, ok := g.names[d]; ok {
+		return name
+	}
+	var name string
+	switch d := d.(type) {
+	case *typedefDecl:
+		name = g.exported(d.name)
+	case *recordDecl:
+		name = d.name
+		if td := g.tagTypedefs[d]; td != nil {
+			name = td.name
+		}
+		name = g.exported(name)
+	case *enumDecl:
+		name = d.name
+		if td := g.tagTypedefs[d]; td != nil {
+			name = td.name
+		}
+		name = g.exported(name)
+	case *constDecl:
+		name = g.exported(d.name)
+	case enumeratorName:
+		name = g.exported(d.name)
+	case descriptorName:
+		name = g.goName(d.r) + "TypeDescriptor"
+	}
+	for g.used[name] {
+		name += "_"
+	}
+	g.used[name] = true
+	g.names[d] = name
+	return name
+}
+
+// scalar returns the Go type and descriptor of a C arithmetic type.
+func (g *generator) scalar(name string) (scalarType, bool) {
+	switch name {
+	case "long":
+		if g.opts.llp64 {
+			return scalarType{"int32", "types.SInt32TypeDescriptor"}, true
+		}
+		name = "ssize_t"
+	case "unsigned long":
+		if g.opts.llp64 {
+			return scalarType{"uint32", "types.UInt32TypeDescriptor"}, true
+		}
+		name = "size_t"
+	}
+	st, ok := scalarTypes[name]
+	return st, ok
+}
+
+// goType returns the Go spelling of t and queues the declarations it
+// refers to.
+func (g *generator) goType(t *cType) (string, error) {
+	switch t.kind {
+	case kindScalar:
+		if st, ok := g.scalar(t.name); ok {
+			return st.goType, nil
+		}
+		return "", fmt.Errorf("unsupported type %s", t.name)
+	case kindNamed:
+		if st, ok := g.scalar(t.name); ok {
+			return st.goType, nil
+		}
+		td, ok := g.api.typedefs[t.name]
+		if !ok {
+			return "", fmt.Errorf("unknown type %s", t.name)
+		}
+		if tag := g.tagOf(td.typ); tag != nil && g.tagTypedefs[tag] == td {
+			return g.goType(td.typ)
+		}
+		switch g.api.resolve(t).kind {
+		case kindFunc:
+			return "", fmt.Errorf("%s is a function type", t.name)
+		case kindVoid:
+			return "", fmt.Errorf("%s is void", t.name)
+		}
+		if _, err := g.goType(td.typ); err != nil {
+			return "", fmt.Errorf("%s: %w", t.name, err)
+		}
+		g.need(td)
+		return g.goName(td), nil
+	case kindPointer:
+		switch elem := g.api.resolve(t.elem); elem.kind {
+		case kindFunc:
+			return "uintptr", nil
+		case kindVoid, kindPointer, kindArray:
+			return "unsafe.Pointer", nil
+		}
+		if s, err := g.goType(t.elem); err == nil {
+			return "*" + s, nil
+		}
+		return "unsafe.Pointer", nil
+	case kindArray:
+		if t.length < 0 {
+			return "", errors.New("arrays of unknown size are not supported")
+		}
+		s, err := g.goType(t.elem)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[%d]%s", t.length, s), nil
+	case kindStruct, kindUnion:
+		r, _ := g.tagOf(t).(*recordDecl)
+		if r == nil {
+			return "", fmt.Errorf("%s is incomplete", t)
+		}
+		if err := g.checkRecord(r); err != nil {
+			return "", fmt.Errorf("%s: %w", t, err)
+		}
+		g.need(r)
+		return g.goName(r), nil
+	case kindEnum:
+		e, _ := g.tagOf(t).(*enumDecl)
+		if e == nil {
+			return "", fmt.Errorf("%s is incomplete", t)
+		}
+		g.need(e)
+		return g.goName(e), nil
+	case kindVoid:
+		return "", errors.New("void is not a value type")
+	}
+	return "", fmt.Errorf("unsupported type %s", t)
+}
+
+// checkRecord reports why r cannot be mirrored as a Go struct, if it
+// cannot. A record being checked counts as valid, so that records may
+// point to themselves.
+func (g *generator) checkRecord(r *recordDecl) error {
+	if c, ok := g.checks[r]; ok {
+		return c.err
+	}
+	c := &recordCheck{}
+	g.checks[r] = c
+	switch {
+	case !r.complete:
+		c.err = errors.New("incomplete type")
+	case r.union:
+		c.err = errors.New("unions are not supported")
+	case r.bad != "":
+		c.err = errors.New(r.bad)
+	case len(r.fields) == 0:
+		c.err = errors.New("empty structs are not supported")
+	}
+	for _, f := range r.fields {
+		if c.err != nil {
+			break
+		}
+		if _, err := g.goType(f.typ); err != nil {
+			c.err = fmt.Errorf("field %s: %w", f.name, err)
+		}
+	}
+	return c.err
+}
+
+// descriptor returns the Go expression for the type descriptor of t, a
+// type goType accepted.
+func (g *generator) descriptor(t *cType) string {
+	switch t.kind {
+	case kindScalar:
+		st, _ := g.scalar(t.name)
+		return st.desc
+	case kindNamed:
+		if st, ok := g.scalar(t.name); ok {
+			return st.desc
+		}
+		return g.descriptor(g.api.typedefs[t.name].typ)
+	case kindStruct:
+		return g.goName(descriptorName{g.tagOf(t).(*recordDecl)})
+	case kindEnum:
+		_, desc := g.enumType(g.tagOf(t).(*enumDecl))
+		return desc
+	}
+	return "types.PointerTypeDescriptor"
+}
+
+// enumType returns the Go type and descriptor of the smallest integer type
+// that holds the values of e, the way compilers choose the type of an enum.
+func (g *generator) enumType(e *enumDecl) (goType, desc string) {
+	lo, hi := int64(0), int64(0)
+	for _, c := range e.consts {
+		lo, hi = min(lo, c.value), max(hi, c.value)
+	}
+	switch {
+	case lo >= math.MinInt32 && hi <= math.MaxInt32:
+		return "int32", "types.SInt32TypeDescriptor"
+	case lo >= 0 && hi <= math.MaxUint32:
+		return "uint32", "types.UInt32TypeDescriptor"
+	case lo < 0:
+		return "int64", "types.SInt64TypeDescriptor"
+	}
+	return "uint64", "types.UInt64TypeDescriptor"
+}
+
+// emit returns the Go declaration of d.
+func (g *generator) emit(d any) (string, error) {
+	var b strings.Builder
+	switch d := d.(type) {
+	case *enumDecl:
+		if d.name == "" {
+			b.WriteString("const (\n")
+			for _, c := range d.consts {
+				fmt.Fprintf(&b, "\t%s = %d\n", g.goName(enumeratorName{c.name}), c.value)
+			}
+			b.WriteString(")\n")
+			break
+		}
+		name := g.goName(d)
+		underlying, _ := g.enumType(d)
+		fmt.Fprintf(&b, "// %s is enum %s.\ntype %s %s\n", name, d.name, name, underlying)
+		if len(d.consts) > 0 {
+			b.WriteString("\nconst (\n")
+			for _, c := range d.consts {
+				fmt.Fprintf(&b, "\t%s %s = %d\n", g.goName(enumeratorName{c.name}), name, c.value)
+			}
+			b.WriteString(")\n")
+		}
+
+	case *constDecl:
+		typ, unsigned := "", d.unsigned
+		if d.typ != nil {
+			var err error
+			if typ, err = g.goType(d.typ); err != nil || typ == "unsafe.Pointer" || typ == "bool" {
+				typ = ""
+			}
+			if st, ok := g.scalar(g.api.resolve(d.typ).name); ok && strings.HasPrefix(st.goType, "u") {
+				unsigned = true
+			}
+		}
+		value := fmt.Sprint(d.value)
+		if unsigned && d.value < 0 {
+			value = fmt.Sprint(uint64(d.value))
+		}
+		if name := g.goName(d); name != d.name {
+			fmt.Fprintf(&b, "// %s is %s.\n", name, d.name)
+		}
+		fmt.Fprintf(&b, "const %s %s = %s\n", g.goName(d), typ, value)
+
+	case *typedefDecl:
+		if _, ok := scalarTypes[d.name]; ok {
+			return "", nil
+		}
+		name := g.goName(d)
+		switch resolved := g.api.resolve(d.typ); {
+		case resolved.kind == kindFunc:
+			return "", errors.New("function types are not supported; use a pointer to one")
+		case g.api.isFuncPointer(d.typ):
+			fmt.Fprintf(&b, "// %s is %s, a C function pointer such as a callback made with\n// ffi.NewCallback.\ntype %s uintptr\n", name, d.name, name)
+			return b.String(), nil
+		}
+		typ, err := g.goType(d.typ)
+		if err != nil {
+			return "", err
+		}
+		if g.tagOf(d.typ) != nil {
+			fmt.Fprintf(&b, "// %s is %s.\ntype %s = %s\n", name, d.name, name, typ)
+		} else {
+			fmt.Fprintf(&b, "// %s is %s.\ntype %s %s\n", name, d.name, name, typ)
+		}
+
+	case *recordDecl:
+		if err := g.checkRecord(d); err != nil {
+			return "", err
+		}
+		name := g.goName(d)
+		fmt.Fprintf(&b, "// %s is %s %s.\ntype %s struct {\n", name, d.kindName(), d.name, name)
+		fields := make(map[string]bool)
+		for _, f := range d.fields {
+			typ, err := g.goType(f.typ)
+			if err != nil {
+				return "", err
+			}
+			fname := g.exported(f.name)
+			for fields[fname] {
+				fname += "_"
+			}
+			fields[fname] = true
+			fmt.Fprintf(&b, "\t%s %s\n", fname, typ)
+		}
+		desc := g.goName(descriptorName{d})
+		fmt.Fprintf(&b, "}\n\n// %s describes %s in call interfaces.\nvar %s = &types.TypeDescriptor{\n\tKind: types.StructType,\n\tMembers: %s,\n}\n",
+			desc, name, desc, g.members(d))
+	}
+	return b.String(), nil
+}
+
+// members returns the expression for the members of a struct descriptor.
+// An array contributes one member per element.
+func (g *generator) members(r *recordDecl) string {
+	var groups, items []string
+	flush := func() {
+		if len(items) > 0 {
+			groups = append(groups, "[]*types.TypeDescriptor{"+strings.Join(items, ", ")+"}")
+			items = nil
+		}
+	}
+	for _, f := range r.fields {
+		t, n := g.api.resolve(f.typ), 1
+		for t.kind == kindArray {
+			n *= t.length
+			t = g.api.resolve(t.elem)
+		}
+		switch desc := g.descriptor(t); n {
+		case 0:
+		case 1:
+			items = append(items, desc)
+		default:
+			flush()
+			groups = append(groups, fmt.Sprintf("slices.Repeat([]*types.TypeDescriptor{%s}, %d)", desc, n))
+		}
+	}
+	if len(groups) == 0 {
+		return "[]*types.TypeDescriptor{\n" + strings.Join(items, ",\n") + ",\n}"
+	}
+	flush()
+	return "slices.Concat(\n" + strings.Join(groups, ",\n") + ",\n)"
+}
+
+// goKeywords are the names a parameter cannot have, or must not shadow in
+// the generated wrappers.
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true,
+	"goto": true, "if": true, "import": true, "interface": true, "map": true, "package": true,
+	"range": true, "return": true, "select": true, "struct": true, "switch": true, "type": true,
+	"var": true, "l": true, "r": true, "err": true, "unsafe": true, "ffi": true, "types": true,
+	"bool": true, "byte": true, "int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+	"float32": true, "float64": true, "string": true, "len": true, "nil": true,
+}
+
+// function emits the Library method and signature for a C function.
+func (g *generator) function(f *funcDecl) error {
+	t := f.typ
+	if t.variadic {
+		return errors.New("variadic functions need a call interface per call; use ffi.PrepareVariadicCallInterface")
+	}
+	var params, args, descs []string
+	seen := make(map[string]bool)
+	for i, p := range t.params {
+		typ, err := g.goType(p.typ)
+		if err != nil {
+			return fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+		name := p.name
+		if name == "" {
+			name = fmt.Sprintf("p%d", i)
+		}
+		for goKeywords[name] || seen[name] || g.used[name] {
+			name += "_"
+		}
+		seen[name] = true
+		params = append(params, name+" "+typ)
+		args = append(args, "unsafe.Pointer(&"+name+")")
+		descs = append(descs, g.descriptor(p.typ))
+	}
+
+	ret, retDesc := "", "types.VoidTypeDescriptor"
+	if g.api.resolve(t.elem).kind != kindVoid {
+		var err error
+		if ret, err = g.goType(t.elem); err != nil {
+			return fmt.Errorf("result: %w", err)
+		}
+		retDesc = g.descriptor(t.elem)
+	}
+
+	method := g.exported(f.name)
+	for g.methods[method] {
+		method += "_"
+	}
+	g.methods[method] = true
+
+	index := len(g.sigs)
+	argList := "nil"
+	if len(descs) > 0 {
+		argList = "[]*types.TypeDescriptor{" + strings.Join(descs, ", ") + "}"
+	}
+	g.sigs = append(g.sigs, fmt.Sprintf("{%q, %s, %s},", f.name, retDesc, argList))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s calls %s.\n", method, f.name)
+	call := fmt.Sprintf("l.fns[%d].call(%%s%s)", index, strings.Join(slices.Insert(args, 0, ""), ", "))
+	if ret == "" {
+		fmt.Fprintf(&b, "func (l *Library) %s(%s) error {\n\treturn %s\n}\n", method, strings.Join(params, ", "), fmt.Sprintf(call, "nil"))
+	} else {
+		fmt.Fprintf(&b, "func (l *Library) %s(%s) (%s, error) {\n\tvar r %s\n\terr := %s\n\treturn r, err\n}\n",
+			method, strings.Join(params, ", "), ret, ret, fmt.Sprintf(call, "unsafe.Pointer(&r)"))
+	}
+	g.funcs = append(g.funcs, b.String())
+	return nil
+}
+
+// libraryCode is the Library type every binding with functions shares.
+const libraryCode = `// Library is a loaded library with its functions bound.
+type Library struct {
+	handle unsafe.Pointer
+	fns    [len(signatures)]binding
+}
+
+// binding is a function of the library and its prepared call interface.
+type binding struct {
+	fn  unsafe.Pointer
+	cif types.CallInterface
+	err error // Why fn is nil
+}
+
+// signature is the C name and type of a bound function.
+type signature struct {
+	name string
+	ret  *types.TypeDescriptor
+	args []*types.TypeDescriptor
+}
+
+// Load loads the library and binds its functions. A function the library
+// does not export is not an error until it is called, so that one binding
+// serves several versions of the library.
+func Load(name string) (*Library, error) {
+	handle, err := ffi.LoadLibrary(name)
+	if err != nil {
+		return nil, err
+	}
+	l := &Library{handle: handle}
+	for i, s := range signatures {
+		b := &l.fns[i]
+		if err := ffi.PrepareCallInterface(&b.cif, types.DefaultCall, s.ret, s.args); err != nil {
+			_ = ffi.FreeLibrary(handle)
+			return nil, fmt.Errorf("%s: %w", s.name, err)
+		}
+		b.fn, b.err = ffi.GetSymbol(handle, s.name)
+	}
+	return l, nil
+}
+
+// Close unloads the library. The Library must not be used afterwards.
+func (l *Library) Close() error {
+	return ffi.FreeLibrary(l.handle)
+}
+
+func (b *binding) call(rvalue unsafe.Pointer, avalue ...unsafe.Pointer) error {
+	if b.fn == nil {
+		return b.err
+	}
+	return ffi.CallFunction(&b.cif, b.fn, rvalue, avalue)
+}
+`
+
+// file assembles and formats the generated file.
+func (g *generator) file() ([]byte, error) {
+	var body bytes.Buffer
+	write := func(s string) {
+		if s != "" {
+			body.WriteString(s)
+			body.WriteString("\n")
+		}
+	}
+	for _, td := range g.api.typedefOrder {
+		write(g.code[td])
+	}
+	for _, e := range g.api.enumOrder {
+		write(g.code[e])
+	}
+	for _, c := range g.api.consts {
+		write(g.code[c])
+	}
+	for _, r := range g.api.recordOrder {
+		write(g.code[r])
+	}
+	if len(g.funcs) > 0 {
+		write(libraryCode)
+		write("var signatures = [...]signature{\n" + strings.Join(g.sigs, "\n") + "\n}\n")
+		for _, f := range g.funcs {
+			write(f)
+		}
+	}
+
+	// Import what the body uses.
+	fset := gotoken.NewFileSet()
+	f, err := goparser.ParseFile(fset, "", "package p\n"+body.String(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("generated code does not parse: %w", err)
+	}
+	imports := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				imports[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by goffi-gen from %s; DO NOT EDIT.\n\npackage %s\n\n", g.opts.source, g.opts.pkg)
+	if len(imports) > 0 {
+		out.WriteString("import (\n")
+	}
+	for _, p := range []string{"fmt", "slices", "unsafe"} {
+		if imports[p] {
+			fmt.Fprintf(&out, "\t%q\n", p)
+		}
+	}
+	out.WriteString("\n")
+	for _, p := range []string{"ffi", "types"} {
+		if imports[p] {
+			fmt.Fprintf(&out, "\t\"github.com/go-webgpu/goffi/%s\"\n", p)
+		}
+	}
+	if len(imports) > 0 {
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
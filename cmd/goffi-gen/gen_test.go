@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseHeader(t *testing.T) {
+	src, err := os.ReadFile("testdata/demo.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := newAPI()
+	parseHeader(a, lex(string(src), "demo.h"), func(file string, line int, err error) {
+		t.Errorf("%s:%d: %v", file, line, err)
+	})
+
+	if r := a.records["DemoShape"]; r == nil || !r.complete || len(r.fields) != 6 {
+		t.Fatalf("DemoShape = %+v, want a complete struct with 6 fields", r)
+	} else if f := r.fields[0]; f.typ.kind != kindArray || f.typ.length != 4 {
+		t.Errorf("DemoShape.corners = %v, want an array of DEMO_MAX elements", f.typ)
+	}
+	if r := a.records["DemoShape_color"]; r == nil || len(r.fields) != 2 {
+		t.Errorf("anonymous member struct = %+v, want DemoShape_color with 2 fields", r)
+	}
+	if r := a.records["DemoBits"]; r == nil || r.bad == "" {
+		t.Errorf("DemoBits = %+v, want it marked as having bit-fields", r)
+	}
+	for name, want := range map[string]int64{
+		"DemoMode_On":      1,
+		"DemoMode_Force32": 0x7FFFFFFF,
+		"DEMO_LIMIT":       8,
+		"DemoFlags_Write":  2,
+	} {
+		if got, ok := a.enumValues[name]; !ok || got != want {
+			t.Errorf("%s = %d, %v, want %d", name, got, ok, want)
+		}
+	}
+	if !a.isFuncPointer(&cType{kind: kindNamed, name: "DemoCallback"}) {
+		t.Errorf("DemoCallback = %v, want a function pointer", a.typedefs["DemoCallback"].typ)
+	}
+
+	funcs := make(map[string]*cType)
+	for _, f := range a.funcs {
+		funcs[f.name] = f.typ
+	}
+	if f := funcs["demoPrintf"]; f == nil || !f.variadic {
+		t.Errorf("demoPrintf = %v, want a variadic function", f)
+	}
+	if f := funcs["demoSum"]; f == nil || len(f.params) != 3 {
+		t.Errorf("demoSum = %v, want 3 parameters", f)
+	} else if !a.isFuncPointer(f.params[2].typ) || f.params[0].typ.kind != kindPointer {
+		t.Errorf("demoSum parameters = %v, %v, want array and function parameters adjusted to pointers",
+			f.params[0].typ, f.params[2].typ)
+	}
+}
+
+func TestParseTypeName(t *testing.T) {
+	a := newAPI()
+	for _, tt := range []struct{ in, want string }{
+		{"unsigned long long", "unsigned long long"},
+		{"const char *", "char *"},
+		{"int (*)(void *, long)", "int (void *, long) *"},
+		{"void (*(*)(int))(double)", "void (double) * (int) *"},
+		{"char *(const char *, int)", "char * (char *, int)"},
+		{"float [3][4]", "float[4][3]"},
+	} {
+		got, err := parseTypeName(a, tt.in)
+		if err != nil {
+			t.Errorf("parseTypeName(%q): %v", tt.in, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("parseTypeName(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEvalConst(t *testing.T) {
+	values := map[string]int64{"A": 3}
+	for _, tt := range []struct {
+		in   string
+		want int64
+	}{
+		{"1 << 4 | A", 19},
+		{"(uint32_t)-1 & 0xff", 255},
+		{"-(A + 1) * 2", -8},
+		{"A > 2 ? 'x' : 0", 'x'},
+		{"0x7FFFFFFFul", 0x7FFFFFFF},
+	} {
+		got, err := evalConst(lex(tt.in, ""), values)
+		if err != nil || got != tt.want {
+			t.Errorf("evalConst(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+// generateFile runs the generator, whose output go/format has accepted.
+func generateFile(t *testing.T, input, cpp string, opts options) string {
+	t.Helper()
+	var log bytes.Buffer
+	src, err := bind(input, cpp, nil, opts, false, &log)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, log.Bytes())
+	}
+	t.Logf("warnings:\n%s", log.Bytes())
+	return string(src)
+}
+
+func TestGenerate(t *testing.T) {
+	src := generateFile(t, "testdata/demo.h", "", options{pkg: "demo", source: "demo.h", trim: []string{"demo", "Demo"}})
+	for _, want := range []string{
+		"type Mode int32",
+		"Mode_Force32 Mode = 2147483647",
+		"const Flags_Write Flags = 2",
+		"const DEMO_WHOLE_SIZE = 18446744073709551615",
+		"type Context unsafe.Pointer",
+		"type Callback uintptr",
+		"Corners [4]Point",
+		"Color   Shape_color",
+		"slices.Repeat([]*types.TypeDescriptor{PointTypeDescriptor}, 4)",
+		`{"demoAdd", PointTypeDescriptor, []*types.TypeDescriptor{PointTypeDescriptor, PointTypeDescriptor}},`,
+		"func (l *Library) Area(shape *Shape) (float64, error)",
+		"func (l *Library) Destroy(ctx Context) error",
+		"func (l *Library) Sum(values *int, n uintptr, filter uintptr) (int, error)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code lacks %q", want)
+		}
+	}
+	for _, skipped := range []string{"Printf", "DemoBits", "DEMO_NAME"} {
+		if strings.Contains(src, skipped) {
+			t.Errorf("generated code has %s, which it cannot express", skipped)
+		}
+	}
+}
+
+func TestGenerateFromAST(t *testing.T) {
+	src := generateFile(t, "testdata/ast.json", "", options{pkg: "ast", source: "ast.json", trim: []string{"ast", "Ast"}})
+	for _, want := range []string{
+		"Kind_B Kind = 4",
+		"Kind_C Kind = 5",
+		"Inner Item_inner",
+		"func (l *Library) Scale(v Vec, s float32) (Vec, error)",
+		"func (l *Library) Use(item *Item, kind Kind) error",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code lacks %q", want)
+		}
+	}
+	if strings.Contains(src, "stdint_helper") {
+		t.Error("generated code binds a function from an included header")
+	}
+}
+
+// TestGeneratedBindings builds bindings for a few libc functions and calls
+// them.
+func TestGeneratedBindings(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a program")
+	}
+	if runtime.GOOS != "linux" || runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("needs glibc on linux/amd64 or linux/arm64")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	root, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := generateFile(t, "testdata/libc.h", defaultCPP(), options{pkg: "libc", source: "libc.h"})
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module gentest\n\ngo 1.25\n\nrequire github.com/go-webgpu/goffi v0.0.0\n\n" +
+			"replace github.com/go-webgpu/goffi => " + root + "\n",
+		"libc/libc.go": src,
+		"main.go": `package main
+
+import (
+	"fmt"
+
+	"gentest/libc"
+)
+
+func main() {
+	lib, err := libc.Load("libc.so.6")
+	if err != nil {
+		panic(err)
+	}
+	defer lib.Close()
+	s := []byte("generated\x00")
+	n, err1 := lib.Strlen(&s[0])
+	a, err2 := lib.Abs(-7)
+	d, err3 := lib.Div(17, 5)
+	fmt.Println(n, a, d.Quot, d.Rem, err1, err2, err3)
+}
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goTool, "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run: %v\n%s", err, out)
+	}
+	if got, want := strings.TrimSpace(string(out)), "9 7 3 2 <nil> <nil> <nil>"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,415 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tokenKind is the lexical category of a token.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString // String or character literal
+	tokPunct
+	tokDefine // Object-like macro; text is its name, body its replacement
+)
+
+// token is a C token and the file it comes from, per line markers.
+type token struct {
+	kind tokenKind
+	text string
+	file string
+	line int
+	body []token // tokDefine only
+}
+
+// lex splits C source into tokens. Comments are dropped, and so are
+// preprocessor directives, except that line markers (# 12 "file.h", as
+// written by cc -E) set the file of the tokens that follow, and that
+// object-like macro definitions become tokDefine tokens.
+func lex(src, file string) []token {
+	var toks []token
+	line := 1
+	atLineStart := true
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			atLineStart = true
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+			continue
+		case c == '\\' && i+1 < len(src) && src[i+1] == '\n':
+			line++
+			i += 2
+			continue
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				end = len(src) - i - 2
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+			continue
+		case c == '#' && atLineStart:
+			j := i
+			for j < len(src) && (src[j] != '\n' || src[j-1] == '\\') {
+				j++
+			}
+			directive := strings.ReplaceAll(src[i+1:j], "\\\n", "")
+			line += strings.Count(src[i:j], "\n")
+			if f, l, ok := lineMarker(directive); ok {
+				file, line = f, l-1
+			} else if name, body, ok := objectMacro(directive); ok {
+				toks = append(toks, token{kind: tokDefine, text: name, file: file, line: line, body: lex(body, file)})
+			}
+			i = j
+			continue
+		}
+		atLineStart = false
+
+		start := i
+		kind := tokPunct
+		switch {
+		case isIdentStart(c):
+			for i < len(src) && isIdentChar(src[i]) {
+				i++
+			}
+			kind = tokIdent
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			for i < len(src) && (isIdentChar(src[i]) || src[i] == '.' ||
+				(src[i] == '+' || src[i] == '-') && (src[i-1] == 'e' || src[i-1] == 'E' || src[i-1] == 'p' || src[i-1] == 'P')) {
+				i++
+			}
+			kind = tokNumber
+		case c == '"' || c == '\'':
+			i++
+			for i < len(src) && src[i] != c && src[i] != '\n' {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			kind = tokString
+		default:
+			i++
+			for _, p := range []string{"...", "<<", ">>", "->", "==", "!=", "<=", ">=", "&&", "||", "##"} {
+				if strings.HasPrefix(src[start:], p) {
+					i = start + len(p)
+					break
+				}
+			}
+		}
+		i = min(i, len(src))
+		toks = append(toks, token{kind: kind, text: src[start:i], file: file, line: line})
+	}
+	return append(toks, token{kind: tokEOF, file: file, line: line})
+}
+
+// lineMarker parses the directive (without '#') as a line marker.
+func lineMarker(directive string) (file string, line int, ok bool) {
+	fields := strings.Fields(directive)
+	if len(fields) > 0 && fields[0] == "line" {
+		fields = fields[1:]
+	}
+	if len(fields) < 2 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return "", 0, false
+	}
+	rest := strings.TrimSpace(directive[strings.Index(directive, fields[1]):])
+	if !strings.HasPrefix(rest, `"`) {
+		return "", 0, false
+	}
+	end := strings.IndexByte(rest[1:], '"')
+	if end < 0 {
+		return "", 0, false
+	}
+	return rest[1 : end+1], n, true
+}
+
+// objectMacro parses the directive (without '#') as the definition of an
+// object-like macro.
+func objectMacro(directive string) (name, body string, ok bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(directive), "define")
+	if !ok || rest == "" || rest[0] != ' ' && rest[0] != '\t' {
+		return "", "", false
+	}
+	rest = strings.TrimLeft(rest, " \t")
+	i := 0
+	for i < len(rest) && isIdentChar(rest[i]) {
+		i++
+	}
+	if i == 0 || i < len(rest) && rest[i] == '(' {
+		return "", "", false // Function-like macro
+	}
+	return rest[:i], rest[i:], true
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || c >= '0' && c <= '9'
+}
+
+// evalConst evaluates an integer constant expression, looking identifiers
+// up in values. It handles literals, enumerators, casts to arithmetic
+// types, and the unary and binary integer operators headers use to define
+// enumerators and array sizes.
+func evalConst(toks []token, values map[string]int64) (int64, error) {
+	v, _, err := evalConstSigned(toks, values)
+	return v, err
+}
+
+// evalConstSigned is evalConst that also reports whether the expression is
+// unsigned, having an unsigned literal or cast, so that a negative result
+// stands for a value above math.MaxInt64.
+func evalConstSigned(toks []token, values map[string]int64) (v int64, unsigned bool, err error) {
+	if len(toks) > 0 && toks[len(toks)-1].kind == tokEOF {
+		toks = toks[:len(toks)-1]
+	}
+	e := &evaluator{toks: toks, values: values}
+	if v, err = e.binary(0); err != nil {
+		return 0, false, err
+	}
+	if e.pos != len(e.toks) {
+		return 0, false, fmt.Errorf("unexpected %q in constant expression", e.toks[e.pos].text)
+	}
+	return v, e.unsigned, nil
+}
+
+// evaluator is a precedence-climbing evaluator over a token slice.
+type evaluator struct {
+	toks     []token
+	pos      int
+	values   map[string]int64
+	unsigned bool // An unsigned literal or cast was seen
+}
+
+// binaryPrec is the precedence of the binary operators evalConst handles.
+var binaryPrec = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10,
+}
+
+func (e *evaluator) peek() string {
+	if e.pos < len(e.toks) {
+		return e.toks[e.pos].text
+	}
+	return ""
+}
+
+func (e *evaluator) binary(minPrec int) (int64, error) {
+	x, err := e.unary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := e.peek()
+		prec, ok := binaryPrec[op]
+		if !ok || prec <= minPrec || e.toks[e.pos].kind != tokPunct {
+			if op == "?" && minPrec == 0 {
+				e.pos++
+				a, err := e.binary(0)
+				if err != nil {
+					return 0, err
+				}
+				if e.peek() != ":" {
+					return 0, fmt.Errorf("missing ':' in conditional expression")
+				}
+				e.pos++
+				b, err := e.binary(0)
+				if err != nil {
+					return 0, err
+				}
+				if x != 0 {
+					return a, nil
+				}
+				return b, nil
+			}
+			return x, nil
+		}
+		e.pos++
+		y, err := e.binary(prec)
+		if err != nil {
+			return 0, err
+		}
+		if x, err = applyBinary(op, x, y); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// applyBinary applies the C binary operator op to x and y.
+func applyBinary(op string, x, y int64) (int64, error) {
+	switch op {
+	case "||":
+		x = b2i(x != 0 || y != 0)
+	case "&&":
+		x = b2i(x != 0 && y != 0)
+	case "|":
+		x |= y
+	case "^":
+		x ^= y
+	case "&":
+		x &= y
+	case "==":
+		x = b2i(x == y)
+	case "!=":
+		x = b2i(x != y)
+	case "<":
+		x = b2i(x < y)
+	case ">":
+		x = b2i(x > y)
+	case "<=":
+		x = b2i(x <= y)
+	case ">=":
+		x = b2i(x >= y)
+	case "<<":
+		x <<= uint64(y)
+	case ">>":
+		x >>= uint64(y)
+	case "+":
+		x += y
+	case "-":
+		x -= y
+	case "*":
+		x *= y
+	case "/", "%":
+		if y == 0 {
+			return 0, fmt.Errorf("division by zero in constant expression")
+		}
+		if op == "/" {
+			x /= y
+		} else {
+			x %= y
+		}
+	default:
+		return 0, fmt.Errorf("unsupported operator %s in constant expression", op)
+	}
+	return x, nil
+}
+
+func (e *evaluator) unary() (int64, error) {
+	if e.pos >= len(e.toks) {
+		return 0, fmt.Errorf("constant expression ends early")
+	}
+	t := e.toks[e.pos]
+	e.pos++
+	switch {
+	case t.text == "-", t.text == "+", t.text == "~", t.text == "!":
+		x, err := e.unary()
+		switch t.text {
+		case "-":
+			x = -x
+		case "~":
+			x = ^x
+		case "!":
+			x = b2i(x == 0)
+		}
+		return x, err
+	case t.text == "(":
+		// A cast to an arithmetic type, such as (uint32_t)1 or
+		// (unsigned long long)-1, or a parenthesized expression.
+		j := e.pos
+		for j < len(e.toks) && e.toks[j].kind == tokIdent && isCastWord(e.toks[j].text) {
+			j++
+		}
+		if j > e.pos && j < len(e.toks) && e.toks[j].text == ")" {
+			for _, w := range e.toks[e.pos:j] {
+				if w.text == "unsigned" || strings.HasPrefix(w.text, "uint") || w.text == "size_t" {
+					e.unsigned = true
+				}
+			}
+			e.pos = j + 1
+			return e.unary()
+		}
+		x, err := e.binary(0)
+		if err != nil {
+			return 0, err
+		}
+		if e.peek() != ")" {
+			return 0, fmt.Errorf("missing ')' in constant expression")
+		}
+		e.pos++
+		return x, nil
+	case t.kind == tokNumber:
+		if strings.ContainsAny(t.text, "uU") {
+			e.unsigned = true
+		}
+		v, err := parseIntLiteral(t.text)
+		if v < 0 {
+			e.unsigned = true // Above math.MaxInt64
+		}
+		return v, err
+	case t.kind == tokString && strings.HasPrefix(t.text, "'"):
+		s, err := strconv.Unquote(t.text)
+		if err != nil || len(s) == 0 {
+			return 0, fmt.Errorf("invalid character constant %s", t.text)
+		}
+		return int64(s[0]), nil
+	case t.kind == tokIdent:
+		if v, ok := e.values[t.text]; ok {
+			return v, nil
+		}
+		return 0, fmt.Errorf("unknown identifier %s in constant expression", t.text)
+	}
+	return 0, fmt.Errorf("unexpected %q in constant expression", t.text)
+}
+
+// isCastWord reports whether word can be part of a cast's type name.
+func isCastWord(word string) bool {
+	switch word {
+	case "signed", "unsigned", "char", "short", "int", "long", "_Bool", "bool", "const":
+		return true
+	}
+	_, ok := scalarTypes[word]
+	return ok
+}
+
+// parseIntLiteral parses a C integer literal with an optional U/L suffix.
+func parseIntLiteral(s string) (int64, error) {
+	digits := strings.TrimRight(s, "uUlL")
+	base := 10
+	switch {
+	case strings.HasPrefix(digits, "0x"), strings.HasPrefix(digits, "0X"):
+		base, digits = 16, digits[2:]
+	case strings.HasPrefix(digits, "0b"), strings.HasPrefix(digits, "0B"):
+		base, digits = 2, digits[2:]
+	case len(digits) > 1 && digits[0] == '0':
+		base, digits = 8, digits[1:]
+	}
+	u, err := strconv.ParseUint(digits, base, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer constant %s", s)
+	}
+	return int64(u), nil
+}
+
+func b2i(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
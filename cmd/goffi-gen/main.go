@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+// Command goffi-gen generates Go bindings for a C library from its header,
+// or from the JSON AST clang dumps for it. The bindings hold a Go type and
+// a TypeDescriptor for each struct, typed constants for enums, and a
+// Library type whose Load prepares a call interface for every function and
+// whose methods call them:
+//
+//	goffi-gen -pkg wgpu -prefix wgpu,WGPU -trim wgpu,WGPU -o wgpu.go webgpu.h
+//
+//	lib, err := wgpu.Load("libwgpu_native.so")
+//	instance, err := lib.CreateInstance(nil)
+//
+// The header is preprocessed with "cc -E -dD" when a C compiler is installed,
+// and read as it is otherwise, which suits headers whose macros are only
+// annotations. For headers the built-in parser does not follow, pass clang's
+// dump instead:
+//
+//	clang -Xclang -ast-dump=json -fsyntax-only webgpu.h > webgpu.json
+//	goffi-gen -pkg wgpu -prefix wgpu,WGPU webgpu.json
+//
+// Without -prefix, the declarations of the named header are bound, and not
+// those of the headers it includes. Types they use are bound as well,
+// wherever they come from. Declarations that cannot be expressed, such as
+// variadic functions, unions and bit-fields, are skipped with a warning;
+// pointers to them become unsafe.Pointer.
+//
+// C long follows the pointer size, as on Linux and macOS; -llp64 makes it
+// 32-bit, as on Windows.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// listFlag is a flag that may be repeated or hold a comma-separated list.
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(s string) error {
+	for v := range strings.SplitSeq(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+func main() {
+	var (
+		opts     options
+		includes listFlag
+		defines  listFlag
+		out      = flag.String("o", "", "write the bindings to `file` instead of standard output")
+		cpp      = flag.String("cpp", defaultCPP(), "preprocessor `command`; empty reads the header as it is")
+		verbose  = flag.Bool("v", false, "also report declarations skipped in included headers")
+	)
+	flag.StringVar(&opts.pkg, "pkg", "", "package `name` of the bindings (default: from -o, or the input file)")
+	flag.Var((*listFlag)(&opts.prefixes), "prefix", "bind declarations whose names have one of these `prefixes`")
+	flag.Var((*listFlag)(&opts.trim), "trim", "remove these `prefixes` from C names to form Go names")
+	flag.BoolVar(&opts.llp64, "llp64", false, "C long is 32-bit, as on Windows")
+	flag.Var(&includes, "I", "add `dir` to the preprocessor's include path")
+	flag.Var(&defines, "D", "define `macro` for the preprocessor")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: goffi-gen [flags] header.h|ast.json\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	input := flag.Arg(0)
+	opts.source = filepath.Base(input)
+	if opts.pkg == "" {
+		opts.pkg = packageName(*out, input)
+	}
+
+	var cppArgs []string
+	for _, dir := range includes {
+		cppArgs = append(cppArgs, "-I"+dir)
+	}
+	for _, d := range defines {
+		cppArgs = append(cppArgs, "-D"+d)
+	}
+	src, err := bind(input, *cpp, cppArgs, opts, *verbose, os.Stderr)
+	if err != nil {
+		fatal(err)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		fatal(err)
+	}
+}
+
+// bind reads the header or AST dump input and returns the bindings for it,
+// reporting what it skips to log.
+func bind(input, cpp string, cppArgs []string, opts options, verbose bool, log io.Writer) ([]byte, error) {
+	// Report what the parser skipped once the main file is known.
+	type warning struct {
+		file string
+		line int
+		err  error
+	}
+	var warnings []warning
+	warn := func(file string, line int, err error) {
+		warnings = append(warnings, warning{file, line, err})
+	}
+	a := newAPI()
+	if strings.HasSuffix(input, ".json") {
+		data, err := os.ReadFile(input)
+		if err != nil {
+			return nil, err
+		}
+		if opts.mainFile, err = readAST(a, data, warn); err != nil {
+			return nil, err
+		}
+	} else {
+		src, err := preprocess(cpp, cppArgs, input)
+		if err != nil {
+			return nil, err
+		}
+		opts.mainFile = input
+		parseHeader(a, lex(string(src), input), warn)
+	}
+	for _, w := range warnings {
+		if verbose || w.file == opts.mainFile {
+			fmt.Fprintf(log, "goffi-gen: %s: %v\n", describe(w.file, w.line), w.err)
+		}
+	}
+	g := newGenerator(a, opts, func(format string, args ...any) {
+		fmt.Fprintf(log, "goffi-gen: "+format+"\n", args...)
+	})
+	return g.generate()
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "goffi-gen: %v\n", err)
+	os.Exit(1)
+}
+
+// defaultCPP returns "cc -E -dD", which keeps macro definitions, if a C
+// compiler is installed.
+func defaultCPP() string {
+	if _, err := exec.LookPath("cc"); err == nil {
+		return "cc -E -dD"
+	}
+	return ""
+}
+
+// preprocess returns the header, run through cpp with the extra arguments
+// args unless cpp is empty.
+func preprocess(cpp string, args []string, header string) ([]byte, error) {
+	if cpp == "" {
+		return os.ReadFile(header)
+	}
+	args = append(append(strings.Fields(cpp), args...), header)
+	cmd := exec.Command(args[0], args[1:]...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	src, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w\n%s", cpp, err, stderr.Bytes())
+	}
+	return src, nil
+}
+
+// packageName derives a package name from the output directory or, when
+// writing to standard output, the input file.
+func packageName(out, input string) string {
+	base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+	if out != "" {
+		if abs, err := filepath.Abs(filepath.Dir(out)); err == nil {
+			base = filepath.Base(abs)
+		}
+	}
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return -1
+	}, base)
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "bindings" + name
+	}
+	return name
+}
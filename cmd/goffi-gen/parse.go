@@ -0,0 +1,766 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parser reads the top-level declarations of preprocessed C into an api.
+// It understands what public headers declare (typedefs, structs, unions,
+// enums, static const integers and function prototypes) and skips anything
+// else, such as inline function bodies, declaration by declaration.
+type parser struct {
+	toks []token
+	pos  int
+	api  *api
+	warn func(file string, line int, err error)
+}
+
+// parseError is a syntax error at a token.
+type parseError struct {
+	tok token
+	msg string
+}
+
+func (e *parseError) Error() string { return e.msg }
+
+// parseHeader parses the tokens of a header into a. Macros that expand to
+// integer constant expressions become constants; they are evaluated before
+// the declarations, which may use them, and again after them, since they
+// may use enumerators.
+func parseHeader(a *api, toks []token, warn func(file string, line int, err error)) {
+	var decls, macros []token
+	for _, t := range toks {
+		if t.kind == tokDefine {
+			macros = append(macros, t)
+		} else {
+			decls = append(decls, t)
+		}
+	}
+	macros = defineConsts(a, macros)
+
+	p := &parser{toks: decls, api: a, warn: warn}
+	for p.peek().kind != tokEOF {
+		start := p.pos
+		if err := p.topLevel(); err != nil {
+			tok := p.toks[start]
+			if pe, ok := err.(*parseError); ok {
+				tok = pe.tok
+			}
+			p.warn(tok.file, tok.line, err)
+			p.pos = start
+			p.skipDeclaration()
+		}
+	}
+	defineConsts(a, macros)
+}
+
+// defineConsts adds the macros that evaluate to integers as constants, and
+// returns the others.
+func defineConsts(a *api, macros []token) []token {
+	for progress := true; progress; {
+		progress = false
+		rest := macros[:0:0]
+		for _, m := range macros {
+			if _, ok := a.enumValues[m.text]; ok || len(m.body) <= 1 {
+				continue // Redefined, or empty like an include guard
+			}
+			v, unsigned, err := evalConstSigned(m.body, a.enumValues)
+			if err != nil {
+				rest = append(rest, m)
+				continue
+			}
+			a.addConst(&constDecl{name: m.text, file: m.file, value: v, unsigned: unsigned})
+			progress = true
+		}
+		macros = rest
+	}
+	return macros
+}
+
+// parseTypeName parses a type name such as "const char *" or
+// "void (*)(int)", as clang writes them in AST dumps.
+func parseTypeName(a *api, s string) (*cType, error) {
+	p := &parser{toks: lex(s, ""), api: a, warn: func(string, int, error) {}}
+	base, _, err := p.specifiers()
+	if err != nil {
+		return nil, err
+	}
+	_, t, err := p.declarator(base, true)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokEOF {
+		return nil, p.errorf("unexpected %q in type name %q", p.peek().text, s)
+	}
+	return t, nil
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) peekAt(n int) token {
+	if p.pos+n < len(p.toks) {
+		return p.toks[p.pos+n]
+	}
+	return p.toks[len(p.toks)-1]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(text string) bool {
+	if t := p.peek(); t.kind != tokString && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		return p.errorf("expected %q, found %q", text, p.peek().text)
+	}
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &parseError{tok: p.peek(), msg: fmt.Sprintf(format, args...)}
+}
+
+// skipDeclaration skips to the end of the current declaration: the next
+// ';' outside brackets, or a '}' closing a top-level body.
+func (p *parser) skipDeclaration() {
+	depth := 0
+	for {
+		t := p.next()
+		switch {
+		case t.kind == tokEOF:
+			return
+		case t.kind != tokPunct:
+		case t.text == "(" || t.text == "[" || t.text == "{":
+			depth++
+		case t.text == ")" || t.text == "]":
+			depth--
+		case t.text == "}":
+			depth--
+			if depth <= 0 {
+				p.accept(";")
+				return
+			}
+		case t.text == ";" && depth <= 0:
+			return
+		}
+	}
+}
+
+// skipBalanced skips a bracketed group starting at the current token.
+func (p *parser) skipBalanced() {
+	depth := 0
+	for {
+		t := p.next()
+		if t.kind == tokEOF {
+			return
+		}
+		if t.kind != tokPunct {
+			continue
+		}
+		switch t.text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		}
+		if depth == 0 {
+			return
+		}
+	}
+}
+
+// skipAttributes skips GNU attributes, declspecs and asm labels.
+func (p *parser) skipAttributes() {
+	for {
+		switch p.peek().text {
+		case "__attribute__", "__attribute", "__declspec", "__asm__", "__asm", "asm", "_Alignas", "alignas":
+			p.next()
+			if p.peek().text == "(" {
+				p.skipBalanced()
+			}
+		default:
+			return
+		}
+	}
+}
+
+// topLevel parses one top-level declaration.
+func (p *parser) topLevel() error {
+	switch t := p.peek(); {
+	case t.text == ";" || t.text == "}":
+		p.next()
+		return nil
+	case t.text == "extern" && p.peekAt(1).kind == tokString:
+		// extern "C" { ... } in a header that was not preprocessed as C.
+		p.pos += 2
+		p.accept("{")
+		return nil
+	case t.text == "_Static_assert" || t.text == "static_assert":
+		p.skipDeclaration()
+		return nil
+	}
+
+	file, line := p.peek().file, p.peek().line
+	base, storage, err := p.specifiers()
+	if err != nil {
+		return err
+	}
+	if p.accept(";") {
+		return nil
+	}
+	for {
+		name, t, err := p.declarator(base, false)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return p.errorf("declaration declares nothing")
+		}
+		p.skipAttributes()
+		if t.kind == kindFunc && p.peek().text == "{" {
+			p.skipBalanced() // inline function definition
+			return nil
+		}
+
+		var init []token
+		if p.accept("=") {
+			start, depth := p.pos, 0
+			for t := p.peek(); t.kind != tokEOF && (depth > 0 || t.text != "," && t.text != ";"); t = p.peek() {
+				switch t.text {
+				case "(", "[", "{":
+					depth++
+				case ")", "]", "}":
+					depth--
+				}
+				p.next()
+			}
+			init = p.toks[start:p.pos]
+		}
+
+		switch {
+		case storage == "typedef":
+			p.api.addTypedef(&typedefDecl{name: name, file: file, typ: t})
+			p.nameAnonymous(t, name)
+		case t.kind == kindFunc && storage != "static":
+			p.api.addFunc(&funcDecl{name: name, file: file, typ: t})
+		case storage == "static" && init != nil:
+			if v, unsigned, err := evalConstSigned(init, p.api.enumValues); err == nil {
+				p.api.addConst(&constDecl{name: name, file: file, typ: t, value: v, unsigned: unsigned})
+			} else {
+				p.warn(file, line, fmt.Errorf("%s: %v", name, err))
+			}
+		}
+		if p.accept(";") {
+			return nil
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+	}
+}
+
+// nameAnonymous gives an anonymous struct, union or enum the name of the
+// typedef that declares it, as in typedef struct { ... } Foo;.
+func (p *parser) nameAnonymous(t *cType, name string) {
+	if t.record != nil && t.record.name == "" {
+		t.record.name = name
+		t.name = name
+		if _, ok := p.api.records[name]; !ok {
+			p.api.records[name] = t.record
+			p.api.recordOrder = append(p.api.recordOrder, t.record)
+		}
+		p.api.nameNested(t.record)
+	}
+	if t.kind == kindEnum && t.name == "" && t.length > 0 {
+		e := p.api.enumOrder[t.length-1]
+		e.name = name
+		p.api.enums[name] = e
+		t.name = name
+	}
+}
+
+// isTypeStart reports whether tok can begin declaration specifiers.
+func (p *parser) isTypeStart(tok token) bool {
+	if tok.kind != tokIdent {
+		return false
+	}
+	if _, ok := specifierKeywords[tok.text]; ok {
+		return true
+	}
+	if _, ok := scalarTypes[tok.text]; ok {
+		return true
+	}
+	_, ok := p.api.typedefs[tok.text]
+	return ok
+}
+
+// specifierKeywords are the keywords declaration specifiers are made of,
+// with what they are: storage class, qualifier, type word or tag.
+var specifierKeywords = map[string]string{
+	"typedef": "storage", "extern": "storage", "static": "storage", "register": "storage",
+	"auto": "storage", "inline": "ignore", "__inline": "ignore", "__inline__": "ignore",
+	"_Noreturn": "ignore", "__extension__": "ignore", "_Thread_local": "storage", "__thread": "storage",
+	"const": "ignore", "volatile": "ignore", "restrict": "ignore", "__restrict": "ignore",
+	"__restrict__": "ignore", "__const": "ignore", "__volatile__": "ignore", "_Nonnull": "ignore",
+	"_Nullable": "ignore", "_Null_unspecified": "ignore", "__unaligned": "ignore",
+	"__cdecl": "ignore", "__stdcall": "ignore", "__fastcall": "ignore",
+	"void": "word", "char": "word", "short": "word", "int": "word", "long": "word",
+	"float": "word", "double": "word", "signed": "word", "unsigned": "word",
+	"__signed__": "word", "_Bool": "word", "bool": "word",
+	"struct": "tag", "union": "tag", "enum": "tag",
+	"__attribute__": "attr", "__attribute": "attr", "__declspec": "attr", "_Alignas": "attr", "alignas": "attr",
+}
+
+// specifiers parses declaration specifiers and returns the base type and
+// storage class.
+func (p *parser) specifiers() (base *cType, storage string, err error) {
+	var words []string
+	for {
+		t := p.peek()
+		if t.kind != tokIdent {
+			break
+		}
+		class, isKeyword := specifierKeywords[t.text]
+		if t.text == "_Atomic" {
+			return nil, "", p.errorf("_Atomic types are not supported")
+		}
+		switch {
+		case class == "storage":
+			storage = t.text
+			p.next()
+			continue
+		case class == "ignore":
+			p.next()
+			continue
+		case class == "attr":
+			p.skipAttributes()
+			continue
+		case class == "word":
+			if base != nil {
+				return nil, "", p.errorf("unexpected %q after type", t.text)
+			}
+			if t.text == "__signed__" {
+				t.text = "signed"
+			}
+			words = append(words, t.text)
+			p.next()
+			continue
+		case class == "tag":
+			if base != nil || words != nil {
+				return nil, "", p.errorf("unexpected %q after type", t.text)
+			}
+			if base, err = p.tagged(); err != nil {
+				return nil, "", err
+			}
+			continue
+		case isKeyword:
+			break
+		}
+		if base != nil || words != nil {
+			break
+		}
+		// An identifier where a type is expected: a typedef name, unless it
+		// is an annotation macro left by an unpreprocessed header
+		// (WGPU_EXPORT void ...), which is followed by the real type.
+		if _, ok := scalarTypes[t.text]; ok {
+			base = &cType{kind: kindScalar, name: t.text}
+		} else if _, ok := p.api.typedefs[t.text]; ok {
+			base = &cType{kind: kindNamed, name: t.text}
+		} else if next := p.peekAt(1); p.isTypeStart(next) {
+			p.next()
+			continue
+		} else {
+			base = &cType{kind: kindNamed, name: t.text}
+		}
+		p.next()
+	}
+	if base == nil {
+		if words == nil {
+			return nil, "", p.errorf("expected a type, found %q", p.peek().text)
+		}
+		name, err := canonicalScalar(words)
+		if err != nil {
+			return nil, "", p.errorf("%v", err)
+		}
+		base = &cType{kind: kindScalar, name: name}
+		if name == "void" {
+			base = &cType{kind: kindVoid}
+		}
+	}
+	return base, storage, nil
+}
+
+// tagged parses a struct, union or enum specifier, with its body if any.
+func (p *parser) tagged() (*cType, error) {
+	tag := p.next()
+	file := tag.file
+	p.skipAttributes()
+	name := ""
+	if p.peek().kind == tokIdent {
+		name = p.next().text
+	}
+	p.skipAttributes()
+
+	if tag.text == "enum" {
+		t := &cType{kind: kindEnum, name: name}
+		if p.peek().text == ":" {
+			// C23 fixed underlying type; enumerators are still integers.
+			p.next()
+			if _, _, err := p.specifiers(); err != nil {
+				return nil, err
+			}
+		}
+		if p.peek().text != "{" {
+			return t, nil
+		}
+		d, err := p.enumBody(name, file)
+		if err != nil {
+			return nil, err
+		}
+		p.api.addEnum(d)
+		if name == "" {
+			t.length = len(p.api.enumOrder) // Position of d, for nameAnonymous
+		}
+		p.skipAttributes()
+		return t, nil
+	}
+
+	union := tag.text == "union"
+	kind := kindStruct
+	if union {
+		kind = kindUnion
+	}
+	t := &cType{kind: kind, name: name}
+	if p.peek().text != "{" {
+		if name == "" {
+			return nil, p.errorf("%s without name or body", tag.text)
+		}
+		p.api.record(name, file, union)
+		return t, nil
+	}
+	var r *recordDecl
+	if name != "" {
+		r = p.api.record(name, file, union)
+		r.file = file
+	} else {
+		r = &recordDecl{file: file, union: union}
+		t.record = r
+	}
+	if err := p.recordBody(r); err != nil {
+		return nil, err
+	}
+	r.complete = true
+	if r.name != "" {
+		p.api.nameNested(r)
+	}
+	p.skipAttributes()
+	return t, nil
+}
+
+// recordBody parses the member declarations of a struct or union.
+func (p *parser) recordBody(r *recordDecl) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.accept("}") {
+		if p.peek().kind == tokEOF {
+			return p.errorf("unterminated %s body", r.kindName())
+		}
+		if p.accept(";") {
+			continue
+		}
+		base, _, err := p.specifiers()
+		if err != nil {
+			return err
+		}
+		if p.accept(";") {
+			// C11 anonymous struct or union member.
+			r.setBad("anonymous members are not supported")
+			continue
+		}
+		for {
+			name, t, err := p.declarator(base, false)
+			if err != nil {
+				return err
+			}
+			if p.accept(":") {
+				for p.peek().text != "," && p.peek().text != ";" && p.peek().kind != tokEOF {
+					p.next()
+				}
+				r.setBad("bit-fields are not supported")
+			}
+			p.skipAttributes()
+			r.fields = append(r.fields, field{name: name, typ: t})
+			if p.accept(";") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *recordDecl) kindName() string {
+	if r.union {
+		return "union"
+	}
+	return "struct"
+}
+
+func (r *recordDecl) setBad(reason string) {
+	if r.bad == "" {
+		r.bad = reason
+	}
+}
+
+// enumBody parses the enumerators of an enum.
+func (p *parser) enumBody(name, file string) (*enumDecl, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	d := &enumDecl{name: name, file: file, consts: []enumConst{}}
+	next := int64(0)
+	for !p.accept("}") {
+		t := p.next()
+		if t.kind != tokIdent {
+			return nil, p.errorf("expected enumerator, found %q", t.text)
+		}
+		p.skipAttributes()
+		value := next
+		if p.accept("=") {
+			start, depth := p.pos, 0
+			for t := p.peek(); t.kind != tokEOF && (depth > 0 || t.text != "," && t.text != "}"); t = p.peek() {
+				switch t.text {
+				case "(":
+					depth++
+				case ")":
+					depth--
+				}
+				p.next()
+			}
+			v, err := evalConst(p.toks[start:p.pos], p.api.enumValues)
+			if err != nil {
+				return nil, &parseError{tok: t, msg: fmt.Sprintf("enumerator %s: %v", t.text, err)}
+			}
+			value = v
+		}
+		d.consts = append(d.consts, enumConst{name: t.text, value: value})
+		p.api.enumValues[t.text] = value
+		next = value + 1
+		if !p.accept(",") {
+			if err := p.expect("}"); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return d, nil
+}
+
+// hole stands for the type a nested declarator applies to, until the
+// suffixes after it are known.
+var hole = &cType{kind: kindVoid, name: "<hole>"}
+
+// declarator parses a (possibly abstract) declarator applied to base and
+// returns the declared name and type.
+func (p *parser) declarator(base *cType, abstract bool) (string, *cType, error) {
+	t := base
+	for p.accept("*") {
+		t = &cType{kind: kindPointer, elem: t}
+		for {
+			tok := p.peek()
+			if class := specifierKeywords[tok.text]; class == "ignore" {
+				p.next()
+				continue
+			} else if class == "attr" {
+				p.skipAttributes()
+				continue
+			}
+			// A nullability macro between '*' and the name (WGPU_NULLABLE).
+			if tok.kind == tokIdent && p.peekAt(1).kind == tokIdent && !p.isTypeStart(tok) {
+				p.next()
+				continue
+			}
+			break
+		}
+	}
+	p.skipAttributes()
+	for specifierKeywords[p.peek().text] == "ignore" {
+		p.next() // calling conventions such as __stdcall before the name
+	}
+
+	name := ""
+	var nested *cType
+	switch tok := p.peek(); {
+	case tok.text == "(" && p.isNestedDeclarator(abstract):
+		p.next()
+		n, inner, err := p.declarator(hole, abstract)
+		if err != nil {
+			return "", nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return "", nil, err
+		}
+		name, nested = n, inner
+	case tok.kind == tokIdent && specifierKeywords[tok.text] == "":
+		name = p.next().text
+	case !abstract:
+		return "", nil, p.errorf("expected declarator, found %q", tok.text)
+	}
+
+	// Suffixes bind tighter than the prefix pointers: collect them, then
+	// apply them from the innermost outwards.
+	var suffixes []*cType
+	for {
+		p.skipAttributes()
+		switch {
+		case p.accept("["):
+			start, depth := p.pos, 0
+			for tok := p.peek(); tok.kind != tokEOF && (depth > 0 || tok.text != "]"); tok = p.peek() {
+				switch tok.text {
+				case "[", "(":
+					depth++
+				case "]", ")":
+					depth--
+				}
+				p.next()
+			}
+			dim := p.toks[start:p.pos]
+			if err := p.expect("]"); err != nil {
+				return "", nil, err
+			}
+			length := -1 // [] or [*]: decays to a pointer
+			for len(dim) > 0 && (dim[0].text == "static" || dim[0].text == "const" || dim[0].text == "restrict") {
+				dim = dim[1:]
+			}
+			if len(dim) > 0 && !(len(dim) == 1 && dim[0].text == "*") {
+				n, err := evalConst(dim, p.api.enumValues)
+				if err != nil {
+					return "", nil, p.errorf("array size: %v", err)
+				}
+				length = int(n)
+			}
+			suffixes = append(suffixes, &cType{kind: kindArray, length: length})
+		case p.peek().text == "(":
+			params, variadic, err := p.parameters()
+			if err != nil {
+				return "", nil, err
+			}
+			suffixes = append(suffixes, &cType{kind: kindFunc, params: params, variadic: variadic})
+		default:
+			for i := len(suffixes) - 1; i >= 0; i-- {
+				suffixes[i].elem = t
+				t = suffixes[i]
+			}
+			if nested != nil {
+				t = substituteHole(nested, t)
+			}
+			return name, t, nil
+		}
+	}
+}
+
+// isNestedDeclarator reports whether the '(' at the current token opens a
+// nested declarator, as in int (*fn)(void), rather than a parameter list.
+func (p *parser) isNestedDeclarator(abstract bool) bool {
+	next := p.peekAt(1)
+	switch {
+	case next.text == "*" || next.text == "^" || next.text == "(" || next.text == "[":
+		return true
+	case next.text == "__cdecl" || next.text == "__stdcall" || next.text == "__fastcall":
+		return true // int (__stdcall *fn)(void)
+	case next.kind == tokIdent && specifierKeywords[next.text] == "attr":
+		return true
+	case next.kind == tokIdent:
+		return !abstract && !p.isTypeStart(next)
+	}
+	return false
+}
+
+// substituteHole returns t with hole replaced by with.
+func substituteHole(t, with *cType) *cType {
+	if t == hole {
+		return with
+	}
+	c := *t
+	if c.elem != nil {
+		c.elem = substituteHole(c.elem, with)
+	}
+	return &c
+}
+
+// parameters parses a parameter list. Array and function parameters decay
+// to pointers.
+func (p *parser) parameters() ([]param, bool, error) {
+	if err := p.expect("("); err != nil {
+		return nil, false, err
+	}
+	if p.accept(")") {
+		return nil, false, nil
+	}
+	if p.peek().text == "void" && p.peekAt(1).text == ")" {
+		p.pos += 2
+		return nil, false, nil
+	}
+	var params []param
+	for {
+		if p.accept("...") {
+			if err := p.expect(")"); err != nil {
+				return nil, false, err
+			}
+			return params, true, nil
+		}
+		base, _, err := p.specifiers()
+		if err != nil {
+			return nil, false, err
+		}
+		name, t, err := p.declarator(base, true)
+		if err != nil {
+			return nil, false, err
+		}
+		switch t.kind {
+		case kindArray:
+			t = &cType{kind: kindPointer, elem: t.elem}
+		case kindFunc:
+			t = &cType{kind: kindPointer, elem: t}
+		}
+		params = append(params, param{name: name, typ: t})
+		if p.accept(")") {
+			return params, false, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, false, err
+		}
+	}
+}
+
+// isFuncPointer reports whether t, after typedefs, points to a function.
+func (a *api) isFuncPointer(t *cType) bool {
+	t = a.resolve(t)
+	return t.kind == kindPointer && a.resolve(t.elem).kind == kindFunc
+}
+
+// describe returns a short description of a declaration's location.
+func describe(file string, line int) string {
+	if file == "" {
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("%s:%d", strings.TrimPrefix(file, "./"), line)
+}
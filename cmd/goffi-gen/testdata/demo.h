@@ -0,0 +1,48 @@
+#include <stdint.h>
+#include <stddef.h>
+
+#define DEMO_EXPORT __attribute__((visibility("default")))
+#define DEMO_MAX 4
+
+typedef uint32_t DemoFlags;
+static const DemoFlags DemoFlags_Read = 1 << 0;
+static const DemoFlags DemoFlags_Write = 1 << 1;
+
+typedef enum DemoMode {
+    DemoMode_Off = 0,
+    DemoMode_On,
+    DemoMode_Force32 = 0x7FFFFFFF
+} DemoMode;
+
+enum { DEMO_LIMIT = DEMO_MAX * 2 };
+
+typedef struct DemoContextImpl* DemoContext;
+
+typedef struct DemoPoint {
+    int32_t x, y;
+} DemoPoint;
+
+typedef struct {
+    DemoPoint corners[DEMO_MAX];
+    const char *label;
+    size_t count;
+    struct { float r, g; } color;
+    DemoMode mode;
+    double weight;
+} DemoShape;
+
+struct DemoBits { unsigned a : 3; };
+
+typedef void (*DemoCallback)(void *userdata, int status);
+
+DEMO_EXPORT DemoContext demoCreate(const char *name, DemoFlags flags);
+DEMO_EXPORT void demoDestroy(DemoContext ctx);
+DEMO_EXPORT DemoPoint demoAdd(DemoPoint a, DemoPoint b);
+DEMO_EXPORT double demoArea(const DemoShape *shape);
+DEMO_EXPORT int demoPrintf(const char *fmt, ...);
+DEMO_EXPORT void demoSetCallback(DemoContext ctx, DemoCallback cb, void *userdata);
+DEMO_EXPORT long demoSum(const long values[], size_t n, int (*filter)(long));
+DEMO_EXPORT void demoBits(struct DemoBits b);
+#define DEMO_WHOLE_SIZE (0xffffffffffffffffULL)
+#define DEMO_VERSION_MAJOR 2
+#define DEMO_NAME "demo"
@@ -0,0 +1,11 @@
+#include <stddef.h>
+
+typedef struct {
+    int quot;
+    int rem;
+} div_t;
+
+size_t strlen(const char *s);
+int abs(int n);
+div_t div(int numer, int denom);
+int printf(const char *format, ...);
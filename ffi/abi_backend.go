@@ -0,0 +1,115 @@
+package ffi
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// ABIBackend is an alternative implementation of native calls for one
+// calling convention on one architecture, such as an experimental JIT-stub
+// caller or a caller for a foreign convention. It is registered next to
+// goffi's built-in implementation with RegisterABIBackend and chosen per
+// CallInterface with SelectABIBackend.
+//
+// ClassifyReturn computes CallInterface.Flags for the return type; it and
+// ClassifyArgument's register counts are only interpreted by the backend
+// itself, except that arguments overflowing the registers must still fit the
+// platform's stack slots.
+type ABIBackend interface {
+	Execute(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error
+	ClassifyReturn(t *types.TypeDescriptor, convention types.CallingConvention) int
+	ClassifyArgument(t *types.TypeDescriptor, convention types.CallingConvention) (gprCount, sseCount int)
+}
+
+// abiBackendAdapter exposes an ABIBackend through the arch interfaces.
+type abiBackendAdapter struct {
+	ABIBackend
+}
+
+func (a abiBackendAdapter) ClassifyArgument(t *types.TypeDescriptor, convention types.CallingConvention) arch.ArgumentClassification {
+	gpr, sse := a.ABIBackend.ClassifyArgument(t, convention)
+	return arch.ArgumentClassification{GPRCount: gpr, SSECount: sse}
+}
+
+// RegisterABIBackend registers b under name for convention on goarch (a
+// GOARCH value such as "amd64"). Backends for other architectures are
+// accepted but never selected. types.DefaultCall stands for the platform's
+// default convention. Registering the same name, convention and architecture
+// twice is an error.
+func RegisterABIBackend(name string, convention types.CallingConvention, goarch string, b ABIBackend) error {
+	if b == nil {
+		return &InvalidCallInterfaceError{Field: "backend", Reason: "must not be nil", Index: -1}
+	}
+	if convention == types.DefaultCall {
+		convention = types.DefaultConvention()
+	}
+	adapter := abiBackendAdapter{b}
+	key := arch.BackendKey{Name: name, Convention: convention, Arch: goarch}
+	if err := arch.RegisterBackend(key, arch.Backend{Caller: adapter, Classifier: adapter}); err != nil {
+		return fmt.Errorf("goffi: %w", err)
+	}
+	return nil
+}
+
+// SelectABIBackend switches a prepared CallInterface to the backend registered
+// under name for the CIF's calling convention on the running architecture,
+// re-classifying the signature with it. The empty name selects goffi's
+// built-in implementation again.
+//
+// Example:
+//
+//	var cif types.CallInterface
+//	err := ffi.PrepareCallInterface(&cif, types.DefaultCall, retType, argTypes)
+//	err = ffi.SelectABIBackend(&cif, "jit")
+//	err = ffi.CallFunction(&cif, fn, rvalue, avalue) // executed by "jit"
+func SelectABIBackend(cif *types.CallInterface, name string) error {
+	if cif == nil || cif.ReturnType == nil {
+		return &InvalidCallInterfaceError{Field: "cif", Reason: "must be prepared", Index: -1}
+	}
+	if _, err := backendFor(cif.Convention, name); err != nil {
+		return err
+	}
+	prev := cif.Backend
+	cif.Backend = name
+	if err := preparePlatformSpecific(cif); err != nil {
+		cif.Backend = prev
+		_ = preparePlatformSpecific(cif) // restore the previous classification
+		return err
+	}
+	return nil
+}
+
+// backendFor looks up the named backend for convention on this architecture.
+func backendFor(convention types.CallingConvention, name string) (arch.Backend, error) {
+	b, ok := arch.LookupBackend(arch.BackendKey{Name: name, Convention: convention, Arch: runtime.GOARCH})
+	if !ok {
+		if name == "" {
+			return arch.Backend{}, types.ErrUnsupportedArchitecture
+		}
+		return arch.Backend{}, &InvalidCallInterfaceError{
+			Field:  "Backend",
+			Reason: fmt.Sprintf("no ABI backend %q registered for convention %d on %s", name, convention, runtime.GOARCH),
+			Index:  -1,
+		}
+	}
+	return b, nil
+}
+
+// callerFor returns the caller that executes cif.
+func callerFor(cif *types.CallInterface) (arch.FunctionCaller, error) {
+	if cif.Backend == "" {
+		if arch.Registry.Caller == nil {
+			return nil, types.ErrUnsupportedArchitecture
+		}
+		return arch.Registry.Caller, nil
+	}
+	b, err := backendFor(cif.Convention, cif.Backend)
+	if err != nil {
+		return nil, err
+	}
+	return b.Caller, nil
+}
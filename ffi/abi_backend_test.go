@@ -0,0 +1,89 @@
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// constBackend is an ABIBackend that never calls native code: every call
+// stores result in the return buffer.
+type constBackend struct {
+	result int64
+	calls  int
+}
+
+const constBackendFlags = 0x7eed
+
+func (b *constBackend) Execute(cif *types.CallInterface, fn, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	b.calls++
+	if rvalue != nil {
+		*(*int64)(rvalue) = b.result
+	}
+	return nil
+}
+
+func (b *constBackend) ClassifyReturn(*types.TypeDescriptor, types.CallingConvention) int {
+	return constBackendFlags
+}
+
+func (b *constBackend) ClassifyArgument(*types.TypeDescriptor, types.CallingConvention) (int, int) {
+	return 1, 0
+}
+
+func TestABIBackend(t *testing.T) {
+	backend := &constBackend{result: 1234}
+	if err := RegisterABIBackend("test-const", types.DefaultCall, runtime.GOARCH, backend); err != nil {
+		t.Fatalf("RegisterABIBackend failed: %v", err)
+	}
+	if err := RegisterABIBackend("test-const", types.DefaultCall, runtime.GOARCH, backend); err == nil {
+		t.Error("expected error registering the same backend twice")
+	}
+
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt64TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	builtinFlags := cif.Flags
+
+	if err := SelectABIBackend(&cif, "test-const"); err != nil {
+		t.Fatalf("SelectABIBackend failed: %v", err)
+	}
+	if cif.Flags != constBackendFlags {
+		t.Errorf("Flags = %#x, want the backend's classification %#x", cif.Flags, constBackendFlags)
+	}
+
+	// The function pointer is never dereferenced by this backend.
+	var dummy byte
+	var arg, result int64 = 7, 0
+	err := CallFunction(&cif, unsafe.Pointer(&dummy), unsafe.Pointer(&result), []unsafe.Pointer{unsafe.Pointer(&arg)})
+	if err != nil {
+		t.Fatalf("CallFunction failed: %v", err)
+	}
+	if result != 1234 || backend.calls != 1 {
+		t.Errorf("result = %d after %d backend calls, want 1234 after 1", result, backend.calls)
+	}
+
+	// Switching back restores the built-in classification.
+	if err := SelectABIBackend(&cif, ""); err != nil {
+		t.Fatalf("SelectABIBackend(\"\") failed: %v", err)
+	}
+	if cif.Flags != builtinFlags || cif.Backend != "" {
+		t.Errorf("Flags = %#x Backend = %q, want built-in %#x", cif.Flags, cif.Backend, builtinFlags)
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		err := SelectABIBackend(&cif, "test-missing")
+		var icErr *InvalidCallInterfaceError
+		if !errors.As(err, &icErr) || icErr.Field != "Backend" {
+			t.Errorf("expected InvalidCallInterfaceError for Backend, got %v", err)
+		}
+		if cif.Backend != "" {
+			t.Errorf("failed selection changed Backend to %q", cif.Backend)
+		}
+	})
+}
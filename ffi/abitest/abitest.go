@@ -0,0 +1,88 @@
+// Package abitest captures the machine registers and stack words that a
+// call interface produces, so binding authors can check that their type
+// descriptors lower to exactly the ABI the C side expects.
+//
+// Capture makes a real call through goffi, with the arguments classified and
+// placed as for any native function, to a stub that records its incoming
+// argument registers and returns. The result is an ffi.RawCall, the same
+// register-level description CallRaw takes, so expectations read like the
+// ABI documentation:
+//
+//	var cif types.CallInterface
+//	ffi.PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor,
+//	    []*types.TypeDescriptor{types.SInt32TypeDescriptor, types.DoubleTypeDescriptor})
+//	frame, err := abitest.Capture(&cif, []unsafe.Pointer{unsafe.Pointer(&n), unsafe.Pointer(&x)})
+//	// frame.GPR[0] == uint64(n), frame.FPR[0] == math.Float64bits(x)
+//
+// Capture is available where ffi.CallRaw is: System V amd64 (Linux, macOS,
+// FreeBSD) and arm64 (Linux, macOS, Windows, FreeBSD). Elsewhere it returns
+// types.ErrUnsupportedArchitecture.
+package abitest
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// frame is the layout the capture stub writes; the offsets are hard-coded
+// in capture_$GOARCH.s.
+type frame struct {
+	gpr   [8]uint64 // 0
+	fpr   [8]uint64 // 64
+	sret  uint64    // 128
+	stack [9]uint64 // 136, the larger of the two MaxRawStackWords
+}
+
+var (
+	// mu serializes captures: the stub writes to the single captured frame.
+	mu       sync.Mutex
+	captured frame
+)
+
+// Capture performs the call described by cif with the arguments avalue, as
+// ffi.CallFunction would, and returns the argument registers and stack words
+// the callee received.
+//
+// Stack holds ffi.MaxRawStackWords words; goffi zeroes the ones a call does
+// not use. Registers a call does not use hold whatever the call path left in
+// them, so compare only the ones the ABI assigns. FPR holds the low 64 bits
+// of each vector register. On amd64, where the result pointer of a large
+// struct return travels in RDI, it shows up in GPR[0] and Sret is zero.
+// The callee returns zero, and nothing through a result pointer.
+func Capture(cif *types.CallInterface, avalue []unsafe.Pointer) (ffi.RawCall, error) {
+	if !supported {
+		return ffi.RawCall{}, types.ErrUnsupportedArchitecture
+	}
+	if cif == nil || cif.ReturnType == nil {
+		return ffi.RawCall{}, &ffi.InvalidCallInterfaceError{Field: "cif", Reason: "must be prepared", Index: -1}
+	}
+
+	// A return buffer the call path can write the stub's zeroed result
+	// registers to.
+	var rvalue unsafe.Pointer
+	switch rt := cif.ReturnType; {
+	case rt.Kind == types.VoidType:
+	case rt.CString || rt.WString:
+		rvalue = unsafe.Pointer(new(string))
+	default:
+		buf := make([]uint64, rt.Size/8+2)
+		rvalue = unsafe.Pointer(&buf[0])
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	captured = frame{}
+	if err := ffi.CallFunction(cif, *(*unsafe.Pointer)(unsafe.Pointer(&captureABI0)), rvalue, avalue); err != nil {
+		return ffi.RawCall{}, err
+	}
+
+	return ffi.RawCall{
+		GPR:   captured.gpr,
+		FPR:   captured.fpr,
+		Stack: append([]uint64(nil), captured.stack[:ffi.MaxRawStackWords]...),
+		Sret:  uintptr(captured.sret),
+	}, nil
+}
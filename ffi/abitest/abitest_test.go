@@ -0,0 +1,124 @@
+//go:build (amd64 && (linux || darwin || freebsd)) || (arm64 && (linux || darwin || windows || freebsd))
+
+package abitest_test
+
+import (
+	"math"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/abitest"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// intRegs is the number of integer argument registers of the ABI.
+func intRegs() int {
+	if runtime.GOARCH == "amd64" {
+		return 6
+	}
+	return 8
+}
+
+func prepare(t *testing.T, rt *types.TypeDescriptor, args ...*types.TypeDescriptor) *types.CallInterface {
+	t.Helper()
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, rt, args); err != nil {
+		t.Fatal(err)
+	}
+	return &cif
+}
+
+func TestCaptureScalars(t *testing.T) {
+	cif := prepare(t, types.VoidTypeDescriptor,
+		types.SInt32TypeDescriptor, types.DoubleTypeDescriptor, types.UInt64TypeDescriptor, types.FloatTypeDescriptor)
+	n, d, u, f := int32(-7), 1.5, uint64(0x0123456789abcdef), float32(2.5)
+	frame, err := abitest.Capture(cif, []unsafe.Pointer{
+		unsafe.Pointer(&n), unsafe.Pointer(&d), unsafe.Pointer(&u), unsafe.Pointer(&f),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int32(frame.GPR[0]) != n || frame.GPR[1] != u {
+		t.Errorf("GPR = %#x, want %d and %#x in the first two", frame.GPR, n, u)
+	}
+	if frame.FPR[0] != math.Float64bits(d) || uint32(frame.FPR[1]) != math.Float32bits(f) {
+		t.Errorf("FPR = %#x, want %v and %v in the first two", frame.FPR, d, f)
+	}
+}
+
+func TestCaptureStackWords(t *testing.T) {
+	regs := intRegs()
+	count := regs + 3
+	argTypes := make([]*types.TypeDescriptor, count)
+	vals := make([]uint64, count)
+	avalue := make([]unsafe.Pointer, count)
+	for i := range vals {
+		argTypes[i] = types.UInt64TypeDescriptor
+		vals[i] = 0x1000 + uint64(i)
+		avalue[i] = unsafe.Pointer(&vals[i])
+	}
+	frame, err := abitest.Capture(prepare(t, types.VoidTypeDescriptor, argTypes...), avalue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < regs; i++ {
+		if frame.GPR[i] != vals[i] {
+			t.Errorf("GPR[%d] = %#x, want %#x", i, frame.GPR[i], vals[i])
+		}
+	}
+	if len(frame.Stack) != ffi.MaxRawStackWords {
+		t.Fatalf("len(Stack) = %d, want %d", len(frame.Stack), ffi.MaxRawStackWords)
+	}
+	for k := 0; k < 3; k++ {
+		if frame.Stack[k] != vals[regs+k] {
+			t.Errorf("Stack[%d] = %#x, want %#x", k, frame.Stack[k], vals[regs+k])
+		}
+	}
+	for k := 3; k < len(frame.Stack); k++ {
+		if frame.Stack[k] != 0 {
+			t.Errorf("Stack[%d] = %#x, want unused word zero", k, frame.Stack[k])
+		}
+	}
+}
+
+func TestCaptureHomogeneousStruct(t *testing.T) {
+	vec := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.DoubleTypeDescriptor, types.DoubleTypeDescriptor},
+	}
+	v := [2]float64{3, 4}
+	frame, err := abitest.Capture(prepare(t, types.VoidTypeDescriptor, vec), []unsafe.Pointer{unsafe.Pointer(&v)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Both System V (class SSE, SSE) and AAPCS64 (HFA) pass it in two
+	// vector registers.
+	if frame.FPR[0] != math.Float64bits(3) || frame.FPR[1] != math.Float64bits(4) {
+		t.Errorf("FPR = %#x, want 3.0 and 4.0", frame.FPR[:2])
+	}
+}
+
+func TestCaptureStructReturnPointer(t *testing.T) {
+	large := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt64TypeDescriptor, types.UInt64TypeDescriptor, types.UInt64TypeDescriptor},
+	}
+	x := uint64(9)
+	frame, err := abitest.Capture(prepare(t, large, types.UInt64TypeDescriptor), []unsafe.Pointer{unsafe.Pointer(&x)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch runtime.GOARCH {
+	case "amd64":
+		// The result pointer is the hidden first argument.
+		if frame.GPR[0] == 0 || frame.GPR[1] != x || frame.Sret != 0 {
+			t.Errorf("GPR[0:2] = %#x, Sret = %#x, want result pointer then 9", frame.GPR[:2], frame.Sret)
+		}
+	case "arm64":
+		if frame.Sret == 0 || frame.GPR[0] != x {
+			t.Errorf("Sret = %#x, GPR[0] = %#x, want result pointer in X8 and 9 in X0", frame.Sret, frame.GPR[0])
+		}
+	}
+}
@@ -0,0 +1,58 @@
+//go:build amd64 && (linux || darwin || freebsd)
+
+#include "textflag.h"
+#include "cet_amd64.h"
+
+// capture stores the System V argument registers RDI, RSI, RDX, RCX, R8,
+// R9 and XMM0-XMM7, and the 9 stack words above the return address, into
+// ·captured, then returns with zeroed result registers. It is called from
+// C, through the goffi call path, and runs on the g0 stack.
+GLOBL ·captureABI0(SB), NOPTR|RODATA, $8
+DATA ·captureABI0(SB)/8, $capture(SB)
+
+TEXT capture(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
+	LEAQ ·captured(SB), R11
+
+	MOVQ DI, 0(R11)
+	MOVQ SI, 8(R11)
+	MOVQ DX, 16(R11)
+	MOVQ CX, 24(R11)
+	MOVQ R8, 32(R11)
+	MOVQ R9, 40(R11)
+
+	MOVSD X0, 64(R11)
+	MOVSD X1, 72(R11)
+	MOVSD X2, 80(R11)
+	MOVSD X3, 88(R11)
+	MOVSD X4, 96(R11)
+	MOVSD X5, 104(R11)
+	MOVSD X6, 112(R11)
+	MOVSD X7, 120(R11)
+
+	// No indirect result register: 128(R11) stays zero.
+
+	MOVQ 8(SP), AX
+	MOVQ AX, 136(R11)
+	MOVQ 16(SP), AX
+	MOVQ AX, 144(R11)
+	MOVQ 24(SP), AX
+	MOVQ AX, 152(R11)
+	MOVQ 32(SP), AX
+	MOVQ AX, 160(R11)
+	MOVQ 40(SP), AX
+	MOVQ AX, 168(R11)
+	MOVQ 48(SP), AX
+	MOVQ AX, 176(R11)
+	MOVQ 56(SP), AX
+	MOVQ AX, 184(R11)
+	MOVQ 64(SP), AX
+	MOVQ AX, 192(R11)
+	MOVQ 72(SP), AX
+	MOVQ AX, 200(R11)
+
+	XORQ AX, AX
+	XORQ DX, DX
+	XORPS X0, X0
+	XORPS X1, X1
+	RET
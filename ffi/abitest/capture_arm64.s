@@ -0,0 +1,56 @@
+//go:build arm64 && (linux || darwin || windows || freebsd)
+
+#include "textflag.h"
+
+// capture stores the AAPCS64 argument registers X0-X7 and D0-D7, the
+// indirect result register X8 and the 7 stack words at SP into ·captured,
+// then returns with zeroed result registers. It is called from C, through
+// the goffi call path, and runs on the g0 stack.
+GLOBL ·captureABI0(SB), NOPTR|RODATA, $8
+DATA ·captureABI0(SB)/8, $capture(SB)
+
+TEXT capture(SB), NOSPLIT|NOFRAME, $0
+	MOVD $·captured(SB), R9
+
+	MOVD R0, 0(R9)
+	MOVD R1, 8(R9)
+	MOVD R2, 16(R9)
+	MOVD R3, 24(R9)
+	MOVD R4, 32(R9)
+	MOVD R5, 40(R9)
+	MOVD R6, 48(R9)
+	MOVD R7, 56(R9)
+
+	FMOVD F0, 64(R9)
+	FMOVD F1, 72(R9)
+	FMOVD F2, 80(R9)
+	FMOVD F3, 88(R9)
+	FMOVD F4, 96(R9)
+	FMOVD F5, 104(R9)
+	FMOVD F6, 112(R9)
+	FMOVD F7, 120(R9)
+
+	MOVD R8, 128(R9)
+
+	MOVD 0(RSP), R10
+	MOVD R10, 136(R9)
+	MOVD 8(RSP), R10
+	MOVD R10, 144(R9)
+	MOVD 16(RSP), R10
+	MOVD R10, 152(R9)
+	MOVD 24(RSP), R10
+	MOVD R10, 160(R9)
+	MOVD 32(RSP), R10
+	MOVD R10, 168(R9)
+	MOVD 40(RSP), R10
+	MOVD R10, 176(R9)
+	MOVD 48(RSP), R10
+	MOVD R10, 184(R9)
+
+	MOVD ZR, R0
+	MOVD ZR, R1
+	FMOVD ZR, F0
+	FMOVD ZR, F1
+	FMOVD ZR, F2
+	FMOVD ZR, F3
+	RET
@@ -0,0 +1,7 @@
+//go:build !((amd64 && (linux || darwin || freebsd)) || (arm64 && (linux || darwin || windows || freebsd)))
+
+package abitest
+
+var captureABI0 uintptr
+
+const supported = false
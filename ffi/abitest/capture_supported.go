@@ -0,0 +1,8 @@
+//go:build (amd64 && (linux || darwin || freebsd)) || (arm64 && (linux || darwin || windows || freebsd))
+
+package abitest
+
+// captureABI0 is the address of the capture stub in capture_$GOARCH.s.
+var captureABI0 uintptr
+
+const supported = true
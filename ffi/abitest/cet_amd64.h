@@ -0,0 +1,5 @@
+// ENDBR64 marks an indirect branch target for Intel CET indirect branch
+// tracking (IBT). Every entry point that C code reaches through a function
+// pointer must start with it. It decodes as a NOP on CPUs without CET.
+// The Go assembler has no mnemonic for it, so it is emitted as raw bytes.
+#define ENDBR64 BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa
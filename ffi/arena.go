@@ -0,0 +1,97 @@
+package ffi
+
+import (
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Arena owns memory and callbacks handed to native code for a bounded time,
+// typically the descriptor structs, strings and arrays built by Marshal for
+// one call:
+//
+//	arena := ffi.NewArena()
+//	defer arena.Free()
+//	label, err := arena.CString("main device")
+//
+// The memory is allocated from the Go heap, which does not move objects, and
+// the arena keeps it reachable: it stays valid until Free, provided the arena
+// itself stays reachable (the deferred Free above ensures that). An Arena is
+// safe for concurrent use.
+type Arena struct {
+	mu        sync.Mutex
+	blocks    []unsafe.Pointer
+	callbacks []uintptr
+}
+
+// NewArena returns an empty arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// Alloc returns size zeroed bytes aligned to align, which must be a power of
+// two (0 means pointer alignment). A zero size yields nil.
+func (a *Arena) Alloc(size, align uintptr) unsafe.Pointer {
+	if size == 0 {
+		return nil
+	}
+	const word = unsafe.Sizeof(uint64(0))
+	if align < word {
+		align = word
+	}
+	// make([]uint64) is 8-byte aligned; larger alignments over-allocate.
+	buf := make([]uint64, (size+align-word+word-1)/word)
+	base := unsafe.Pointer(unsafe.SliceData(buf))
+	p := unsafe.Add(base, (align-uintptr(base)%align)%align)
+
+	a.mu.Lock()
+	a.blocks = append(a.blocks, base)
+	a.mu.Unlock()
+	return p
+}
+
+// CString returns a NUL-terminated copy of s. It fails with ErrNULInString
+// if s contains a NUL byte.
+func (a *Arena) CString(s string) (unsafe.Pointer, error) {
+	if strings.IndexByte(s, 0) >= 0 {
+		return nil, ErrNULInString
+	}
+	p := a.Alloc(uintptr(len(s))+1, 1)
+	copy(unsafe.Slice((*byte)(p), len(s)), s)
+	return p, nil
+}
+
+// WideCString returns a NUL-terminated wchar_t copy of s, encoded as by
+// WideCString.
+func (a *Arena) WideCString(s string) (unsafe.Pointer, error) {
+	ws, err := WideCString(s)
+	if err != nil {
+		return nil, err
+	}
+	size := uintptr(len(ws)) * WCharSize
+	p := a.Alloc(size, WCharSize)
+	copy(unsafe.Slice((*byte)(p), size), unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(ws))), size))
+	return p, nil
+}
+
+// Callback registers fn as with NewCallback and releases it on Free. As with
+// WithScopedCallback, Windows cannot reclaim the slot.
+func (a *Arena) Callback(fn any) uintptr {
+	ptr := NewCallback(fn)
+	a.mu.Lock()
+	a.callbacks = append(a.callbacks, ptr)
+	a.mu.Unlock()
+	return ptr
+}
+
+// Free releases everything the arena handed out. Native code must no longer
+// use it. The arena can be reused afterwards.
+func (a *Arena) Free() {
+	a.mu.Lock()
+	callbacks := a.callbacks
+	a.blocks, a.callbacks = nil, nil
+	a.mu.Unlock()
+	for _, ptr := range callbacks {
+		releaseScopedCallback(ptr)
+	}
+}
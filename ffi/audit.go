@@ -0,0 +1,258 @@
+package ffi
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// AuditFormat is the version of the AuditManifest format.
+const AuditFormat = 1
+
+// AuditManifest lists the native surface a program touched while an audit
+// was running: every library it loaded, every symbol it resolved and every
+// callback it registered. Entries are sorted and carry no addresses or
+// timestamps, so two runs exercising the same code produce identical
+// manifests, and its JSON encoding can be signed and diffed in review.
+type AuditManifest struct {
+	Format    int             `json:"format"`  // AuditFormat
+	Program   string          `json:"program"` // Path of the executable
+	GOOS      string          `json:"goos"`
+	GOARCH    string          `json:"goarch"`
+	Libraries []AuditLibrary  `json:"libraries"`
+	Callbacks []AuditCallback `json:"callbacks"`
+	Unowned   []string        `json:"unowned_symbols,omitempty"` // Resolved in handles not seen loaded, e.g. loaded before the audit
+}
+
+// AuditLibrary is a library a program loaded or tried to load.
+type AuditLibrary struct {
+	Name    string   `json:"name"`             // As passed to LoadLibrary
+	Path    string   `json:"path,omitempty"`   // File the loader mapped, where the platform reports it
+	SHA256  string   `json:"sha256,omitempty"` // Digest of the file at Path when the report was made
+	Error   string   `json:"error,omitempty"`  // Why loading failed; empty if it succeeded
+	Symbols []string `json:"symbols"`          // Symbols resolved in it
+}
+
+// AuditCallback is a Go function registered as a callback, aggregated by
+// registration site.
+type AuditCallback struct {
+	Site  string `json:"site"`  // Function and file:line that called NewCallback
+	Count int    `json:"count"` // Registrations at Site
+}
+
+// auditLibrary is the recorder's state for one loaded library.
+type auditLibrary struct {
+	name    string
+	path    string
+	symbols map[string]bool
+}
+
+// auditRecorder accumulates the events of a running audit.
+type auditRecorder struct {
+	mu        sync.Mutex
+	libraries map[string]*auditLibrary  // by name
+	handles   map[uintptr]*auditLibrary // by handle, while loaded
+	failed    map[string]string         // name -> first load error
+	unowned   map[string]bool           // symbols of untracked handles
+	callbacks map[string]int            // site -> registrations
+}
+
+var auditor atomic.Pointer[auditRecorder]
+
+// StartAudit begins recording the native surface the program touches into
+// an AuditManifest, discarding any previous recording. Start it first thing
+// in main, so that nothing is loaded before, and collect the manifest after
+// the code under review has run:
+//
+//	ffi.StartAudit()
+//	defer func() {
+//	    m := ffi.StopAudit()
+//	    f, _ := os.Create("native-surface.json")
+//	    m.WriteJSON(f) // sign and review this file
+//	    f.Close()
+//	}()
+//
+// The audit is independent of SetTraceHook and does not slow down calls.
+func StartAudit() {
+	auditor.Store(&auditRecorder{
+		libraries: make(map[string]*auditLibrary),
+		handles:   make(map[uintptr]*auditLibrary),
+		failed:    make(map[string]string),
+		unowned:   make(map[string]bool),
+		callbacks: make(map[string]int),
+	})
+}
+
+// StopAudit ends the audit and returns its manifest, or nil if no audit was
+// running.
+func StopAudit() *AuditManifest {
+	a := auditor.Swap(nil)
+	if a == nil {
+		return nil
+	}
+	return a.manifest()
+}
+
+// AuditReport returns the manifest of the running audit so far, or nil if
+// no audit is running.
+func AuditReport() *AuditManifest {
+	a := auditor.Load()
+	if a == nil {
+		return nil
+	}
+	return a.manifest()
+}
+
+// record adds ev to the audit.
+func (a *auditRecorder) record(ev TraceEvent) {
+	switch ev.Kind {
+	case TraceLoad:
+		var path string
+		if ev.Err == nil {
+			if v, err := queryLibraryVersion(foreignPointer(ev.Addr)); err == nil {
+				path = v.Path
+			}
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if ev.Err != nil {
+			if _, ok := a.failed[ev.Name]; !ok {
+				a.failed[ev.Name] = ev.Err.Error()
+			}
+			return
+		}
+		lib := a.libraries[ev.Name]
+		if lib == nil {
+			lib = &auditLibrary{name: ev.Name, symbols: make(map[string]bool)}
+			a.libraries[ev.Name] = lib
+		}
+		lib.path = cmp.Or(lib.path, path)
+		a.handles[ev.Addr] = lib
+	case TraceUnload:
+		if ev.Err == nil && libraryState(foreignPointer(ev.Addr)) == nil {
+			a.mu.Lock()
+			delete(a.handles, ev.Addr)
+			a.mu.Unlock()
+		}
+	case TraceSymbol:
+		if ev.Err != nil {
+			return
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if lib := a.handles[ev.Library]; lib != nil {
+			lib.symbols[ev.Name] = true
+		} else {
+			a.unowned[ev.Name] = true
+		}
+	case TraceCallbackRegister:
+		site := callbackSite()
+		a.mu.Lock()
+		a.callbacks[site]++
+		a.mu.Unlock()
+	}
+}
+
+// callbackSite returns the first caller of NewCallback outside goffi, as
+// "function file:line". Helpers that register callbacks on behalf of their
+// caller (Arena.Callback, CallbackPool, NewDecodingCallback, ...) are
+// skipped, so the site is the application code.
+func callbackSite() string {
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs[:])])
+	for {
+		f, more := frames.Next()
+		internal := strings.HasPrefix(f.Function, "github.com/go-webgpu/goffi/ffi.") && !strings.HasSuffix(f.File, "_test.go")
+		if !internal || !more {
+			return fmt.Sprintf("%s %s:%d", f.Function, f.File, f.Line)
+		}
+	}
+}
+
+// manifest builds the manifest of what a has recorded.
+func (a *auditRecorder) manifest() *AuditManifest {
+	m := &AuditManifest{
+		Format:    AuditFormat,
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		Libraries: []AuditLibrary{},
+		Callbacks: []AuditCallback{},
+	}
+	m.Program, _ = os.Executable()
+
+	a.mu.Lock()
+	for _, lib := range a.libraries {
+		m.Libraries = append(m.Libraries, AuditLibrary{
+			Name:    lib.name,
+			Path:    lib.path,
+			Symbols: sortedKeys(lib.symbols),
+		})
+	}
+	for name, err := range a.failed {
+		if a.libraries[name] == nil {
+			m.Libraries = append(m.Libraries, AuditLibrary{Name: name, Error: err, Symbols: []string{}})
+		}
+	}
+	if len(a.unowned) > 0 {
+		m.Unowned = sortedKeys(a.unowned)
+	}
+	for site, n := range a.callbacks {
+		m.Callbacks = append(m.Callbacks, AuditCallback{Site: site, Count: n})
+	}
+	a.mu.Unlock()
+
+	for i := range m.Libraries {
+		m.Libraries[i].SHA256 = fileDigest(m.Libraries[i].Path)
+	}
+	slices.SortFunc(m.Libraries, func(x, y AuditLibrary) int { return cmp.Compare(x.Name, y.Name) })
+	slices.SortFunc(m.Callbacks, func(x, y AuditCallback) int { return cmp.Compare(x.Site, y.Site) })
+	return m
+}
+
+// WriteJSON writes m to w as indented JSON. The encoding is deterministic,
+// so the bytes written can be signed as they are.
+func (m *AuditManifest) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// sortedKeys returns the keys of set in order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// fileDigest returns the hex SHA-256 of the file at path, or "" if it
+// cannot be read.
+func fileDigest(path string) string {
+	if path == "" {
+		return ""
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,60 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	StartAudit()
+	defer StopAudit()
+
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	defer FreeLibrary(handle)
+	if _, err := GetSymbol(handle, sym); err != nil {
+		t.Fatal(err)
+	}
+	LoadLibrary("libgoffi_no_such_library.so")
+	ptr := NewCallback(func(a int32) int32 { return a })
+	defer releaseCallback(ptr)
+
+	var first, second bytes.Buffer
+	if err := AuditReport().WriteJSON(&first); err != nil {
+		t.Fatal(err)
+	}
+	m := StopAudit()
+	if err := m.WriteJSON(&second); err != nil {
+		t.Fatal(err)
+	}
+	if first.String() != second.String() {
+		t.Errorf("manifest not deterministic:\n%s\nvs\n%s", &first, &second)
+	}
+	if AuditReport() != nil || StopAudit() != nil {
+		t.Error("audit still running after StopAudit")
+	}
+
+	if len(m.Libraries) != 2 {
+		t.Fatalf("Libraries = %+v, want the loaded and the missing library", m.Libraries)
+	}
+	loaded, missing := m.Libraries[0], m.Libraries[1]
+	if loaded.Name != lib || !slices.Equal(loaded.Symbols, []string{sym}) || loaded.Error != "" {
+		t.Errorf("loaded library = %+v, want %s with symbol %s", loaded, lib, sym)
+	}
+	if loaded.Path == "" || len(loaded.SHA256) != 64 {
+		t.Errorf("loaded library has path %q, digest %q", loaded.Path, loaded.SHA256)
+	}
+	if missing.Error == "" {
+		t.Errorf("missing library = %+v, want its load error", missing)
+	}
+	if len(m.Callbacks) != 1 || m.Callbacks[0].Count != 1 || !strings.Contains(m.Callbacks[0].Site, "TestAudit") {
+		t.Errorf("Callbacks = %+v, want one registration in TestAudit", m.Callbacks)
+	}
+}
@@ -0,0 +1,258 @@
+package ffi
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestBlockingCallInterface(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", sym, err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	cif.Blocking = true
+
+	str := []byte("blocking call\x00")
+	strPtr := unsafe.Pointer(&str[0])
+	// Run concurrently so the blocking stacks are both reused and grown.
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				var n uint64
+				if err := CallFunction(cif, strlen, unsafe.Pointer(&n),
+					[]unsafe.Pointer{unsafe.Pointer(&strPtr)}); err != nil {
+					t.Error(err)
+					return
+				}
+				if n != 13 {
+					t.Errorf("strlen = %d, want 13", n)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	runtime.KeepAlive(str)
+}
+
+func TestWithBlockingCall(t *testing.T) {
+	sleepFn, scale := sleepFunc(t)
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.UInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	// With a single P, other goroutines only make progress during the
+	// call if the P is handed off.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	var ticks atomic.Int64
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				ticks.Add(1)
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	d := 100 * scale
+	var rc int32
+	start := time.Now()
+	err := CallFunctionWithOptions(context.Background(), cif, sleepFn, unsafe.Pointer(&rc),
+		[]unsafe.Pointer{unsafe.Pointer(&d)}, WithBlockingCall())
+	elapsed := time.Since(start)
+	close(stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed < 90*time.Millisecond {
+		t.Errorf("call returned after %v, want >= 100ms", elapsed)
+	}
+	if ticks.Load() == 0 {
+		t.Error("no goroutine ran while the blocking call was in progress")
+	}
+	if cif.Blocking {
+		t.Error("WithBlockingCall must not modify the caller's CallInterface")
+	}
+}
+
+func TestBlockingCallCallback(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol(qsort): %v", err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	xs := []int32{3, 1, 2}
+	base := unsafe.Pointer(&xs[0])
+	n, size := uint64(len(xs)), uint64(4)
+	cmp := NewCallback(func(a, b *int32) int32 { return *a - *b })
+	defer releaseCallback(cmp)
+	if err := CallFunctionWithOptions(context.Background(), cif, qsort, nil,
+		[]unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp)},
+		WithBlockingCall()); err != nil {
+		t.Fatal(err)
+	}
+	if xs[0] != 1 || xs[1] != 2 || xs[2] != 3 {
+		t.Errorf("qsort with a callback during a blocking call = %v, want [1 2 3]", xs)
+	}
+}
+
+//go:noinline
+func blockingCallbackDepth(n int) int {
+	var pad [256]byte
+	if n == 0 {
+		return int(pad[0])
+	}
+	return blockingCallbackDepth(n-1) + int(pad[n%len(pad)])
+}
+
+func TestBlockingCallCallbackStackGrowth(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol(qsort): %v", err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	cif.Blocking = true
+
+	// The comparator grows the goroutine stack, allocates and collects, so
+	// callbacks during the call move the stack and run the scheduler.
+	var calls atomic.Int64
+	cmp := NewCallback(func(a, b *int32) int32 {
+		if calls.Add(1)%64 == 0 {
+			runtime.GC()
+		}
+		_ = make([]byte, 1024)
+		blockingCallbackDepth(64)
+		return *a - *b
+	})
+	defer releaseCallback(cmp)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				xs := []int32{5, 3, 8, 1, 9, 2, 7, 4, 6, 0}
+				base := unsafe.Pointer(&xs[0])
+				n, size := uint64(len(xs)), uint64(4)
+				if err := CallFunction(cif, qsort, nil, []unsafe.Pointer{
+					unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp),
+				}); err != nil {
+					t.Error(err)
+					return
+				}
+				for i, x := range xs {
+					if x != int32(i) {
+						t.Errorf("qsort during a blocking call = %v", xs)
+						return
+					}
+				}
+				// The goroutine must still be able to park afterwards.
+				time.Sleep(time.Microsecond)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() == 0 {
+		t.Error("comparator was never called")
+	}
+}
+
+func TestBlockingCallResultAfterStackGrowth(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	bsearch, err := GetSymbol(handle, "bsearch")
+	if err != nil {
+		t.Fatalf("GetSymbol(bsearch): %v", err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.PointerTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	cif.Blocking = true
+
+	cmp := NewCallback(func(a, b *int32) int32 {
+		blockingCallbackDepth(64)
+		return *a - *b
+	})
+	defer releaseCallback(cmp)
+
+	// A fresh goroutine starts with a small stack, so the comparator moves
+	// it while bsearch still runs. The result must still arrive.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		xs := []int32{1, 3, 5, 7, 9}
+		key := int32(7)
+		keyp, base := unsafe.Pointer(&key), unsafe.Pointer(&xs[0])
+		n, size := uint64(len(xs)), uint64(4)
+		var found unsafe.Pointer
+		if err := CallFunction(cif, bsearch, unsafe.Pointer(&found), []unsafe.Pointer{
+			unsafe.Pointer(&keyp), unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+		if found != unsafe.Pointer(&xs[3]) {
+			t.Errorf("bsearch during a blocking call = %p, want %p", found, &xs[3])
+		}
+	}()
+	<-done
+}
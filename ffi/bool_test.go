@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestBool(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("arguments", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "bool_xor")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.BoolTypeDescriptor,
+			[]*types.TypeDescriptor{types.BoolTypeDescriptor, types.SInt64TypeDescriptor, types.BoolTypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range []struct{ a, b, want bool }{
+			{false, false, false}, {true, false, true}, {false, true, true}, {true, true, false},
+		} {
+			pad := int64(-1)
+			var got bool
+			if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+				[]unsafe.Pointer{unsafe.Pointer(&tt.a), unsafe.Pointer(&pad), unsafe.Pointer(&tt.b)}); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("bool_xor(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("normalized result", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "bool_dirty")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.BoolTypeDescriptor,
+			[]*types.TypeDescriptor{types.UInt8TypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		for _, low := range []uint8{0, 1, 2, 0x80} {
+			var got [8]uint8
+			if err := CallFunction(&cif, sym, unsafe.Pointer(&got[0]),
+				[]unsafe.Pointer{unsafe.Pointer(&low)}); err != nil {
+				t.Fatal(err)
+			}
+			want := [8]uint8{}
+			if low != 0 {
+				want[0] = 1
+			}
+			if got != want {
+				t.Errorf("bool_dirty(%#x) stored % x, want % x", low, got, want)
+			}
+		}
+	})
+
+	t.Run("RegisterFunc", func(t *testing.T) {
+		var count func(a, b, c bool) int32
+		if err := RegisterLibFunc(&count, structTestLib, "bool_count"); err != nil {
+			t.Fatal(err)
+		}
+		if got := count(true, false, true); got != 2 {
+			t.Errorf("bool_count(true, false, true) = %d, want 2", got)
+		}
+	})
+}
@@ -1,21 +1,163 @@
 package ffi
 
 import (
+	"errors"
+	"fmt"
+	"time"
 	"unsafe"
 
 	"github.com/go-webgpu/goffi/internal/arch"
 	"github.com/go-webgpu/goffi/types"
 )
 
-// executeFunction calls a function through architecture-dependent mechanism
+// ErrForkedChild is returned by calls made in a child process created by
+// fork() from native code after EnableForkSafety was called.
+//
+// Only the forking thread survives fork(); the Go runtime's other threads,
+// the locks they held and any library state guarded by them are gone. The
+// only safe thing a child can do is exec or _exit, so goffi refuses further
+// native calls instead of letting them deadlock or corrupt memory.
+var ErrForkedChild = errors.New("goffi: native call attempted in a forked child process")
+
+// validateArgs checks that avalue supplies exactly one non-nil pointer per
+// argument of cif. Execute reads argument values through these pointers and
+// stops at the shorter of the two lengths, so a mismatch would otherwise
+// leave registers holding whatever the previous call put there.
+func validateArgs(cif *types.CallInterface, avalue []unsafe.Pointer) error {
+	if len(avalue) != cif.ArgCount {
+		return &InvalidCallInterfaceError{
+			Field:  "avalue",
+			Reason: fmt.Sprintf("got %d argument pointers, call interface expects %d", len(avalue), cif.ArgCount),
+			Index:  -1,
+		}
+	}
+	for i, p := range avalue {
+		if p == nil {
+			return &InvalidCallInterfaceError{
+				Field:  "avalue",
+				Reason: "argument pointer must not be nil",
+				Index:  i,
+			}
+		}
+	}
+	return nil
+}
+
+// executeFunction calls a function through the reentrancy check and the
+// interceptors of its library, if any, and the architecture-dependent
+// mechanism. out, if not nil, selects what is captured besides rvalue.
 func executeFunction(
 	cif *types.CallInterface,
 	fn unsafe.Pointer,
 	rvalue unsafe.Pointer,
 	avalue []unsafe.Pointer,
+	out *callOutputs,
+) error {
+	if opts := reentrancyCheck.Load(); opts != nil {
+		leave, err := enterCall(opts, fn)
+		if err != nil {
+			return err
+		}
+		defer leave()
+	}
+	call, chain, ok := interceptedCall(fn)
+	if !ok {
+		return executeTraced(cif, fn, rvalue, avalue, out)
+	}
+	call.CIF, call.RValue, call.AValue = cif, rvalue, avalue
+	return runChain(call, chain, func(call *Call) error {
+		if call.CIF == nil || call.Fn == nil {
+			return &InvalidCallInterfaceError{Field: "call", Reason: "interceptor cleared CIF or Fn", Index: -1}
+		}
+		if err := validateArgs(call.CIF, call.AValue); err != nil {
+			return err
+		}
+		return executeTraced(call.CIF, call.Fn, call.RValue, call.AValue, out)
+	})
+}
+
+// executeTraced calls a function through architecture-dependent mechanism
+// and reports the call to the trace hook.
+func executeTraced(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+	out *callOutputs,
+) error {
+	if traceHook.Load() == nil { // audits do not record calls
+		return executeCall(cif, fn, rvalue, avalue, out)
+	}
+	start := time.Now()
+	err := executeCall(cif, fn, rvalue, avalue, out)
+	trace(TraceEvent{Kind: TraceCall, Addr: uintptr(fn), Args: len(avalue), Duration: time.Since(start), Err: err})
+	return err
+}
+
+// executeCall is executeTraced without tracing.
+func executeCall(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+	out *callOutputs,
+) error {
+	if err := checkForkedChild(); err != nil {
+		return err
+	}
+	if v, ok := symbolOwners.Load(uintptr(fn)); ok {
+		owner := v.(symbolOwner)
+		if owner.unloaded || owner.usage != nil && !owner.usage.beginCall() {
+			return &LibraryError{Operation: "call", Name: owner.name, Err: ErrLibraryUnloaded}
+		}
+		if owner.usage != nil {
+			defer owner.usage.endCall()
+		}
+	}
+	if stub, ok := lookupCallStub(fn); ok {
+		return withStringReturn(cif, rvalue, func(rvalue unsafe.Pointer) error {
+			return execute(stubCaller(stub), out, cif, fn, rvalue, avalue)
+		})
+	}
+	caller, err := callerFor(cif)
+	if err != nil {
+		return err
+	}
+	return withStringReturn(cif, rvalue, func(rvalue unsafe.Pointer) error {
+		cif, avalue := promoteVariadic(cif, avalue)
+		return execute(caller, out, cif, fn, rvalue, avalue)
+	})
+}
+
+// callOutputs receives results of a call beyond rvalue. At most one field is
+// set.
+type callOutputs struct {
+	errno *Errno           // error number the callee left behind, see CallWithErrno
+	regs  *ReturnRegisters // raw integer return registers, see CallFunctionRegisters
+}
+
+// execute runs the call through caller, capturing what out asks for.
+func execute(
+	caller arch.FunctionCaller,
+	out *callOutputs,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
 ) error {
-	if arch.Registry.Caller == nil {
-		return types.ErrUnsupportedArchitecture
+	switch {
+	case out == nil:
+		return caller.Execute(cif, fn, rvalue, avalue)
+	case out.errno != nil:
+		return executeErrno(caller, out.errno, cif, fn, rvalue, avalue)
+	case out.regs != nil:
+		rc, ok := caller.(arch.RegisterCaller)
+		if !ok {
+			return types.ErrUnsupportedArchitecture
+		}
+		regs, err := rc.ExecuteRegisters(cif, fn, rvalue, avalue)
+		*out.regs = ReturnRegisters{R1: regs[0], R2: regs[1]}
+		return err
 	}
-	return arch.Registry.Caller.Execute(cif, fn, rvalue, avalue)
+	return caller.Execute(cif, fn, rvalue, avalue)
 }
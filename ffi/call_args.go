@@ -0,0 +1,117 @@
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// CallFunctionArgs executes a C function call with arguments passed as raw
+// uintptr values, following the syscall.Syscall convention.
+//
+// The function is marked //go:uintptrescapes: when an argument is written as
+// uintptr(unsafe.Pointer(p)) directly in the call expression, the compiler
+// keeps the referent alive and at a fixed address until CallFunctionArgs
+// returns. This lets bindings pass Go buffers and handle values without
+// cgo.Handle, runtime.Pinner or a trailing runtime.KeepAlive:
+//
+//	buf := make([]byte, 256)
+//	var n int32
+//	err := ffi.CallFunctionArgs(&cif, snprintfPtr, unsafe.Pointer(&n),
+//	    uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)), uintptr(unsafe.Pointer(fmtPtr)))
+//
+// The guarantee only applies to conversions that appear in the call itself;
+// a uintptr stored in a variable first is just an integer to the GC.
+//
+// Each argument is interpreted according to cif.ArgTypes[i]: integer and
+// pointer kinds use the low bytes of the value, FloatType expects
+// math.Float32bits, Float16Type the bits of a types.Float16 and DoubleType
+// math.Float64bits. On 32-bit platforms a DoubleType, SInt64Type or
+// UInt64Type argument does not fit in one uintptr and takes two consecutive
+// values, low word first, as 64-bit arguments to syscall.Syscall do there.
+// Struct and long double arguments are not representable as uintptr values
+// and are rejected.
+//
+//go:uintptrescapes
+func CallFunctionArgs(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	args ...uintptr,
+) error {
+	if cif == nil {
+		return &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	if fn == nil {
+		return &InvalidCallInterfaceError{
+			Field:  "fn",
+			Reason: "function pointer must not be nil",
+			Index:  -1,
+		}
+	}
+	avalue, err := argValues(cif.ArgTypes, args)
+	if err != nil {
+		return err
+	}
+	err = executeFunction(cif, fn, rvalue, avalue, nil)
+	runtime.KeepAlive(args)
+	return err
+}
+
+// argValues returns the avalue slice for args as CallFunctionArgs passes
+// them. It is generic over the word type so that tests on 64-bit hosts can
+// build the 32-bit layout. An argument wider than a word is assembled from
+// two words into a uint64 that its avalue entry points to.
+func argValues[W uint32 | uintptr](argTypes []*types.TypeDescriptor, args []W) ([]unsafe.Pointer, error) {
+	split := unsafe.Sizeof(W(0)) == 4
+	words := 0
+	for i, t := range argTypes {
+		switch t.Kind {
+		case types.StructType, types.LongDoubleType, types.SInt128Type, types.UInt128Type, types.VectorType:
+			return nil, &InvalidCallInterfaceError{
+				Field:  "args",
+				Reason: "struct, long double, __int128 and vector arguments cannot be passed as uintptr",
+				Index:  i,
+			}
+		}
+		words += argWords(t, split)
+	}
+	if len(args) != words {
+		return nil, &InvalidCallInterfaceError{
+			Field:  "args",
+			Reason: "argument count does not match call interface",
+			Index:  -1,
+		}
+	}
+
+	avalue := make([]unsafe.Pointer, len(argTypes))
+	j := 0
+	for i, t := range argTypes {
+		if argWords(t, split) == 2 {
+			v := uint64(args[j]) | uint64(args[j+1])<<32
+			avalue[i] = unsafe.Pointer(&v)
+			j += 2
+			continue
+		}
+		avalue[i] = unsafe.Pointer(&args[j])
+		j++
+	}
+	return avalue, nil
+}
+
+// argWords returns how many words an argument of type t takes; split
+// reports 32-bit words.
+func argWords(t *types.TypeDescriptor, split bool) int {
+	switch t.Kind {
+	case types.DoubleType, types.SInt64Type, types.UInt64Type:
+		if split {
+			return 2
+		}
+	}
+	return 1
+}
@@ -0,0 +1,99 @@
+package ffi
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCallFunctionArgs(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	defer FreeLibrary(handle)
+
+	memsetFn, err := GetSymbol(handle, "memset")
+	if err != nil {
+		t.Fatalf("GetSymbol(memset): %v", err)
+	}
+
+	// void *memset(void *s, int c, size_t n)
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.PointerTypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.SInt32TypeDescriptor, types.SizeTTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 64)
+	var ret uintptr
+	err = CallFunctionArgs(cif, memsetFn, unsafe.Pointer(&ret),
+		uintptr(unsafe.Pointer(&buf[0])), 0xAB, uintptr(len(buf)-1))
+	if err != nil {
+		t.Fatalf("CallFunctionArgs: %v", err)
+	}
+	runtime.GC()
+	if ret != uintptr(unsafe.Pointer(&buf[0])) {
+		t.Errorf("memset returned %#x, want %p", ret, &buf[0])
+	}
+	for i, b := range buf[:len(buf)-1] {
+		if b != 0xAB {
+			t.Fatalf("buf[%d] = %#x, want 0xAB", i, b)
+		}
+	}
+	if buf[len(buf)-1] != 0 {
+		t.Errorf("memset wrote past n")
+	}
+
+	t.Run("CountMismatch", func(t *testing.T) {
+		err := CallFunctionArgs(cif, memsetFn, nil, 1, 2)
+		var icErr *InvalidCallInterfaceError
+		if !errors.As(err, &icErr) || icErr.Field != "args" {
+			t.Fatalf("expected args InvalidCallInterfaceError, got %v", err)
+		}
+	})
+}
+
+// TestArgValuesSplitWords builds the avalue slice of CallFunctionArgs with
+// 32-bit words, as on linux/arm, and reads it back the way the arm frame
+// does: 8 bytes for double and 64-bit integers, 4 bytes otherwise.
+func TestArgValuesSplitWords(t *testing.T) {
+	argTypes := []*types.TypeDescriptor{
+		types.SInt32TypeDescriptor,
+		types.DoubleTypeDescriptor,
+		types.UInt64TypeDescriptor,
+		types.FloatTypeDescriptor,
+	}
+	d := math.Float64bits(-2.5)
+	args := []uint32{
+		7,
+		uint32(d), uint32(d >> 32),
+		0x55667788, 0x11223344,
+		math.Float32bits(1.5),
+	}
+	avalue, err := argValues(argTypes, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := *(*int32)(avalue[0]); got != 7 {
+		t.Errorf("int32 = %d, want 7", got)
+	}
+	if got := math.Float64frombits(*(*uint64)(avalue[1])); got != -2.5 {
+		t.Errorf("double = %v, want -2.5", got)
+	}
+	if got := *(*uint64)(avalue[2]); got != 0x1122334455667788 {
+		t.Errorf("uint64 = %#x, want 0x1122334455667788", got)
+	}
+	if got := math.Float32frombits(*(*uint32)(avalue[3])); got != 1.5 {
+		t.Errorf("float = %v, want 1.5", got)
+	}
+
+	if _, err := argValues(argTypes, args[:5]); err == nil {
+		t.Error("argValues accepted a double passed as one 32-bit word")
+	}
+}
@@ -6,24 +6,23 @@
 package ffi
 
 import (
+	"fmt"
+	"math"
 	"reflect"
 	"structs"
 	"sync"
 	"unsafe"
 )
 
-// maxCallbacks is the maximum number of concurrent callbacks supported.
-// This limit is determined by the number of trampoline entries in callback_amd64.s.
-const maxCallbacks = 2000
-
 // callbacks holds the global callback registry.
 // The registry is thread-safe and stores all registered Go functions that can be
 // called from C code. Functions are stored as reflect.Value to enable dynamic
 // invocation with proper type checking and argument marshaling.
 var callbacks struct {
-	mu    sync.Mutex                  // Protects funcs and count
+	mu    sync.Mutex                  // Protects funcs, count and free
 	funcs [maxCallbacks]reflect.Value // Registered callback functions
-	count int                         // Number of active callbacks
+	count int                         // Number of trampoline slots handed out
+	free  []int                       // Released slots, reused before fresh ones
 }
 
 // callbackArgs represents the argument block passed from assembly to callbackWrap.
@@ -31,10 +30,13 @@ var callbacks struct {
 // The assembly code saves all CPU registers (both integer and SSE) into a contiguous
 // memory block following this structure.
 type callbackArgs struct {
-	_      structs.HostLayout
-	index  uintptr        // Callback index (0-1999)
-	args   unsafe.Pointer // Pointer to register/stack argument block
-	result uintptr        // Return value from Go callback
+	_        structs.HostLayout
+	index    uintptr        // Callback index (0 to maxCallbacks-1)
+	args     unsafe.Pointer // Pointer to register/stack argument block
+	result   uintptr        // RAX on return
+	resultHi uintptr        // RDX on return, second INTEGER eightbyte of a struct
+	fresult  [2]uint64      // XMM0 and XMM1 on return
+	_        uintptr        // Keeps callbackDispatcher's frame 16-byte aligned
 }
 
 // NewCallback registers a Go function as a C callback and returns a function pointer.
@@ -43,14 +45,19 @@ type callbackArgs struct {
 // Requirements:
 //   - fn must be a function (not nil)
 //   - fn can have multiple arguments of basic types (int, float, pointer, etc.)
-//   - fn can return at most one value of basic type
+//   - fn can return at most one value of basic type, or a struct of at most
+//     16 bytes (on arm64 also a floating-point HFA of up to four members)
 //   - Complex types (string, slice, map, chan, interface) are not supported
-//   - Maximum 2000 callbacks can be registered (program lifetime limit)
+//   - At most CallbackCapacity() callbacks (2000 by default) can be
+//     registered at a time
 //
 // Memory Management:
-//   - Callbacks are never freed (stored in global registry)
+//   - Callbacks stay registered until FreeCallback (stored in global registry)
 //   - This prevents GC from collecting callback data while C code uses it
-//   - For applications with dynamic callback creation, consider callback pools
+//   - Applications that create callbacks dynamically free each one when
+//     native code no longer holds it, which makes its slot reusable
+//   - Callbacks only used during one call can use WithScopedCallback instead,
+//     which gives the slot back when the call returns
 //
 // Usage Example:
 //
@@ -63,7 +70,7 @@ type callbackArgs struct {
 //
 // Using unsafe.Pointer is necessary here as we're creating a function pointer
 // that C code can call. The pointer is obtained from the assembly trampoline table
-// and is guaranteed to be valid for the program lifetime.
+// and is valid until FreeCallback releases it.
 func NewCallback(fn any) uintptr {
 	if fn == nil {
 		panic("ffi: callback function must not be nil")
@@ -80,18 +87,19 @@ func NewCallback(fn any) uintptr {
 
 	// Register callback in global registry
 	callbacks.mu.Lock()
-	defer callbacks.mu.Unlock()
-
-	if callbacks.count >= maxCallbacks {
-		panic("ffi: callback limit reached (2000 callbacks maximum)")
+	idx, ok := allocCallbackSlot()
+	if !ok {
+		callbacks.mu.Unlock()
+		panic(fmt.Sprintf("ffi: callback limit reached (%d callbacks maximum)", maxCallbacks))
 	}
-
-	idx := callbacks.count
-	callbacks.funcs[idx] = val
-	callbacks.count++
+	storeCallback(idx, val)
+	recordCallback(idx)
+	callbacks.mu.Unlock()
 
 	// Return address to corresponding trampoline entry
-	return trampolineEntryAddr(idx)
+	ptr := trampolineEntryAddr(idx)
+	trace(TraceEvent{Kind: TraceCallbackRegister, Addr: ptr})
+	return ptr
 }
 
 // validateCallbackSignature checks if a function type is valid for callbacks.
@@ -125,6 +133,13 @@ func validateCallbackSignature(typ reflect.Type) {
 			reflect.Uintptr, reflect.Float32, reflect.Float64,
 			reflect.Pointer, reflect.UnsafePointer, reflect.Bool:
 			// Valid return types
+		case reflect.Struct:
+			// Structs up to 16 bytes come back in RAX:RDX and XMM0:XMM1;
+			// larger ones would need the caller's hidden result pointer.
+			if retType.Size() > 16 {
+				panic(fmt.Sprintf("ffi: callback struct return %s is %d bytes, at most 16 are supported",
+					retType, retType.Size()))
+			}
 		default:
 			panic("ffi: unsupported callback return type: " + retType.Kind().String())
 		}
@@ -133,14 +148,27 @@ func validateCallbackSignature(typ reflect.Type) {
 	}
 }
 
+// callbackFrameWords returns how many words of the argument block a callback
+// of type typ can read: the saved XMM0-XMM7 and six integer registers,
+// followed by the caller's stack arguments. Each argument that does not get
+// a register takes one stack word per eightbyte, so the count assumes none
+// do; native code cannot tell the callee how much it actually pushed.
+func callbackFrameWords(typ reflect.Type) int {
+	n := 8 + 6
+	for i := range typ.NumIn() {
+		n += max(1, int((typ.In(i).Size()+7)/8))
+	}
+	return n
+}
+
 // trampolineEntryAddr calculates the address of a specific trampoline entry.
-// Each trampoline entry is 5 bytes (CALL instruction) on AMD64.
+// Each trampoline entry is 16 bytes on AMD64 (ENDBR64, MOVL index, JMP, padding).
 // The calculation is: base_address + (index * entry_size).
 //
 // This function is called by NewCallback to get the C-callable function pointer
 // for a registered Go callback.
 func trampolineEntryAddr(i int) uintptr {
-	const entrySize = 5 // AMD64: CALL instruction = 5 bytes
+	const entrySize = 16 // AMD64: see callbackTrampoline in callback_amd64.s
 	return trampolineBaseAddr + uintptr(i*entrySize)
 }
 
@@ -170,11 +198,19 @@ var callbackWrap_call = callbackWrap
 //
 // This function uses reflection to dynamically convert the register values into
 // properly-typed Go values, which adds some overhead but provides type safety.
+// Signatures compileCallback recognizes skip reflection and call the function
+// directly on the integer registers.
 func callbackWrap(a *callbackArgs) {
 	// Retrieve the registered callback function
-	callbacks.mu.Lock()
-	fn := callbacks.funcs[a.index]
-	callbacks.mu.Unlock()
+	if p := callbackPanicPolicy.Load(); p != nil {
+		defer recoverCallback(p, int(a.index))
+	}
+	fn, plan := loadCallback(int(a.index))
+	if plan.invoke != nil {
+		// RDI, RSI, RDX and RCX follow XMM0-XMM7 in the argument block.
+		a.result = plan.invoke((*[4]uintptr)(unsafe.Add(a.args, 8*8)))
+		return
+	}
 
 	typ := fn.Type()
 	numArgs := typ.NumIn()
@@ -186,9 +222,9 @@ func callbackWrap(a *callbackArgs) {
 		numIntRegs   = 6 // RDI, RSI, RDX, RCX, R8, R9
 	)
 
-	// Cast args pointer to array for easy indexing
+	// View the argument block as the words the signature can read
 	// Each register is 8 bytes (64-bit)
-	frame := (*[128]uintptr)(a.args) // Large enough for registers + reasonable stack args
+	frame := unsafe.Slice((*uintptr)(a.args), plan.words)
 
 	var floatIdx int                      // Current float register index (0-7)
 	var intIdx int                        // Current integer register index (0-5)
@@ -375,9 +411,41 @@ func callbackWrap(a *callbackArgs) {
 			a.result = ret.Pointer()
 		case reflect.Float32, reflect.Float64:
 			// For float returns, store the bits as uintptr
-			// The assembly code will move this to XMM0 for return
+			// The assembly code loads fresult into XMM0 for return
 			f64 := ret.Float()
 			a.result = *(*uintptr)(unsafe.Pointer(&f64))
+			if ret.Kind() == reflect.Float32 {
+				a.fresult[0] = uint64(math.Float32bits(float32(f64)))
+			} else {
+				a.fresult[0] = math.Float64bits(f64)
+			}
+		case reflect.Struct:
+			storeStructResult(a, ret)
+		}
+	}
+}
+
+// storeStructResult places a struct of at most 16 bytes in the result
+// registers. Per System V AMD64 ABI §3.2.3, each eightbyte is classified on
+// its own: INTEGER eightbytes take RAX then RDX and SSE eightbytes take XMM0
+// then XMM1, so {double, int64} comes back in XMM0 and RAX.
+func storeStructResult(a *callbackArgs, ret reflect.Value) {
+	typ := ret.Type()
+	sz := typ.Size()
+	var words [2]uint64
+	val := reflect.New(typ)
+	val.Elem().Set(ret)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&words)), sz), unsafe.Slice((*byte)(val.UnsafePointer()), sz))
+
+	ints := [2]*uintptr{&a.result, &a.resultHi}
+	var intIdx, floatIdx int
+	for i, off := 0, uintptr(0); off < sz; i, off = i+1, off+8 {
+		if classifyEightbyte(typ, off, min(off+8, sz)) {
+			a.fresult[floatIdx] = words[i]
+			floatIdx++
+		} else {
+			*ints[intIdx] = uintptr(words[i])
+			intIdx++
 		}
 	}
 }
@@ -411,23 +479,34 @@ func isStructAllFloats(structType reflect.Type) bool {
 	return true
 }
 
-// classifyEightbyte returns true if all struct fields whose offset falls within
-// [startOff, endOff) are SSE types (float or double).
-// Returns false if any field in the range is INTEGER class, or if no fields lie in the range.
-//
-// CAUTION: Does not currently support nested structs.
+// classifyEightbyte returns true if all scalar members whose offset falls
+// within [startOff, endOff) are SSE types (float or double), looking through
+// nested structs and arrays. Returns false if any member in the range is
+// INTEGER class, or if no members lie in the range.
 func classifyEightbyte(structType reflect.Type, startOff, endOff uintptr) bool {
 	allFloat := true
 	hasField := false
-	for i := range structType.NumField() {
-		field := structType.Field(i)
-		if field.Offset >= startOff && field.Offset < endOff {
-			hasField = true
-			if field.Type.Kind() != reflect.Float32 && field.Type.Kind() != reflect.Float64 {
-				allFloat = false
-				break
+	var walk func(typ reflect.Type, off uintptr)
+	walk = func(typ reflect.Type, off uintptr) {
+		switch typ.Kind() {
+		case reflect.Struct:
+			for i := range typ.NumField() {
+				field := typ.Field(i)
+				walk(field.Type, off+field.Offset)
+			}
+		case reflect.Array:
+			for i := range typ.Len() {
+				walk(typ.Elem(), off+uintptr(i)*typ.Elem().Size())
+			}
+		default:
+			if off >= startOff && off < endOff {
+				hasField = true
+				if typ.Kind() != reflect.Float32 && typ.Kind() != reflect.Float64 {
+					allFloat = false
+				}
 			}
 		}
 	}
+	walk(structType, 0)
 	return hasField && allFloat
 }
@@ -4,2014 +4,10 @@
 #include "go_asm.h"
 #include "funcdata.h"
 #include "abi_amd64.h"
+#include "cet_amd64.h"
 
-// callbackTrampoline is the entry point for all callback trampolines.
-// Each entry is exactly 5 bytes (CALL instruction).
-// When C code calls a callback, it jumps to one of these entries.
-// The CALL instruction pushes the return address, which callbackDispatcher
-// uses to calculate the callback index.
-TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
-
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
-	CALL ·callbackDispatcher(SB)
+// callbackTrampoline, the table of entries that jump to callbackDispatcher,
+// is in callback_table*_amd64.s.
 
 // callbackDispatcher is the common handler for all callback trampolines.
 // It routes through crosscall2 → runtime·load_g → runtime·cgocallback
@@ -2019,20 +15,19 @@ TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
 // C-library-created threads (e.g. Metal's addCompletedHandler:).
 //
 // On entry:
-//   0(SP)  = return address (points to next CALL in callbackTrampoline)
-//   8(SP)  = original caller's return address
+//   0(SP)  = original caller's return address
+//   R11    = callback index
 //   RDI-R9 = integer arguments from C
 //   XMM0-7 = float arguments from C
 //
 // Register usage (System V AMD64 ABI):
 //   Integer args: RDI, RSI, RDX, RCX, R8, R9
 //   Float args: XMM0-XMM7
-//   Return: RAX (integer), XMM0 (float)
+//   Return: RAX (integer), XMM0 (float); structs up to 16 bytes use
+//   RAX:RDX and XMM0:XMM1 by eightbyte class
 TEXT ·callbackDispatcher(SB), NOSPLIT|NOFRAME, $0
-	// On entry: return address on stack points into callbackTrampoline.
-	MOVQ 0(SP), AX  // save the return address to calculate the cb index
-	MOVQ 8(SP), R10 // get the return SP so that we can align register args with stack args
-	ADDQ $8, SP     // remove return address from stack, we are not returning to callbackTrampoline, but to its caller.
+	MOVQ R11, AX    // callback index, set by the trampoline entry
+	MOVQ 0(SP), R10 // get the return SP so that we can align register args with stack args
 
 	// Make space for first six int and 8 float argument registers.
 	ADJSP $14*8, SP
@@ -2057,14 +52,6 @@ TEXT ·callbackDispatcher(SB), NOSPLIT|NOFRAME, $0
 	// Switch from the host ABI to the Go ABI.
 	PUSH_REGS_HOST_TO_ABI0()
 
-	// Determine callback index: (return_addr - trampoline_base) / 5 - 1.
-	MOVQ $·callbackTrampoline(SB), DX
-	SUBQ DX, AX
-	MOVQ $0, DX
-	MOVQ $5, CX               // divide by 5 because each CALL instruction is 5 bytes long
-	DIVL CX
-	SUBQ $1, AX               // subtract 1 because return PC is to the next slot
-
 	// Create a struct callbackArgs on our stack to be passed as
 	// the "frame" to cgocallback and on to callbackWrap.
 	// $24 to make enough room for the arguments to runtime.cgocallback.
@@ -2072,6 +59,9 @@ TEXT ·callbackDispatcher(SB), NOSPLIT|NOFRAME, $0
 	MOVQ AX, (24+callbackArgs_index)(SP)  // callback index
 	MOVQ R8, (24+callbackArgs_args)(SP)   // address of args vector
 	MOVQ $0, (24+callbackArgs_result)(SP) // result
+	MOVQ $0, (24+callbackArgs_resultHi)(SP)
+	MOVQ $0, (24+callbackArgs_fresult)(SP)
+	MOVQ $0, (24+callbackArgs_fresult+8)(SP)
 	LEAQ 24(SP), AX                       // take the address of callbackArgs
 
 	// Call cgocallback, which will call callbackWrap(frame).
@@ -2083,7 +73,10 @@ TEXT ·callbackDispatcher(SB), NOSPLIT|NOFRAME, $0
 	CALL crosscall2(SB) // runtime.cgocallback(fn, frame, ctxt uintptr)
 
 	// Get callback result.
-	MOVQ (24+callbackArgs_result)(SP), AX
+	MOVQ  (24+callbackArgs_result)(SP), AX
+	MOVQ  (24+callbackArgs_resultHi)(SP), DX
+	MOVSD (24+callbackArgs_fresult)(SP), X0
+	MOVSD (24+callbackArgs_fresult+8)(SP), X1
 	ADDQ $(24+callbackArgs__size), SP     // remove callbackArgs struct
 
 	POP_REGS_HOST_TO_ABI0()
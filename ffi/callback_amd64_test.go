@@ -0,0 +1,36 @@
+//go:build (linux || darwin) && amd64
+
+package ffi
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+)
+
+// TestCallbackTrampolineCET checks that every trampoline entry starts with an
+// ENDBR64 landing pad and that the entries have the stride NewCallback assumes.
+func TestCallbackTrampolineCET(t *testing.T) {
+	endbr64 := []byte{0xf3, 0x0f, 0x1e, 0xfa}
+	for _, i := range []int{0, 1, 2, 255, 256, maxCallbacks - 1} {
+		if i >= maxCallbacks {
+			continue
+		}
+		addr := trampolineEntryAddr(i)
+		entry := unsafe.Slice((*byte)(foreignPointer(addr)), 16)
+		if !bytes.Equal(entry[:4], endbr64) {
+			t.Errorf("entry %d: starts with % x, want ENDBR64", i, entry[:4])
+		}
+		// MOVL $i, R11 (41 BB imm32)
+		if entry[4] != 0x41 || entry[5] != 0xbb {
+			t.Fatalf("entry %d: expected MOVL imm32 to R11, got % x", i, entry[4:6])
+		}
+		if idx := *(*uint32)(unsafe.Pointer(&entry[6])); idx != uint32(i) {
+			t.Errorf("entry %d: loads index %d", i, idx)
+		}
+	}
+
+	if !bytes.Equal(unsafe.Slice((*byte)(foreignPointer(forkChildHandlerABI0)), 4), endbr64) {
+		t.Error("forkChildHandler does not start with ENDBR64")
+	}
+}
@@ -0,0 +1,282 @@
+//go:build linux && arm
+
+// Package ffi provides callback support for Foreign Function Interface (32-bit ARM Linux version).
+// This file implements Go function registration as C callbacks using
+// pre-compiled assembly trampolines for optimal performance.
+package ffi
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"structs"
+	"sync"
+	"unsafe"
+)
+
+// callbacks holds the global callback registry.
+var callbacks struct {
+	mu    sync.Mutex
+	funcs [maxCallbacks]reflect.Value
+	count int
+	free  []int // Released slots, reused before fresh ones
+}
+
+// callbackArgs represents the argument block passed from assembly to callbackWrap.
+// ARM AAPCS-VFP layout: S0-S15 (float), R0-R3 (integer), then stack words.
+type callbackArgs struct {
+	_        structs.HostLayout
+	entry    uintptr        // Return address of the trampoline entry's BL
+	args     unsafe.Pointer // Pointer to register/stack argument block
+	result   uintptr        // R0 (and S0) on return
+	resultHi uintptr        // R1 (and S1) on return, high word of 64-bit results
+}
+
+// NewCallback registers a Go function as a C callback and returns a function pointer.
+func NewCallback(fn any) uintptr {
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+
+	typ := val.Type()
+	validateCallbackSignature(typ)
+
+	callbacks.mu.Lock()
+	idx, ok := allocCallbackSlot()
+	if !ok {
+		callbacks.mu.Unlock()
+		panic(fmt.Sprintf("ffi: callback limit reached (%d callbacks maximum)", maxCallbacks))
+	}
+	storeCallback(idx, val)
+	recordCallback(idx)
+	callbacks.mu.Unlock()
+
+	ptr := trampolineEntryAddr(idx)
+	trace(TraceEvent{Kind: TraceCallbackRegister, Addr: ptr})
+	return ptr
+}
+
+// validateCallbackSignature checks if a function type is valid for callbacks.
+func validateCallbackSignature(typ reflect.Type) {
+	numIn := typ.NumIn()
+	for i := 0; i < numIn; i++ {
+		argType := typ.In(i)
+		switch argType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Uintptr, reflect.Float32, reflect.Float64,
+			reflect.Ptr, reflect.UnsafePointer, reflect.Bool:
+			// Valid types
+		default:
+			panic("ffi: unsupported callback argument type: " + argType.Kind().String())
+		}
+	}
+
+	numOut := typ.NumOut()
+	switch numOut {
+	case 0:
+		// Void return is valid
+	case 1:
+		retType := typ.Out(0)
+		switch retType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Uintptr, reflect.Float32, reflect.Float64,
+			reflect.Ptr, reflect.UnsafePointer, reflect.Bool:
+			// Valid return types
+		default:
+			panic("ffi: unsupported callback return type: " + retType.Kind().String())
+		}
+	default:
+		panic("ffi: callbacks can only return zero or one value")
+	}
+}
+
+// callbackFrameWords returns how many 32-bit words of the argument block a
+// callback of type typ can read: the saved S0-S15 and R0-R3, followed by the
+// caller's stack arguments. A 64-bit argument may be preceded by a padding
+// word to align it.
+func callbackFrameWords(typ reflect.Type) int {
+	n := 16 + 4
+	for i := range typ.NumIn() {
+		size := typ.In(i).Size()
+		n += int((size + 3) / 4)
+		if size == 8 {
+			n++
+		}
+	}
+	return n
+}
+
+// trampolineEntrySize is the size of one trampoline entry on ARM
+// (MOVW R14, R12 = 4 bytes + BL dispatcher = 4 bytes).
+const trampolineEntrySize = 8
+
+// trampolineEntryAddr calculates the address of a specific trampoline entry.
+func trampolineEntryAddr(i int) uintptr {
+	return trampolineBaseAddr + uintptr(i*trampolineEntrySize)
+}
+
+// callbackWrap_call allows the calling of the ABIInternal wrapper
+// which is required for runtime.cgocallback without the <ABIInternal>
+// tag which is only allowed in the runtime.
+// This closure is used inside callback_arm.s to pass to crosscall2.
+var callbackWrap_call = callbackWrap
+
+// callbackWrap is called from assembly via crosscall2 to invoke the actual Go callback.
+//
+// Arguments are located with the AAPCS-VFP allocation rules: integers and
+// pointers take R0-R3 then stack words, 64-bit integers an even register
+// pair or an 8-byte aligned stack slot, and float32/float64 the lowest free
+// S or D register, back-filling, until a floating-point argument first goes
+// to the stack.
+//
+// Argument block layout (32-bit words):
+//   - [0-15]  S0-S15 (D0-D7)
+//   - [16-19] R0-R3
+//   - [20-]   stack arguments
+func callbackWrap(a *callbackArgs) {
+	// The trampoline's BL leaves the address after itself in LR, which is
+	// the start of the next entry.
+	idx := int((a.entry-trampolineBaseAddr)/trampolineEntrySize) - 1
+
+	if p := callbackPanicPolicy.Load(); p != nil {
+		defer recoverCallback(p, idx)
+	}
+	fn, plan := loadCallback(idx)
+	if plan.invoke != nil {
+		// R0-R3 follow S0-S15 in the argument block.
+		a.result = plan.invoke((*[4]uintptr)(unsafe.Add(a.args, 16*4)))
+		return
+	}
+
+	typ := fn.Type()
+	numArgs := typ.NumIn()
+
+	const (
+		coreBase  = 16 // R0 in the argument block
+		stackBase = 20 // First stack word in the argument block
+	)
+
+	block := unsafe.Slice((*uint32)(a.args), plan.words)
+
+	var (
+		ncrn  int    // Next core register
+		nsaa  int    // Next stack word
+		sused uint16 // Allocated or unavailable S registers
+	)
+
+	coreWord := func(n int, align8 bool) int {
+		if align8 {
+			ncrn = (ncrn + 1) &^ 1
+		}
+		if ncrn+n <= 4 {
+			pos := coreBase + ncrn
+			ncrn += n
+			return pos
+		}
+		ncrn = 4
+		if align8 {
+			nsaa = (nsaa + 1) &^ 1
+		}
+		pos := stackBase + nsaa
+		nsaa += n
+		return pos
+	}
+
+	vfpWord := func(double bool) int {
+		n, mask := 1, uint16(1)
+		if double {
+			n, mask = 2, 3
+		}
+		for s := 0; s+n <= 16; s += n {
+			if sused&(mask<<s) == 0 {
+				sused |= mask << s
+				return s
+			}
+		}
+		sused = 0xffff
+		if double {
+			nsaa = (nsaa + 1) &^ 1
+		}
+		pos := stackBase + nsaa
+		nsaa += n
+		return pos
+	}
+
+	args := make([]reflect.Value, numArgs)
+
+	for i := 0; i < numArgs; i++ {
+		argType := typ.In(i)
+		var val reflect.Value
+
+		switch argType.Kind() {
+		case reflect.Float32:
+			pos := vfpWord(false)
+			val = reflect.ValueOf(math.Float32frombits(block[pos])).Convert(argType)
+
+		case reflect.Float64:
+			pos := vfpWord(true)
+			bits := uint64(block[pos]) | uint64(block[pos+1])<<32
+			val = reflect.ValueOf(math.Float64frombits(bits)).Convert(argType)
+
+		case reflect.Int64, reflect.Uint64:
+			pos := coreWord(2, true)
+			val = reflect.NewAt(argType, unsafe.Pointer(&block[pos])).Elem()
+
+		case reflect.Bool:
+			val = reflect.ValueOf(block[coreWord(1, false)] != 0).Convert(argType)
+
+		case reflect.Ptr:
+			ptr := foreignPointer(uintptr(block[coreWord(1, false)]))
+			val = reflect.NewAt(argType.Elem(), ptr)
+
+		case reflect.UnsafePointer:
+			val = reflect.ValueOf(foreignPointer(uintptr(block[coreWord(1, false)])))
+
+		default:
+			// Narrow integers are extended to a full word by the caller, and
+			// the low bytes of a little-endian word hold the value.
+			pos := coreWord(1, false)
+			val = reflect.NewAt(argType, unsafe.Pointer(&block[pos])).Elem()
+		}
+
+		args[i] = val
+	}
+
+	results := fn.Call(args)
+
+	if len(results) > 0 {
+		ret := results[0]
+		var bits uint64
+		switch ret.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			bits = uint64(ret.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			bits = ret.Uint()
+		case reflect.Bool:
+			if ret.Bool() {
+				bits = 1
+			}
+		case reflect.Ptr, reflect.UnsafePointer:
+			bits = uint64(ret.Pointer())
+		case reflect.Float32:
+			bits = uint64(math.Float32bits(float32(ret.Float())))
+		case reflect.Float64:
+			bits = math.Float64bits(ret.Float())
+		}
+		a.result = uintptr(uint32(bits))
+		a.resultHi = uintptr(uint32(bits >> 32))
+	}
+}
+
+// trampolineBaseAddr is the address of the callback assembly trampoline table.
+//
+//go:linkname _callbackTrampoline github.com/go-webgpu/goffi/ffi.callbackTrampoline
+var _callbackTrampoline byte
+var trampolineBaseAddr = uintptr(unsafe.Pointer(&_callbackTrampoline))
@@ -0,0 +1,82 @@
+//go:build linux && arm
+
+#include "textflag.h"
+#include "go_asm.h"
+#include "funcdata.h"
+
+// callbackTrampoline, the table of entries that jump to callbackDispatcher,
+// is in callback_table*_arm.s.
+
+// callbackDispatcher handles the actual callback invocation on ARM
+// via crosscall2 → runtime·load_g → runtime·cgocallback.
+// This supports callbacks from both Go-managed threads and C-library-created
+// threads.
+//
+// On entry:
+//   R12 = C caller's return address (set by trampoline MOVW)
+//   R14 (LR) = address following the trampoline entry's BL
+//   R0-R3 = integer arguments from C
+//   S0-S15 = float arguments from C
+//   R13 = first stack argument from C (8-byte aligned)
+//
+// Stack frame layout (104 bytes, keeps R13 8-byte aligned for crosscall2):
+//   [0-3]     saved R12 (C caller's return address)
+//   [4-7]     saved R11 (callee-saved, used by Go assembler as REGTMP)
+//   [8-23]    callbackArgs struct (entry, args, result, resultHi = 4*4 bytes)
+//   [24-87]   saved S0-S15 (contiguous with R0-R3 above)
+//   [88-103]  saved R0-R3 (contiguous with the C caller's stack arguments)
+TEXT ·callbackDispatcher(SB), NOSPLIT|NOFRAME, $0
+	NO_LOCAL_POINTERS
+
+	// Save callback register arguments S0-S15, R0-R3 just below the
+	// stack arguments so the three areas form one block.
+	MOVM.WP [R0, R1, R2, R3], (R13)
+	SUB     $64, R13
+	MOVD    F0, (0*8)(R13)
+	MOVD    F1, (1*8)(R13)
+	MOVD    F2, (2*8)(R13)
+	MOVD    F3, (3*8)(R13)
+	MOVD    F4, (4*8)(R13)
+	MOVD    F5, (5*8)(R13)
+	MOVD    F6, (6*8)(R13)
+	MOVD    F7, (7*8)(R13)
+	MOVW    R13, R3 // address of args block
+
+	// Allocate the R12/R11 save area and callbackArgs struct.
+	SUB $24, R13
+
+	// R11 is callee-saved in C but the Go assembler uses it to load
+	// ·callbackWrap_call below, so save it first and restore it at the end.
+	MOVW R12, 0(R13)
+	MOVW R11, 4(R13)
+
+	// Build callbackArgs struct on the stack.
+	ADD  $8, R13, R2
+	MOVW R14, callbackArgs_entry(R2) // trampoline entry return address
+	MOVW R3, callbackArgs_args(R2)   // address of args block
+	MOVW $0, R3
+	MOVW R3, callbackArgs_result(R2)   // result = 0
+	MOVW R3, callbackArgs_resultHi(R2) // resultHi = 0
+
+	// Move parameters into registers.
+	// Get the ABIInternal function pointer
+	// without <ABIInternal> by using a closure.
+	MOVW ·callbackWrap_call(SB), R0
+	MOVW (R0), R0 // fn unsafe.Pointer
+	MOVW R2, R1   // frame (&callbackArgs{...})
+	MOVW $0, R3   // ctxt uintptr
+
+	BL crosscall2(SB)
+
+	// Get callback result in R0:R1 and, for float/double returns, S0:S1.
+	ADD  $8, R13, R2
+	MOVW callbackArgs_result(R2), R0
+	MOVW callbackArgs_resultHi(R2), R1
+	MOVD callbackArgs_result(R2), F0
+
+	// Restore LR and R11.
+	MOVW 0(R13), R14
+	MOVW 4(R13), R11
+	ADD  $(24+64+16), R13
+
+	RET
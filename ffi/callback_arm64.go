@@ -6,30 +6,31 @@
 package ffi
 
 import (
+	"fmt"
+	"math"
 	"reflect"
 	"structs"
 	"sync"
 	"unsafe"
 )
 
-// maxCallbacks is the maximum number of concurrent callbacks supported.
-// This limit is determined by the number of trampoline entries in callback_arm64.s.
-const maxCallbacks = 2000
-
 // callbacks holds the global callback registry.
 var callbacks struct {
 	mu    sync.Mutex
 	funcs [maxCallbacks]reflect.Value
 	count int
+	free  []int // Released slots, reused before fresh ones
 }
 
 // callbackArgs represents the argument block passed from assembly to callbackWrap.
 // ARM64 AAPCS64 layout: D0-D7 (float), X0-X7 (integer)
 type callbackArgs struct {
-	_      structs.HostLayout
-	index  uintptr        // Callback index (0-1999)
-	args   unsafe.Pointer // Pointer to register/stack argument block
-	result uintptr        // Return value from Go callback
+	_        structs.HostLayout
+	index    uintptr        // Callback index (0-1999)
+	args     unsafe.Pointer // Pointer to register/stack argument block
+	result   uintptr        // X0 on return
+	resultHi uintptr        // X1 on return, second doubleword of a struct
+	fresult  [4]uint64      // D0-D3 on return, members of an HFA
 }
 
 // NewCallback registers a Go function as a C callback and returns a function pointer.
@@ -47,17 +48,18 @@ func NewCallback(fn any) uintptr {
 	validateCallbackSignature(typ)
 
 	callbacks.mu.Lock()
-	defer callbacks.mu.Unlock()
-
-	if callbacks.count >= maxCallbacks {
-		panic("ffi: callback limit reached (2000 callbacks maximum)")
+	idx, ok := allocCallbackSlot()
+	if !ok {
+		callbacks.mu.Unlock()
+		panic(fmt.Sprintf("ffi: callback limit reached (%d callbacks maximum)", maxCallbacks))
 	}
+	storeCallback(idx, val)
+	recordCallback(idx)
+	callbacks.mu.Unlock()
 
-	idx := callbacks.count
-	callbacks.funcs[idx] = val
-	callbacks.count++
-
-	return trampolineEntryAddr(idx)
+	ptr := trampolineEntryAddr(idx)
+	trace(TraceEvent{Kind: TraceCallbackRegister, Addr: ptr})
+	return ptr
 }
 
 // validateCallbackSignature checks if a function type is valid for callbacks.
@@ -88,6 +90,13 @@ func validateCallbackSignature(typ reflect.Type) {
 			reflect.Uintptr, reflect.Float32, reflect.Float64,
 			reflect.Ptr, reflect.UnsafePointer, reflect.Bool:
 			// Valid return types
+		case reflect.Struct:
+			// HFAs come back in D0-D3 and other structs up to 16 bytes in
+			// X0:X1; larger ones would need the caller's X8 result pointer.
+			if _, n := callbackHFA(retType); n == 0 && retType.Size() > 16 {
+				panic(fmt.Sprintf("ffi: callback struct return %s is %d bytes, at most 16 are supported",
+					retType, retType.Size()))
+			}
 		default:
 			panic("ffi: unsupported callback return type: " + retType.Kind().String())
 		}
@@ -96,6 +105,17 @@ func validateCallbackSignature(typ reflect.Type) {
 	}
 }
 
+// callbackFrameWords returns how many words of the argument block a callback
+// of type typ can read: the saved D0-D7 and X0-X7, followed by the caller's
+// stack arguments, counting one stack slot per doubleword of each argument.
+func callbackFrameWords(typ reflect.Type) int {
+	n := 8 + 8
+	for i := range typ.NumIn() {
+		n += max(1, int((typ.In(i).Size()+7)/8))
+	}
+	return n
+}
+
 // trampolineEntryAddr calculates the address of a specific trampoline entry.
 // Each trampoline entry is 8 bytes on ARM64 (MOVD $index, R12 = 4 bytes + B dispatcher = 4 bytes).
 func trampolineEntryAddr(i int) uintptr {
@@ -121,9 +141,15 @@ var callbackWrap_call = callbackWrap
 //   - Integers: X0-X7 (8 registers, 64 bytes)
 //   - Stack arguments follow in memory
 func callbackWrap(a *callbackArgs) {
-	callbacks.mu.Lock()
-	fn := callbacks.funcs[a.index]
-	callbacks.mu.Unlock()
+	if p := callbackPanicPolicy.Load(); p != nil {
+		defer recoverCallback(p, int(a.index))
+	}
+	fn, plan := loadCallback(int(a.index))
+	if plan.invoke != nil {
+		// X0-X3 follow D0-D7 in the argument block.
+		a.result = plan.invoke((*[4]uintptr)(unsafe.Add(a.args, 8*8)))
+		return
+	}
 
 	typ := fn.Type()
 	numArgs := typ.NumIn()
@@ -133,7 +159,7 @@ func callbackWrap(a *callbackArgs) {
 		numIntRegs   = 8 // X0-X7
 	)
 
-	frame := (*[128]uintptr)(a.args)
+	frame := unsafe.Slice((*uintptr)(a.args), plan.words)
 
 	var floatIdx int
 	var intIdx int
@@ -185,13 +211,11 @@ func callbackWrap(a *callbackArgs) {
 		case reflect.Ptr:
 			if intIdx < numIntRegs {
 				pos := numFloatRegs + intIdx
-				//nolint:govet,gosec // G103: FFI callback argument
-				ptr := unsafe.Pointer(frame[pos])
+				ptr := foreignPointer(frame[pos])
 				val = reflect.NewAt(argType.Elem(), ptr)
 				intIdx++
 			} else {
-				//nolint:govet,gosec // G103: FFI callback argument
-				ptr := unsafe.Pointer(frame[stackIdx])
+				ptr := foreignPointer(frame[stackIdx])
 				val = reflect.NewAt(argType.Elem(), ptr)
 				stackIdx++
 			}
@@ -199,12 +223,10 @@ func callbackWrap(a *callbackArgs) {
 		case reflect.UnsafePointer:
 			if intIdx < numIntRegs {
 				pos := numFloatRegs + intIdx
-				//nolint:govet,gosec // G103: FFI callback argument
-				val = reflect.ValueOf(unsafe.Pointer(frame[pos]))
+				val = reflect.ValueOf(foreignPointer(frame[pos]))
 				intIdx++
 			} else {
-				//nolint:govet,gosec // G103: FFI callback argument
-				val = reflect.ValueOf(unsafe.Pointer(frame[stackIdx]))
+				val = reflect.ValueOf(foreignPointer(frame[stackIdx]))
 				stackIdx++
 			}
 
@@ -240,12 +262,87 @@ func callbackWrap(a *callbackArgs) {
 		case reflect.Ptr, reflect.UnsafePointer:
 			a.result = ret.Pointer()
 		case reflect.Float32, reflect.Float64:
+			// The assembly code loads fresult into D0 for return
 			f64 := ret.Float()
 			a.result = *(*uintptr)(unsafe.Pointer(&f64))
+			if ret.Kind() == reflect.Float32 {
+				a.fresult[0] = uint64(math.Float32bits(float32(f64)))
+			} else {
+				a.fresult[0] = math.Float64bits(f64)
+			}
+		case reflect.Struct:
+			storeStructResult(a, ret)
 		}
 	}
 }
 
+// storeStructResult places a struct result in the result registers. Per
+// AAPCS64, a homogeneous floating-point aggregate returns one member per
+// register in S0-S3 or D0-D3, and any other struct of at most 16 bytes
+// returns its bytes in X0 and X1.
+func storeStructResult(a *callbackArgs, ret reflect.Value) {
+	typ := ret.Type()
+	sz := typ.Size()
+	var words [4]uint64
+	val := reflect.New(typ)
+	val.Elem().Set(ret)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&words)), sz), unsafe.Slice((*byte)(val.UnsafePointer()), sz))
+
+	elem, n := callbackHFA(typ)
+	switch {
+	case n == 0:
+		a.result = uintptr(words[0])
+		a.resultHi = uintptr(words[1])
+	case elem == reflect.Float32:
+		members := (*[4]uint32)(unsafe.Pointer(&words))
+		for i := range n {
+			a.fresult[i] = uint64(members[i])
+		}
+	default:
+		copy(a.fresult[:], words[:n])
+	}
+}
+
+// callbackHFA reports whether typ is a homogeneous floating-point aggregate:
+// a struct whose scalar members, through nested structs and arrays, are one
+// to four values of the same floating-point kind. It returns that kind and
+// the member count, or 0 members for any other type.
+func callbackHFA(typ reflect.Type) (reflect.Kind, int) {
+	var elem reflect.Kind
+	n := 0
+	var walk func(t reflect.Type) bool
+	walk = func(t reflect.Type) bool {
+		switch t.Kind() {
+		case reflect.Struct:
+			for i := range t.NumField() {
+				if !walk(t.Field(i).Type) {
+					return false
+				}
+			}
+			return true
+		case reflect.Array:
+			for range t.Len() {
+				if !walk(t.Elem()) {
+					return false
+				}
+			}
+			return true
+		case reflect.Float32, reflect.Float64:
+			if n > 0 && t.Kind() != elem {
+				return false
+			}
+			elem = t.Kind()
+			n++
+			return n <= 4
+		}
+		return false
+	}
+	if typ.Kind() != reflect.Struct || !walk(typ) || n == 0 {
+		return reflect.Invalid, 0
+	}
+	return elem, n
+}
+
 // trampolineBaseAddr is the address of the callback assembly trampoline table.
 //
 //go:linkname _callbackTrampoline github.com/go-webgpu/goffi/ffi.callbackTrampoline
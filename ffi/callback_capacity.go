@@ -0,0 +1,10 @@
+//go:build !goffi_callbacks256 && !goffi_callbacks8000
+
+package ffi
+
+//go:generate go run gen_callback_entries.go
+
+// maxCallbacks is the number of trampoline entries, and so of callbacks that
+// can be registered at a time. Build with the goffi_callbacks256 tag to
+// shrink the table, or goffi_callbacks8000 to enlarge it.
+const maxCallbacks = 2000
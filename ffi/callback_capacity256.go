@@ -0,0 +1,6 @@
+//go:build goffi_callbacks256
+
+package ffi
+
+// maxCallbacks is the trampoline table size selected by goffi_callbacks256.
+const maxCallbacks = 256
@@ -0,0 +1,6 @@
+//go:build goffi_callbacks8000 && !goffi_callbacks256
+
+package ffi
+
+// maxCallbacks is the trampoline table size selected by goffi_callbacks8000.
+const maxCallbacks = 8000
@@ -0,0 +1,83 @@
+package ffi
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// callbackContexts holds, per goroutine, the contexts of the callbacks
+// created by NewCallbackContext that are running on it, innermost last.
+var callbackContexts struct {
+	mu          sync.Mutex
+	byGoroutine map[uint64][]context.Context
+}
+
+// NewCallbackContext is NewCallback for a callback that belongs to an
+// operation governed by ctx. While fn runs, CallbackContext returns ctx, so
+// the handler can stop early once the operation is cancelled and pass its
+// deadline on to the Go code it calls:
+//
+//	cb := ffi.NewCallbackContext(ctx, func(userdata unsafe.Pointer, status int32) {
+//	    ctx := ffi.CallbackContext()
+//	    if ctx.Err() != nil {
+//	        return // the request was abandoned
+//	    }
+//	    results <- fetch(ctx, userdata)
+//	})
+//
+// Native code cannot observe ctx: cancelling it does not unregister the
+// callback or stop a call in progress. Each invocation costs a goroutine
+// lookup on top of NewCallback's.
+func NewCallbackContext(ctx context.Context, fn any) uintptr {
+	if ctx == nil {
+		panic("ffi: callback context must not be nil")
+	}
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+	wrapped := reflect.MakeFunc(val.Type(), func(args []reflect.Value) []reflect.Value {
+		gid := goroutineID()
+		pushCallbackContext(gid, ctx)
+		defer popCallbackContext(gid)
+		return val.Call(args)
+	})
+	return NewCallback(wrapped.Interface())
+}
+
+// CallbackContext returns the context of the innermost callback created by
+// NewCallbackContext that is running on the calling goroutine, or
+// context.Background() outside of one.
+func CallbackContext() context.Context {
+	gid := goroutineID()
+	callbackContexts.mu.Lock()
+	defer callbackContexts.mu.Unlock()
+	if s := callbackContexts.byGoroutine[gid]; len(s) > 0 {
+		return s[len(s)-1]
+	}
+	return context.Background()
+}
+
+func pushCallbackContext(gid uint64, ctx context.Context) {
+	callbackContexts.mu.Lock()
+	defer callbackContexts.mu.Unlock()
+	if callbackContexts.byGoroutine == nil {
+		callbackContexts.byGoroutine = make(map[uint64][]context.Context)
+	}
+	callbackContexts.byGoroutine[gid] = append(callbackContexts.byGoroutine[gid], ctx)
+}
+
+func popCallbackContext(gid uint64) {
+	callbackContexts.mu.Lock()
+	defer callbackContexts.mu.Unlock()
+	if s := callbackContexts.byGoroutine[gid]; len(s) > 1 {
+		s[len(s)-1] = nil
+		callbackContexts.byGoroutine[gid] = s[:len(s)-1]
+	} else {
+		delete(callbackContexts.byGoroutine, gid)
+	}
+}
@@ -0,0 +1,47 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"context"
+	"testing"
+	"unsafe"
+)
+
+func TestNewCallbackContext(t *testing.T) {
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "request-7"))
+
+	var got context.Context
+	ptr := NewCallbackContext(ctx, func(x int) int {
+		got = CallbackContext()
+		return x + 1
+	})
+	defer releaseCallback(ptr)
+
+	invoke := func() int {
+		var frame [128]uintptr
+		frame[callbackIntRegIndex(0)] = 41
+		args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+		callbackWrap(args)
+		return int(args.result)
+	}
+	if r := invoke(); r != 42 {
+		t.Fatalf("callback returned %d, want 42", r)
+	}
+	if got == nil || got.Value(key{}) != "request-7" {
+		t.Fatalf("CallbackContext inside the callback = %v, want ctx", got)
+	}
+	if got.Err() != nil {
+		t.Error("context cancelled early")
+	}
+	cancel()
+	invoke()
+	if got.Err() != context.Canceled {
+		t.Errorf("Err after cancel = %v, want context.Canceled", got.Err())
+	}
+
+	if CallbackContext() != context.Background() {
+		t.Error("CallbackContext outside a callback is not context.Background()")
+	}
+}
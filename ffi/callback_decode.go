@@ -0,0 +1,102 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// NewDecodingCallback is NewCallback for handlers that take C structs passed
+// by pointer as ordinary Go values. Each non-nil argDescs[i] declares that
+// parameter i of fn is received from C as a pointer to a struct laid out as
+// argDescs[i]; the struct is decoded with Unmarshal before fn is called.
+// Such a parameter must be a struct T, which receives the zero value for
+// NULL, or a *T, which receives nil:
+//
+//	// void (*on_event)(const struct event *ev, void *userdata);
+//	type event struct {
+//	    Kind    uint32
+//	    Message string
+//	}
+//	cb, err := ffi.NewDecodingCallback(func(ev event, userdata unsafe.Pointer) {
+//	    log.Printf("%d: %s", ev.Kind, ev.Message)
+//	}, []*types.TypeDescriptor{eventDesc})
+//
+// The other parameters are passed as for NewCallback. Decoding copies the
+// struct, so fn may keep the value after returning. A struct the Go type
+// cannot hold (see Unmarshal) is a programming error, and panics like an
+// unsupported callback signature does.
+func NewDecodingCallback(fn any, argDescs []*types.TypeDescriptor) (uintptr, error) {
+	val := reflect.ValueOf(fn)
+	if fn == nil || val.Kind() != reflect.Func {
+		return 0, &InvalidCallInterfaceError{Field: "fn", Reason: "must be a non-nil func", Index: -1}
+	}
+	typ := val.Type()
+	if len(argDescs) > typ.NumIn() {
+		return 0, &InvalidCallInterfaceError{
+			Field:  "argDescs",
+			Reason: fmt.Sprintf("%d descriptors for %d parameters", len(argDescs), typ.NumIn()),
+			Index:  -1,
+		}
+	}
+
+	in := make([]reflect.Type, typ.NumIn())
+	for i := range in {
+		in[i] = typ.In(i)
+		if i >= len(argDescs) || argDescs[i] == nil {
+			continue
+		}
+		if err := prepareMarshalType("argDescs", argDescs[i]); err != nil {
+			return 0, err
+		}
+		t := in[i]
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || argDescs[i].Kind != types.StructType {
+			return 0, &InvalidCallInterfaceError{
+				Field:  "argDescs",
+				Reason: fmt.Sprintf("parameter of type %s is not a struct or struct pointer matching a struct descriptor", in[i]),
+				Index:  i,
+			}
+		}
+		in[i] = reflect.TypeFor[unsafe.Pointer]()
+	}
+	out := make([]reflect.Type, typ.NumOut())
+	for i := range out {
+		out[i] = typ.Out(i)
+	}
+
+	wrapped := reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		for i, desc := range argDescs {
+			if desc != nil {
+				args[i] = decodeCallbackArg(typ.In(i), desc, args[i].Interface().(unsafe.Pointer))
+			}
+		}
+		return val.Call(args)
+	})
+	return NewCallback(wrapped.Interface()), nil
+}
+
+// decodeCallbackArg unmarshals the struct at p into a value of type t, a
+// struct or pointer to struct.
+func decodeCallbackArg(t reflect.Type, desc *types.TypeDescriptor, p unsafe.Pointer) reflect.Value {
+	isPtr := t.Kind() == reflect.Pointer
+	if p == nil {
+		return reflect.Zero(t)
+	}
+	elem := t
+	if isPtr {
+		elem = t.Elem()
+	}
+	v := reflect.New(elem)
+	if err := Unmarshal(p, desc, v.Interface()); err != nil {
+		panic("ffi: decoding callback argument: " + err.Error())
+	}
+	if isPtr {
+		return v
+	}
+	return v.Elem()
+}
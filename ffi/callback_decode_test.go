@@ -0,0 +1,71 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestNewDecodingCallback(t *testing.T) {
+	// struct event { uint32_t kind; const char *message; };
+	eventDesc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		types.UInt32TypeDescriptor, types.PointerTypeDescriptor}}
+	type event struct {
+		Kind    uint32
+		Message string
+	}
+
+	var got event
+	var gotPtr *event
+	var gotUserdata uintptr
+	ptr, err := NewDecodingCallback(func(ev event, userdata uintptr, evp *event) int32 {
+		got, gotUserdata, gotPtr = ev, userdata, evp
+		return int32(ev.Kind) + 1
+	}, []*types.TypeDescriptor{eventDesc, nil, eventDesc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer releaseCallback(ptr)
+
+	arena := NewArena()
+	defer arena.Free()
+	cEvent := arena.Alloc(eventDesc.Size, eventDesc.Alignment)
+	if err := Marshal(cEvent, eventDesc, event{Kind: 4, Message: "resized"}, arena); err != nil {
+		t.Fatal(err)
+	}
+
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = uintptr(cEvent)
+	frame[callbackIntRegIndex(1)] = 0xbeef
+	frame[callbackIntRegIndex(2)] = 0 // NULL
+	args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+	callbackWrap(args)
+
+	if int32(args.result) != 5 {
+		t.Errorf("result = %d, want 5", int32(args.result))
+	}
+	if got != (event{Kind: 4, Message: "resized"}) || gotUserdata != 0xbeef {
+		t.Errorf("handler got %+v, %#x", got, gotUserdata)
+	}
+	if gotPtr != nil {
+		t.Errorf("NULL decoded to %+v, want nil", gotPtr)
+	}
+}
+
+func TestNewDecodingCallbackErrors(t *testing.T) {
+	desc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.UInt32TypeDescriptor}}
+	var icie *InvalidCallInterfaceError
+	if _, err := NewDecodingCallback(func(x uint32) {}, []*types.TypeDescriptor{desc}); !errors.As(err, &icie) || icie.Index != 0 {
+		t.Errorf("non-struct parameter: err = %v", err)
+	}
+	if _, err := NewDecodingCallback(func() {}, []*types.TypeDescriptor{desc}); !errors.As(err, &icie) {
+		t.Errorf("too many descriptors: err = %v", err)
+	}
+	if _, err := NewDecodingCallback(nil, nil); !errors.As(err, &icie) {
+		t.Errorf("nil fn: err = %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// DeferredCallbackOptions configures NewDeferredCallback.
+type DeferredCallbackOptions struct {
+	// Queue is how many invocations can wait for the handler; 0 means 64.
+	Queue int
+
+	// Result is what native code gets back from every invocation,
+	// converted to the result type of fn. nil returns the zero value.
+	Result any
+
+	// Drop makes an invocation that finds the queue full return at once
+	// without running fn, instead of waiting on the native thread for
+	// room.
+	Drop bool
+}
+
+// NewDeferredCallback is NewCallback for fire-and-forget notifications, such
+// as progress, logging or device-change callbacks invoked on threads the
+// native library owns. An invocation only copies its arguments to a queue
+// and returns opts.Result; fn runs later on a goroutine of its own, one
+// invocation at a time and in order, so heavy Go work never runs on the
+// foreign stack or holds up the native thread:
+//
+//	ptr, stop := ffi.NewDeferredCallback(func(device unsafe.Pointer, event uint32) {
+//	    refreshDevices(event)
+//	}, ffi.DeferredCallbackOptions{Queue: 16, Drop: true})
+//	defer stop()
+//
+// Arguments are copied by value: memory a pointer argument refers to may be
+// gone by the time fn runs, so fn must not dereference pointers that are
+// only valid during the native call. fn's results are discarded, and a
+// panic in fn crashes the program as in any goroutine.
+//
+// stop frees the callback, then waits for the queued invocations to finish;
+// native code must not call ptr afterwards. The signature requirements are
+// those of NewCallback, and a Result that cannot be converted to fn's result
+// type panics like an unsupported signature does.
+func NewDeferredCallback(fn any, opts DeferredCallbackOptions) (ptr uintptr, stop func()) {
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+	typ := val.Type()
+
+	results := make([]reflect.Value, typ.NumOut())
+	for i := range results {
+		results[i] = reflect.Zero(typ.Out(i))
+	}
+	if opts.Result != nil {
+		if len(results) != 1 {
+			panic("ffi: deferred callback result given for a function without exactly one result")
+		}
+		rv := reflect.ValueOf(opts.Result)
+		if !rv.CanConvert(typ.Out(0)) {
+			panic(fmt.Sprintf("ffi: deferred callback result %T cannot be converted to %s", opts.Result, typ.Out(0)))
+		}
+		results[0] = rv.Convert(typ.Out(0))
+	}
+	if opts.Queue <= 0 {
+		opts.Queue = 64
+	}
+
+	var (
+		mu      sync.RWMutex // Held for reading while enqueueing, for writing by stop
+		stopped bool
+		queue   = make(chan []reflect.Value, opts.Queue)
+		done    = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+		for args := range queue {
+			val.Call(args)
+		}
+	}()
+
+	wrapped := reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		mu.RLock()
+		defer mu.RUnlock()
+		if stopped {
+			return results
+		}
+		args = slices.Clone(args)
+		if opts.Drop {
+			select {
+			case queue <- args:
+			default:
+			}
+		} else {
+			queue <- args
+		}
+		return results
+	})
+	ptr = NewCallback(wrapped.Interface())
+
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			releaseCallback(ptr)
+			mu.Lock()
+			stopped = true
+			close(queue)
+			mu.Unlock()
+			<-done
+		})
+	}
+	return ptr, stop
+}
@@ -0,0 +1,72 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func invokeDeferred(ptr uintptr, x uintptr) uintptr {
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = x
+	args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+	callbackWrap(args)
+	return args.result
+}
+
+func TestNewDeferredCallback(t *testing.T) {
+	release := make(chan struct{})
+	var got []int32
+	ptr, stop := NewDeferredCallback(func(x int32) int32 {
+		<-release
+		got = append(got, x)
+		return -1
+	}, DeferredCallbackOptions{Result: 7})
+
+	// The handler is blocked, yet every invocation returns at once.
+	for i := range 3 {
+		if r := invokeDeferred(ptr, uintptr(i+1)); r != 7 {
+			t.Errorf("invocation %d returned %d, want 7", i, r)
+		}
+	}
+	close(release)
+	stop()
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("handler got %v, want [1 2 3] in order", got)
+	}
+	if _, ok := LookupCallback(ptr); ok {
+		t.Error("stop did not free the callback")
+	}
+	stop() // A second stop is a no-op.
+}
+
+func TestNewDeferredCallbackDrop(t *testing.T) {
+	release := make(chan struct{})
+	calls := 0
+	ptr, stop := NewDeferredCallback(func(x uintptr) {
+		<-release
+		calls++
+	}, DeferredCallbackOptions{Queue: 1, Drop: true})
+
+	// One invocation is taken by the handler and one queued; the rest are
+	// dropped rather than blocking.
+	for i := range 10 {
+		invokeDeferred(ptr, uintptr(i))
+	}
+	close(release)
+	stop()
+	if calls < 1 || calls > 2 {
+		t.Errorf("handler ran %d times, want 1 or 2", calls)
+	}
+}
+
+func TestNewDeferredCallbackResultType(t *testing.T) {
+	defer func() {
+		if msg, _ := recover().(string); !strings.Contains(msg, "cannot be converted") {
+			t.Errorf("panic %q, want a conversion error", msg)
+		}
+	}()
+	NewDeferredCallback(func() int32 { return 0 }, DeferredCallbackOptions{Result: "x"})
+}
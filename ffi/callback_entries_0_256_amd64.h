@@ -0,0 +1,258 @@
+// Code generated by 'go generate' with gen_callback_entries.go. DO NOT EDIT.
+// Trampoline entries 0 to 255, included by callback_table*_amd64.s.
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $0; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $8; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $9; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $10; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $11; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $12; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $13; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $14; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $15; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $16; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $17; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $18; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $19; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $20; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $21; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $22; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $23; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $24; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $25; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $26; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $27; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $28; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $29; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $30; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $31; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $32; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $33; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $34; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $35; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $36; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $37; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $38; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $39; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $40; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $41; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $42; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $43; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $44; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $45; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $46; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $47; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $48; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $49; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $50; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $51; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $52; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $53; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $54; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $55; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $56; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $57; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $58; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $59; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $60; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $61; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $62; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $63; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $64; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $65; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $66; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $67; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $68; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $69; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $70; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $71; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $72; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $73; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $74; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $75; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $76; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $77; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $78; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $79; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $80; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $81; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $82; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $83; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $84; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $85; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $86; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $87; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $88; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $89; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $90; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $91; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $92; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $93; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $94; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $95; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $96; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $97; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $98; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $99; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $255; JMP ·callbackDispatcher(SB); BYTE $0x90
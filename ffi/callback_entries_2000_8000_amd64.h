@@ -0,0 +1,6002 @@
+// Code generated by 'go generate' with gen_callback_entries.go. DO NOT EDIT.
+// Trampoline entries 2000 to 7999, included by callback_table*_amd64.s.
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $2999; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $3999; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $4999; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $5999; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $6999; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $7999; JMP ·callbackDispatcher(SB); BYTE $0x90
@@ -0,0 +1,1746 @@
+// Code generated by 'go generate' with gen_callback_entries.go. DO NOT EDIT.
+// Trampoline entries 256 to 1999, included by callback_table*_amd64.s.
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $999; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1000; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1001; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1002; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1003; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1004; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1005; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1006; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1007; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1008; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1009; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1010; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1011; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1012; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1013; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1014; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1015; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1016; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1017; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1018; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1019; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1020; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1021; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1022; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1023; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1024; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1025; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1026; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1027; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1028; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1029; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1030; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1031; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1032; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1033; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1034; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1035; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1036; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1037; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1038; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1039; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1040; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1041; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1042; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1043; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1044; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1045; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1046; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1047; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1048; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1049; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1050; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1051; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1052; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1053; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1054; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1055; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1056; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1057; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1058; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1059; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1060; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1061; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1062; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1063; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1064; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1065; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1066; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1067; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1068; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1069; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1070; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1071; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1072; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1073; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1074; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1075; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1076; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1077; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1078; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1079; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1080; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1081; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1082; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1083; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1084; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1085; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1086; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1087; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1088; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1089; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1090; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1091; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1092; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1093; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1094; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1095; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1096; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1097; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1098; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1099; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1100; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1101; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1102; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1103; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1104; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1105; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1106; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1107; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1108; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1109; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1110; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1111; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1112; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1113; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1114; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1115; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1116; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1117; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1118; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1119; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1120; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1121; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1122; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1123; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1124; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1125; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1126; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1127; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1128; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1129; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1130; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1131; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1132; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1133; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1134; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1135; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1136; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1137; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1138; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1139; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1140; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1141; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1142; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1143; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1144; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1145; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1146; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1147; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1148; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1149; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1150; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1151; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1152; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1153; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1154; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1155; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1156; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1157; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1158; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1159; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1160; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1161; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1162; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1163; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1164; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1165; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1166; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1167; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1168; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1169; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1170; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1171; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1172; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1173; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1174; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1175; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1176; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1177; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1178; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1179; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1180; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1181; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1182; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1183; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1184; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1185; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1186; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1187; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1188; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1189; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1190; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1191; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1192; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1193; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1194; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1195; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1196; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1197; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1198; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1199; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1200; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1201; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1202; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1203; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1204; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1205; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1206; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1207; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1208; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1209; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1210; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1211; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1212; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1213; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1214; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1215; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1216; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1217; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1218; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1219; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1220; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1221; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1222; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1223; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1224; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1225; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1226; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1227; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1228; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1229; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1230; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1231; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1232; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1233; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1234; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1235; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1236; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1237; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1238; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1239; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1240; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1241; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1242; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1243; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1244; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1245; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1246; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1247; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1248; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1249; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1250; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1251; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1252; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1253; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1254; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1255; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1256; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1257; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1258; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1259; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1260; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1261; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1262; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1263; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1264; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1265; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1266; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1267; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1268; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1269; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1270; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1271; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1272; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1273; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1274; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1275; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1276; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1277; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1278; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1279; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1280; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1281; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1282; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1283; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1284; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1285; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1286; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1287; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1288; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1289; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1290; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1291; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1292; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1293; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1294; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1295; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1296; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1297; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1298; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1299; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1300; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1301; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1302; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1303; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1304; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1305; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1306; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1307; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1308; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1309; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1310; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1311; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1312; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1313; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1314; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1315; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1316; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1317; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1318; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1319; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1320; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1321; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1322; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1323; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1324; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1325; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1326; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1327; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1328; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1329; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1330; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1331; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1332; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1333; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1334; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1335; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1336; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1337; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1338; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1339; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1340; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1341; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1342; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1343; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1344; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1345; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1346; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1347; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1348; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1349; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1350; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1351; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1352; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1353; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1354; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1355; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1356; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1357; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1358; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1359; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1360; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1361; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1362; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1363; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1364; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1365; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1366; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1367; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1368; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1369; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1370; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1371; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1372; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1373; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1374; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1375; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1376; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1377; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1378; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1379; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1380; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1381; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1382; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1383; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1384; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1385; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1386; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1387; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1388; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1389; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1390; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1391; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1392; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1393; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1394; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1395; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1396; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1397; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1398; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1399; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1400; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1401; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1402; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1403; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1404; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1405; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1406; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1407; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1408; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1409; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1410; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1411; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1412; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1413; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1414; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1415; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1416; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1417; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1418; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1419; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1420; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1421; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1422; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1423; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1424; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1425; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1426; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1427; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1428; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1429; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1430; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1431; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1432; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1433; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1434; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1435; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1436; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1437; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1438; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1439; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1440; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1441; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1442; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1443; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1444; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1445; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1446; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1447; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1448; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1449; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1450; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1451; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1452; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1453; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1454; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1455; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1456; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1457; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1458; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1459; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1460; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1461; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1462; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1463; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1464; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1465; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1466; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1467; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1468; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1469; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1470; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1471; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1472; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1473; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1474; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1475; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1476; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1477; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1478; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1479; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1480; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1481; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1482; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1483; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1484; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1485; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1486; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1487; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1488; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1489; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1490; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1491; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1492; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1493; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1494; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1495; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1496; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1497; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1498; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1499; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1500; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1501; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1502; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1503; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1504; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1505; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1506; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1507; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1508; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1509; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1510; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1511; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1512; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1513; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1514; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1515; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1516; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1517; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1518; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1519; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1520; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1521; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1522; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1523; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1524; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1525; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1526; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1527; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1528; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1529; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1530; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1531; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1532; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1533; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1534; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1535; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1536; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1537; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1538; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1539; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1540; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1541; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1542; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1543; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1544; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1545; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1546; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1547; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1548; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1549; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1550; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1551; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1552; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1553; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1554; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1555; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1556; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1557; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1558; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1559; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1560; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1561; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1562; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1563; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1564; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1565; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1566; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1567; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1568; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1569; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1570; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1571; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1572; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1573; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1574; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1575; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1576; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1577; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1578; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1579; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1580; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1581; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1582; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1583; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1584; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1585; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1586; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1587; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1588; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1589; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1590; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1591; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1592; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1593; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1594; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1595; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1596; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1597; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1598; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1599; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1600; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1601; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1602; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1603; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1604; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1605; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1606; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1607; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1608; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1609; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1610; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1611; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1612; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1613; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1614; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1615; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1616; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1617; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1618; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1619; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1620; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1621; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1622; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1623; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1624; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1625; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1626; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1627; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1628; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1629; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1630; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1631; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1632; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1633; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1634; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1635; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1636; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1637; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1638; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1639; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1640; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1641; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1642; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1643; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1644; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1645; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1646; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1647; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1648; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1649; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1650; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1651; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1652; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1653; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1654; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1655; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1656; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1657; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1658; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1659; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1660; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1661; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1662; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1663; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1664; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1665; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1666; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1667; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1668; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1669; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1670; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1671; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1672; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1673; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1674; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1675; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1676; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1677; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1678; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1679; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1680; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1681; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1682; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1683; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1684; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1685; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1686; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1687; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1688; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1689; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1690; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1691; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1692; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1693; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1694; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1695; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1696; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1697; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1698; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1699; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1700; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1701; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1702; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1703; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1704; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1705; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1706; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1707; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1708; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1709; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1710; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1711; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1712; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1713; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1714; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1715; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1716; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1717; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1718; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1719; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1720; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1721; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1722; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1723; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1724; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1725; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1726; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1727; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1728; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1729; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1730; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1731; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1732; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1733; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1734; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1735; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1736; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1737; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1738; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1739; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1740; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1741; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1742; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1743; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1744; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1745; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1746; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1747; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1748; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1749; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1750; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1751; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1752; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1753; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1754; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1755; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1756; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1757; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1758; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1759; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1760; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1761; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1762; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1763; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1764; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1765; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1766; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1767; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1768; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1769; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1770; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1771; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1772; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1773; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1774; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1775; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1776; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1777; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1778; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1779; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1780; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1781; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1782; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1783; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1784; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1785; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1786; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1787; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1788; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1789; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1790; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1791; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1792; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1793; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1794; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1795; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1796; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1797; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1798; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1799; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1800; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1801; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1802; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1803; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1804; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1805; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1806; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1807; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1808; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1809; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1810; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1811; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1812; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1813; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1814; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1815; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1816; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1817; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1818; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1819; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1820; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1821; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1822; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1823; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1824; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1825; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1826; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1827; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1828; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1829; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1830; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1831; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1832; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1833; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1834; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1835; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1836; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1837; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1838; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1839; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1840; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1841; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1842; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1843; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1844; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1845; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1846; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1847; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1848; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1849; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1850; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1851; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1852; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1853; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1854; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1855; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1856; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1857; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1858; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1859; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1860; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1861; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1862; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1863; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1864; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1865; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1866; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1867; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1868; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1869; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1870; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1871; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1872; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1873; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1874; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1875; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1876; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1877; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1878; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1879; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1880; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1881; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1882; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1883; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1884; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1885; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1886; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1887; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1888; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1889; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1890; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1891; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1892; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1893; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1894; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1895; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1896; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1897; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1898; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1899; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1900; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1901; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1902; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1903; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1904; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1905; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1906; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1907; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1908; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1909; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1910; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1911; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1912; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1913; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1914; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1915; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1916; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1917; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1918; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1919; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1920; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1921; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1922; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1923; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1924; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1925; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1926; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1927; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1928; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1929; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1930; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1931; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1932; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1933; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1934; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1935; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1936; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1937; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1938; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1939; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1940; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1941; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1942; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1943; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1944; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1945; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1946; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1947; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1948; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1949; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1950; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1951; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1952; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1953; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1954; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1955; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1956; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1957; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1958; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1959; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1960; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1961; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1962; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1963; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1964; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1965; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1966; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1967; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1968; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1969; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1970; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1971; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1972; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1973; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1974; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1975; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1976; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1977; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1978; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1979; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1980; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1981; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1982; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1983; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1984; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1985; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1986; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1987; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1988; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1989; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1990; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1991; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1992; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1993; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1994; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1995; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1996; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1997; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1998; JMP ·callbackDispatcher(SB); BYTE $0x90
+	ENDBR64; BYTE $0x41; BYTE $0xbb; LONG $1999; JMP ·callbackDispatcher(SB); BYTE $0x90
@@ -0,0 +1,66 @@
+//go:build ((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm) || (windows && amd64)
+
+package ffi
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// callbackInvoker calls a callback without reflection. regs holds the first
+// four integer argument registers as the dispatcher saved them; the result
+// goes back in the first integer result register.
+type callbackInvoker func(regs *[4]uintptr) uintptr
+
+// compileCallback returns a reflection-free invoker for the signatures most
+// C callbacks use: up to four uintptr parameters, or one or two
+// unsafe.Pointer ones, and no result or a uintptr. Every argument of these
+// arrives in an integer register on all supported ABIs, so the invoker needs
+// no per-argument classification and allocates nothing. Named function types
+// are matched by their underlying type. Other signatures return nil and are
+// marshaled with reflection.
+func compileCallback(fn reflect.Value) callbackInvoker {
+	typ := fn.Type()
+	if typ.IsVariadic() || typ.NumIn() > 4 {
+		return nil
+	}
+	in := make([]reflect.Type, typ.NumIn())
+	for i := range in {
+		in[i] = typ.In(i)
+	}
+	out := make([]reflect.Type, typ.NumOut())
+	for i := range out {
+		out[i] = typ.Out(i)
+	}
+	f := fn.Convert(reflect.FuncOf(in, out, false)).Interface()
+
+	switch f := f.(type) {
+	case func():
+		return func(*[4]uintptr) uintptr { f(); return 0 }
+	case func() uintptr:
+		return func(*[4]uintptr) uintptr { return f() }
+	case func(uintptr):
+		return func(r *[4]uintptr) uintptr { f(r[0]); return 0 }
+	case func(uintptr) uintptr:
+		return func(r *[4]uintptr) uintptr { return f(r[0]) }
+	case func(uintptr, uintptr):
+		return func(r *[4]uintptr) uintptr { f(r[0], r[1]); return 0 }
+	case func(uintptr, uintptr) uintptr:
+		return func(r *[4]uintptr) uintptr { return f(r[0], r[1]) }
+	case func(uintptr, uintptr, uintptr):
+		return func(r *[4]uintptr) uintptr { f(r[0], r[1], r[2]); return 0 }
+	case func(uintptr, uintptr, uintptr) uintptr:
+		return func(r *[4]uintptr) uintptr { return f(r[0], r[1], r[2]) }
+	case func(uintptr, uintptr, uintptr, uintptr):
+		return func(r *[4]uintptr) uintptr { f(r[0], r[1], r[2], r[3]); return 0 }
+	case func(uintptr, uintptr, uintptr, uintptr) uintptr:
+		return func(r *[4]uintptr) uintptr { return f(r[0], r[1], r[2], r[3]) }
+	case func(unsafe.Pointer):
+		return func(r *[4]uintptr) uintptr { f(foreignPointer(r[0])); return 0 }
+	case func(unsafe.Pointer, unsafe.Pointer):
+		return func(r *[4]uintptr) uintptr { f(foreignPointer(r[0]), foreignPointer(r[1])); return 0 }
+	case func(unsafe.Pointer, unsafe.Pointer) uintptr:
+		return func(r *[4]uintptr) uintptr { return f(foreignPointer(r[0]), foreignPointer(r[1])) }
+	}
+	return nil
+}
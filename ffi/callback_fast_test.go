@@ -0,0 +1,88 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+type fastHandler func(a, b uintptr) uintptr
+
+func TestCompileCallback(t *testing.T) {
+	for name, fn := range map[string]any{
+		"void":      func() {},
+		"uintptr4":  func(a, b, c, d uintptr) uintptr { return 0 },
+		"ptr+ptr":   func(a, b unsafe.Pointer) {},
+		"named":     fastHandler(func(a, b uintptr) uintptr { return 0 }),
+		"uintptr3v": func(a, b, c uintptr) {},
+	} {
+		if compileCallback(reflect.ValueOf(fn)) == nil {
+			t.Errorf("%s: no invoker", name)
+		}
+	}
+	for name, fn := range map[string]any{
+		"int":      func(a int) int { return a },
+		"float":    func(a float64) {},
+		"uintptr5": func(a, b, c, d, e uintptr) {},
+		"int32ret": func(a uintptr) int32 { return 0 },
+	} {
+		if compileCallback(reflect.ValueOf(fn)) != nil {
+			t.Errorf("%s: got an invoker, want reflection", name)
+		}
+	}
+}
+
+func TestCallbackFastPath(t *testing.T) {
+	var gotPtr unsafe.Pointer
+	ptr := NewCallback(func(a, b, c uintptr) uintptr { return a*100 + b*10 + c })
+	defer releaseCallback(ptr)
+	ptrPtr := NewCallback(func(p, q unsafe.Pointer) { gotPtr = q })
+	defer releaseCallback(ptrPtr)
+
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = 1
+	frame[callbackIntRegIndex(1)] = 2
+	frame[callbackIntRegIndex(2)] = 3
+	args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+	if allocs := testing.AllocsPerRun(100, func() { callbackWrap(args) }); allocs != 0 {
+		t.Errorf("callbackWrap allocated %v times per call, want 0", allocs)
+	}
+	if args.result != 123 {
+		t.Errorf("result = %d, want 123", args.result)
+	}
+
+	var target int
+	frame[callbackIntRegIndex(1)] = uintptr(unsafe.Pointer(&target))
+	callbackWrap(&callbackArgs{index: callbackIndex(ptrPtr), args: unsafe.Pointer(&frame)})
+	if gotPtr != unsafe.Pointer(&target) {
+		t.Errorf("second pointer = %p, want %p", gotPtr, &target)
+	}
+
+	// Swapping in a function recompiles the invoker.
+	if err := SwapCallback(ptr, func(a, b, c uintptr) uintptr { return a + b + c }); err != nil {
+		t.Fatal(err)
+	}
+	frame[callbackIntRegIndex(1)] = 2
+	callbackWrap(args)
+	if args.result != 6 {
+		t.Errorf("result after SwapCallback = %d, want 6", args.result)
+	}
+}
+
+// Benchmark callback invocation through the reflection-free path.
+func BenchmarkCallbackInvokeUintptr(b *testing.B) {
+	ptr := NewCallback(func(a, b uintptr) uintptr { return a + b })
+	defer releaseCallback(ptr)
+
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = 10
+	frame[callbackIntRegIndex(1)] = 20
+	args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		callbackWrap(args)
+	}
+}
@@ -0,0 +1,67 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestFreeCallback(t *testing.T) {
+	live := CallbackCount()
+	ptr := NewCallback(func() {})
+	info, ok := LookupCallback(ptr)
+	if !ok {
+		t.Fatal("LookupCallback found nothing")
+	}
+	if err := FreeCallback(ptr); err != nil {
+		t.Fatal(err)
+	}
+	if got := CallbackCount(); got != live {
+		t.Errorf("CallbackCount = %d after FreeCallback, want %d", got, live)
+	}
+
+	var icie *InvalidCallInterfaceError
+	if err := FreeCallback(ptr); !errors.As(err, &icie) || icie.Field != "ptr" {
+		t.Errorf("second FreeCallback: err = %v, want InvalidCallInterfaceError for ptr", err)
+	}
+	if err := FreeCallback(ptr + 1); !errors.As(err, &icie) {
+		t.Errorf("misaligned ptr: err = %v, want InvalidCallInterfaceError", err)
+	}
+
+	func() {
+		defer func() {
+			msg, _ := recover().(string)
+			if !strings.Contains(msg, "freed callback") {
+				t.Errorf("calling a freed callback panicked with %q", msg)
+			}
+		}()
+		var frame [128]uintptr
+		callbackWrap(&callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)})
+	}()
+
+	// Slots are reused oldest first, so ptr comes back once the slots
+	// freed before it are taken.
+	var taken []uintptr
+	defer func() {
+		for _, p := range taken {
+			releaseCallback(p)
+		}
+	}()
+	for range CallbackCapacity() {
+		p := NewCallback(func() {})
+		taken = append(taken, p)
+		if p == ptr {
+			break
+		}
+	}
+	if taken[len(taken)-1] != ptr {
+		t.Fatal("the freed slot was not reused")
+	}
+	reused, _ := LookupCallback(ptr)
+	if reused.Generation != info.Generation+1 {
+		t.Errorf("Generation = %d after reuse, want %d", reused.Generation, info.Generation+1)
+	}
+}
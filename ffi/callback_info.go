@@ -0,0 +1,13 @@
+package ffi
+
+import "runtime"
+
+// CallbackInfo describes a registered callback, as returned by
+// LookupCallback.
+type CallbackInfo struct {
+	Ptr        uintptr         // Function pointer returned by NewCallback
+	Func       any             // Go function currently behind Ptr (see SwapCallback)
+	Stack      []runtime.Frame // Where NewCallback was called, innermost first
+	Calls      uint64          // Invocations since registration
+	Generation uint32          // Callbacks freed from Ptr's slot before this one (see FreeCallback)
+}
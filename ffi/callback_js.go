@@ -0,0 +1,201 @@
+//go:build js
+
+package ffi
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+	"syscall/js"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch/wasm"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// jsCallbacks maps callback handles to their js.Func so that they can be
+// released.
+var jsCallbacks = struct {
+	sync.Mutex
+	funcs map[uintptr]js.Func
+}{funcs: make(map[uintptr]js.Func)}
+
+// CallbackCount returns the number of callbacks currently registered.
+func CallbackCount() int {
+	jsCallbacks.Lock()
+	defer jsCallbacks.Unlock()
+	return len(jsCallbacks.funcs)
+}
+
+// NewCallback wraps a Go function as a JavaScript function and returns its
+// handle, to be passed as a pointer argument.
+//
+// Arguments are converted from JavaScript by the parameter's Go kind:
+// integer and floating-point kinds from Numbers or BigInts, bool by
+// truthiness, and uintptr (including Handle) as a pointer: null is 0 and an
+// object, function or string is a handle that is valid only until the
+// callback returns. Missing arguments are zero. The result is converted back
+// the same way.
+func NewCallback(fn any) uintptr {
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+	typ := val.Type()
+	for i := 0; i < typ.NumIn(); i++ {
+		if !jsCallbackKind(typ.In(i).Kind()) {
+			panic("ffi: unsupported callback argument type: " + typ.In(i).Kind().String())
+		}
+	}
+	if typ.NumOut() > 1 {
+		panic("ffi: callbacks can only return zero or one value")
+	}
+	if typ.NumOut() == 1 && !jsCallbackKind(typ.Out(0).Kind()) {
+		panic("ffi: unsupported callback return type: " + typ.Out(0).Kind().String())
+	}
+
+	f := js.FuncOf(func(_ js.Value, args []js.Value) any {
+		in := make([]reflect.Value, typ.NumIn())
+		var temps []uintptr
+		for i := range in {
+			arg := js.Undefined()
+			if i < len(args) {
+				arg = args[i]
+			}
+			in[i] = reflect.New(typ.In(i)).Elem()
+			switch k := typ.In(i).Kind(); {
+			case k == reflect.Bool:
+				in[i].SetBool(arg.Truthy())
+			case k == reflect.Float32 || k == reflect.Float64:
+				in[i].SetFloat(jsNumber(arg))
+			case k == reflect.Uintptr:
+				h := jsPointer(arg)
+				if _, _, ok := wasm.Lookup(h); ok && h != 0 {
+					temps = append(temps, h)
+				}
+				in[i].SetUint(uint64(h))
+			case k >= reflect.Uint && k <= reflect.Uint64:
+				in[i].SetUint(uint64(jsInt(arg)))
+			default:
+				in[i].SetInt(jsInt(arg))
+			}
+		}
+		out := val.Call(in)
+		for _, h := range temps {
+			wasm.Release(h)
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		switch r := out[0]; r.Kind() {
+		case reflect.Bool:
+			return r.Bool()
+		case reflect.Float32, reflect.Float64:
+			return r.Float()
+		case reflect.Uintptr:
+			if v, _, ok := wasm.Lookup(uintptr(r.Uint())); ok {
+				return v
+			}
+			if r.Uint() == 0 {
+				return nil
+			}
+			return float64(r.Uint())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return float64(r.Uint())
+		default:
+			return float64(r.Int())
+		}
+	})
+	h := wasm.NewHandle(f.Value, js.Undefined())
+	jsCallbacks.Lock()
+	jsCallbacks.funcs[h] = f
+	jsCallbacks.Unlock()
+	trace(TraceEvent{Kind: TraceCallbackRegister, Addr: h})
+	return h
+}
+
+// releaseCallback releases a callback created by NewCallback.
+func releaseCallback(ptr uintptr) {
+	jsCallbacks.Lock()
+	f, ok := jsCallbacks.funcs[ptr]
+	delete(jsCallbacks.funcs, ptr)
+	jsCallbacks.Unlock()
+	if ok {
+		wasm.Release(ptr)
+		f.Release()
+		dropCallbackUserdata(ptr)
+	}
+}
+
+// FreeCallback releases the JavaScript function behind ptr, a handle
+// returned by NewCallback. It returns an *InvalidCallInterfaceError if ptr
+// is not a registered callback.
+func FreeCallback(ptr uintptr) error {
+	jsCallbacks.Lock()
+	_, ok := jsCallbacks.funcs[ptr]
+	jsCallbacks.Unlock()
+	if !ok {
+		return &InvalidCallInterfaceError{Field: "ptr", Reason: "not a registered callback", Index: -1}
+	}
+	releaseCallback(ptr)
+	trace(TraceEvent{Kind: TraceCallbackRelease, Addr: ptr})
+	return nil
+}
+
+// registeredCallbacks returns the handles of all registered callbacks.
+func registeredCallbacks() []uintptr {
+	jsCallbacks.Lock()
+	defer jsCallbacks.Unlock()
+	ptrs := make([]uintptr, 0, len(jsCallbacks.funcs))
+	for h := range jsCallbacks.funcs {
+		ptrs = append(ptrs, h)
+	}
+	return ptrs
+}
+
+func jsNumber(v js.Value) float64 {
+	var f float64
+	_ = wasm.FromJS(types.DoubleTypeDescriptor, v, unsafe.Pointer(&f))
+	return f
+}
+
+func jsInt(v js.Value) int64 {
+	var n int64
+	_ = wasm.FromJS(types.SInt64TypeDescriptor, v, unsafe.Pointer(&n))
+	return n
+}
+
+func jsPointer(v js.Value) uintptr {
+	var h uintptr
+	_ = wasm.FromJS(types.PointerTypeDescriptor, v, unsafe.Pointer(&h))
+	return h
+}
+
+func jsCallbackKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr, reflect.Float32, reflect.Float64, reflect.Bool:
+		return true
+	}
+	return false
+}
+
+// SwapCallback is not supported on this platform: it returns an
+// *UnsupportedPlatformError.
+func SwapCallback(ptr uintptr, fn any) error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// LookupCallback always reports false on this platform.
+func LookupCallback(addr uintptr) (CallbackInfo, bool) {
+	return CallbackInfo{}, false
+}
+
+// CallbackCapacity returns -1: js callbacks are not limited by a table.
+func CallbackCapacity() int {
+	return -1
+}
@@ -0,0 +1,43 @@
+//go:build !(((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm) || windows || js)
+
+package ffi
+
+import (
+	"runtime"
+)
+
+// NewCallback panics: native code cannot call back into Go on this platform.
+func NewCallback(fn any) uintptr {
+	panic(&UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH})
+}
+
+func releaseCallback(uintptr) {}
+
+func registeredCallbacks() []uintptr { return nil }
+
+// CallbackCount always returns 0: no callbacks can be registered.
+func CallbackCount() int {
+	return 0
+}
+
+// FreeCallback is not supported on this platform: it returns an
+// *UnsupportedPlatformError.
+func FreeCallback(ptr uintptr) error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// SwapCallback is not supported on this platform: it returns an
+// *UnsupportedPlatformError.
+func SwapCallback(ptr uintptr, fn any) error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// LookupCallback always reports false on this platform.
+func LookupCallback(addr uintptr) (CallbackInfo, bool) {
+	return CallbackInfo{}, false
+}
+
+// CallbackCapacity always returns 0: no callbacks can be registered.
+func CallbackCapacity() int {
+	return 0
+}
@@ -0,0 +1,141 @@
+package ffi
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// CallbackPanicMode selects what happens when a Go callback panics.
+type CallbackPanicMode int
+
+const (
+	// CallbackPanicAbort writes the panic, the callback and the stack of the
+	// panicking goroutine to standard error and exits with status 2, as an
+	// unrecovered panic does, without unwinding through the native frames.
+	CallbackPanicAbort CallbackPanicMode = iota
+
+	// CallbackPanicRecover stops the panic; native code sees the callback
+	// return zero.
+	CallbackPanicRecover
+
+	// CallbackPanicLog is CallbackPanicRecover that also logs the panic and
+	// its stack with the standard log package.
+	CallbackPanicLog
+)
+
+// CallbackPanic describes a panic in a Go callback.
+type CallbackPanic struct {
+	Callback uintptr // Pointer native code called, see LookupCallback
+	Value    any     // Value passed to panic
+	Stack    []byte  // Stack of the panicking goroutine
+}
+
+// String returns a one-line description of the panic.
+func (p *CallbackPanic) String() string {
+	return fmt.Sprintf("ffi: callback %#x panicked: %v", p.Callback, p.Value)
+}
+
+// CallbackPanicPolicy configures SetCallbackPanicPolicy and
+// NewCallbackWithPanicPolicy.
+type CallbackPanicPolicy struct {
+	Mode CallbackPanicMode
+
+	// Report, if set, receives each panic before Mode is applied, for
+	// instance to pass it on to a crash reporter. It runs on the goroutine
+	// of the callback, while native code waits for it to return.
+	Report func(*CallbackPanic)
+}
+
+var callbackPanicPolicy atomic.Pointer[CallbackPanicPolicy]
+
+// nativeException is implemented by the panic values CatchCxx and CatchObjC
+// use to carry an exception from their handlers, which run as callbacks, to
+// the Catch function that recovers it. Panic policies let them pass.
+type nativeException interface {
+	nativeException()
+}
+
+// SetCallbackPanicPolicy sets what happens when a Go callback panics and
+// returns the previous policy. Without a policy the panic unwinds through the
+// native frames that called the callback without running their cleanup, so
+// native state such as locks and allocations is left inconsistent, and
+// unless a recover further up stops it the process dies with a trace that
+// rarely points at the callback:
+//
+//	ffi.SetCallbackPanicPolicy(&ffi.CallbackPanicPolicy{
+//	    Mode:   ffi.CallbackPanicLog,
+//	    Report: func(p *ffi.CallbackPanic) { sentry.CaptureMessage(p.String()) },
+//	})
+//
+// A nil p removes the policy, which then costs a single atomic load per
+// callback invocation. The policy covers callbacks from NewCallback and the
+// functions built on it; callbacks created with NewCallbackWithPanicPolicy
+// follow their own. Exceptions that CatchCxx and CatchObjC carry through
+// callbacks are not subject to any policy. On Windows on arm64 and 386 and
+// on js, where goffi does not own the trampolines, it has no effect.
+func SetCallbackPanicPolicy(p *CallbackPanicPolicy) *CallbackPanicPolicy {
+	if p != nil {
+		c := *p
+		p = &c
+	}
+	return callbackPanicPolicy.Swap(p)
+}
+
+// NewCallbackWithPanicPolicy is NewCallback for a callback with a panic
+// policy of its own, which takes precedence over SetCallbackPanicPolicy. A
+// recovered panic makes fn return the zero values of its results. It works
+// on every platform with callbacks, and costs a reflect.Value.Call per
+// invocation on top of NewCallback's.
+func NewCallbackWithPanicPolicy(fn any, p *CallbackPanicPolicy) uintptr {
+	if p == nil {
+		panic("ffi: callback panic policy must not be nil")
+	}
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+	policy := *p
+	typ := val.Type()
+	var ptr uintptr
+	wrapped := reflect.MakeFunc(typ, func(args []reflect.Value) (results []reflect.Value) {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(nativeException); ok {
+					panic(r)
+				}
+				handleCallbackPanic(&policy, ptr, r)
+				results = make([]reflect.Value, typ.NumOut())
+				for i := range results {
+					results[i] = reflect.Zero(typ.Out(i))
+				}
+			}
+		}()
+		return val.Call(args)
+	})
+	ptr = NewCallback(wrapped.Interface())
+	return ptr
+}
+
+// handleCallbackPanic applies p to the panic value v recovered from the
+// callback ptr. It returns only if the panic is to be recovered.
+func handleCallbackPanic(p *CallbackPanicPolicy, ptr uintptr, v any) {
+	cp := &CallbackPanic{Callback: ptr, Value: v, Stack: debug.Stack()}
+	if p.Report != nil {
+		p.Report(cp)
+	}
+	switch p.Mode {
+	case CallbackPanicRecover:
+	case CallbackPanicLog:
+		log.Printf("%v\n%s", cp, cp.Stack)
+	default:
+		fmt.Fprintf(os.Stderr, "%v\n\n%s", cp, cp.Stack)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,116 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCallbackPanicPolicy(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol(qsort): %v", err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var reports []*CallbackPanic
+	prev := SetCallbackPanicPolicy(&CallbackPanicPolicy{
+		Mode:   CallbackPanicRecover,
+		Report: func(p *CallbackPanic) { reports = append(reports, p) },
+	})
+	defer SetCallbackPanicPolicy(prev)
+
+	// The panic must not unwind through qsort; every comparison reports
+	// and returns 0.
+	xs := []int32{3, 1, 2}
+	base := unsafe.Pointer(&xs[0])
+	n, size := uint64(len(xs)), uint64(4)
+	cmp := NewCallback(func(a, b *int32) int32 { panic("boom") })
+	defer releaseCallback(cmp)
+	if err := CallFunction(cif, qsort, nil,
+		[]unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp)}); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) == 0 {
+		t.Fatal("Report was not called")
+	}
+	p := reports[0]
+	if p.Callback != cmp || p.Value != "boom" || !bytes.Contains(p.Stack, []byte("TestCallbackPanicPolicy")) {
+		t.Errorf("report = %v with stack\n%s", p, p.Stack)
+	}
+}
+
+func TestNewCallbackWithPanicPolicy(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	errBoom := errors.New("boom")
+	ptr := NewCallbackWithPanicPolicy(func(x uintptr) int32 {
+		if x == 0 {
+			panic(errBoom)
+		}
+		return int32(x)
+	}, &CallbackPanicPolicy{Mode: CallbackPanicLog})
+	defer releaseCallback(ptr)
+
+	invoke := func(x uintptr) uintptr {
+		var frame [128]uintptr
+		frame[callbackIntRegIndex(0)] = x
+		args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+		callbackWrap(args)
+		return args.result
+	}
+	if r := invoke(5); r != 5 {
+		t.Errorf("result = %d, want 5", r)
+	}
+	if r := invoke(0); r != 0 {
+		t.Errorf("result after panic = %d, want 0", r)
+	}
+	if out := buf.String(); !strings.Contains(out, "panicked: boom") {
+		t.Errorf("log output %q does not mention the panic", out)
+	}
+}
+
+func TestCallbackPanicAbort(t *testing.T) {
+	if os.Getenv("GOFFI_TEST_CALLBACK_ABORT") == "1" {
+		SetCallbackPanicPolicy(&CallbackPanicPolicy{Mode: CallbackPanicAbort})
+		ptr := NewCallback(func() { panic("boom") })
+		var frame [128]uintptr
+		callbackWrap(&callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)})
+		t.Fatal("callback returned")
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCallbackPanicAbort$")
+	cmd.Env = append(os.Environ(), "GOFFI_TEST_CALLBACK_ABORT=1")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 2 {
+		t.Fatalf("child exited with %v, want status 2", err)
+	}
+	if !strings.Contains(stderr.String(), "panicked: boom") {
+		t.Errorf("stderr %q does not mention the panic", stderr.String())
+	}
+}
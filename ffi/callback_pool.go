@@ -0,0 +1,127 @@
+package ffi
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// callbackJob is one callback invocation handed to a CallbackPool worker.
+type callbackJob struct {
+	fn      reflect.Value
+	args    []reflect.Value
+	results []reflect.Value
+	panicV  any
+	doneCh  chan struct{}
+}
+
+// CallbackPool runs callbacks on a fixed set of ordinary goroutines instead
+// of on the foreign thread that invoked them. The trampoline decodes the
+// arguments, hands them to a worker and blocks the native caller until the
+// worker has produced the result, so the callback stays synchronous for the
+// C library while heavy Go work (allocation, I/O, lock contention) runs on
+// regular goroutines rather than on the thread the runtime had to adopt
+// for the callback.
+//
+//	pool := ffi.NewCallbackPool(4)
+//	defer pool.Close()
+//	onChunk := pool.NewCallback(func(data unsafe.Pointer, n uintptr) int32 {
+//	    return process(unsafe.Slice((*byte)(data), n)) // may allocate, write files...
+//	})
+//
+// The handoff costs two channel operations per invocation, so it pays off
+// only for callbacks doing substantial work. A callback that calls back
+// into native code while a worker runs it does so from the worker's
+// goroutine, not the invoking thread; callbacks whose library requires
+// reentrant calls on the same thread must not be pooled. A CallbackPool is
+// safe for concurrent use.
+type CallbackPool struct {
+	jobs   chan *callbackJob
+	size   int
+	mu     sync.RWMutex // guards closed against concurrent invoke/Close
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewCallbackPool starts size worker goroutines. size < 1 is treated as
+// runtime.GOMAXPROCS(0). Call Close to stop them.
+func NewCallbackPool(size int) *CallbackPool {
+	if size < 1 {
+		size = runtime.GOMAXPROCS(0)
+	}
+	p := &CallbackPool{jobs: make(chan *callbackJob), size: size}
+	p.wg.Add(size)
+	for range size {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *CallbackPool) worker() {
+	defer p.wg.Done()
+	for job := range p.jobs {
+		job.run()
+	}
+}
+
+// run calls the job's function, capturing a panic for the waiting caller.
+func (j *callbackJob) run() {
+	defer close(j.doneCh)
+	defer func() { j.panicV = recover() }()
+	j.results = j.fn.Call(j.args)
+}
+
+// NewCallback is ffi.NewCallback for fn run on the pool's workers. fn has the
+// same requirements; a panic in fn is re-raised on the invoking thread, as
+// if fn had run there. After Close, invocations run fn directly on the
+// invoking thread.
+func (p *CallbackPool) NewCallback(fn any) uintptr {
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+	wrapped := reflect.MakeFunc(val.Type(), func(args []reflect.Value) []reflect.Value {
+		return p.invoke(val, args)
+	})
+	return NewCallback(wrapped.Interface())
+}
+
+// invoke runs fn on a worker and waits for it.
+func (p *CallbackPool) invoke(fn reflect.Value, args []reflect.Value) []reflect.Value {
+	job := &callbackJob{fn: fn, args: args, doneCh: make(chan struct{})}
+	p.mu.RLock()
+	if p.closed {
+		p.mu.RUnlock()
+		return fn.Call(args)
+	}
+	p.jobs <- job
+	p.mu.RUnlock()
+
+	<-job.doneCh
+	if job.panicV != nil {
+		panic(job.panicV)
+	}
+	return job.results
+}
+
+// Size returns the number of workers.
+func (p *CallbackPool) Size() int {
+	return p.size
+}
+
+// Close waits for running callbacks to finish and stops the workers.
+// Callbacks created by the pool stay registered and run inline afterwards.
+func (p *CallbackPool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.jobs)
+	p.mu.Unlock()
+	p.wg.Wait()
+}
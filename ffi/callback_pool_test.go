@@ -0,0 +1,67 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func TestCallbackPool(t *testing.T) {
+	pool := NewCallbackPool(2)
+	if pool.Size() != 2 {
+		t.Fatalf("Size = %d, want 2", pool.Size())
+	}
+
+	invokerID := goroutineID()
+	var mu sync.Mutex
+	var ranOn []uint64
+	ptr := pool.NewCallback(func(a, b int) int {
+		mu.Lock()
+		ranOn = append(ranOn, goroutineID())
+		mu.Unlock()
+		return a * b
+	})
+	defer releaseCallback(ptr)
+
+	invoke := func() int {
+		var frame [128]uintptr
+		frame[callbackIntRegIndex(0)] = 6
+		frame[callbackIntRegIndex(1)] = 7
+		args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+		callbackWrap(args)
+		return int(args.result)
+	}
+	if r := invoke(); r != 42 {
+		t.Errorf("pooled callback returned %d, want 42", r)
+	}
+	if len(ranOn) != 1 || ranOn[0] == invokerID {
+		t.Errorf("callback ran on goroutine %v, want a worker other than %d", ranOn, invokerID)
+	}
+
+	pool.Close()
+	pool.Close()
+	if r := invoke(); r != 42 {
+		t.Errorf("callback after Close returned %d, want 42", r)
+	}
+	if ranOn[1] != invokerID {
+		t.Errorf("callback after Close ran on goroutine %d, want the invoker %d", ranOn[1], invokerID)
+	}
+}
+
+func TestCallbackPoolPanic(t *testing.T) {
+	pool := NewCallbackPool(1)
+	defer pool.Close()
+	ptr := pool.NewCallback(func() { panic("boom") })
+	defer releaseCallback(ptr)
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want the handler's panic", r)
+		}
+	}()
+	var frame [128]uintptr
+	callbackWrap(&callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)})
+	t.Error("panic was not re-raised on the invoking goroutine")
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// callbackReturning passes fn to the C helper name, which calls it with seed
+// and stores the value it returned through the result registers.
+func callbackReturning[T comparable](t *testing.T, name string, seed int64, fn func(int64) T) T {
+	t.Helper()
+	sym, err := GetSymbol(structTestLib, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.SInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cb := NewCallback(fn)
+	defer func() {
+		if err := FreeCallback(cb); err != nil {
+			t.Error(err)
+		}
+	}()
+	var got T
+	out := unsafe.Pointer(&got)
+	if err := CallFunction(&cif, sym, nil,
+		[]unsafe.Pointer{unsafe.Pointer(&cb), unsafe.Pointer(&seed), unsafe.Pointer(&out)}); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func checkCallbackReturn[T comparable](t *testing.T, name string, fn func(int64) T) {
+	t.Helper()
+	want := fn(7)
+	if got := callbackReturning(t, name, 7, fn); got != want {
+		t.Errorf("%s = %+v, want %+v", name, got, want)
+	}
+}
+
+func TestCallbackReturn(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("double", func(t *testing.T) {
+		checkCallbackReturn(t, "cb_ret_double", func(n int64) float64 { return float64(n) + 0.25 })
+	})
+	t.Run("float", func(t *testing.T) {
+		checkCallbackReturn(t, "cb_ret_float", func(n int64) float32 { return float32(n) * 1.5 })
+	})
+	t.Run("int32+uint32", func(t *testing.T) {
+		type pair struct {
+			A int32
+			B uint32
+		}
+		checkCallbackReturn(t, "cb_ret_pair_i32_u32", func(n int64) pair { return pair{int32(-n), uint32(n) << 20} })
+	})
+	t.Run("float32x2", func(t *testing.T) {
+		type pair struct{ X, Y float32 }
+		checkCallbackReturn(t, "cb_ret_pair_f32", func(n int64) pair { return pair{float32(n), -2.5} })
+	})
+	t.Run("int64x2", func(t *testing.T) {
+		type pair struct{ A, B int64 }
+		checkCallbackReturn(t, "cb_ret_pair_i64", func(n int64) pair { return pair{n << 40, -n} })
+	})
+	t.Run("float64+int64", func(t *testing.T) {
+		type mixed struct {
+			D float64
+			N int64
+		}
+		checkCallbackReturn(t, "cb_ret_f64_i64", func(n int64) mixed { return mixed{float64(n) / 4, n * 1000} })
+	})
+	t.Run("rect", func(t *testing.T) {
+		type point struct{ X, Y float32 }
+		type rect struct{ Origin, Size point }
+		checkCallbackReturn(t, "cb_ret_rect_f", func(n int64) rect {
+			return rect{point{float32(n), 2}, point{640, 480.5}}
+		})
+	})
+	t.Run("float64x4", func(t *testing.T) {
+		type quad struct{ A, B, C, D float64 }
+		fn := func(n int64) quad { return quad{float64(n), 1.5, -3, 1e10} }
+		if runtime.GOARCH == "arm64" {
+			checkCallbackReturn(t, "cb_ret_quad_d", fn)
+			return
+		}
+		// Returned through a hidden pointer on System V, which callbacks
+		// do not take.
+		defer func() {
+			if msg, _ := recover().(string); !strings.Contains(msg, "at most 16") {
+				t.Errorf("NewCallback panicked with %q, want the 16-byte limit", msg)
+			}
+		}()
+		NewCallback(fn)
+	})
+}
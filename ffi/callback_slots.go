@@ -0,0 +1,277 @@
+//go:build ((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm) || (windows && amd64)
+
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync/atomic"
+)
+
+// callbackMeta is the diagnostic record of a trampoline slot.
+type callbackMeta struct {
+	stack []uintptr     // Program counters of the NewCallback caller; guarded by callbacks.mu
+	calls atomic.Uint64 // Invocations since registration
+	gen   uint32        // Times the slot was freed; guarded by callbacks.mu
+}
+
+// callbackStats parallels callbacks.funcs.
+var callbackStats [maxCallbacks]callbackMeta
+
+// callbackPlan is what callbackWrap needs to know about a slot besides its
+// function, worked out once when the function is stored.
+type callbackPlan struct {
+	invoke callbackInvoker // Reflection-free invoker; nil goes through reflect.Call
+	words  int             // Length of the argument block the signature reads
+}
+
+// callbackPlans parallels callbacks.funcs. Guarded by callbacks.mu.
+var callbackPlans [maxCallbacks]callbackPlan
+
+// storeCallback puts fn in slot idx together with its plan. The caller
+// holds callbacks.mu.
+func storeCallback(idx int, fn reflect.Value) {
+	callbacks.funcs[idx] = fn
+	callbackPlans[idx] = callbackPlan{
+		invoke: compileCallback(fn),
+		words:  callbackFrameWords(fn.Type()),
+	}
+}
+
+// recordCallback starts the record of a slot NewCallback just filled. The
+// caller holds callbacks.mu.
+func recordCallback(idx int) {
+	var pcs [32]uintptr
+	n := runtime.Callers(3, pcs[:]) // skip Callers, recordCallback and NewCallback
+	callbackStats[idx].stack = append([]uintptr(nil), pcs[:n]...)
+	callbackStats[idx].calls.Store(0)
+}
+
+// allocCallbackSlot returns a trampoline index for a new callback, preferring
+// slots given back by releaseCallback. Released slots are reused oldest
+// first, so a stale pointer that native code still holds keeps pointing at a
+// freed slot for as long as possible. The caller holds callbacks.mu.
+func allocCallbackSlot() (int, bool) {
+	if len(callbacks.free) > 0 {
+		idx := callbacks.free[0]
+		callbacks.free = callbacks.free[1:]
+		return idx, true
+	}
+	if callbacks.count >= maxCallbacks {
+		return 0, false
+	}
+	idx := callbacks.count
+	callbacks.count++
+	return idx, true
+}
+
+// callbackSlot returns the registry index of ptr, a pointer returned by
+// NewCallback and not released since. The caller holds callbacks.mu.
+func callbackSlot(ptr uintptr) (int, bool) {
+	entrySize := trampolineEntryAddr(1) - trampolineEntryAddr(0)
+	if ptr < trampolineBaseAddr || (ptr-trampolineBaseAddr)%entrySize != 0 {
+		return 0, false
+	}
+	idx := (ptr - trampolineBaseAddr) / entrySize
+	if idx >= uintptr(callbacks.count) || !callbacks.funcs[idx].IsValid() {
+		return 0, false
+	}
+	return int(idx), true
+}
+
+// loadCallback returns the function registered in slot idx for an
+// invocation from native code, and its plan. A call through a freed pointer
+// panics with the slot and its generation rather than failing somewhere in
+// reflect.
+func loadCallback(idx int) (reflect.Value, callbackPlan) {
+	callbacks.mu.Lock()
+	fn, plan, gen := callbacks.funcs[idx], callbackPlans[idx], callbackStats[idx].gen
+	callbacks.mu.Unlock()
+	if !fn.IsValid() {
+		panic(fmt.Sprintf("ffi: native code called freed callback %#x (slot %d, generation %d)",
+			trampolineEntryAddr(idx), idx, gen))
+	}
+	callbackStats[idx].calls.Add(1)
+	return fn, plan
+}
+
+// recoverCallback is deferred by callbackWrap while a panic policy is set.
+// The dispatcher zeroes the result registers before the call, so a
+// recovered callback returns zero to native code. Exceptions on their way to
+// CatchCxx or CatchObjC continue unwinding.
+func recoverCallback(p *CallbackPanicPolicy, idx int) {
+	if r := recover(); r != nil {
+		if _, ok := r.(nativeException); ok {
+			panic(r)
+		}
+		handleCallbackPanic(p, trampolineEntryAddr(idx), r)
+	}
+}
+
+// SwapCallback replaces the Go function behind ptr, a pointer returned by
+// NewCallback, with fn, so native code that holds ptr calls fn from then on.
+// Live reloading and reconfiguring a handler do not have to register a new
+// pointer with the native library:
+//
+//	ptr := ffi.NewCallback(handleEvent)
+//	// pass ptr to the library once, later:
+//	err := ffi.SwapCallback(ptr, handleEventV2)
+//
+// fn must have exactly the type of the registered function, since native
+// code keeps calling ptr with the same signature. The swap is atomic:
+// every invocation that starts afterwards runs fn, while invocations already
+// running finish with the previous function. On Windows on arm64, js and
+// platforms without callbacks it returns an *UnsupportedPlatformError.
+func SwapCallback(ptr uintptr, fn any) error {
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func || val.IsNil() {
+		return &InvalidCallInterfaceError{Field: "fn", Reason: "must be a non-nil function", Index: -1}
+	}
+
+	callbacks.mu.Lock()
+	defer callbacks.mu.Unlock()
+	idx, ok := callbackSlot(ptr)
+	if !ok {
+		return &InvalidCallInterfaceError{Field: "ptr", Reason: "not a registered callback", Index: -1}
+	}
+	if old := callbacks.funcs[idx].Type(); old != val.Type() {
+		return &InvalidCallInterfaceError{
+			Field:  "fn",
+			Reason: fmt.Sprintf("has type %s, the callback was registered as %s", val.Type(), old),
+			Index:  -1,
+		}
+	}
+	storeCallback(idx, val)
+	return nil
+}
+
+// LookupCallback returns what is known about the callback whose trampoline
+// contains addr: a pointer returned by NewCallback, or any address inside
+// its trampoline entry such as a crash address from a native backtrace. It
+// maps pointers seen in native logs and debuggers back to Go code:
+//
+//	if info, ok := ffi.LookupCallback(pc); ok {
+//	    log.Printf("callback %v called %d times, registered at:", info.Func, info.Calls)
+//	    for _, f := range info.Stack {
+//	        log.Printf("  %s %s:%d", f.Function, f.File, f.Line)
+//	    }
+//	}
+//
+// It reports false for addresses outside the trampoline table and released
+// callbacks. On Windows on arm64, js and platforms without callbacks, where
+// goffi does not own the trampolines, it always reports false.
+func LookupCallback(addr uintptr) (CallbackInfo, bool) {
+	entrySize := trampolineEntryAddr(1) - trampolineEntryAddr(0)
+	if addr < trampolineBaseAddr {
+		return CallbackInfo{}, false
+	}
+
+	callbacks.mu.Lock()
+	idx, ok := callbackSlot(trampolineEntryAddr(int((addr - trampolineBaseAddr) / entrySize)))
+	if !ok {
+		callbacks.mu.Unlock()
+		return CallbackInfo{}, false
+	}
+	fn, pcs, gen := callbacks.funcs[idx], callbackStats[idx].stack, callbackStats[idx].gen
+	callbacks.mu.Unlock()
+
+	info := CallbackInfo{
+		Ptr:        trampolineEntryAddr(idx),
+		Func:       fn.Interface(),
+		Calls:      callbackStats[idx].calls.Load(),
+		Generation: gen,
+	}
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		info.Stack = append(info.Stack, f)
+		if !more {
+			break
+		}
+	}
+	return info, true
+}
+
+// FreeCallback releases ptr, a pointer returned by NewCallback, so that its
+// trampoline slot can be reused by later callbacks. Programs that register a
+// callback per object, such as one per window or per request, free it with
+// the object instead of running out of slots:
+//
+//	ptr := ffi.NewCallback(onResize)
+//	defer ffi.FreeCallback(ptr)
+//
+// Native code must not call ptr afterwards. Until the slot is reused, such a
+// call panics with the slot and its generation, the number of callbacks
+// freed from it; LookupCallback reports the generation of a live callback.
+// Freed slots are reused oldest first. It returns an
+// *InvalidCallInterfaceError if ptr is not a registered callback, including
+// one already freed. On Windows on arm64 and platforms without callbacks it
+// returns an *UnsupportedPlatformError.
+func FreeCallback(ptr uintptr) error {
+	callbacks.mu.Lock()
+	idx, ok := callbackSlot(ptr)
+	if !ok {
+		callbacks.mu.Unlock()
+		return &InvalidCallInterfaceError{Field: "ptr", Reason: "not a registered callback", Index: -1}
+	}
+	freeCallbackSlot(idx)
+	callbacks.mu.Unlock()
+	trace(TraceEvent{Kind: TraceCallbackRelease, Addr: ptr})
+	return nil
+}
+
+// releaseCallback makes the trampoline slot of ptr, a pointer returned by
+// NewCallback, available to later callbacks. Native code must not call ptr
+// afterwards.
+func releaseCallback(ptr uintptr) {
+	entrySize := trampolineEntryAddr(1) - trampolineEntryAddr(0)
+	idx := int((ptr - trampolineBaseAddr) / entrySize)
+
+	callbacks.mu.Lock()
+	defer callbacks.mu.Unlock()
+	if callbacks.funcs[idx].IsValid() {
+		freeCallbackSlot(idx)
+	}
+}
+
+// freeCallbackSlot empties slot idx, starts its next generation and queues
+// it for reuse. The caller holds callbacks.mu.
+func freeCallbackSlot(idx int) {
+	callbacks.funcs[idx] = reflect.Value{}
+	callbackPlans[idx] = callbackPlan{}
+	callbackStats[idx].stack = nil
+	callbackStats[idx].gen++
+	callbacks.free = append(callbacks.free, idx)
+	dropCallbackUserdata(trampolineEntryAddr(idx))
+}
+
+// registeredCallbacks returns the pointers of all registered callbacks.
+func registeredCallbacks() []uintptr {
+	callbacks.mu.Lock()
+	defer callbacks.mu.Unlock()
+	var ptrs []uintptr
+	for idx := range callbacks.count {
+		if callbacks.funcs[idx].IsValid() {
+			ptrs = append(ptrs, trampolineEntryAddr(idx))
+		}
+	}
+	return ptrs
+}
+
+// CallbackCount returns the number of callbacks currently registered.
+// Slots released by scoped callbacks are not counted.
+func CallbackCount() int {
+	callbacks.mu.Lock()
+	defer callbacks.mu.Unlock()
+	return callbacks.count - len(callbacks.free)
+}
+
+// CallbackCapacity returns how many callbacks can be registered at a time:
+// the size of the trampoline table, 2000 unless the program was built with
+// the goffi_callbacks256 or goffi_callbacks8000 tag. Embedded programs can
+// shrink the binary with the former; hosts that register many callbacks can
+// raise the ceiling with the latter.
+func CallbackCapacity() int {
+	return maxCallbacks
+}
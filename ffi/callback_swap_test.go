@@ -0,0 +1,89 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestSwapCallback(t *testing.T) {
+	ptr := NewCallback(func(a, b int) int { return a + b })
+	defer releaseCallback(ptr)
+
+	call := func() int {
+		var frame [128]uintptr
+		frame[callbackIntRegIndex(0)] = 6
+		frame[callbackIntRegIndex(1)] = 3
+		args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+		callbackWrap(args)
+		return int(args.result)
+	}
+	if got := call(); got != 9 {
+		t.Fatalf("before swap = %d, want 9", got)
+	}
+
+	if err := SwapCallback(ptr, func(a, b int) int { return a * b }); err != nil {
+		t.Fatal(err)
+	}
+	if got := call(); got != 18 {
+		t.Errorf("after swap = %d, want 18", got)
+	}
+
+	var icie *InvalidCallInterfaceError
+	if err := SwapCallback(ptr, func(a, b int32) int32 { return 0 }); !errors.As(err, &icie) || icie.Field != "fn" {
+		t.Errorf("different signature: err = %v, want InvalidCallInterfaceError for fn", err)
+	}
+	if err := SwapCallback(ptr, nil); !errors.As(err, &icie) {
+		t.Errorf("nil fn: err = %v", err)
+	}
+	if err := SwapCallback(ptr+1, func(a, b int) int { return 0 }); !errors.As(err, &icie) || icie.Field != "ptr" {
+		t.Errorf("misaligned ptr: err = %v, want InvalidCallInterfaceError for ptr", err)
+	}
+}
+
+func TestSwapReleasedCallback(t *testing.T) {
+	ptr := NewCallback(func() {})
+	releaseCallback(ptr)
+	if err := SwapCallback(ptr, func() {}); err == nil {
+		t.Error("swapping a released callback succeeded")
+	}
+}
+
+func TestLookupCallback(t *testing.T) {
+	handler := func(x int) int { return x }
+	ptr := NewCallback(handler)
+	defer releaseCallback(ptr)
+
+	for range 3 {
+		var frame [128]uintptr
+		callbackWrap(&callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)})
+	}
+
+	for _, addr := range []uintptr{ptr, ptr + 3} {
+		info, ok := LookupCallback(addr)
+		if !ok {
+			t.Fatalf("LookupCallback(%#x) found nothing", addr)
+		}
+		if info.Ptr != ptr || info.Calls != 3 {
+			t.Errorf("LookupCallback(%#x) = Ptr %#x, Calls %d; want %#x, 3", addr, info.Ptr, info.Calls, ptr)
+		}
+		if len(info.Stack) == 0 || !strings.HasSuffix(info.Stack[0].Function, ".TestLookupCallback") {
+			t.Errorf("registration stack starts at %v, want TestLookupCallback", info.Stack)
+		}
+		if _, ok := info.Func.(func(int) int); !ok {
+			t.Errorf("Func = %T", info.Func)
+		}
+	}
+
+	if _, ok := LookupCallback(trampolineBaseAddr - 1); ok {
+		t.Error("address below the table found a callback")
+	}
+	released := NewCallback(func() {})
+	releaseCallback(released)
+	if _, ok := LookupCallback(released); ok {
+		t.Error("released callback found")
+	}
+}
@@ -0,0 +1,9 @@
+//go:build (linux || darwin || windows) && amd64 && goffi_callbacks256
+
+#include "textflag.h"
+#include "cet_amd64.h"
+
+// callbackTrampoline with 256 entries, for builds with the
+// goffi_callbacks256 tag; see callback_table_amd64.s.
+TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
+#include "callback_entries_0_256_amd64.h"
@@ -0,0 +1,8 @@
+//go:build (linux || darwin || freebsd) && arm64 && goffi_callbacks256
+
+#include "textflag.h"
+
+// callbackTrampoline with 256 entries, for builds with the
+// goffi_callbacks256 tag; see callback_table_arm64.s.
+TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
+#include "callback_entries_0_256_arm64.h"
@@ -0,0 +1,10 @@
+//go:build (linux || darwin || freebsd) && arm64 && goffi_callbacks8000 && !goffi_callbacks256
+
+#include "textflag.h"
+
+// callbackTrampoline with 8000 entries, for builds with the
+// goffi_callbacks8000 tag; see callback_table_arm64.s.
+TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
+#include "callback_entries_0_256_arm64.h"
+#include "callback_entries_256_2000_arm64.h"
+#include "callback_entries_2000_8000_arm64.h"
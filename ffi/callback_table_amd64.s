@@ -0,0 +1,25 @@
+//go:build (linux || darwin || windows) && amd64 && !goffi_callbacks256 && !goffi_callbacks8000
+
+#include "textflag.h"
+#include "cet_amd64.h"
+
+// callbackTrampoline is the entry point for all callback trampolines.
+// Each entry is exactly 16 bytes:
+//
+//	ENDBR64                    4 bytes  CET indirect branch landing pad
+//	MOVL $index, R11           6 bytes  (emitted as raw bytes, see below)
+//	JMP ·callbackDispatcher    5 bytes
+//	NOP                        1 byte   padding
+//
+// When C code calls a callback, it jumps to one of these entries, which
+// passes its index to callbackDispatcher in R11 (scratch in the SysV and Win64 ABIs).
+// Jumping instead of calling keeps the return address on the stack that of
+// the C caller, so CET shadow stacks see a matched CALL/RET pair.
+// MOVL is spelled out as bytes (41 BB imm32) so the assembler cannot pick a
+// shorter encoding for small indices and break the fixed entry size.
+//
+// The table has maxCallbacks entries, chosen with build tags: 2000 by
+// default, 256 with goffi_callbacks256, 8000 with goffi_callbacks8000.
+TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
+#include "callback_entries_0_256_amd64.h"
+#include "callback_entries_256_2000_amd64.h"
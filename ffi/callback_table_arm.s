@@ -0,0 +1,15 @@
+//go:build linux && arm && !goffi_callbacks256 && !goffi_callbacks8000
+
+#include "textflag.h"
+
+// callbackTrampoline contains the trampoline entries for ARM callbacks.
+// Each entry moves the C caller's return address into R12 (a scratch
+// register per AAPCS) and branches with link to callbackDispatcher, which
+// derives the entry index from LR. Loading the index as an immediate would
+// need a literal pool below GOARM=7 and break the fixed entry size.
+//
+// The table has maxCallbacks entries, chosen with build tags: 2000 by
+// default, 256 with goffi_callbacks256, 8000 with goffi_callbacks8000.
+TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
+#include "callback_entries_0_256_arm.h"
+#include "callback_entries_256_2000_arm.h"
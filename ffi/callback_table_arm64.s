@@ -0,0 +1,14 @@
+//go:build (linux || darwin || freebsd) && arm64 && !goffi_callbacks256 && !goffi_callbacks8000
+
+#include "textflag.h"
+
+// callbackTrampoline contains the trampoline entries for ARM64 callbacks.
+// Each entry loads its index into R12 (a volatile register per AAPCS64)
+// and branches to callbackDispatcher. Using B (not BL) preserves LR
+// so the dispatcher can return directly to the C caller.
+//
+// The table has maxCallbacks entries, chosen with build tags: 2000 by
+// default, 256 with goffi_callbacks256, 8000 with goffi_callbacks8000.
+TEXT ·callbackTrampoline(SB), NOSPLIT|NOFRAME, $0
+#include "callback_entries_0_256_arm64.h"
+#include "callback_entries_256_2000_arm64.h"
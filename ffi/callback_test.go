@@ -3,6 +3,8 @@
 package ffi
 
 import (
+	"fmt"
+	"reflect"
 	"runtime"
 	"sync"
 	"testing"
@@ -505,7 +507,7 @@ func TestCallback_LimitEnforcement(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
 			t.Error("Expected panic when exceeding callback limit")
-		} else if r != "ffi: callback limit reached (2000 callbacks maximum)" {
+		} else if r != fmt.Sprintf("ffi: callback limit reached (%d callbacks maximum)", maxCallbacks) {
 			t.Errorf("Wrong panic message: %v", r)
 		}
 	}()
@@ -656,6 +658,30 @@ func TestCallback_NoGarbageCollection(t *testing.T) {
 	}
 }
 
+// Test a callback with more stack arguments than fit the old fixed-size
+// view of the argument block.
+func TestCallback_ManyStackArgs(t *testing.T) {
+	const numArgs = 127 // reflect.FuncOf allows at most 128
+	in := make([]reflect.Type, numArgs)
+	for i := range in {
+		in[i] = reflect.TypeFor[int64]()
+	}
+	fnType := reflect.FuncOf(in, []reflect.Type{reflect.TypeFor[int64]()}, false)
+	fn := reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{args[numArgs-1]}
+	})
+	ptr := NewCallback(fn.Interface())
+	defer releaseCallback(ptr)
+
+	frame := make([]uintptr, callbackStackIndex(numArgs))
+	frame[callbackStackIndex(numArgs-1-callbackIntRegCount())] = 4242
+	args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame[0])}
+	callbackWrap(args)
+	if args.result != 4242 {
+		t.Errorf("last argument = %d, want 4242", args.result)
+	}
+}
+
 // Benchmark callback registration.
 func BenchmarkNewCallback(b *testing.B) {
 	callback := func(x int) int {
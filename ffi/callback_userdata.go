@@ -0,0 +1,115 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// callbackUserdata maps the tokens handed out by NewCallbackWithUserdata to
+// their Go values, and each such callback to its token so FreeCallback can
+// drop both.
+var callbackUserdata struct {
+	mu     sync.Mutex
+	next   uintptr
+	values map[uintptr]any
+	tokens map[uintptr]uintptr // Callback pointer to token
+}
+
+// NewCallbackWithUserdata is NewCallback for C APIs that take a callback
+// together with a void* userdata argument they pass back on every call. It
+// registers userdata and returns the callback pointer and an opaque token to
+// give the library as the userdata argument. fn declares exactly one
+// interface parameter, in the position of the void* in the C signature; the
+// dispatcher turns the token native code passes there back into userdata:
+//
+//	ptr, token := ffi.NewCallbackWithUserdata(func(status int32, w any) {
+//	    w.(*Window).onResize(status)
+//	}, win)
+//	// setResizeCallback(handle, ptr, token)
+//
+// Any token from NewCallbackWithUserdata is accepted, so one callback can
+// serve several objects, as long as the value fits the parameter's type.
+// Tokens are small integers, not addresses, so native code must not
+// dereference them. FreeCallback also releases the token; afterwards a call
+// that passes it panics.
+func NewCallbackWithUserdata(fn any, userdata any) (ptr, token uintptr) {
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+	typ := val.Type()
+	slot := -1
+	in := make([]reflect.Type, typ.NumIn())
+	for i := range in {
+		in[i] = typ.In(i)
+		if in[i].Kind() == reflect.Interface {
+			if slot >= 0 {
+				panic("ffi: callback with userdata must have exactly one interface parameter")
+			}
+			slot = i
+		}
+	}
+	if slot < 0 {
+		panic("ffi: callback with userdata must have exactly one interface parameter")
+	}
+	udType := in[slot]
+	if userdata != nil && !reflect.TypeOf(userdata).AssignableTo(udType) {
+		panic(fmt.Sprintf("ffi: callback userdata %T does not implement %s", userdata, udType))
+	}
+	in[slot] = reflect.TypeFor[uintptr]()
+	out := make([]reflect.Type, typ.NumOut())
+	for i := range out {
+		out[i] = typ.Out(i)
+	}
+
+	wrapped := reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		tok := uintptr(args[slot].Uint())
+		v, ok := lookupUserdata(tok)
+		if !ok {
+			panic(fmt.Sprintf("ffi: callback called with unknown userdata token %#x", tok))
+		}
+		if v == nil {
+			args[slot] = reflect.Zero(udType)
+		} else if rv := reflect.ValueOf(v); rv.Type().AssignableTo(udType) {
+			args[slot] = rv.Convert(udType)
+		} else {
+			panic(fmt.Sprintf("ffi: callback userdata %T does not implement %s", v, udType))
+		}
+		return val.Call(args)
+	})
+	ptr = NewCallback(wrapped.Interface())
+
+	callbackUserdata.mu.Lock()
+	defer callbackUserdata.mu.Unlock()
+	if callbackUserdata.values == nil {
+		callbackUserdata.values = make(map[uintptr]any)
+		callbackUserdata.tokens = make(map[uintptr]uintptr)
+	}
+	callbackUserdata.next++
+	token = callbackUserdata.next
+	callbackUserdata.values[token] = userdata
+	callbackUserdata.tokens[ptr] = token
+	return ptr, token
+}
+
+func lookupUserdata(token uintptr) (any, bool) {
+	callbackUserdata.mu.Lock()
+	defer callbackUserdata.mu.Unlock()
+	v, ok := callbackUserdata.values[token]
+	return v, ok
+}
+
+// dropCallbackUserdata releases the token registered with the callback ptr,
+// if any, when the callback is freed.
+func dropCallbackUserdata(ptr uintptr) {
+	callbackUserdata.mu.Lock()
+	defer callbackUserdata.mu.Unlock()
+	if token, ok := callbackUserdata.tokens[ptr]; ok {
+		delete(callbackUserdata.tokens, ptr)
+		delete(callbackUserdata.values, token)
+	}
+}
@@ -0,0 +1,79 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+type userdataWindow struct{ name string }
+
+func TestNewCallbackWithUserdata(t *testing.T) {
+	var got *userdataWindow
+	var gotStatus int32
+	handler := func(status int32, ud any) int32 {
+		got, gotStatus = ud.(*userdataWindow), status
+		return status * 2
+	}
+	main, side := &userdataWindow{"main"}, &userdataWindow{"side"}
+	ptr, token := NewCallbackWithUserdata(handler, main)
+	defer releaseCallback(ptr)
+	ptr2, token2 := NewCallbackWithUserdata(handler, side)
+	if token == 0 || token == token2 {
+		t.Fatalf("tokens %#x and %#x, want distinct non-zero tokens", token, token2)
+	}
+
+	invoke := func(tok uintptr) int32 {
+		var frame [128]uintptr
+		frame[callbackIntRegIndex(0)] = 21
+		frame[callbackIntRegIndex(1)] = tok
+		args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+		callbackWrap(args)
+		return int32(args.result)
+	}
+	if r := invoke(token); r != 42 || got != main || gotStatus != 21 {
+		t.Errorf("callback returned %d with %v, %d; want 42 with main, 21", r, got, gotStatus)
+	}
+	// One callback serves every registered object.
+	if invoke(token2); got != side {
+		t.Errorf("callback with the second token got %v, want side", got)
+	}
+
+	if err := FreeCallback(ptr2); err != nil {
+		t.Fatal(err)
+	}
+	func() {
+		defer func() {
+			if msg, _ := recover().(string); !strings.Contains(msg, "unknown userdata token") {
+				t.Errorf("calling with a freed token panicked with %q", msg)
+			}
+		}()
+		invoke(token2)
+	}()
+}
+
+func TestNewCallbackWithUserdataSignature(t *testing.T) {
+	for name, fn := range map[string]any{
+		"none": func(x int32) {},
+		"two":  func(a, b any) {},
+	} {
+		func() {
+			defer func() {
+				if msg, _ := recover().(string); !strings.Contains(msg, "exactly one interface parameter") {
+					t.Errorf("%s: panic %q", name, msg)
+				}
+			}()
+			NewCallbackWithUserdata(fn, 1)
+		}()
+	}
+	func() {
+		defer func() {
+			if msg, _ := recover().(string); !strings.Contains(msg, "does not implement") {
+				t.Errorf("mismatched userdata: panic %q", msg)
+			}
+		}()
+		NewCallbackWithUserdata(func(s interface{ String() string }) {}, 1)
+	}()
+}
@@ -1,11 +1,13 @@
-//go:build windows
+//go:build windows && !amd64
 
 // Package ffi provides Foreign Function Interface capabilities.
-// This file contains Windows-specific callback implementation using syscall.NewCallback.
+// This file contains the callback implementation for Windows on arm64, which
+// uses syscall.NewCallback; Windows x64 has its own trampolines.
 package ffi
 
 import (
 	"reflect"
+	"runtime"
 	"sync"
 	"syscall"
 )
@@ -17,27 +19,28 @@ var windowsCallbacks struct {
 }
 
 // NewCallback registers a Go function as a C callback and returns a function pointer.
-// On Windows, this delegates to syscall.NewCallback which properly handles Win64 ABI.
+// On Windows on arm64, this delegates to syscall.NewCallback.
 //
 // Windows requirements:
-//   - Function MUST have exactly one return value (uintptr-sized)
-//   - All arguments must be uintptr-sized (8 bytes on amd64)
+//   - fn returns nothing or one value
 //   - Maximum ~1024 callbacks (Go runtime limit)
 //
 // Supported argument/return types:
-//   - int, int64, uint, uint64, uintptr
+//   - int, int8, int16, int32, int64 and their unsigned counterparts, uintptr
+//   - bool
 //   - unsafe.Pointer, *T
 //
-// NOT supported on Windows:
-//   - void return (must return something)
-//   - int8, int16, int32, uint8, uint16, uint32, bool (not uintptr-sized)
+// syscall.NewCallback only takes uintptr-sized values, so narrower integers
+// and bool are converted at the boundary: arguments are truncated from their
+// register, results widened into it, and a void callback returns 0.
+//
+// NOT supported on Windows on arm64:
 //   - float32, float64 (use math.Float64bits/math.Float64frombits)
 //
 // Example:
 //
-//	cb := ffi.NewCallback(func(status, adapter, msg, userdata uintptr) uintptr {
+//	cb := ffi.NewCallback(func(status int32, adapter, msg, userdata uintptr) {
 //	    // Handle callback from C code
-//	    return 0
 //	})
 func NewCallback(fn any) uintptr {
 	if fn == nil {
@@ -49,46 +52,38 @@ func NewCallback(fn any) uintptr {
 		panic("ffi: callback must be a function")
 	}
 
-	// Validate function signature for syscall.NewCallback compatibility
 	typ := val.Type()
-
-	// Check argument types - syscall.NewCallback requires uintptr-sized args only
-	// On Windows 64-bit, only int/int64/uint/uint64/uintptr/pointer types work
 	for i := 0; i < typ.NumIn(); i++ {
 		argType := typ.In(i)
 		switch argType.Kind() {
-		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64,
-			reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer:
-			// Supported uintptr-sized types (8 bytes on amd64)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer, reflect.Bool:
+			// Supported types
 		case reflect.Float32, reflect.Float64:
 			panic("ffi: float arguments not supported in Windows callbacks (use uintptr and math.Float64bits)")
-		case reflect.Int8, reflect.Int16, reflect.Int32,
-			reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Bool:
-			panic("ffi: Windows callbacks require uintptr-sized arguments, got " + argType.String() +
-				" (use uintptr instead)")
 		default:
 			panic("ffi: unsupported argument type in callback: " + argType.String())
 		}
 	}
 
-	// Check return type - syscall.NewCallback requires exactly one return value
-	if typ.NumOut() != 1 {
-		panic("ffi: Windows callbacks must have exactly one uintptr-sized return value")
-	}
-	{
+	switch typ.NumOut() {
+	case 0:
+		// Void return is valid
+	case 1:
 		retType := typ.Out(0)
 		switch retType.Kind() {
-		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64,
-			reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer:
-			// Supported uintptr-sized return types (8 bytes on amd64)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer, reflect.Bool:
+			// Supported return types
 		case reflect.Float32, reflect.Float64:
 			panic("ffi: float return type not supported in Windows callbacks")
-		case reflect.Int8, reflect.Int16, reflect.Int32,
-			reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Bool:
-			panic("ffi: Windows callbacks require uintptr-sized return type, got " + retType.String())
 		default:
 			panic("ffi: unsupported return type in callback: " + retType.String())
 		}
+	default:
+		panic("ffi: callbacks can only return zero or one value")
 	}
 
 	// Track callback count for debugging
@@ -96,9 +91,73 @@ func NewCallback(fn any) uintptr {
 	windowsCallbacks.count++
 	windowsCallbacks.mu.Unlock()
 
-	// Delegate to Go's built-in syscall.NewCallback which handles Win64 ABI correctly
-	// syscall.NewCallback expects function with uintptr-sized arguments
-	return syscall.NewCallback(fn)
+	// Delegate to Go's built-in syscall.NewCallback which handles the platform ABI
+	ptr := syscall.NewCallback(uintptrCallback(val).Interface())
+	trace(TraceEvent{Kind: TraceCallbackRegister, Addr: ptr})
+	return ptr
+}
+
+// uintptrCallback adapts fn to the uintptr-sized signature syscall.NewCallback
+// expects: narrow integer and bool parameters become uintptr and are
+// truncated before fn runs, and the result is widened to uintptr, or 0 for a
+// void fn. A function that already fits is returned as it is.
+func uintptrCallback(fn reflect.Value) reflect.Value {
+	typ := fn.Type()
+	uintptrType := reflect.TypeFor[uintptr]()
+	fits := func(t reflect.Type) bool {
+		switch t.Kind() {
+		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64,
+			reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer:
+			return true
+		}
+		return false
+	}
+
+	in := make([]reflect.Type, typ.NumIn())
+	adapt := typ.NumOut() == 0 || !fits(typ.Out(0))
+	for i := range in {
+		in[i] = typ.In(i)
+		if !fits(in[i]) {
+			in[i] = uintptrType
+			adapt = true
+		}
+	}
+	if !adapt {
+		return fn
+	}
+	out := []reflect.Type{uintptrType}
+	if typ.NumOut() == 1 && fits(typ.Out(0)) {
+		out[0] = typ.Out(0)
+	}
+
+	return reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		for i, arg := range args {
+			switch t := typ.In(i); {
+			case t.Kind() == reflect.Bool:
+				args[i] = reflect.ValueOf(uint8(arg.Uint()) != 0).Convert(t)
+			case !fits(t):
+				args[i] = arg.Convert(t)
+			}
+		}
+		results := fn.Call(args)
+		if len(results) == 0 {
+			return []reflect.Value{reflect.Zero(uintptrType)}
+		}
+		ret := results[0]
+		switch ret.Kind() {
+		case reflect.Int8, reflect.Int16, reflect.Int32:
+			return []reflect.Value{reflect.ValueOf(uintptr(ret.Int()))}
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			return []reflect.Value{reflect.ValueOf(uintptr(ret.Uint()))}
+		case reflect.Bool:
+			var b uintptr
+			if ret.Bool() {
+				b = 1
+			}
+			return []reflect.Value{reflect.ValueOf(b)}
+		}
+		return results
+	})
 }
 
 // CallbackCount returns the number of callbacks registered.
@@ -108,3 +167,35 @@ func CallbackCount() int {
 	defer windowsCallbacks.mu.Unlock()
 	return windowsCallbacks.count
 }
+
+// releaseCallback does nothing: the runtime behind syscall.NewCallback never
+// frees its slots.
+func releaseCallback(uintptr) {}
+
+// FreeCallback is not supported on Windows, where syscall.NewCallback never
+// frees its slots: it returns an *UnsupportedPlatformError.
+func FreeCallback(ptr uintptr) error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// registeredCallbacks returns nil: Windows callbacks cannot be released.
+func registeredCallbacks() []uintptr { return nil }
+
+// SwapCallback is not supported on Windows, where syscall.NewCallback owns
+// the trampolines: it returns an *UnsupportedPlatformError.
+func SwapCallback(ptr uintptr, fn any) error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// LookupCallback always reports false on Windows, where syscall.NewCallback
+// owns the trampolines.
+func LookupCallback(addr uintptr) (CallbackInfo, bool) {
+	return CallbackInfo{}, false
+}
+
+// CallbackCapacity returns the limit of syscall.NewCallback, which the Go
+// runtime fixes at 2000 for the whole process; the goffi_callbacks build
+// tags have no effect on Windows.
+func CallbackCapacity() int {
+	return 2000
+}
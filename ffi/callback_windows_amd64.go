@@ -0,0 +1,227 @@
+//go:build windows && amd64
+
+// Package ffi provides callback support for Foreign Function Interface (Windows x64 version).
+// This file implements Go function registration as C callbacks using goffi's
+// own assembly trampolines, which unlike syscall.NewCallback see the XMM
+// argument registers.
+package ffi
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"structs"
+	"sync"
+	"unsafe"
+)
+
+// callbacks holds the global callback registry.
+var callbacks struct {
+	mu    sync.Mutex                  // Protects funcs, count and free
+	funcs [maxCallbacks]reflect.Value // Registered callback functions
+	count int                         // Number of trampoline slots handed out
+	free  []int                       // Released slots, reused before fresh ones
+}
+
+// callbackArgs represents the argument block passed from assembly to callbackWrap.
+// Win64 assigns arguments to positional slots: the first four travel in
+// RCX/XMM0, RDX/XMM1, R8/XMM2 and R9/XMM3, the rest on the stack. The
+// dispatcher spills RCX, RDX, R8 and R9 to their home area, where they line
+// up with the stack arguments, and saves XMM0-XMM3 separately.
+type callbackArgs struct {
+	_       structs.HostLayout
+	index   uintptr        // Callback index (0-1999)
+	args    unsafe.Pointer // Home area and stack arguments, one slot per argument
+	fargs   unsafe.Pointer // XMM0-XMM3
+	result  uintptr        // RAX on return
+	fresult uint64         // XMM0 on return
+}
+
+// NewCallback registers a Go function as a C callback and returns a function pointer.
+// The returned uintptr can be passed to C code as a callback function pointer.
+//
+// Windows x64 requirements:
+//   - fn can have arguments of basic types: integers of any width, bool,
+//     float32, float64 and pointers
+//   - fn can return nothing or one value of those types
+//   - The first four arguments may arrive in XMM0-XMM3, and floating-point
+//     results go back in XMM0
+//   - At most CallbackCapacity() callbacks (2000 by default) can be
+//     registered at a time
+//
+// Win64 leaves the bits above a narrow integer or bool undefined, so
+// arguments are read from their low bytes, and results are sign- or
+// zero-extended to all of RAX.
+//
+// Callbacks stay registered until FreeCallback releases them.
+//
+// Example:
+//
+//	cb := ffi.NewCallback(func(frames uint32, gain float32) {
+//	    // Handle callback from C code
+//	})
+func NewCallback(fn any) uintptr {
+	if fn == nil {
+		panic("ffi: callback function must not be nil")
+	}
+
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		panic("ffi: callback must be a function")
+	}
+
+	typ := val.Type()
+	validateCallbackSignature(typ)
+
+	callbacks.mu.Lock()
+	idx, ok := allocCallbackSlot()
+	if !ok {
+		callbacks.mu.Unlock()
+		panic(fmt.Sprintf("ffi: callback limit reached (%d callbacks maximum)", maxCallbacks))
+	}
+	storeCallback(idx, val)
+	recordCallback(idx)
+	callbacks.mu.Unlock()
+
+	ptr := trampolineEntryAddr(idx)
+	trace(TraceEvent{Kind: TraceCallbackRegister, Addr: ptr})
+	return ptr
+}
+
+// validateCallbackSignature checks if a function type is valid for callbacks.
+func validateCallbackSignature(typ reflect.Type) {
+	for i := 0; i < typ.NumIn(); i++ {
+		argType := typ.In(i)
+		switch argType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Uintptr, reflect.Float32, reflect.Float64,
+			reflect.Pointer, reflect.UnsafePointer, reflect.Bool:
+			// Valid types
+		default:
+			panic("ffi: unsupported callback argument type: " + argType.Kind().String())
+		}
+	}
+
+	switch typ.NumOut() {
+	case 0:
+		// Void return is valid
+	case 1:
+		retType := typ.Out(0)
+		switch retType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Uintptr, reflect.Float32, reflect.Float64,
+			reflect.Pointer, reflect.UnsafePointer, reflect.Bool:
+			// Valid return types
+		default:
+			panic("ffi: unsupported callback return type: " + retType.Kind().String())
+		}
+	default:
+		panic("ffi: callbacks can only return zero or one value")
+	}
+}
+
+// callbackFrameWords returns how many argument slots a callback of type typ
+// can read: one per argument, and at least the four of the home area.
+func callbackFrameWords(typ reflect.Type) int {
+	return max(4, typ.NumIn())
+}
+
+// trampolineEntryAddr calculates the address of a specific trampoline entry.
+// Each entry is 16 bytes, as on the other amd64 platforms (see
+// callback_table_amd64.s).
+func trampolineEntryAddr(i int) uintptr {
+	const entrySize = 16
+	return trampolineBaseAddr + uintptr(i*entrySize)
+}
+
+// callbackWrap_call allows the calling of the ABIInternal wrapper
+// which is required for runtime.cgocallback without the <ABIInternal>
+// tag which is only allowed in the runtime.
+// This closure is used inside callback_windows_amd64.s to pass to
+// runtime.cgocallback.
+var callbackWrap_call = callbackWrap
+
+// callbackWrap is called from assembly via runtime.cgocallback to invoke the
+// actual Go callback. runtime.cgocallback attaches threads the Go runtime did
+// not create, so native libraries may call back from their own threads.
+//
+// Argument i is in slot i of a.args, except that a floating-point argument
+// among the first four is in XMM register i instead. A float32, narrow
+// integer or bool uses the low bytes of its register or slot.
+func callbackWrap(a *callbackArgs) {
+	if p := callbackPanicPolicy.Load(); p != nil {
+		defer recoverCallback(p, int(a.index))
+	}
+	fn, plan := loadCallback(int(a.index))
+	if plan.invoke != nil {
+		// The home area holds RCX, RDX, R8 and R9.
+		a.result = plan.invoke((*[4]uintptr)(a.args))
+		return
+	}
+
+	typ := fn.Type()
+	numArgs := typ.NumIn()
+
+	const numRegSlots = 4 // RCX/XMM0, RDX/XMM1, R8/XMM2, R9/XMM3
+
+	slots := unsafe.Slice((*uintptr)(a.args), plan.words)
+	fregs := (*[numRegSlots]uint64)(a.fargs)
+
+	args := make([]reflect.Value, numArgs)
+	for i := range numArgs {
+		argType := typ.In(i)
+		bits := uint64(slots[i])
+		if i < numRegSlots {
+			if k := argType.Kind(); k == reflect.Float32 || k == reflect.Float64 {
+				bits = fregs[i]
+			}
+		}
+
+		switch argType.Kind() {
+		case reflect.Float32:
+			args[i] = reflect.ValueOf(math.Float32frombits(uint32(bits))).Convert(argType)
+		case reflect.Float64:
+			args[i] = reflect.ValueOf(math.Float64frombits(bits)).Convert(argType)
+		case reflect.Pointer:
+			args[i] = reflect.NewAt(argType.Elem(), foreignPointer(uintptr(bits)))
+		case reflect.UnsafePointer:
+			args[i] = reflect.ValueOf(foreignPointer(uintptr(bits))).Convert(argType)
+		case reflect.Bool:
+			args[i] = reflect.ValueOf(uint8(bits) != 0).Convert(argType)
+		default:
+			args[i] = reflect.NewAt(argType, unsafe.Pointer(&slots[i])).Elem()
+		}
+	}
+
+	results := fn.Call(args)
+	if len(results) == 0 {
+		return
+	}
+
+	ret := results[0]
+	switch ret.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		a.result = uintptr(ret.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		a.result = uintptr(ret.Uint())
+	case reflect.Bool:
+		if ret.Bool() {
+			a.result = 1
+		}
+	case reflect.Pointer, reflect.UnsafePointer:
+		a.result = ret.Pointer()
+	case reflect.Float32:
+		// The assembly code loads fresult into XMM0 for return
+		a.fresult = uint64(math.Float32bits(float32(ret.Float())))
+	case reflect.Float64:
+		a.fresult = math.Float64bits(ret.Float())
+	}
+}
+
+// trampolineBaseAddr is the address of the callback assembly trampoline table.
+//
+//go:linkname _callbackTrampoline github.com/go-webgpu/goffi/ffi.callbackTrampoline
+var _callbackTrampoline byte
+var trampolineBaseAddr = uintptr(unsafe.Pointer(&_callbackTrampoline))
@@ -0,0 +1,76 @@
+//go:build windows && amd64
+
+#include "textflag.h"
+#include "go_asm.h"
+#include "funcdata.h"
+#include "abi_amd64.h"
+
+// callbackTrampoline, the table of entries that jump to callbackDispatcher,
+// is in callback_table*_amd64.s.
+
+// callbackDispatcher is the common handler for all callback trampolines on
+// Windows x64. Like runtime·callbackasm1 behind syscall.NewCallback, it
+// calls runtime·cgocallback directly, which attaches threads the Go runtime
+// did not create.
+//
+// On entry:
+//   0(SP)     = original caller's return address
+//   8-39(SP)  = home area for the four register arguments
+//   40(SP)    = fifth and later arguments
+//   R11       = callback index
+//   RCX, RDX, R8, R9 = integer arguments 1-4
+//   XMM0-XMM3 = floating-point arguments 1-4
+//
+// Return: RAX (integer), XMM0 (float)
+TEXT ·callbackDispatcher(SB), NOSPLIT|NOFRAME, $0
+	// Spill the register arguments to their home area, so that they line up
+	// with the stack arguments.
+	MOVQ CX, 8(SP)
+	MOVQ DX, 16(SP)
+	MOVQ R8, 24(SP)
+	MOVQ R9, 32(SP)
+	MOVQ R11, AX   // callback index, set by the trampoline entry
+	LEAQ 8(SP), R8 // R8 = address of argument slots
+
+	// Save the floating-point argument registers.
+	ADJSP $4*8, SP
+	MOVSD X0, (0*8)(SP)
+	MOVSD X1, (1*8)(SP)
+	MOVSD X2, (2*8)(SP)
+	MOVSD X3, (3*8)(SP)
+	MOVQ  SP, R9 // R9 = address of XMM0-XMM3
+
+	// Switch from the host ABI to the Go ABI. This saves RDI, RSI and
+	// XMM6-XMM15, which are callee-saved on Windows.
+	PUSH_REGS_HOST_TO_ABI0()
+
+	// Create a struct callbackArgs on our stack to be passed as
+	// the "frame" to cgocallback and on to callbackWrap.
+	// $24 to make enough room for the arguments to runtime.cgocallback.
+	SUBQ $(24+callbackArgs__size), SP
+	MOVQ AX, (24+callbackArgs_index)(SP)   // callback index
+	MOVQ R8, (24+callbackArgs_args)(SP)    // address of argument slots
+	MOVQ R9, (24+callbackArgs_fargs)(SP)   // address of XMM0-XMM3
+	MOVQ $0, (24+callbackArgs_result)(SP)  // result
+	MOVQ $0, (24+callbackArgs_fresult)(SP) // floating-point result
+	LEAQ 24(SP), AX                        // take the address of callbackArgs
+
+	// Call cgocallback, which will call callbackWrap(frame).
+	MOVQ ·callbackWrap_call(SB), DI // Get the ABIInternal function pointer
+	MOVQ (DI), DI                   // without <ABIInternal> by using a closure.
+	MOVQ DI, 0(SP)                  // fn
+	MOVQ AX, 8(SP)                  // frame (address of callbackArgs)
+	MOVQ $0, 16(SP)                 // context
+
+	CALL runtime·cgocallback(SB)
+
+	// Get callback result.
+	MOVQ  (24+callbackArgs_result)(SP), AX
+	MOVSD (24+callbackArgs_fresult)(SP), X0
+	ADDQ  $(24+callbackArgs__size), SP // remove callbackArgs struct
+
+	POP_REGS_HOST_TO_ABI0()
+
+	ADJSP $-4*8, SP // remove XMM0-XMM3
+
+	RET
@@ -0,0 +1,89 @@
+//go:build windows && amd64
+
+package ffi
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+)
+
+// invokeWin64Callback runs the callback at ptr on argument slots and
+// XMM0-XMM3 as the dispatcher saves them.
+func invokeWin64Callback(ptr uintptr, slots [16]uintptr, xmm [4]uint64) *callbackArgs {
+	a := &callbackArgs{
+		index: (ptr - trampolineBaseAddr) / 16,
+		args:  unsafe.Pointer(&slots),
+		fargs: unsafe.Pointer(&xmm),
+	}
+	callbackWrap(a)
+	return a
+}
+
+func TestWin64CallbackFloats(t *testing.T) {
+	var gotN uintptr
+	var gotGain float32
+	var gotScale, gotBias float64
+	ptr := NewCallback(func(n uintptr, gain float32, scale float64, frames uintptr, bias float64) float64 {
+		gotN, gotGain, gotScale, gotBias = n, gain, scale, bias
+		return float64(n)*scale + float64(gain) + bias + float64(frames)
+	})
+	defer releaseCallback(ptr)
+
+	// Positional slots: n in RCX, gain in XMM1, scale in XMM2, frames in
+	// R9 and bias, the fifth argument, on the stack.
+	var slots [16]uintptr
+	slots[0] = 3
+	slots[3] = 100
+	slots[4] = uintptr(math.Float64bits(0.125))
+	xmm := [4]uint64{0, uint64(math.Float32bits(1.5)), math.Float64bits(2), 0}
+	a := invokeWin64Callback(ptr, slots, xmm)
+
+	if gotN != 3 || gotGain != 1.5 || gotScale != 2 || gotBias != 0.125 {
+		t.Errorf("callback got %d, %v, %v, %v; want 3, 1.5, 2, 0.125", gotN, gotGain, gotScale, gotBias)
+	}
+	if got := math.Float64frombits(a.fresult); got != 107.625 {
+		t.Errorf("XMM0 result = %v, want 107.625", got)
+	}
+
+	ptr32 := NewCallback(func(x float32) float32 { return x * 2 })
+	defer releaseCallback(ptr32)
+	a = invokeWin64Callback(ptr32, [16]uintptr{}, [4]uint64{uint64(math.Float32bits(1.25))})
+	if got := math.Float32frombits(uint32(a.fresult)); got != 2.5 {
+		t.Errorf("float32 result = %v, want 2.5", got)
+	}
+}
+
+func TestWin64CallbackNarrowTypes(t *testing.T) {
+	var gotA int8
+	var gotB uint16
+	var gotOK bool
+	ptr := NewCallback(func(a int8, b uint16, ok bool) int32 {
+		gotA, gotB, gotOK = a, b, ok
+		return int32(a) * 1000
+	})
+	defer releaseCallback(ptr)
+
+	// Win64 leaves the bits above a narrow argument undefined.
+	slots := [16]uintptr{0xdeadbe00 | 0xfe, 0xdead0000 | 0xbeef, 0xdeadbe00 | 1}
+	a := invokeWin64Callback(ptr, slots, [4]uint64{})
+	if gotA != -2 || gotB != 0xbeef || !gotOK {
+		t.Errorf("callback got %d, %#x, %v; want -2, 0xbeef, true", gotA, gotB, gotOK)
+	}
+	if int64(a.result) != -2000 {
+		t.Errorf("RAX = %#x, want -2000 sign-extended", a.result)
+	}
+
+	boolPtr := NewCallback(func(x uint8) bool { return x == 7 })
+	defer releaseCallback(boolPtr)
+	if a := invokeWin64Callback(boolPtr, [16]uintptr{0x107}, [4]uint64{}); a.result != 1 {
+		t.Errorf("bool result = %d, want 1", a.result)
+	}
+
+	called := false
+	voidPtr := NewCallback(func(uintptr) { called = true })
+	defer releaseCallback(voidPtr)
+	if a := invokeWin64Callback(voidPtr, [16]uintptr{}, [4]uint64{}); !called || a.result != 0 {
+		t.Errorf("void callback: called = %v, RAX = %#x", called, a.result)
+	}
+}
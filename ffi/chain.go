@@ -0,0 +1,109 @@
+package ffi
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// ChainLayout locates the chain header in the links of an extension chain:
+// the pointer to the next link and the 32-bit structure type tag.
+type ChainLayout struct {
+	NextOffset  uintptr // Offset of the next-link pointer
+	STypeOffset uintptr // Offset of the uint32 structure type
+}
+
+var (
+	// WGPUChain is the header of WebGPU extension structs, which start with
+	// a WGPUChainedStruct { const WGPUChainedStruct *next; WGPUSType sType; }.
+	WGPUChain = ChainLayout{NextOffset: 0, STypeOffset: unsafe.Sizeof(uintptr(0))}
+
+	// VulkanChain is the header of Vulkan structs, which start with
+	// VkStructureType sType followed by const void *pNext.
+	VulkanChain = ChainLayout{STypeOffset: 0, NextOffset: unsafe.Sizeof(uintptr(0))}
+)
+
+// Chain builds a linked list of extension structs, as hung off the
+// nextInChain member of WebGPU descriptors or the pNext member of Vulkan
+// ones. Every link and everything it points to is allocated from one arena,
+// so the whole chain stays valid until the arena is freed:
+//
+//	arena := ffi.NewArena()
+//	defer arena.Free()
+//	chain := ffi.NewChain(arena, ffi.WGPUChain)
+//	// struct WGPUShaderSourceWGSL { WGPUChainedStruct chain; WGPUStringView code; };
+//	if _, err := chain.Append(sTypeShaderSourceWGSL, wgslDesc, &shaderSourceWGSL{Code: src}); err != nil {
+//	    return err
+//	}
+//	moduleDesc.NextInChain = chain.Head()
+//
+// A Chain is not safe for concurrent use.
+type Chain struct {
+	layout ChainLayout
+	arena  *Arena
+	head   unsafe.Pointer
+	tail   unsafe.Pointer
+}
+
+// NewChain returns an empty chain whose links have the given header layout
+// and are allocated from arena.
+func NewChain(arena *Arena, layout ChainLayout) *Chain {
+	return &Chain{layout: layout, arena: arena}
+}
+
+// Append marshals v into a new link laid out as desc (see Marshal), sets its
+// header to sType and the end of the chain, links it after the current last
+// link and returns it. The Go fields that correspond to the header are
+// overwritten, so they may be left zero; a nil v appends a link with only
+// its header set.
+func (c *Chain) Append(sType uint32, desc *types.TypeDescriptor, v any) (unsafe.Pointer, error) {
+	if c.arena == nil {
+		return nil, &InvalidCallInterfaceError{Field: "arena", Reason: "must not be nil", Index: -1}
+	}
+	if err := prepareMarshalType("desc", desc); err != nil {
+		return nil, err
+	}
+	ptrSize := unsafe.Sizeof(uintptr(0))
+	if c.layout.NextOffset%ptrSize != 0 || c.layout.NextOffset+ptrSize > desc.Size ||
+		c.layout.STypeOffset%4 != 0 || c.layout.STypeOffset+4 > desc.Size {
+		return nil, &TypeValidationError{
+			TypeName: "desc",
+			Kind:     int(desc.Kind),
+			Reason:   fmt.Sprintf("%d-byte link cannot hold the chain header", desc.Size),
+			Index:    -1,
+		}
+	}
+
+	link := c.arena.Alloc(desc.Size, desc.Alignment)
+	if v != nil {
+		if err := Marshal(link, desc, v, c.arena); err != nil {
+			return nil, err
+		}
+	}
+	*(*uint32)(unsafe.Add(link, c.layout.STypeOffset)) = sType
+	*(*unsafe.Pointer)(unsafe.Add(link, c.layout.NextOffset)) = nil
+
+	if c.tail == nil {
+		c.head = link
+	} else {
+		*(*unsafe.Pointer)(unsafe.Add(c.tail, c.layout.NextOffset)) = link
+	}
+	c.tail = link
+	return link, nil
+}
+
+// Head returns the first link, to store in the base struct's chain pointer,
+// or nil for an empty chain.
+func (c *Chain) Head() unsafe.Pointer {
+	return c.head
+}
+
+// Len returns the number of links.
+func (c *Chain) Len() int {
+	n := 0
+	for p := c.head; p != nil; p = *(*unsafe.Pointer)(unsafe.Add(p, c.layout.NextOffset)) {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,107 @@
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestChainWGPU(t *testing.T) {
+	header := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt32TypeDescriptor}}
+	// struct { WGPUChainedStruct chain; const char *label; };
+	labelDesc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		header, types.PointerTypeDescriptor}}
+	// struct { WGPUChainedStruct chain; uint32_t count; };
+	countDesc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		header, types.UInt32TypeDescriptor}}
+	type chained struct {
+		Next  unsafe.Pointer
+		SType uint32
+	}
+
+	arena := NewArena()
+	defer arena.Free()
+	chain := NewChain(arena, WGPUChain)
+	if chain.Head() != nil || chain.Len() != 0 {
+		t.Fatal("new chain is not empty")
+	}
+	first, err := chain.Append(7, labelDesc, &struct {
+		Chain chained
+		Label string
+	}{Label: "ext"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := chain.Append(9, countDesc, &struct {
+		Chain chained
+		Count uint32
+	}{Count: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	third, err := chain.Append(11, countDesc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if chain.Head() != first || chain.Len() != 3 {
+		t.Fatalf("Head = %p, Len = %d; want %p, 3", chain.Head(), chain.Len(), first)
+	}
+	link := (*chained)(first)
+	if link.SType != 7 || link.Next != second {
+		t.Errorf("first link = %+v", *link)
+	}
+	if got := goStringN(*(*unsafe.Pointer)(unsafe.Add(first, header.Size)), 16); got != "ext" {
+		t.Errorf("label = %q", got)
+	}
+	if link := (*chained)(second); link.SType != 9 || link.Next != third {
+		t.Errorf("second link = %+v", *link)
+	}
+	if got := *(*uint32)(unsafe.Add(second, header.Size)); got != 3 {
+		t.Errorf("count = %d", got)
+	}
+	if link := (*chained)(third); link.SType != 11 || link.Next != nil {
+		t.Errorf("last link = %+v", *link)
+	}
+}
+
+func TestChainVulkan(t *testing.T) {
+	// struct { VkStructureType sType; const void *pNext; uint32_t flags; };
+	desc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		types.UInt32TypeDescriptor, types.PointerTypeDescriptor, types.UInt32TypeDescriptor}}
+	arena := NewArena()
+	defer arena.Free()
+	chain := NewChain(arena, VulkanChain)
+	a, err := chain.Append(1000, desc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := chain.Append(2000, desc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *(*uint32)(a) != 1000 || *(*unsafe.Pointer)(unsafe.Add(a, VulkanChain.NextOffset)) != b {
+		t.Error("first link header not set")
+	}
+	if *(*uint32)(b) != 2000 || *(*unsafe.Pointer)(unsafe.Add(b, VulkanChain.NextOffset)) != nil {
+		t.Error("last link header not set")
+	}
+}
+
+func TestChainErrors(t *testing.T) {
+	var tve *TypeValidationError
+	chain := NewChain(NewArena(), WGPUChain)
+	if _, err := chain.Append(1, types.UInt32TypeDescriptor, nil); !errors.As(err, &tve) {
+		t.Errorf("link too small for the header: err = %v, want *TypeValidationError", err)
+	}
+	var icie *InvalidCallInterfaceError
+	if _, err := NewChain(nil, WGPUChain).Append(1, types.UInt32TypeDescriptor, nil); !errors.As(err, &icie) {
+		t.Errorf("nil arena: err = %v", err)
+	}
+	if chain.Len() != 0 {
+		t.Error("failed Append linked a link")
+	}
+}
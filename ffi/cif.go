@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"slices"
 
 	"github.com/go-webgpu/goffi/internal/arch"
 	"github.com/go-webgpu/goffi/types"
@@ -40,7 +41,21 @@ func prepareCallInterfaceCore(
 	cif.ArgTypes = argTypes
 	cif.ReturnType = returnType
 
-	// Initialize composite types
+	if err := prepareReturnType(returnType); err != nil {
+		return err
+	}
+
+	stackBytes, err := argumentStackBytes(argTypes)
+	if err != nil {
+		return err
+	}
+	cif.StackBytes = stackBytes
+
+	return preparePlatformSpecific(cif)
+}
+
+// prepareReturnType lays out a composite return type and validates its kind.
+func prepareReturnType(returnType *types.TypeDescriptor) error {
 	if returnType.Size == 0 && returnType.Kind == types.StructType {
 		if err := initializeCompositeType(returnType); err != nil {
 			return err
@@ -50,40 +65,174 @@ func prepareCallInterfaceCore(
 	if !isValidType(returnType) {
 		return newInvalidTypeError("returnType", int(returnType.Kind), "unsupported type kind")
 	}
+	return nil
+}
 
-	// Calculate stack size
+// argumentStackBytes lays out composite argument types, validates every
+// argument kind and returns the stack space the arguments would occupy.
+func argumentStackBytes(argTypes []*types.TypeDescriptor) (uintptr, error) {
 	stackBytes := uintptr(0)
 	for i, t := range argTypes {
 		if t.Size == 0 && t.Kind == types.StructType {
 			if err := initializeCompositeType(t); err != nil {
-				return fmt.Errorf("argument type at index %d: %w", i, err)
+				return 0, fmt.Errorf("argument type at index %d: %w", i, err)
 			}
 		}
 		if !isValidType(t) {
-			return newInvalidTypeAtIndexError("argTypes", int(t.Kind), i, "unsupported type kind")
+			return 0, newInvalidTypeAtIndexError("argTypes", int(t.Kind), i, "unsupported type kind")
 		}
 		stackBytes = align(stackBytes, t.Alignment)
 		stackBytes += align(t.Size, 8)
 	}
-	cif.StackBytes = stackBytes
-
-	return preparePlatformSpecific(cif)
+	return stackBytes, nil
 }
 
 // preparePlatformSpecific performs platform-specific preparation
 func preparePlatformSpecific(cif *types.CallInterface) error {
-	if arch.Registry.Classifier == nil {
-		return types.ErrUnsupportedArchitecture
+	backend, err := backendFor(cif.Convention, cif.Backend)
+	if err != nil {
+		return err
+	}
+	classifier := backend.Classifier
+	if cif.Backend == "" {
+		if err := checkLongDouble(cif); err != nil {
+			return err
+		}
+		if err := checkInt128(cif); err != nil {
+			return err
+		}
+		if err := checkVector(cif); err != nil {
+			return err
+		}
 	}
 
-	cif.Flags = arch.Registry.Classifier.ClassifyReturn(cif.ReturnType, cif.Convention)
+	cif.Flags = classifier.ClassifyReturn(cif.ReturnType, cif.Convention)
 
+	return checkArgumentLimits(cif, classifier)
+}
+
+// checkLongDouble rejects long double arguments and return values, also
+// inside structs, unless the built-in backend can pass them: on x86-64
+// System V only.
+func checkLongDouble(cif *types.CallInterface) error {
+	if runtime.GOARCH == "amd64" && runtime.GOOS != "windows" && cif.Convention == types.UnixCallingConvention {
+		return nil
+	}
+	const reason = "long double is only supported on amd64 System V"
+	if hasLongDouble(cif.ReturnType) {
+		return newInvalidTypeError("returnType", int(cif.ReturnType.Kind), reason)
+	}
+	for i, t := range cif.ArgTypes {
+		if hasLongDouble(t) {
+			return newInvalidTypeAtIndexError("argTypes", int(t.Kind), i, reason)
+		}
+	}
+	return nil
+}
+
+// hasLongDouble reports whether t is a long double or a struct holding one.
+func hasLongDouble(t *types.TypeDescriptor) bool {
+	if t.Kind == types.StructType {
+		return slices.ContainsFunc(t.Members, hasLongDouble)
+	}
+	return t.Kind == types.LongDoubleType
+}
+
+// checkInt128 rejects 128-bit integer arguments and return values, also
+// inside structs, unless the built-in backend can pass them: on x86-64
+// System V and on arm64 outside Windows.
+func checkInt128(cif *types.CallInterface) error {
+	if sysVOrARM64(cif) {
+		return nil
+	}
+	const reason = "__int128 is only supported on amd64 System V and arm64"
+	if hasInt128(cif.ReturnType) {
+		return newInvalidTypeError("returnType", int(cif.ReturnType.Kind), reason)
+	}
+	for i, t := range cif.ArgTypes {
+		if hasInt128(t) {
+			return newInvalidTypeAtIndexError("argTypes", int(t.Kind), i, reason)
+		}
+	}
+	return nil
+}
+
+// hasInt128 reports whether t is a 128-bit integer or a struct holding one.
+func hasInt128(t *types.TypeDescriptor) bool {
+	if t.Kind == types.StructType {
+		return slices.ContainsFunc(t.Members, hasInt128)
+	}
+	return t.Kind == types.SInt128Type || t.Kind == types.UInt128Type
+}
+
+// checkVector rejects vector arguments and return values the built-in
+// backend cannot pass: outside x86-64 System V and arm64 (not Windows),
+// inside structs, or other than 16 bytes of scalar lanes.
+func checkVector(cif *types.CallInterface) error {
+	supported := sysVOrARM64(cif)
+	problem := func(t *types.TypeDescriptor) string {
+		switch {
+		case t.Kind == types.StructType && slices.ContainsFunc(t.Members, hasVector):
+			return "vectors cannot be struct members"
+		case t.Kind != types.VectorType:
+			return ""
+		case !supported:
+			return "vectors are only supported on amd64 System V and arm64"
+		case t.Size != 16 || t.Elem == nil || !isVectorLane(t.Elem) || t.Size%t.Elem.Size != 0:
+			return "vectors must be 16 bytes of integer or floating-point lanes"
+		}
+		return ""
+	}
+	if reason := problem(cif.ReturnType); reason != "" {
+		return newInvalidTypeError("returnType", int(cif.ReturnType.Kind), reason)
+	}
+	for i, t := range cif.ArgTypes {
+		if reason := problem(t); reason != "" {
+			return newInvalidTypeAtIndexError("argTypes", int(t.Kind), i, reason)
+		}
+	}
+	return nil
+}
+
+// hasVector reports whether t is a vector or a struct holding one.
+func hasVector(t *types.TypeDescriptor) bool {
+	if t.Kind == types.StructType {
+		return slices.ContainsFunc(t.Members, hasVector)
+	}
+	return t.Kind == types.VectorType
+}
+
+// isVectorLane reports whether t can be the lane type of a vector.
+func isVectorLane(t *types.TypeDescriptor) bool {
+	switch t.Kind {
+	case types.FloatType, types.DoubleType, types.Float16Type:
+		return true
+	}
+	return isIntegerKind(t.Kind)
+}
+
+// sysVOrARM64 reports whether the built-in backend makes the calls of cif
+// on x86-64 System V or on arm64 outside Windows, the targets that pass
+// 128-bit integers and vectors.
+func sysVOrARM64(cif *types.CallInterface) bool {
+	switch {
+	case runtime.GOOS == "windows":
+		return false
+	case runtime.GOARCH == "amd64":
+		return cif.Convention == types.UnixCallingConvention
+	}
+	return runtime.GOARCH == "arm64"
+}
+
+// checkArgumentLimits classifies the arguments of cif and rejects lists that
+// overflow the stack slots of the syscall layer.
+func checkArgumentLimits(cif *types.CallInterface, classifier arch.ArgumentClassifier) error {
 	var gprCount, sseCount int
 	maxGPR, maxSSE := maxGPRegisters(cif.Convention), maxSSERegisters(cif.Convention)
 	maxStack := maxStackSlots(cif.Convention)
 
 	for _, arg := range cif.ArgTypes {
-		classification := arch.Registry.Classifier.ClassifyArgument(arg, cif.Convention)
+		classification := classifier.ClassifyArgument(arg, cif.Convention)
 		gprCount += classification.GPRCount
 		sseCount += classification.SSECount
 	}
@@ -139,6 +288,15 @@ func initializeCompositeType(t *types.TypeDescriptor) error {
 		}
 	}
 
+	if t.PackedAlignment&(t.PackedAlignment-1) != 0 {
+		return &TypeValidationError{
+			TypeName: "compositeType",
+			Kind:     int(t.Kind),
+			Reason:   "packed alignment is not a power of two",
+			Index:    -1,
+		}
+	}
+
 	t.Size = 0
 	t.Alignment = 0
 
@@ -152,11 +310,12 @@ func initializeCompositeType(t *types.TypeDescriptor) error {
 			return newInvalidTypeAtIndexError("structMember", int(member.Kind), i, "unsupported type kind")
 		}
 
-		t.Size = align(t.Size, member.Alignment)
+		memberAlignment := t.MemberAlignment(member)
+		t.Size = align(t.Size, memberAlignment)
 		t.Size += member.Size
 
-		if member.Alignment > t.Alignment {
-			t.Alignment = member.Alignment
+		if memberAlignment > t.Alignment {
+			t.Alignment = memberAlignment
 		}
 	}
 
@@ -170,7 +329,8 @@ func isValidType(t *types.TypeDescriptor) bool {
 	case types.VoidType, types.IntType, types.FloatType, types.DoubleType,
 		types.UInt8Type, types.SInt8Type, types.UInt16Type, types.SInt16Type,
 		types.UInt32Type, types.SInt32Type, types.UInt64Type, types.SInt64Type,
-		types.StructType, types.PointerType:
+		types.StructType, types.PointerType, types.Float16Type, types.LongDoubleType,
+		types.BoolType, types.SInt128Type, types.UInt128Type, types.VectorType:
 		return true
 	default:
 		return false
@@ -212,6 +372,6 @@ func maxStackSlots(convention types.CallingConvention) int {
 	// Our syscall layer supports up to maxArgs (15) total args:
 	// Unix AMD64:  6 GP registers + 9 stack slots = 15
 	// Unix ARM64:  8 GP registers + 7 stack slots = 15
-	// Windows:     syscall.SyscallN supports up to 15 args natively
+	// Windows:     4 register slots + 9 stack slots, within CallWin64's 15
 	return 9
 }
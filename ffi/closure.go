@@ -0,0 +1,197 @@
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// PrepareClosure creates a C function pointer for the prototype cif
+// describes, which calls handler, as libffi's ffi_prep_closure does. Unlike
+// NewCallback it needs no Go function of matching signature, so code
+// generators and bindings that learn prototypes at run time can create
+// callbacks for any prototype they can describe, including struct
+// arguments and variadic functions:
+//
+//	// int (*compar)(const void *, const void *)
+//	var cif types.CallInterface
+//	ffi.PrepareCallInterface(&cif, types.DefaultCall, types.SInt32TypeDescriptor,
+//	    []*types.TypeDescriptor{types.PointerTypeDescriptor, types.PointerTypeDescriptor})
+//	cmp, err := ffi.PrepareClosure(&cif, func(args []unsafe.Pointer, ret unsafe.Pointer) {
+//	    a, b := *(**int32)(args[0]), *(**int32)(args[1])
+//	    *(*int32)(ret) = *a - *b
+//	})
+//
+// cif must have been prepared with PrepareCallInterface or
+// PrepareVariadicCallInterface. On each call, args[i] points to the value of
+// argument i laid out as cif.ArgTypes[i], and ret to zeroed storage for a
+// cif.ReturnType, which handler fills in; ret is nil for a void prototype.
+// Both are valid only until handler returns. Variadic arguments arrive
+// promoted, as float to double and narrow integers to int, and are
+// converted back to the types cif declares.
+//
+// The closure takes a trampoline slot like NewCallback and is released with
+// FreeCallback. Argument and return types the platform's callbacks cannot
+// receive (see NewCallback), such as long double, 128-bit integers and
+// vectors, make it return an *InvalidCallInterfaceError, and structs whose
+// layout Go cannot reproduce, such as packed ones, a *LayoutMismatchError.
+func PrepareClosure(cif *types.CallInterface, handler func(args []unsafe.Pointer, ret unsafe.Pointer)) (uintptr, error) {
+	if cif == nil {
+		return 0, &InvalidCallInterfaceError{Field: "cif", Reason: "must not be nil", Index: -1}
+	}
+	if handler == nil {
+		return 0, &InvalidCallInterfaceError{Field: "handler", Reason: "must not be nil", Index: -1}
+	}
+	if cif.ReturnType == nil || cif.ArgCount != len(cif.ArgTypes) {
+		return 0, &InvalidCallInterfaceError{Field: "cif", Reason: "not prepared", Index: -1}
+	}
+	if cif.FixedArgCount > 0 && (runtime.GOARCH == "arm" || runtime.GOOS == "darwin" && runtime.GOARCH == "arm64") {
+		return 0, &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "variadic closures are not supported on " + runtime.GOOS + "/" + runtime.GOARCH,
+			Index:  -1,
+		}
+	}
+
+	declared := make([]reflect.Type, len(cif.ArgTypes))
+	in := make([]reflect.Type, len(cif.ArgTypes))
+	for i, d := range cif.ArgTypes {
+		t, err := closureType(d)
+		if err != nil {
+			return 0, closureTypeError("argTypes", i, err)
+		}
+		declared[i], in[i] = t, t
+		if cif.FixedArgCount > 0 && i >= cif.FixedArgCount {
+			in[i] = promotedType(t)
+		}
+	}
+	var out []reflect.Type
+	if cif.ReturnType.Kind != types.VoidType {
+		t, err := closureType(cif.ReturnType)
+		if err != nil {
+			return 0, closureTypeError("returnType", -1, err)
+		}
+		out = []reflect.Type{t}
+	}
+
+	fn := reflect.MakeFunc(reflect.FuncOf(in, out, false), func(vals []reflect.Value) []reflect.Value {
+		args := make([]unsafe.Pointer, len(vals))
+		for i, v := range vals {
+			p := reflect.New(declared[i])
+			switch {
+			case in[i] == declared[i]:
+				p.Elem().Set(v)
+			case declared[i].Kind() == reflect.Bool:
+				p.Elem().SetBool(v.Int() != 0)
+			default:
+				p.Elem().Set(v.Convert(declared[i]))
+			}
+			args[i] = p.UnsafePointer()
+		}
+		if len(out) == 0 {
+			handler(args, nil)
+			return nil
+		}
+		ret := reflect.New(out[0])
+		handler(args, ret.UnsafePointer())
+		return []reflect.Value{ret.Elem()}
+	})
+	return newClosureCallback(fn.Interface())
+}
+
+// closureType returns the Go type a closure receives a value of the C type
+// d as. Structs become struct types with one field per member, F0, F1 and
+// so on, which must have the layout d describes.
+func closureType(d *types.TypeDescriptor) (reflect.Type, error) {
+	if d == nil {
+		return nil, errors.New("must not be nil")
+	}
+	switch d.Kind {
+	case types.IntType, types.SInt32Type:
+		return reflect.TypeFor[int32](), nil
+	case types.FloatType:
+		return reflect.TypeFor[float32](), nil
+	case types.DoubleType:
+		return reflect.TypeFor[float64](), nil
+	case types.UInt8Type:
+		return reflect.TypeFor[uint8](), nil
+	case types.SInt8Type:
+		return reflect.TypeFor[int8](), nil
+	case types.UInt16Type:
+		return reflect.TypeFor[uint16](), nil
+	case types.SInt16Type:
+		return reflect.TypeFor[int16](), nil
+	case types.UInt32Type:
+		return reflect.TypeFor[uint32](), nil
+	case types.UInt64Type:
+		return reflect.TypeFor[uint64](), nil
+	case types.SInt64Type:
+		return reflect.TypeFor[int64](), nil
+	case types.PointerType:
+		return reflect.TypeFor[unsafe.Pointer](), nil
+	case types.BoolType:
+		return reflect.TypeFor[bool](), nil
+	case types.StructType:
+		fields := make([]reflect.StructField, len(d.Members))
+		for i, m := range d.Members {
+			ft, err := closureType(m)
+			if err != nil {
+				return nil, fmt.Errorf("member %d: %w", i, err)
+			}
+			fields[i] = reflect.StructField{Name: "F" + strconv.Itoa(i), Type: ft}
+		}
+		t := reflect.StructOf(fields)
+		if err := CheckLayout(t, d); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	return nil, fmt.Errorf("kind %d cannot be received by a callback", d.Kind)
+}
+
+// closureTypeError reports why closureType rejected the type of
+// field[index].
+func closureTypeError(field string, index int, err error) error {
+	if lerr := (*LayoutMismatchError)(nil); errors.As(err, &lerr) {
+		return lerr
+	}
+	return &InvalidCallInterfaceError{Field: field, Reason: err.Error(), Index: index}
+}
+
+// promotedType returns the type C's default argument promotions turn a
+// variadic argument of type t into.
+func promotedType(t reflect.Type) reflect.Type {
+	switch t.Kind() {
+	case reflect.Float32:
+		return reflect.TypeFor[float64]()
+	case reflect.Bool, reflect.Int8, reflect.Int16, reflect.Uint8, reflect.Uint16:
+		return reflect.TypeFor[int32]()
+	}
+	return t
+}
+
+// newClosureCallback registers fn like NewCallback, but returns an error
+// where NewCallback panics: on a signature the platform's callbacks cannot
+// take, a full trampoline table or a platform without callbacks.
+func newClosureCallback(fn any) (ptr uintptr, err error) {
+	defer func() {
+		switch r := recover().(type) {
+		case nil:
+		case runtime.Error:
+			panic(r)
+		case error:
+			err = r
+		case string:
+			err = &InvalidCallInterfaceError{Field: "cif", Reason: strings.TrimPrefix(r, "ffi: "), Index: -1}
+		default:
+			panic(r)
+		}
+	}()
+	return NewCallback(fn), nil
+}
@@ -0,0 +1,158 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestPrepareClosureQsort(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol(qsort): %v", err)
+	}
+
+	var cmpCIF types.CallInterface
+	if err := PrepareCallInterface(&cmpCIF, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	cmp, err := PrepareClosure(&cmpCIF, func(args []unsafe.Pointer, ret unsafe.Pointer) {
+		a, b := *(**int32)(args[0]), *(**int32)(args[1])
+		*(*int32)(ret) = *a - *b
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer FreeCallback(cmp)
+
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	xs := []int32{5, -3, 9, 0, 2}
+	base := unsafe.Pointer(&xs[0])
+	n, size := uint64(len(xs)), uint64(4)
+	if err := CallFunction(&cif, qsort, nil,
+		[]unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp)}); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.IsSorted(xs) {
+		t.Errorf("%v is not sorted", xs)
+	}
+}
+
+func TestPrepareClosureStructArg(t *testing.T) {
+	pair := &types.TypeDescriptor{Kind: types.StructType, Size: 8, Alignment: 4,
+		Members: []*types.TypeDescriptor{types.SInt32TypeDescriptor, types.SInt32TypeDescriptor}}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt64TypeDescriptor,
+		[]*types.TypeDescriptor{pair, types.DoubleTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	ptr, err := PrepareClosure(&cif, func(args []unsafe.Pointer, ret unsafe.Pointer) {
+		p := (*[2]int32)(args[0])
+		*(*int64)(ret) = int64(p[0])*1000 + int64(p[1]) + int64(*(*float64)(args[1]))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer FreeCallback(ptr)
+
+	// {-4, 7} travels in one integer register, the double in the first
+	// floating-point one.
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = uintptr(0xfffffffc) | 7<<32
+	frame[0] = uintptr(math.Float64bits(0.5e6))
+	args := &callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)}
+	callbackWrap(args)
+	if got := int64(args.result); got != 496007 {
+		t.Errorf("result = %d, want 496007", got)
+	}
+}
+
+func TestPrepareClosureVariadic(t *testing.T) {
+	var cif types.CallInterface
+	if err := PrepareVariadicCallInterface(&cif, types.DefaultCall, 1, types.VoidTypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt32TypeDescriptor, types.FloatTypeDescriptor, types.BoolTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	var gotN int32
+	var gotF float32
+	var gotB bool
+	ptr, err := PrepareClosure(&cif, func(args []unsafe.Pointer, ret unsafe.Pointer) {
+		if ret != nil {
+			t.Error("ret is not nil for a void prototype")
+		}
+		gotN, gotF, gotB = *(*int32)(args[0]), *(*float32)(args[1]), *(*bool)(args[2])
+	})
+	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
+		if !errors.Is(err, &InvalidCallInterfaceError{}) {
+			t.Errorf("err = %v, want InvalidCallInterfaceError", err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer FreeCallback(ptr)
+
+	// The float arrives as a double and the bool as an int.
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = 3
+	frame[0] = uintptr(math.Float64bits(1.5))
+	frame[callbackIntRegIndex(1)] = 1
+	callbackWrap(&callbackArgs{index: callbackIndex(ptr), args: unsafe.Pointer(&frame)})
+	if gotN != 3 || gotF != 1.5 || !gotB {
+		t.Errorf("handler got %d, %v, %v; want 3, 1.5, true", gotN, gotF, gotB)
+	}
+}
+
+func TestPrepareClosureErrors(t *testing.T) {
+	handler := func([]unsafe.Pointer, unsafe.Pointer) {}
+	var icie *InvalidCallInterfaceError
+	if _, err := PrepareClosure(nil, handler); !errors.As(err, &icie) || icie.Field != "cif" {
+		t.Errorf("nil cif: err = %v", err)
+	}
+	if _, err := PrepareClosure(&types.CallInterface{}, handler); !errors.As(err, &icie) || icie.Reason != "not prepared" {
+		t.Errorf("unprepared cif: err = %v", err)
+	}
+
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.Int32x4TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := PrepareClosure(&cif, nil); !errors.As(err, &icie) || icie.Field != "handler" {
+		t.Errorf("nil handler: err = %v", err)
+	}
+	if _, err := PrepareClosure(&cif, handler); !errors.As(err, &icie) || icie.Field != "argTypes" || icie.Index != 1 {
+		t.Errorf("vector argument: err = %v", err)
+	}
+
+	packed := &types.TypeDescriptor{Kind: types.StructType, Size: 5, Alignment: 1, PackedAlignment: 1,
+		Members: []*types.TypeDescriptor{types.UInt8TypeDescriptor, types.UInt32TypeDescriptor}}
+	cif = types.CallInterface{}
+	if err := PrepareCallInterface(&cif, types.DefaultCall, packed, nil); err != nil {
+		t.Fatal(err)
+	}
+	var lerr *LayoutMismatchError
+	if _, err := PrepareClosure(&cif, handler); !errors.As(err, &lerr) {
+		t.Errorf("packed struct return: err = %v, want LayoutMismatchError", err)
+	}
+}
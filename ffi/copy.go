@@ -0,0 +1,203 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// CopyOut copies elements of type elem from the C array at src, which holds
+// count of them, into dst and returns the number copied: min(len(dst),
+// count), as with the built-in copy.
+//
+// It is the usual second half of an enumeration API:
+//
+//	var n uint32
+//	// wgpuAdapterEnumerateFeatures(adapter, NULL) -> n, then again with a buffer
+//	features := make([]Feature, n)
+//	ffi.CopyOut(features, buf, int(n), featureDesc)
+//
+// The C elements are elem.Size bytes apart, with struct descriptors laid out
+// as for PrepareCallInterface. T must have the same scalar members as elem,
+// in the same order and of the same sizes, but may place them at different
+// offsets: a Go struct and its C counterpart disagree on padding where the
+// ABIs align types differently (uint64_t on 32-bit ARM, for one). Blank Go
+// fields (_) are treated as padding, and a Go array stands for that many
+// consecutive members. Go pointers, strings, slices and other managed types
+// are rejected; use uintptr or unsafe.Pointer for C pointers.
+func CopyOut[T any](dst []T, src unsafe.Pointer, count int, elem *types.TypeDescriptor) (int, error) {
+	n := min(len(dst), count)
+	if n <= 0 {
+		return 0, nil
+	}
+	if src == nil {
+		return 0, &InvalidCallInterfaceError{Field: "src", Reason: "must not be nil", Index: -1}
+	}
+	plan, err := planCopy(reflect.TypeFor[T](), elem)
+	if err != nil {
+		return 0, err
+	}
+	goBase := unsafe.Pointer(unsafe.SliceData(dst))
+	plan.run(n, func(cOff, goOff, size uintptr) {
+		copy(unsafe.Slice((*byte)(unsafe.Add(goBase, goOff)), size), unsafe.Slice((*byte)(unsafe.Add(src, cOff)), size))
+	})
+	return n, nil
+}
+
+// CopyIn copies src into the C array at dst, laying each element out as
+// elem, and returns len(src). dst must have room for len(src) elements of
+// elem.Size bytes. The element rules are those of CopyOut; C padding bytes
+// are left as they were.
+func CopyIn[T any](dst unsafe.Pointer, src []T, elem *types.TypeDescriptor) (int, error) {
+	if len(src) == 0 {
+		return 0, nil
+	}
+	if dst == nil {
+		return 0, &InvalidCallInterfaceError{Field: "dst", Reason: "must not be nil", Index: -1}
+	}
+	plan, err := planCopy(reflect.TypeFor[T](), elem)
+	if err != nil {
+		return 0, err
+	}
+	goBase := unsafe.Pointer(unsafe.SliceData(src))
+	plan.run(len(src), func(cOff, goOff, size uintptr) {
+		copy(unsafe.Slice((*byte)(unsafe.Add(dst, cOff)), size), unsafe.Slice((*byte)(unsafe.Add(goBase, goOff)), size))
+	})
+	return len(src), nil
+}
+
+// copySegment is a run of bytes at the same relative position in a C
+// element and its Go counterpart.
+type copySegment struct {
+	cOff, goOff, size uintptr
+}
+
+// copyPlan describes how one element maps between C and Go memory.
+type copyPlan struct {
+	cStride, goStride uintptr
+	segments          []copySegment
+}
+
+// run calls move for every segment of n consecutive elements, with offsets
+// from the start of the arrays. Identical layouts are moved in one piece.
+func (p *copyPlan) run(n int, move func(cOff, goOff, size uintptr)) {
+	if len(p.segments) == 1 && p.cStride == p.goStride && p.segments[0].size == p.cStride {
+		move(0, 0, uintptr(n)*p.cStride)
+		return
+	}
+	for i := range uintptr(n) {
+		for _, s := range p.segments {
+			move(i*p.cStride+s.cOff, i*p.goStride+s.goOff, s.size)
+		}
+	}
+}
+
+// planCopy matches the scalar members of elem with those of goType.
+func planCopy(goType reflect.Type, elem *types.TypeDescriptor) (*copyPlan, error) {
+	if elem == nil {
+		return nil, &TypeValidationError{TypeName: goType.String(), Kind: 0, Reason: "element descriptor is nil", Index: -1}
+	}
+	if elem.Size == 0 && elem.Kind == types.StructType {
+		if err := initializeCompositeType(elem); err != nil {
+			return nil, err
+		}
+	}
+	if !isValidType(elem) || elem.Kind == types.VoidType {
+		return nil, newInvalidTypeError("element", int(elem.Kind), "unsupported type kind")
+	}
+
+	var cLeaves, goLeaves []copySegment
+	cLeaves = descriptorLeaves(elem, 0, cLeaves)
+	goLeaves, err := goLeavesOf(goType, 0, goLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatch := func(i int, reason string) error {
+		return &TypeValidationError{TypeName: goType.String(), Kind: int(elem.Kind), Reason: reason, Index: i}
+	}
+	if len(cLeaves) != len(goLeaves) {
+		return nil, mismatch(-1, fmt.Sprintf("%d scalar members, descriptor has %d", len(goLeaves), len(cLeaves)))
+	}
+
+	plan := &copyPlan{cStride: elem.Size, goStride: goType.Size()}
+	identical := plan.cStride == plan.goStride
+	for i, c := range cLeaves {
+		g := goLeaves[i]
+		if c.size != g.size {
+			return nil, mismatch(i, fmt.Sprintf("scalar member is %d bytes, descriptor has %d", g.size, c.size))
+		}
+		identical = identical && c.cOff == g.goOff
+		// Merge with the previous segment when both sides continue it.
+		if k := len(plan.segments) - 1; k >= 0 {
+			last := &plan.segments[k]
+			if last.cOff+last.size == c.cOff && last.goOff+last.size == g.goOff {
+				last.size += c.size
+				continue
+			}
+		}
+		plan.segments = append(plan.segments, copySegment{cOff: c.cOff, goOff: g.goOff, size: c.size})
+	}
+	// Padding that lines up on both sides is copied along, so identical
+	// layouts become a single move.
+	if identical {
+		plan.segments = []copySegment{{size: plan.cStride}}
+	}
+	return plan, nil
+}
+
+// descriptorLeaves appends the offsets and sizes of the scalar members of t,
+// placed at off, in declaration order.
+func descriptorLeaves(t *types.TypeDescriptor, off uintptr, leaves []copySegment) []copySegment {
+	if t.Kind != types.StructType {
+		return append(leaves, copySegment{cOff: off, size: t.Size})
+	}
+	var pos uintptr
+	for _, m := range t.Members {
+		pos = align(pos, t.MemberAlignment(m))
+		leaves = descriptorLeaves(m, off+pos, leaves)
+		pos += m.Size
+	}
+	return leaves
+}
+
+// goLeavesOf appends the offsets and sizes of the scalar fields of t, placed
+// at off, in declaration order. Blank fields are skipped.
+func goLeavesOf(t reflect.Type, off uintptr, leaves []copySegment) ([]copySegment, error) {
+	switch t.Kind() {
+	case reflect.Struct:
+		var err error
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if f.Name == "_" {
+				continue
+			}
+			if leaves, err = goLeavesOf(f.Type, off+f.Offset, leaves); err != nil {
+				return nil, err
+			}
+		}
+		return leaves, nil
+	case reflect.Array:
+		var err error
+		for i := range uintptr(t.Len()) {
+			if leaves, err = goLeavesOf(t.Elem(), off+i*t.Elem().Size(), leaves); err != nil {
+				return nil, err
+			}
+		}
+		return leaves, nil
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.UnsafePointer:
+		return append(leaves, copySegment{goOff: off, size: t.Size()}), nil
+	default:
+		return nil, &TypeValidationError{
+			TypeName: t.String(),
+			Kind:     0,
+			Reason:   "Go type holds managed memory; use uintptr or unsafe.Pointer for C pointers",
+			Index:    -1,
+		}
+	}
+}
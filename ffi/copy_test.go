@@ -0,0 +1,112 @@
+package ffi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCopyScalars(t *testing.T) {
+	c := []int32{1, 2, 3, 4}
+	dst := make([]int32, 3)
+	n, err := CopyOut(dst, unsafe.Pointer(&c[0]), len(c), types.SInt32TypeDescriptor)
+	if err != nil || n != 3 {
+		t.Fatalf("CopyOut = %d, %v, want 3", n, err)
+	}
+	if dst[0] != 1 || dst[2] != 3 {
+		t.Errorf("dst = %v", dst)
+	}
+
+	if n, err := CopyIn(unsafe.Pointer(&c[0]), []int32{9, 8}, types.SInt32TypeDescriptor); err != nil || n != 2 {
+		t.Fatalf("CopyIn = %d, %v, want 2", n, err)
+	}
+	if c[0] != 9 || c[1] != 8 || c[2] != 3 {
+		t.Errorf("c = %v", c)
+	}
+}
+
+func TestCopyStructs(t *testing.T) {
+	// struct { uint8_t tag; double value; uint16_t id; };
+	desc := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt8TypeDescriptor, types.DoubleTypeDescriptor, types.UInt16TypeDescriptor},
+	}
+	type item struct {
+		Tag   uint8
+		Value float64
+		ID    uint16
+	}
+	src := []item{{1, 1.5, 10}, {2, 2.5, 20}, {3, 3.5, 30}}
+	buf := make([]uint64, 3*3) // 24-byte C elements
+	if _, err := CopyIn(unsafe.Pointer(&buf[0]), src, desc); err != nil {
+		t.Fatal(err)
+	}
+	dst := make([]item, 3)
+	if n, err := CopyOut(dst, unsafe.Pointer(&buf[0]), 3, desc); err != nil || n != 3 {
+		t.Fatalf("CopyOut = %d, %v", n, err)
+	}
+	for i := range src {
+		if dst[i] != src[i] {
+			t.Errorf("dst[%d] = %+v, want %+v", i, dst[i], src[i])
+		}
+	}
+}
+
+func TestCopyDifferentLayout(t *testing.T) {
+	// A packed Go view of struct { uint32_t a; uint64_t b; }: the C side pads
+	// b to offset 8, the Go side holds it as two words at offset 4.
+	desc := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt32TypeDescriptor, types.UInt64TypeDescriptor},
+	}
+	type packed struct {
+		A uint32
+		B [2]uint32
+	}
+	if _, err := planCopy(reflect.TypeFor[packed](), desc); err == nil {
+		t.Fatal("expected a member mismatch")
+	}
+
+	// Blank fields and arrays: struct { uint8_t rgb[3]; uint8_t pad; float x; }
+	// with the pad member dropped on the Go side still needs offset mapping.
+	rgb := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt8TypeDescriptor, types.UInt8TypeDescriptor, types.UInt8TypeDescriptor, types.FloatTypeDescriptor},
+	}
+	type pixel struct {
+		RGB [3]uint8
+		_   uint8
+		X   float32
+	}
+	c := []byte{1, 2, 3, 0xee, 0, 0, 0x80, 0x3f}
+	dst := make([]pixel, 1)
+	if _, err := CopyOut(dst, unsafe.Pointer(&c[0]), 1, rgb); err != nil {
+		t.Fatal(err)
+	}
+	if dst[0].RGB != [3]uint8{1, 2, 3} || dst[0].X != 1 {
+		t.Errorf("dst = %+v", dst[0])
+	}
+}
+
+func TestCopyRejects(t *testing.T) {
+	var tve *TypeValidationError
+	buf := make([]uint64, 4)
+	if _, err := CopyOut(make([]string, 1), unsafe.Pointer(&buf[0]), 1, types.PointerTypeDescriptor); !errors.As(err, &tve) {
+		t.Errorf("string elements: err = %v, want *TypeValidationError", err)
+	}
+	if _, err := CopyOut(make([]int64, 1), unsafe.Pointer(&buf[0]), 1, types.SInt32TypeDescriptor); !errors.As(err, &tve) {
+		t.Errorf("size mismatch: err = %v, want *TypeValidationError", err)
+	}
+	if _, err := CopyOut(make([]int32, 1), unsafe.Pointer(&buf[0]), 1, nil); !errors.As(err, &tve) {
+		t.Errorf("nil descriptor: err = %v, want *TypeValidationError", err)
+	}
+	if _, err := CopyOut(make([]int32, 1), nil, 1, types.SInt32TypeDescriptor); err == nil {
+		t.Error("nil src: expected an error")
+	}
+	if n, err := CopyOut(make([]int32, 1), nil, 0, types.SInt32TypeDescriptor); n != 0 || err != nil {
+		t.Errorf("empty copy = %d, %v, want 0, nil", n, err)
+	}
+}
@@ -0,0 +1,49 @@
+package ffi
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// stringSize is the size of the Go string header a string return decodes into.
+const stringSize = unsafe.Sizeof("")
+
+// returnsString reports whether cif's return value is decoded into a Go
+// string (see types.CStringTypeDescriptor and types.WStringTypeDescriptor).
+func returnsString(cif *types.CallInterface) bool {
+	rt := cif.ReturnType
+	return rt != nil && rt.Kind == types.PointerType && (rt.CString || rt.WString)
+}
+
+// withStringReturn runs call with rvalue. If cif returns a decoded string,
+// call instead receives a temporary pointer slot and the string it points to
+// is copied into the Go string at rvalue.
+func withStringReturn(cif *types.CallInterface, rvalue unsafe.Pointer, call func(rvalue unsafe.Pointer) error) error {
+	if rvalue == nil || !returnsString(cif) {
+		return call(rvalue)
+	}
+	var p unsafe.Pointer
+	if err := call(unsafe.Pointer(&p)); err != nil {
+		return err
+	}
+	if cif.ReturnType.WString {
+		*(*string)(rvalue) = goWideStringN(p, cif.ReturnType.MaxLen)
+	} else {
+		*(*string)(rvalue) = goStringN(p, cif.ReturnType.MaxLen)
+	}
+	return nil
+}
+
+// goStringN copies the NUL-terminated string at p, stopping after maxLen
+// bytes if maxLen > 0. A nil p yields "".
+func goStringN(p unsafe.Pointer, maxLen int) string {
+	if p == nil {
+		return ""
+	}
+	n := 0
+	for (maxLen <= 0 || n < maxLen) && *(*byte)(unsafe.Add(p, n)) != 0 {
+		n++
+	}
+	return string(unsafe.Slice((*byte)(p), n))
+}
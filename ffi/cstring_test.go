@@ -0,0 +1,72 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCStringReturn(t *testing.T) {
+	requireStructLib(t)
+	sym, err := GetSymbol(structTestLib, "cstring_echo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := []byte("hello, world\x00")
+	tests := []struct {
+		name string
+		ret  *types.TypeDescriptor
+		arg  unsafe.Pointer
+		want string
+	}{
+		{"full", types.CStringTypeDescriptor, unsafe.Pointer(&src[0]), "hello, world"},
+		{"max", types.CStringType(5), unsafe.Pointer(&src[0]), "hello"},
+		{"max beyond NUL", types.CStringType(64), unsafe.Pointer(&src[0]), "hello, world"},
+		{"NULL", types.CStringTypeDescriptor, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cif types.CallInterface
+			if err := PrepareCallInterface(&cif, types.DefaultCall, tt.ret,
+				[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+				t.Fatal(err)
+			}
+			got := "unset"
+			arg := tt.arg
+			if err := CallFunctionInto(&cif, sym, ReturnBufferOf(&got), []unsafe.Pointer{unsafe.Pointer(&arg)}); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	runtime.KeepAlive(src)
+}
+
+func TestCStringReturnBufferTooSmall(t *testing.T) {
+	requireStructLib(t)
+	sym, err := GetSymbol(structTestLib, "cstring_echo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.CStringTypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	var p uintptr
+	var arg unsafe.Pointer
+	err = CallFunctionInto(&cif, sym, ReturnBufferOf(&p), []unsafe.Pointer{unsafe.Pointer(&arg)})
+	var icErr *InvalidCallInterfaceError
+	if !errors.As(err, &icErr) || icErr.Field != "rvalue" {
+		t.Fatalf("err = %v, want *InvalidCallInterfaceError for rvalue", err)
+	}
+}
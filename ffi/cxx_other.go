@@ -0,0 +1,9 @@
+//go:build !((linux || darwin || freebsd) && (amd64 || arm64))
+
+package ffi
+
+// CatchCxx runs fn and returns its error. On this platform goffi cannot
+// install a C++ terminate handler, so exceptions are not caught.
+func CatchCxx(fn func() error) error {
+	return fn()
+}
@@ -0,0 +1,207 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// cxxCatch is the state of the terminate handler that CatchCxx installs on
+// first use.
+var cxxCatch struct {
+	mu        sync.Mutex
+	installed bool
+	active    map[uint64]int // Goroutines inside CatchCxx, with nesting depth
+
+	setTerminate unsafe.Pointer // std::set_terminate
+	currentType  unsafe.Pointer // __cxa_current_exception_type
+	endCatch     unsafe.Pointer // __cxa_end_catch
+	demangle     unsafe.Pointer // __cxa_demangle
+	free         unsafe.Pointer // free
+	prev         uintptr        // Handler installed before ours
+
+	cifPtr      types.CallInterface // void *(void *)
+	cifVoid     types.CallInterface // void (void *)
+	cifNone     types.CallInterface // void (void)
+	cifRetPtr   types.CallInterface // void *(void)
+	cifDemangle types.CallInterface // char *(const char *, char *, size_t *, int *)
+}
+
+// cxxThrow is the panic value that carries an exception from the terminate
+// handler to the CatchCxx that recovers it.
+type cxxThrow struct {
+	err *CxxExceptionError
+}
+
+func (cxxThrow) nativeException() {}
+
+// cxxRuntimeLibrary returns the library that exports the C++ ABI runtime.
+func cxxRuntimeLibrary() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "/usr/lib/libc++abi.dylib"
+	case "freebsd":
+		return "libcxxrt.so.1"
+	default:
+		return "libstdc++.so.6"
+	}
+}
+
+// CatchCxx runs fn and returns its error, or a *CxxExceptionError if a C++
+// exception thrown by a native call fn makes on the calling goroutine
+// escapes into Go, instead of std::terminate aborting the process:
+//
+//	err := ffi.CatchCxx(func() error {
+//	    return ffi.CallFunction(cif, compileShader, &module, args)
+//	})
+//
+// The unwinder cannot pass through Go frames, so an exception that reaches
+// them is reported as unhandled and the C++ runtime calls the terminate
+// handler. CatchCxx installs one with std::set_terminate that, on a
+// goroutine inside CatchCxx, releases the exception, abandons the native
+// frames between fn and the throw and resumes fn's caller. Those frames are
+// not unwound: destructors in them do not run and locks they hold stay
+// held, so treat the object the call worked on as unusable. std::terminate
+// called for any other reason, or on a thread not inside CatchCxx, goes to
+// the previously installed handler as before.
+func CatchCxx(fn func() error) (err error) {
+	if err := installCxxHandler(); err != nil {
+		return err
+	}
+	gid := goroutineID()
+	cxxCatch.mu.Lock()
+	cxxCatch.active[gid]++
+	cxxCatch.mu.Unlock()
+	defer func() {
+		cxxCatch.mu.Lock()
+		if cxxCatch.active[gid]--; cxxCatch.active[gid] == 0 {
+			delete(cxxCatch.active, gid)
+		}
+		cxxCatch.mu.Unlock()
+		if r := recover(); r != nil {
+			t, ok := r.(cxxThrow)
+			if !ok {
+				panic(r)
+			}
+			err = t.err
+		}
+	}()
+	return fn()
+}
+
+// installCxxHandler installs the terminate handler unless it is in place.
+// Shutdown puts the previous handler back.
+func installCxxHandler() error {
+	cxxCatch.mu.Lock()
+	defer cxxCatch.mu.Unlock()
+	if cxxCatch.installed {
+		return nil
+	}
+
+	lib, err := LoadLibrary(cxxRuntimeLibrary())
+	if err != nil {
+		return err
+	}
+	syms := map[string]*unsafe.Pointer{
+		"_ZSt13set_terminatePFvvE":     &cxxCatch.setTerminate,
+		"__cxa_current_exception_type": &cxxCatch.currentType,
+		"__cxa_end_catch":              &cxxCatch.endCatch,
+		"__cxa_demangle":               &cxxCatch.demangle,
+		"free":                         &cxxCatch.free,
+	}
+	for name, dst := range syms {
+		if *dst, err = GetSymbol(lib, name); err != nil {
+			return err
+		}
+	}
+	ptr := types.PointerTypeDescriptor
+	cifs := []struct {
+		cif  *types.CallInterface
+		ret  *types.TypeDescriptor
+		args []*types.TypeDescriptor
+	}{
+		{&cxxCatch.cifPtr, ptr, []*types.TypeDescriptor{ptr}},
+		{&cxxCatch.cifVoid, types.VoidTypeDescriptor, []*types.TypeDescriptor{ptr}},
+		{&cxxCatch.cifNone, types.VoidTypeDescriptor, nil},
+		{&cxxCatch.cifRetPtr, ptr, nil},
+		{&cxxCatch.cifDemangle, ptr, []*types.TypeDescriptor{ptr, ptr, ptr, ptr}},
+	}
+	for _, c := range cifs {
+		if err := PrepareCallInterface(c.cif, types.DefaultCall, c.ret, c.args); err != nil {
+			return err
+		}
+	}
+
+	handler := NewCallback(cxxTerminateHandler)
+	if err := CallFunction(&cxxCatch.cifPtr, cxxCatch.setTerminate, unsafe.Pointer(&cxxCatch.prev), []unsafe.Pointer{unsafe.Pointer(&handler)}); err != nil {
+		return err
+	}
+	cxxCatch.active = make(map[uint64]int)
+	cxxCatch.installed = true
+	OnShutdown(func() error {
+		cxxCatch.mu.Lock()
+		defer cxxCatch.mu.Unlock()
+		var ignored uintptr
+		cxxCatch.installed = false
+		return CallFunction(&cxxCatch.cifPtr, cxxCatch.setTerminate, unsafe.Pointer(&ignored), []unsafe.Pointer{unsafe.Pointer(&cxxCatch.prev)})
+	})
+	return nil
+}
+
+// cxxTerminateHandler is the terminate handler. When an exception escapes,
+// it runs on the thread that threw, with the exception marked as caught.
+func cxxTerminateHandler() {
+	gid := goroutineID()
+	cxxCatch.mu.Lock()
+	caught, prev := cxxCatch.active[gid] > 0, cxxCatch.prev
+	cxxCatch.mu.Unlock()
+
+	var typeInfo uintptr
+	if caught {
+		_ = CallFunction(&cxxCatch.cifRetPtr, cxxCatch.currentType, unsafe.Pointer(&typeInfo), nil)
+	}
+	if typeInfo != 0 {
+		name := cxxTypeName(typeInfo)
+		// Balance the __cxa_begin_catch the runtime did before terminating,
+		// which also destroys the exception object.
+		_ = CallFunction(&cxxCatch.cifNone, cxxCatch.endCatch, nil, nil)
+		panic(cxxThrow{&CxxExceptionError{Type: name}})
+	}
+	if prev != 0 {
+		_ = CallFunction(&cxxCatch.cifNone, foreignPointer(prev), nil, nil)
+	}
+}
+
+// cxxTypeName returns the demangled name of a std::type_info, or its
+// mangled name if it cannot be demangled.
+func cxxTypeName(typeInfo uintptr) string {
+	// std::type_info is a vtable pointer followed by the mangled name.
+	namePtr := *(*uintptr)(foreignPointer(typeInfo + unsafe.Sizeof(uintptr(0))))
+	if namePtr == 0 {
+		return ""
+	}
+	// libstdc++ marks types with internal linkage with a leading '*'.
+	mangled := goStringN(foreignPointer(namePtr), 0)
+	if strings.HasPrefix(mangled, "*") {
+		mangled = mangled[1:]
+		namePtr++
+	}
+
+	var buf, length, demangled uintptr
+	var status int32
+	statusPtr := unsafe.Pointer(&status)
+	err := CallFunction(&cxxCatch.cifDemangle, cxxCatch.demangle, unsafe.Pointer(&demangled), []unsafe.Pointer{
+		unsafe.Pointer(&namePtr), unsafe.Pointer(&buf), unsafe.Pointer(&length), unsafe.Pointer(&statusPtr),
+	})
+	if err != nil || status != 0 || demangled == 0 {
+		return mangled
+	}
+	name := goStringN(foreignPointer(demangled), 0)
+	_ = CallFunction(&cxxCatch.cifVoid, cxxCatch.free, nil, []unsafe.Pointer{unsafe.Pointer(&demangled)})
+	return name
+}
@@ -0,0 +1,82 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCatchCxx(t *testing.T) {
+	lib, err := LoadLibrary(cxxRuntimeLibrary())
+	if err != nil {
+		t.Skipf("C++ runtime not available: %v", err)
+	}
+	allocate, err := GetSymbol(lib, "__cxa_allocate_exception")
+	if err != nil {
+		t.Fatal(err)
+	}
+	throw, err := GetSymbol(lib, "__cxa_throw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	intTypeInfo, err := GetSymbol(lib, "_ZTIi") // typeid(int)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ptr := types.PointerTypeDescriptor
+	allocCIF := &types.CallInterface{}
+	if err := PrepareCallInterface(allocCIF, types.DefaultCall, ptr, []*types.TypeDescriptor{types.UInt64TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	throwCIF := &types.CallInterface{}
+	if err := PrepareCallInterface(throwCIF, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{ptr, ptr, ptr}); err != nil {
+		t.Fatal(err)
+	}
+
+	// throw 42;
+	throwInt := func(opts ...CallOption) error {
+		size := uint64(4)
+		var obj unsafe.Pointer
+		if err := CallFunction(allocCIF, allocate, unsafe.Pointer(&obj), []unsafe.Pointer{unsafe.Pointer(&size)}); err != nil {
+			return err
+		}
+		*(*int32)(obj) = 42
+		var dtor unsafe.Pointer
+		return CallFunctionWithOptions(context.Background(), throwCIF, throw, nil,
+			[]unsafe.Pointer{unsafe.Pointer(&obj), unsafe.Pointer(&intTypeInfo), unsafe.Pointer(&dtor)}, opts...)
+	}
+
+	for i := 0; i < 2; i++ {
+		err := throwInt(WithCxxExceptions())
+		var exc *CxxExceptionError
+		if !errors.As(err, &exc) || exc.Type != "int" {
+			t.Fatalf("throw 42: err = %v, want a CxxExceptionError of type int", err)
+		}
+	}
+
+	// The exception reaches CatchCxx through the terminate handler, a
+	// callback, so a panic policy must let it pass.
+	for _, mode := range []CallbackPanicMode{CallbackPanicRecover, CallbackPanicLog} {
+		prev := SetCallbackPanicPolicy(&CallbackPanicPolicy{
+			Mode:   mode,
+			Report: func(p *CallbackPanic) { t.Errorf("policy saw the exception: %v", p) },
+		})
+		err := throwInt(WithCxxExceptions())
+		SetCallbackPanicPolicy(prev)
+		var exc *CxxExceptionError
+		if !errors.As(err, &exc) || exc.Type != "int" {
+			t.Fatalf("throw 42 with policy %d: err = %v, want a CxxExceptionError of type int", mode, err)
+		}
+	}
+
+	errGo := errors.New("go error")
+	if err := CatchCxx(func() error { return errGo }); err != errGo {
+		t.Errorf("CatchCxx passed through %v, want %v", err, errGo)
+	}
+}
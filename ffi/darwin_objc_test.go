@@ -3,6 +3,8 @@
 package ffi
 
 import (
+	"context"
+	"errors"
 	"math"
 	"os"
 	"runtime"
@@ -752,3 +754,36 @@ func TestDarwinCAMetalLayerProperties(t *testing.T) {
 		objcCallVoid(t, rt, layer, selSetDisplaySyncEnabled, objcArgBool(true))
 	})
 }
+
+func TestDarwinObjCExceptions(t *testing.T) {
+	rt := loadObjcRuntime(t)
+
+	withAutoreleasePool(t, rt, func() {
+		array := objcCallPtr(t, rt, rt.getClass(t, "NSArray"), rt.sel(t, "array"))
+		selObjectAtIndex := rt.sel(t, "objectAtIndex:")
+
+		cif := &types.CallInterface{}
+		argTypes := []*types.TypeDescriptor{types.PointerTypeDescriptor, types.PointerTypeDescriptor, types.UInt64TypeDescriptor}
+		if err := PrepareCallInterface(cif, types.DefaultCall, types.PointerTypeDescriptor, argTypes); err != nil {
+			t.Fatal(err)
+		}
+		index := uint64(5)
+		var obj uintptr
+		avalue := []unsafe.Pointer{unsafe.Pointer(&array), unsafe.Pointer(&selObjectAtIndex), unsafe.Pointer(&index)}
+
+		err := CallFunctionWithOptions(context.Background(), cif, rt.objcMsgSend, unsafe.Pointer(&obj), avalue, WithObjCExceptions())
+		var exc *ObjCExceptionError
+		if !errors.As(err, &exc) || exc.Name != "NSRangeException" || exc.Reason == "" {
+			t.Fatalf("objectAtIndex:5 of an empty array: err = %v, want an NSRangeException", err)
+		}
+
+		// The goroutine can keep calling into Objective-C afterwards.
+		if n := objcCallUInt64(t, rt, array, rt.sel(t, "count")); n != 0 {
+			t.Errorf("count = %d, want 0", n)
+		}
+		errGo := errors.New("go error")
+		if err := CatchObjC(func() error { return errGo }); err != errGo {
+			t.Errorf("CatchObjC passed through %v, want %v", err, errGo)
+		}
+	})
+}
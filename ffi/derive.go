@@ -0,0 +1,99 @@
+package ffi
+
+import (
+	"slices"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// WithReturnType derives a call interface from base, a prepared one, with
+// the return type replaced. The arguments of base are not validated or
+// classified again; only returnType is. base is left unchanged.
+//
+// Together with WithArgs this builds families of related interfaces from a
+// single validated base, such as the objc_msgSend variants of one selector
+// signature:
+//
+//	var base types.CallInterface
+//	err := ffi.PrepareCallInterface(&base, types.DefaultCall,
+//		types.PointerTypeDescriptor,
+//		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.PointerTypeDescriptor})
+//	sendDouble, err := ffi.WithReturnType(&base, types.DoubleTypeDescriptor)
+func WithReturnType(base *types.CallInterface, returnType *types.TypeDescriptor) (*types.CallInterface, error) {
+	if err := checkDeriveBase(base); err != nil {
+		return nil, err
+	}
+	if returnType == nil {
+		return nil, &InvalidCallInterfaceError{
+			Field:  "returnType",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	if err := prepareReturnType(returnType); err != nil {
+		return nil, err
+	}
+	backend, err := backendFor(base.Convention, base.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	cif := base.Clone()
+	cif.ReturnType = returnType
+	cif.Flags = backend.Classifier.ClassifyReturn(returnType, cif.Convention)
+	return cif, nil
+}
+
+// WithArgs derives a call interface from base, a prepared one, with the
+// argument types replaced. The return classification of base is reused;
+// only argTypes are validated and classified. A variadic base keeps its
+// FixedArgCount, so argTypes must still start with the fixed arguments.
+// base is left unchanged.
+func WithArgs(base *types.CallInterface, argTypes ...*types.TypeDescriptor) (*types.CallInterface, error) {
+	if err := checkDeriveBase(base); err != nil {
+		return nil, err
+	}
+	if base.FixedArgCount > len(argTypes) {
+		return nil, &InvalidCallInterfaceError{
+			Field:  "argTypes",
+			Reason: "fewer arguments than the fixed arguments of the variadic base",
+			Index:  -1,
+		}
+	}
+	stackBytes, err := argumentStackBytes(argTypes)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := backendFor(base.Convention, base.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	cif := base.Clone()
+	cif.ArgCount = len(argTypes)
+	cif.ArgTypes = slices.Clone(argTypes)
+	cif.StackBytes = stackBytes
+	if err := checkArgumentLimits(cif, backend.Classifier); err != nil {
+		return nil, err
+	}
+	return cif, nil
+}
+
+// checkDeriveBase rejects a base that was never prepared.
+func checkDeriveBase(base *types.CallInterface) error {
+	if base == nil {
+		return &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	if base.ReturnType == nil {
+		return &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "not prepared with PrepareCallInterface",
+			Index:  -1,
+		}
+	}
+	return nil
+}
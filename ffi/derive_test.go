@@ -0,0 +1,109 @@
+package ffi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestWithReturnTypeMatchesPrepare(t *testing.T) {
+	args := []*types.TypeDescriptor{types.PointerTypeDescriptor, types.PointerTypeDescriptor}
+	var base types.CallInterface
+	if err := PrepareCallInterface(&base, types.DefaultCall, types.PointerTypeDescriptor, args); err != nil {
+		t.Fatalf("PrepareCallInterface: %v", err)
+	}
+	baseFlags := base.Flags
+
+	for _, rt := range []*types.TypeDescriptor{
+		types.DoubleTypeDescriptor,
+		types.VoidTypeDescriptor,
+		{Kind: types.StructType, Members: []*types.TypeDescriptor{
+			types.DoubleTypeDescriptor, types.DoubleTypeDescriptor, types.DoubleTypeDescriptor, types.DoubleTypeDescriptor,
+		}},
+	} {
+		derived, err := WithReturnType(&base, rt)
+		if err != nil {
+			t.Fatalf("WithReturnType(kind %d): %v", rt.Kind, err)
+		}
+		var want types.CallInterface
+		if err := PrepareCallInterface(&want, types.DefaultCall, rt, args); err != nil {
+			t.Fatalf("PrepareCallInterface(kind %d): %v", rt.Kind, err)
+		}
+		if derived.Flags != want.Flags || derived.ReturnType != rt || derived.StackBytes != want.StackBytes {
+			t.Errorf("kind %d: derived Flags=%#x StackBytes=%d, want Flags=%#x StackBytes=%d",
+				rt.Kind, derived.Flags, derived.StackBytes, want.Flags, want.StackBytes)
+		}
+	}
+
+	if base.ReturnType != types.PointerTypeDescriptor || base.Flags != baseFlags {
+		t.Error("WithReturnType modified the base call interface")
+	}
+}
+
+func TestWithArgsMatchesPrepare(t *testing.T) {
+	var base types.CallInterface
+	if err := PrepareVariadicCallInterface(&base, types.DefaultCall, 1, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatalf("PrepareVariadicCallInterface: %v", err)
+	}
+
+	args := []*types.TypeDescriptor{types.PointerTypeDescriptor, types.DoubleTypeDescriptor, types.SInt64TypeDescriptor}
+	derived, err := WithArgs(&base, args...)
+	if err != nil {
+		t.Fatalf("WithArgs: %v", err)
+	}
+	var want types.CallInterface
+	if err := PrepareVariadicCallInterface(&want, types.DefaultCall, 1, types.SInt32TypeDescriptor, args); err != nil {
+		t.Fatalf("PrepareVariadicCallInterface: %v", err)
+	}
+	if derived.ArgCount != 3 || derived.StackBytes != want.StackBytes || derived.Flags != want.Flags || derived.FixedArgCount != 1 {
+		t.Errorf("derived = %+v, want %+v", derived, want)
+	}
+
+	args[1] = types.FloatTypeDescriptor
+	if derived.ArgTypes[1] != types.DoubleTypeDescriptor {
+		t.Error("derived ArgTypes aliases the caller's slice")
+	}
+	if base.ArgCount != 1 || len(base.ArgTypes) != 1 {
+		t.Error("WithArgs modified the base call interface")
+	}
+}
+
+func TestDeriveErrors(t *testing.T) {
+	var invalid *InvalidCallInterfaceError
+
+	if _, err := WithReturnType(nil, types.VoidTypeDescriptor); !errors.As(err, &invalid) || invalid.Field != "cif" {
+		t.Errorf("WithReturnType(nil base) = %v, want InvalidCallInterfaceError on cif", err)
+	}
+	var unprepared types.CallInterface
+	if _, err := WithArgs(&unprepared); !errors.As(err, &invalid) || invalid.Field != "cif" {
+		t.Errorf("WithArgs(unprepared) = %v, want InvalidCallInterfaceError on cif", err)
+	}
+
+	var base types.CallInterface
+	if err := PrepareVariadicCallInterface(&base, types.DefaultCall, 2, types.VoidTypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+		t.Fatalf("PrepareVariadicCallInterface: %v", err)
+	}
+	if _, err := WithReturnType(&base, nil); !errors.As(err, &invalid) || invalid.Field != "returnType" {
+		t.Errorf("WithReturnType(nil type) = %v, want InvalidCallInterfaceError on returnType", err)
+	}
+	if _, err := WithArgs(&base, types.PointerTypeDescriptor); !errors.As(err, &invalid) || invalid.Field != "argTypes" {
+		t.Errorf("WithArgs(fewer than fixed) = %v, want InvalidCallInterfaceError on argTypes", err)
+	}
+
+	bad := &types.TypeDescriptor{Size: 4, Alignment: 4, Kind: types.TypeKind(99)}
+	var typeErr *TypeValidationError
+	if _, err := WithArgs(&base, types.PointerTypeDescriptor, types.SInt32TypeDescriptor, bad); !errors.As(err, &typeErr) || typeErr.Index != 2 {
+		t.Errorf("WithArgs(invalid kind) = %v, want TypeValidationError at index 2", err)
+	}
+
+	many := make([]*types.TypeDescriptor, 40)
+	for i := range many {
+		many[i] = types.SInt64TypeDescriptor
+	}
+	if _, err := WithArgs(&base, many...); !errors.Is(err, ErrTooManyArguments) {
+		t.Errorf("WithArgs(40 args) = %v, want ErrTooManyArguments", err)
+	}
+}
@@ -0,0 +1,266 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// DescribeStruct derives the type descriptor of the C struct that the Go
+// struct type t mirrors, laid out as for PrepareCallInterface. The result
+// works with Marshal and Unmarshal for values of t:
+//
+//	type surfaceConfig struct {
+//	    Format  int      `ffi:"int32"`   // C enum
+//	    Label   string                   // const char *
+//	    Formats []uint32 `ffi:"count=uint32"`
+//	    Present func()                   // callback pointer
+//	    Cache   map[string]int `ffi:"-"`
+//	}
+//	desc, err := ffi.DescribeStruct(reflect.TypeFor[surfaceConfig]())
+//
+// Without tags, fields map as follows: bool to C bool; sized integers and
+// floats to the same C types; int, uint and uintptr to the pointer-sized
+// integers; unsafe.Pointer, strings, funcs and pointers to pointers (typed
+// with types.PointerTo where the Go type says what they point to);
+// types.Int128 and types.UInt128 to the 128-bit integers; structs to nested
+// structs; arrays to consecutive members; and slices to a size_t count
+// followed by a pointer. Blank fields are skipped.
+//
+// The ffi tag holds comma-separated options:
+//
+//   - "-" skips the field
+//   - a C type overrides the default: bool, int8, uint8, int16, uint16,
+//     int32, uint32, int64, uint64, int (C int), float, double, float16 for Go bool,
+//     integer and float fields; longdouble for Go float fields; pointer,
+//     cstring, wstring for pointer-like fields
+//   - "count=TYPE" sets the integer type of a slice's count member
+//   - "padding=N" inserts N bytes (uint8_t members) before the field
+//
+// Unexported fields must be tagged "-".
+func DescribeStruct(t reflect.Type) (*types.TypeDescriptor, error) {
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, &TypeValidationError{TypeName: fmt.Sprint(t), Kind: int(types.StructType), Reason: "not a struct type", Index: -1}
+	}
+	d := &describer{structs: make(map[reflect.Type]*types.TypeDescriptor)}
+	desc, err := d.structType(t)
+	if err != nil {
+		return nil, err
+	}
+	if err := initializeCompositeType(desc); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// ffiTag is a parsed `ffi:"..."` struct tag.
+type ffiTag struct {
+	skip    bool
+	ctype   string
+	count   string
+	padding int
+}
+
+// parseFFITag parses the ffi tag of f.
+func parseFFITag(f reflect.StructField) (ffiTag, error) {
+	var tag ffiTag
+	s, ok := f.Tag.Lookup("ffi")
+	if !ok || s == "" {
+		return tag, nil
+	}
+	for opt := range strings.SplitSeq(s, ",") {
+		opt = strings.TrimSpace(opt)
+		switch {
+		case opt == "-":
+			tag.skip = true
+		case strings.HasPrefix(opt, "count="):
+			tag.count = strings.TrimPrefix(opt, "count=")
+		case strings.HasPrefix(opt, "padding="):
+			n, err := strconv.Atoi(strings.TrimPrefix(opt, "padding="))
+			if err != nil || n < 0 {
+				return tag, fmt.Errorf("field %s: invalid ffi tag option %q", f.Name, opt)
+			}
+			tag.padding = n
+		default:
+			if _, ok := taggedTypes[opt]; !ok {
+				return tag, fmt.Errorf("field %s: unknown ffi tag option %q", f.Name, opt)
+			}
+			tag.ctype = opt
+		}
+	}
+	return tag, nil
+}
+
+// taggedTypes are the C types an ffi tag can name.
+var taggedTypes = map[string]*types.TypeDescriptor{
+	"bool":       types.BoolTypeDescriptor,
+	"int8":       types.SInt8TypeDescriptor,
+	"uint8":      types.UInt8TypeDescriptor,
+	"int16":      types.SInt16TypeDescriptor,
+	"uint16":     types.UInt16TypeDescriptor,
+	"int32":      types.SInt32TypeDescriptor,
+	"uint32":     types.UInt32TypeDescriptor,
+	"int64":      types.SInt64TypeDescriptor,
+	"uint64":     types.UInt64TypeDescriptor,
+	"int":        types.IntTypeDescriptor,
+	"float":      types.FloatTypeDescriptor,
+	"double":     types.DoubleTypeDescriptor,
+	"float16":    types.Float16TypeDescriptor,
+	"longdouble": types.LongDoubleTypeDescriptor,
+	"pointer":    types.PointerTypeDescriptor,
+	"cstring":    types.CStringTypeDescriptor,
+	"wstring":    types.WStringTypeDescriptor,
+}
+
+// describer builds descriptors, sharing one per struct type so that
+// self-referential types (linked lists) terminate.
+type describer struct {
+	structs map[reflect.Type]*types.TypeDescriptor
+}
+
+func (d *describer) structType(t reflect.Type) (*types.TypeDescriptor, error) {
+	if desc, ok := d.structs[t]; ok {
+		return desc, nil
+	}
+	desc := &types.TypeDescriptor{Kind: types.StructType}
+	d.structs[t] = desc
+	fail := func(reason string, index int) error {
+		return &TypeValidationError{TypeName: t.String(), Kind: int(types.StructType), Reason: reason, Index: index}
+	}
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Name == "_" {
+			continue
+		}
+		tag, err := parseFFITag(f)
+		if err != nil {
+			return nil, fail(err.Error(), i)
+		}
+		if tag.skip {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fail(fmt.Sprintf("field %s is unexported; tag it `ffi:\"-\"` to skip it", f.Name), i)
+		}
+		for range tag.padding {
+			desc.Members = append(desc.Members, types.UInt8TypeDescriptor)
+		}
+		members, err := d.field(f.Type, tag)
+		if err != nil {
+			return nil, fail(fmt.Sprintf("field %s: %v", f.Name, err), i)
+		}
+		desc.Members = append(desc.Members, members...)
+	}
+	if len(desc.Members) == 0 {
+		return nil, fail("no fields to describe", -1)
+	}
+	return desc, nil
+}
+
+// field returns the members a field of type t contributes.
+func (d *describer) field(t reflect.Type, tag ffiTag) ([]*types.TypeDescriptor, error) {
+	switch {
+	case t == reflect.TypeFor[types.LongDouble]():
+		if tag.ctype != "" {
+			return nil, fmt.Errorf("type option %q does not apply to %s", tag.ctype, t)
+		}
+		return []*types.TypeDescriptor{types.LongDoubleTypeDescriptor}, nil
+	case t.Kind() == reflect.Array:
+		elem, err := d.field(t.Elem(), tag)
+		if err != nil {
+			return nil, err
+		}
+		members := make([]*types.TypeDescriptor, 0, t.Len()*len(elem))
+		for range t.Len() {
+			members = append(members, elem...)
+		}
+		return members, nil
+	case t.Kind() == reflect.Slice:
+		count := types.SizeTTypeDescriptor
+		if tag.count != "" {
+			count = taggedTypes[tag.count]
+			if count == nil || !isIntegerKind(count.Kind) {
+				return nil, fmt.Errorf("count type %q is not an integer", tag.count)
+			}
+		}
+		elem, err := d.pointee(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return []*types.TypeDescriptor{count, types.PointerTo(elem)}, nil
+	}
+	if tag.count != "" {
+		return nil, fmt.Errorf("count= applies to slices only")
+	}
+	m, err := d.member(t, tag.ctype)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.TypeDescriptor{m}, nil
+}
+
+// member returns the descriptor of a single member of Go type t, with the
+// C type ctype if it is not empty.
+func (d *describer) member(t reflect.Type, ctype string) (*types.TypeDescriptor, error) {
+	pointerLike := false
+	switch t.Kind() {
+	case reflect.String, reflect.Func, reflect.Pointer, reflect.UnsafePointer:
+		pointerLike = true
+	case reflect.Uintptr:
+		pointerLike = ctype == "pointer" || ctype == "cstring" || ctype == "wstring"
+	case reflect.Struct:
+		if ctype != "" {
+			return nil, fmt.Errorf("type option %q does not apply to a struct", ctype)
+		}
+		if m := scalarTypeFor(t); m != nil {
+			return m, nil
+		}
+		return d.structType(t)
+	}
+
+	if ctype != "" {
+		override := taggedTypes[ctype]
+		if (override.Kind == types.PointerType) != pointerLike {
+			return nil, fmt.Errorf("type option %q does not apply to %s", ctype, t)
+		}
+		if !pointerLike {
+			isFloat := t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+			floatKind := override.Kind == types.FloatType || override.Kind == types.DoubleType ||
+				override.Kind == types.LongDoubleType
+			if isFloat && !floatKind && override.Kind != types.Float16Type || !isFloat && floatKind {
+				return nil, fmt.Errorf("type option %q does not apply to %s", ctype, t)
+			}
+		}
+		return override, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return types.PointerTypeDescriptor, nil
+	case reflect.Pointer:
+		elem, err := d.pointee(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.PointerTo(elem), nil
+	case reflect.Func, reflect.UnsafePointer:
+		return types.PointerTypeDescriptor, nil
+	}
+	if m := scalarTypeFor(t); m != nil {
+		return m, nil
+	}
+	return nil, fmt.Errorf("unsupported Go type %s", t)
+}
+
+// pointee returns the element descriptor of a pointer or slice to t.
+func (d *describer) pointee(t reflect.Type) (*types.TypeDescriptor, error) {
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice:
+		return nil, fmt.Errorf("pointers to %s are not supported", t)
+	}
+	return d.member(t, "")
+}
@@ -0,0 +1,121 @@
+package ffi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestDescribeStruct(t *testing.T) {
+	type config struct {
+		Format  int `ffi:"int32"`
+		Enabled bool
+		Level   uint8   `ffi:"padding=3"`
+		Scale   float64 `ffi:"float"`
+		Label   string
+		Wide    string   `ffi:"wstring"`
+		Formats []uint32 `ffi:"count=uint32"`
+		Limits  limits
+		Best    *limits
+		Present func()
+		RGB     [3]uint8
+		Cache   map[string]int `ffi:"-"`
+		_       int
+	}
+	desc, err := DescribeStruct(reflect.TypeFor[config]())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []types.TypeKind{
+		types.SInt32Type, types.BoolType,
+		types.UInt8Type, types.UInt8Type, types.UInt8Type, types.UInt8Type, // padding, Level
+		types.FloatType, types.PointerType, types.PointerType,
+		types.UInt32Type, types.PointerType,
+		types.StructType, types.PointerType, types.PointerType,
+		types.UInt8Type, types.UInt8Type, types.UInt8Type,
+	}
+	if len(desc.Members) != len(want) {
+		t.Fatalf("%d members, want %d", len(desc.Members), len(want))
+	}
+	for i, k := range want {
+		if desc.Members[i].Kind != k {
+			t.Errorf("member %d kind = %d, want %d", i, desc.Members[i].Kind, k)
+		}
+	}
+	if !desc.Members[8].WString {
+		t.Error("Wide is not a wchar_t pointer")
+	}
+	if e := desc.Members[12].Elem; e == nil || e.Kind != types.StructType || len(e.Members) != 2 {
+		t.Errorf("Best element = %+v, want struct limits", e)
+	}
+	if desc.Size == 0 {
+		t.Error("descriptor is not laid out")
+	}
+
+	in := config{Format: 3, Enabled: true, Level: 9, Scale: 1.5, Label: "x", Wide: "w",
+		Formats: []uint32{4, 5}, Limits: limits{1, 2}, Best: &limits{3, 4}, RGB: [3]uint8{7, 8, 9}}
+	arena := NewArena()
+	defer arena.Free()
+	buf := arena.Alloc(desc.Size, desc.Alignment)
+	if err := Marshal(buf, desc, in, arena); err != nil {
+		t.Fatal(err)
+	}
+	var out config
+	if err := Unmarshal(buf, desc, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Format != 3 || !out.Enabled || out.Level != 9 || out.Scale != 1.5 || out.Label != "x" ||
+		out.Wide != "w" || len(out.Formats) != 2 || out.Limits != in.Limits || *out.Best != *in.Best || out.RGB != in.RGB {
+		t.Errorf("round trip = %+v", out)
+	}
+	if got := *(*uint8)(unsafe.Add(buf, 5)); got != 0 {
+		t.Errorf("padding byte = %d, want untouched", got)
+	}
+}
+
+func TestDescribeStructRecursive(t *testing.T) {
+	type node struct {
+		Value int32
+		Next  *node
+	}
+	desc, err := DescribeStruct(reflect.TypeFor[node]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Members[1].Elem != desc {
+		t.Error("Next does not point back to the node descriptor")
+	}
+}
+
+func TestDescribeStructErrors(t *testing.T) {
+	var tve *TypeValidationError
+	for name, typ := range map[string]reflect.Type{
+		"not a struct": reflect.TypeFor[int](),
+		"empty":        reflect.TypeFor[struct{}](),
+		"unexported":   reflect.TypeFor[struct{ x int }](),
+		"map":          reflect.TypeFor[struct{ M map[int]int }](),
+		"unknown option": reflect.TypeFor[struct {
+			X int `ffi:"int128"`
+		}](),
+		"float as int": reflect.TypeFor[struct {
+			X float32 `ffi:"int32"`
+		}](),
+		"string as int": reflect.TypeFor[struct {
+			X string `ffi:"int32"`
+		}](),
+		"count on int": reflect.TypeFor[struct {
+			X int `ffi:"count=uint32"`
+		}](),
+		"bad padding": reflect.TypeFor[struct {
+			X int `ffi:"padding=-1"`
+		}](),
+	} {
+		if _, err := DescribeStruct(typ); !errors.As(err, &tve) {
+			t.Errorf("%s: err = %v, want *TypeValidationError", name, err)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package ffi
 
 import (
 	"fmt"
+	"time"
 	"unsafe"
 
 	"github.com/go-webgpu/goffi/internal/dl"
@@ -30,15 +31,29 @@ const (
 
 	// RTLD_GLOBAL makes symbols available for subsequently loaded libraries.
 	RTLD_GLOBAL = dl.RTLD_GLOBAL
+
+	// RTLD_LAZY resolves function symbols on first call instead of at load.
+	RTLD_LAZY = dl.RTLD_LAZY
+
+	// RTLD_LOCAL keeps the library's symbols from resolving references in
+	// libraries loaded later; it is the dlopen default when RTLD_GLOBAL is
+	// not given.
+	RTLD_LOCAL = dl.RTLD_LOCAL
+
+	// RTLD_NODELETE keeps the library loaded after FreeLibrary.
+	RTLD_NODELETE = dl.RTLD_NODELETE
 )
 
 // LoadLibrary loads a shared library using dlopen.
 //
 // This function loads the specified shared library and returns a handle for use
-// with GetSymbol. The library is loaded with RTLD_NOW|RTLD_GLOBAL flags.
+// with GetSymbol. The library is loaded with RTLD_NOW|RTLD_GLOBAL flags; use
+// LoadLibraryWithOptions and WithDlopenFlags for others.
 //
 // Parameters:
 //   - name: Path to the shared library (e.g., "libm.dylib", "/usr/lib/libSystem.B.dylib")
+//     or a bare name such as "wgpu", which ResolveLibrary locates if dlopen
+//     cannot load it as is
 //
 // Returns:
 //   - Handle to the loaded library (use with GetSymbol and FreeLibrary)
@@ -53,8 +68,24 @@ const (
 //	defer ffi.FreeLibrary(handle)
 //
 // Note: Always pair LoadLibrary with FreeLibrary to prevent resource leaks.
-func LoadLibrary(name string) (unsafe.Pointer, error) {
-	handle, err := dl.Dlopen(name, RTLD_NOW|RTLD_GLOBAL)
+func LoadLibrary(name string) (handle unsafe.Pointer, err error) {
+	return loadLibraryFlags(name, RTLD_NOW|RTLD_GLOBAL)
+}
+
+// loadLibraryFlags is LoadLibrary with the dlopen mode flags.
+func loadLibraryFlags(name string, flags int) (handle unsafe.Pointer, err error) {
+	defer traceLoad(name, time.Now(), &handle, &err)
+
+	if err := checkLibraryPolicy(name); err != nil {
+		return nil, err
+	}
+
+	h, err := dl.Dlopen(name, flags)
+	if err != nil {
+		if path := resolveBareName(name); path != "" {
+			h, err = dl.Dlopen(path, flags)
+		}
+	}
 	if err != nil {
 		return nil, &LibraryError{
 			Operation: "load",
@@ -63,7 +94,9 @@ func LoadLibrary(name string) (unsafe.Pointer, error) {
 		}
 	}
 
-	return unsafe.Pointer(handle), nil
+	handle = foreignPointer(h)
+	trackLoad(handle)
+	return checkLoadedPolicy(name, handle)
 }
 
 // GetSymbol retrieves a function pointer from a loaded library using dlsym.
@@ -87,7 +120,14 @@ func LoadLibrary(name string) (unsafe.Pointer, error) {
 //	}
 //
 // Note: The returned pointer is only valid while the library remains loaded.
-func GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+func GetSymbol(handle unsafe.Pointer, name string) (sym unsafe.Pointer, err error) {
+	defer traceSymbol(handle, name, &sym, &err)
+	defer trackSymbol(handle, name, &sym, &err)
+
+	if err := checkSymbolPolicy(name); err != nil {
+		return nil, err
+	}
+
 	fnPtr, err := dl.Dlsym(uintptr(handle), name)
 	if err != nil {
 		return nil, &LibraryError{
@@ -105,7 +145,7 @@ func GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
 		}
 	}
 
-	return unsafe.Pointer(fnPtr), nil
+	return foreignPointer(fnPtr), nil
 }
 
 // FreeLibrary unloads a previously loaded library using dlclose.
@@ -129,15 +169,27 @@ func GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
 //	defer ffi.FreeLibrary(handle)
 //
 // Safety:
-//   - Do not use function pointers obtained from this library after FreeLibrary
+//   - Function pointers obtained with GetSymbol fail with ErrLibraryUnloaded
+//     in CallFunction and its variants once the library is unloaded; do not
+//     use them or other pointers into the library in any other way
 //   - Always pair LoadLibrary with FreeLibrary to prevent resource leaks
 //   - Safe to call with nil handle (returns nil without error)
-func FreeLibrary(handle unsafe.Pointer) error {
+//   - Fails with ErrLibraryInUse instead of unloading a library that
+//     LibraryRefs still reference (see RetainLibrary, FreeLibraryWhenUnused)
+//     or that a call through one of its symbols is still in progress in,
+//     such as a callback the library is running
+func FreeLibrary(handle unsafe.Pointer) (err error) {
+	defer traceUnload(handle, &err)
+
 	if handle == nil {
 		return nil // Allow nil handle for convenience
 	}
+	if err := beginFree(handle); err != nil {
+		return err
+	}
+	defer func() { endFree(handle, err) }()
 
-	err := dl.Dlclose(uintptr(handle))
+	err = dl.Dlclose(uintptr(handle))
 	if err != nil {
 		return &LibraryError{
 			Operation: "free",
@@ -0,0 +1,143 @@
+//go:build js
+
+// JavaScript "library" loading for GOOS=js: libraries are JavaScript objects
+// reached from globalThis, symbols are their function properties.
+
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"syscall/js"
+	"time"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch/wasm"
+)
+
+// LoadLibrary resolves a JavaScript object that plays the role of a library:
+// name is a dot-separated path from globalThis ("Module" for an Emscripten
+// build, "myLib.v2"), and "" or "globalThis" is the global object itself.
+// The script defining the object must have been loaded already.
+//
+// Example:
+//
+//	lib, err := ffi.LoadLibrary("Module")
+//	add, err := ffi.GetSymbol(lib, "_add")
+//	err = ffi.CallFunction(&cif, add, unsafe.Pointer(&sum), avalue)
+//
+// Arguments and return values are converted as described by the call
+// interface: integers up to 32 bits and floating-point values are Numbers,
+// 64-bit integers BigInts, structs Arrays of their members. Pointer-typed
+// values are handles of JavaScript values (see JSHandle), and string return
+// types (types.CStringTypeDescriptor) receive JavaScript strings. A thrown
+// exception is returned as an error.
+func LoadLibrary(name string) (handle unsafe.Pointer, err error) {
+	defer traceLoad(name, time.Now(), &handle, &err)
+
+	if err := checkLibraryPolicy(name); err != nil {
+		return nil, err
+	}
+
+	v, err := lookupJSPath(js.Global(), name)
+	if err != nil {
+		return nil, &LibraryError{Operation: "load", Name: name, Err: err}
+	}
+	t := v.Type()
+	if t != js.TypeObject && t != js.TypeFunction {
+		return nil, &LibraryError{Operation: "load", Name: name, Err: fmt.Errorf("not an object but %s", t)}
+	}
+	handle = foreignPointer(wasm.NewHandle(v, js.Undefined()))
+	trackLoad(handle)
+	return handle, nil
+}
+
+// GetSymbol resolves the function property name (a dot-separated path) of a
+// library object. The function is called with the object as this.
+func GetSymbol(handle unsafe.Pointer, name string) (sym unsafe.Pointer, err error) {
+	defer traceSymbol(handle, name, &sym, &err)
+	defer trackSymbol(handle, name, &sym, &err)
+
+	if err := checkSymbolPolicy(name); err != nil {
+		return nil, err
+	}
+
+	lib, _, ok := wasm.Lookup(uintptr(handle))
+	if !ok {
+		return nil, &LibraryError{Operation: "symbol", Name: name, Err: errors.New("invalid library handle")}
+	}
+	this := lib
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		var err error
+		if this, err = lookupJSPath(lib, name[:i]); err != nil {
+			return nil, &LibraryError{Operation: "symbol", Name: name, Err: err}
+		}
+	}
+	fn, err := lookupJSPath(lib, name)
+	if err != nil {
+		return nil, &LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("symbol not found")}
+	}
+	if fn.Type() != js.TypeFunction {
+		return nil, &LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("not a function but %s", fn.Type())}
+	}
+	return foreignPointer(wasm.NewHandle(fn, this)), nil
+}
+
+// FreeLibrary releases a library handle. Symbols resolved from it stay
+// valid. Safe to call with a nil handle.
+func FreeLibrary(handle unsafe.Pointer) (err error) {
+	defer traceUnload(handle, &err)
+
+	if handle == nil {
+		return nil
+	}
+	if _, _, ok := wasm.Lookup(uintptr(handle)); !ok {
+		return &LibraryError{Operation: "free", Name: "<library handle>", Err: errors.New("invalid library handle")}
+	}
+	if err := beginFree(handle); err != nil {
+		return err
+	}
+	defer func() { endFree(handle, err) }()
+
+	wasm.Release(uintptr(handle))
+	return nil
+}
+
+// JSHandle returns a new handle for v, to pass a JavaScript value as a
+// pointer argument. Release it with ReleaseJSValue.
+func JSHandle(v js.Value) Handle {
+	return Handle(wasm.NewHandle(v, js.Undefined()))
+}
+
+// JSValue returns the JavaScript value behind a handle: one returned by
+// JSHandle, LoadLibrary or GetSymbol, or a pointer-typed return value that
+// held an object, function or string.
+func JSValue(h Handle) (js.Value, bool) {
+	v, _, ok := wasm.Lookup(uintptr(h))
+	return v, ok
+}
+
+// ReleaseJSValue forgets a handle so that the JavaScript value it refers to
+// can be garbage collected. Pointer-typed return values that hold objects
+// are new handles and must be released by the caller.
+func ReleaseJSValue(h Handle) {
+	wasm.Release(uintptr(h))
+}
+
+// lookupJSPath follows a dot-separated property path from v.
+func lookupJSPath(v js.Value, path string) (js.Value, error) {
+	if path == "" || path == "globalThis" {
+		return v, nil
+	}
+	for _, part := range strings.Split(path, ".") {
+		if v.IsUndefined() || v.IsNull() {
+			break
+		}
+		v = v.Get(part)
+	}
+	if v.IsUndefined() || v.IsNull() {
+		return js.Undefined(), fmt.Errorf("%s is not defined", path)
+	}
+	return v, nil
+}
@@ -0,0 +1,158 @@
+package ffi
+
+import (
+	"syscall/js"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func setupJSLib(t *testing.T) unsafe.Pointer {
+	t.Helper()
+	js.Global().Call("eval", `globalThis.goffiTestLib = {
+		scale: 10,
+		add(a, b) { return a + b },
+		mul64(a, b) { return a * b },
+		scaled(x) { return x * this.scale },
+		greet(name) { return "hello, " + name },
+		box(v) { return {v} },
+		unbox(o) { return o.v },
+		pair(p) { return [p[1], p[0]] },
+		fail() { throw new Error("boom") },
+		apply(f, x) { return f(x) + 1 },
+	}`)
+	t.Cleanup(func() { js.Global().Delete("goffiTestLib") })
+	lib, err := LoadLibrary("goffiTestLib")
+	if err != nil {
+		t.Fatalf("LoadLibrary: %v", err)
+	}
+	t.Cleanup(func() { FreeLibrary(lib) })
+	return lib
+}
+
+func jsCall(t *testing.T, lib unsafe.Pointer, name string, ret *types.TypeDescriptor, args []*types.TypeDescriptor, rvalue unsafe.Pointer, avalue ...unsafe.Pointer) error {
+	t.Helper()
+	fn, err := GetSymbol(lib, name)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", name, err)
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, ret, args); err != nil {
+		t.Fatal(err)
+	}
+	return CallFunction(&cif, fn, rvalue, avalue)
+}
+
+func TestJSCallNumbers(t *testing.T) {
+	lib := setupJSLib(t)
+	i32 := types.SInt32TypeDescriptor
+	a, b := int32(-7), int32(30)
+	var sum int32
+	if err := jsCall(t, lib, "add", i32, []*types.TypeDescriptor{i32, i32}, unsafe.Pointer(&sum), unsafe.Pointer(&a), unsafe.Pointer(&b)); err != nil {
+		t.Fatal(err)
+	}
+	if sum != 23 {
+		t.Errorf("add = %d, want 23", sum)
+	}
+
+	// 64-bit integers travel as BigInt and keep their precision.
+	i64 := types.SInt64TypeDescriptor
+	x, y := int64(1<<40+1), int64(-3000)
+	var prod int64
+	if err := jsCall(t, lib, "mul64", i64, []*types.TypeDescriptor{i64, i64}, unsafe.Pointer(&prod), unsafe.Pointer(&x), unsafe.Pointer(&y)); err != nil {
+		t.Fatal(err)
+	}
+	if prod != x*y {
+		t.Errorf("mul64 = %d, want %d", prod, x*y)
+	}
+
+	// Symbols are called with their object as this.
+	d := 1.25
+	var r float64
+	if err := jsCall(t, lib, "scaled", types.DoubleTypeDescriptor, []*types.TypeDescriptor{types.DoubleTypeDescriptor}, unsafe.Pointer(&r), unsafe.Pointer(&d)); err != nil {
+		t.Fatal(err)
+	}
+	if r != 12.5 {
+		t.Errorf("scaled = %v, want 12.5", r)
+	}
+}
+
+func TestJSCallValues(t *testing.T) {
+	lib := setupJSLib(t)
+	ptr := types.PointerTypeDescriptor
+
+	name := JSHandle(js.ValueOf("wasm"))
+	defer ReleaseJSValue(name)
+	var s string
+	if err := jsCall(t, lib, "greet", types.CStringTypeDescriptor, []*types.TypeDescriptor{ptr}, unsafe.Pointer(&s), unsafe.Pointer(&name)); err != nil {
+		t.Fatal(err)
+	}
+	if s != "hello, wasm" {
+		t.Errorf("greet = %q", s)
+	}
+
+	// Objects come back as handles and can be passed back in.
+	v := int32(42)
+	var box Handle
+	if err := jsCall(t, lib, "box", ptr, []*types.TypeDescriptor{types.SInt32TypeDescriptor}, unsafe.Pointer(&box), unsafe.Pointer(&v)); err != nil {
+		t.Fatal(err)
+	}
+	if obj, ok := JSValue(box); !ok || obj.Get("v").Int() != 42 {
+		t.Fatalf("box returned %#x, not a handle of {v: 42}", box)
+	}
+	var back int32
+	if err := jsCall(t, lib, "unbox", types.SInt32TypeDescriptor, []*types.TypeDescriptor{ptr}, unsafe.Pointer(&back), unsafe.Pointer(&box)); err != nil {
+		t.Fatal(err)
+	}
+	ReleaseJSValue(box)
+	if back != 42 {
+		t.Errorf("unbox = %d, want 42", back)
+	}
+
+	// Structs are Arrays of their members.
+	pair := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.SInt32TypeDescriptor, types.DoubleTypeDescriptor}}
+	swapped := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.DoubleTypeDescriptor, types.SInt32TypeDescriptor}}
+	in := struct {
+		a int32
+		b float64
+	}{3, 4.5}
+	var out struct {
+		b float64
+		a int32
+	}
+	if err := jsCall(t, lib, "pair", swapped, []*types.TypeDescriptor{pair}, unsafe.Pointer(&out), unsafe.Pointer(&in)); err != nil {
+		t.Fatal(err)
+	}
+	if out.a != in.a || out.b != in.b {
+		t.Errorf("pair = %+v, want the members of %+v swapped", out, in)
+	}
+}
+
+func TestJSCallErrors(t *testing.T) {
+	lib := setupJSLib(t)
+	if err := jsCall(t, lib, "fail", types.VoidTypeDescriptor, nil, nil); err == nil {
+		t.Error("expected an error from a throwing function")
+	}
+	if _, err := GetSymbol(lib, "scale"); err == nil {
+		t.Error("GetSymbol on a non-function property should fail")
+	}
+	if _, err := LoadLibrary("goffiNoSuchLib"); err == nil {
+		t.Error("LoadLibrary of an undefined global should fail")
+	}
+}
+
+func TestJSCallback(t *testing.T) {
+	lib := setupJSLib(t)
+	cb := NewCallback(func(x int32) int32 { return x * 2 })
+	defer releaseCallback(cb)
+	x := int32(20)
+	var r int32
+	ptr := types.PointerTypeDescriptor
+	if err := jsCall(t, lib, "apply", types.SInt32TypeDescriptor, []*types.TypeDescriptor{ptr, types.SInt32TypeDescriptor}, unsafe.Pointer(&r), unsafe.Pointer(&cb), unsafe.Pointer(&x)); err != nil {
+		t.Fatal(err)
+	}
+	if r != 41 {
+		t.Errorf("apply = %d, want 41", r)
+	}
+}
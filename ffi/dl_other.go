@@ -0,0 +1,29 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm) || windows || js || wasip1)
+
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// LoadLibrary fails: dynamic libraries cannot be loaded on this platform.
+// The error is a *LibraryError wrapping an *UnsupportedPlatformError, so
+// callers can test for it with errors.As and fall back at run time.
+func LoadLibrary(name string) (unsafe.Pointer, error) {
+	return nil, &LibraryError{Operation: "load", Name: name, Err: &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}}
+}
+
+// GetSymbol fails: no library handle can exist on this platform.
+func GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	return nil, &LibraryError{Operation: "symbol", Name: name, Err: &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}}
+}
+
+// FreeLibrary accepts a nil handle, matching the other platforms, and fails
+// for anything else.
+func FreeLibrary(handle unsafe.Pointer) error {
+	if handle == nil {
+		return nil
+	}
+	return &LibraryError{Operation: "free", Name: "<library handle>", Err: &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}}
+}
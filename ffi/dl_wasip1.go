@@ -0,0 +1,143 @@
+//go:build wasip1
+
+// Library loading for wasip1. The Go port cannot load code at run time, so
+// "libraries" are side modules the host links in as imports: each function
+// is declared with //go:wasmimport and registered with RegisterImport.
+
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// wasmLibrary is a side module registered with RegisterImport; its address
+// is the library handle.
+type wasmLibrary struct {
+	name    string
+	symbols map[string]*wasmSymbol
+}
+
+// wasmSymbol is a registered import; its address is the function pointer,
+// under which its stub is registered as a CallStub.
+type wasmSymbol struct {
+	lib  *wasmLibrary
+	name string
+}
+
+var wasmImports = struct {
+	sync.Mutex
+	libs    map[string]*wasmLibrary
+	handles map[unsafe.Pointer]*wasmLibrary
+}{
+	libs:    make(map[string]*wasmLibrary),
+	handles: make(map[unsafe.Pointer]*wasmLibrary),
+}
+
+// RegisterImport makes symbol of the side module library callable through
+// LoadLibrary, GetSymbol and CallFunction. stub performs the call, normally by
+// forwarding to a function the program imports from the module:
+//
+//	//go:wasmimport mathlib add
+//	func mathlibAdd(a, b int32) int32
+//
+//	err := ffi.RegisterImport("mathlib", "add", func(_ *types.CallInterface, _, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+//	    *(*int32)(rvalue) = mathlibAdd(*(*int32)(avalue[0]), *(*int32)(avalue[1]))
+//	    return nil
+//	})
+//
+// The host runtime must provide the module when the program is instantiated,
+// for example by linking the side module in wasmtime or wazero. Binding code
+// that loads "mathlib" and calls "add" then runs unchanged on wasip1.
+func RegisterImport(library, symbol string, stub CallStub) error {
+	if stub == nil {
+		return ErrNilCallStub
+	}
+	wasmImports.Lock()
+	defer wasmImports.Unlock()
+	lib, ok := wasmImports.libs[library]
+	if !ok {
+		lib = &wasmLibrary{name: library, symbols: make(map[string]*wasmSymbol)}
+		wasmImports.libs[library] = lib
+		wasmImports.handles[unsafe.Pointer(lib)] = lib
+	}
+	if _, ok := lib.symbols[symbol]; ok {
+		return fmt.Errorf("goffi: import %s.%s already registered", library, symbol)
+	}
+	sym := &wasmSymbol{lib: lib, name: symbol}
+	lib.symbols[symbol] = sym
+
+	callStubMu.Lock()
+	defer callStubMu.Unlock()
+	callStubs.Store(uintptr(unsafe.Pointer(sym)), stub)
+	callStubCount.Add(1)
+	return nil
+}
+
+// LoadLibrary returns a handle for a side module registered with
+// RegisterImport. Other names fail with an *UnsupportedPlatformError inside
+// the *LibraryError: wasip1 cannot load libraries.
+func LoadLibrary(name string) (handle unsafe.Pointer, err error) {
+	defer traceLoad(name, time.Now(), &handle, &err)
+
+	if err := checkLibraryPolicy(name); err != nil {
+		return nil, err
+	}
+
+	wasmImports.Lock()
+	defer wasmImports.Unlock()
+	lib, ok := wasmImports.libs[name]
+	if !ok {
+		return nil, &LibraryError{Operation: "load", Name: name, Err: &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}}
+	}
+	handle = unsafe.Pointer(lib)
+	trackLoad(handle)
+	return handle, nil
+}
+
+// GetSymbol returns the function pointer of an import registered with
+// RegisterImport.
+func GetSymbol(handle unsafe.Pointer, name string) (sym unsafe.Pointer, err error) {
+	defer traceSymbol(handle, name, &sym, &err)
+	defer trackSymbol(handle, name, &sym, &err)
+
+	if err := checkSymbolPolicy(name); err != nil {
+		return nil, err
+	}
+
+	wasmImports.Lock()
+	defer wasmImports.Unlock()
+	lib, ok := wasmImports.handles[handle]
+	if !ok {
+		return nil, &LibraryError{Operation: "symbol", Name: name, Err: errors.New("invalid library handle")}
+	}
+	s, ok := lib.symbols[name]
+	if !ok {
+		return nil, &LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("symbol not found")}
+	}
+	return unsafe.Pointer(s), nil
+}
+
+// FreeLibrary does nothing: imports stay linked for the life of the
+// program. Safe to call with a nil handle.
+func FreeLibrary(handle unsafe.Pointer) (err error) {
+	defer traceUnload(handle, &err)
+
+	if handle == nil {
+		return nil
+	}
+	wasmImports.Lock()
+	defer wasmImports.Unlock()
+	if _, ok := wasmImports.handles[handle]; !ok {
+		return &LibraryError{Operation: "free", Name: "<library handle>", Err: errors.New("invalid library handle")}
+	}
+	if err := beginFree(handle); err != nil {
+		return err
+	}
+	endFree(handle, nil)
+	return nil
+}
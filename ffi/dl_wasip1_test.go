@@ -0,0 +1,53 @@
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestWasip1Import(t *testing.T) {
+	// A pure Go stub stands in for a //go:wasmimport function.
+	err := RegisterImport("goffi_test_mathlib", "add", func(_ *types.CallInterface, _, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+		*(*int32)(rvalue) = *(*int32)(avalue[0]) + *(*int32)(avalue[1])
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterImport: %v", err)
+	}
+	if err := RegisterImport("goffi_test_mathlib", "add", func(*types.CallInterface, unsafe.Pointer, unsafe.Pointer, []unsafe.Pointer) error { return nil }); err == nil {
+		t.Error("registering an import twice should fail")
+	}
+
+	lib, err := LoadLibrary("goffi_test_mathlib")
+	if err != nil {
+		t.Fatalf("LoadLibrary: %v", err)
+	}
+	defer FreeLibrary(lib)
+	add, err := GetSymbol(lib, "add")
+	if err != nil {
+		t.Fatalf("GetSymbol: %v", err)
+	}
+	var cif types.CallInterface
+	i32 := types.SInt32TypeDescriptor
+	if err := PrepareCallInterface(&cif, types.DefaultCall, i32, []*types.TypeDescriptor{i32, i32}); err != nil {
+		t.Fatal(err)
+	}
+	a, b := int32(40), int32(2)
+	var sum int32
+	if err := CallFunction(&cif, add, unsafe.Pointer(&sum), []unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b)}); err != nil {
+		t.Fatalf("CallFunction: %v", err)
+	}
+	if sum != 42 {
+		t.Errorf("add = %d, want 42", sum)
+	}
+
+	if _, err := GetSymbol(lib, "sub"); err == nil {
+		t.Error("GetSymbol of an unregistered import should fail")
+	}
+	if _, err := LoadLibrary("libc.so.6"); !errors.Is(err, &UnsupportedPlatformError{}) {
+		t.Errorf("LoadLibrary(libc.so.6) = %v, want UnsupportedPlatformError", err)
+	}
+}
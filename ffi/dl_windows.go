@@ -4,6 +4,7 @@ package ffi
 
 import (
 	"syscall"
+	"time"
 	"unsafe"
 )
 
@@ -30,28 +31,35 @@ var (
 //	    log.Fatal(err)
 //	}
 //	defer ffi.FreeLibrary(handle)
-func LoadLibrary(name string) (unsafe.Pointer, error) {
+func LoadLibrary(name string) (handle unsafe.Pointer, err error) {
+	defer traceLoad(name, time.Now(), &handle, &err)
+
+	if err := checkLibraryPolicy(name); err != nil {
+		return nil, err
+	}
+
 	namePtr, err := syscall.UTF16PtrFromString(name)
 	if err != nil {
 		return nil, &LibraryError{
 			Operation: "load",
 			Name:      name,
-			Err:       err,
+			Err:       dlErrno(err),
 		}
 	}
 
-	handle, _, err := procLoadLibrary.Call(uintptr(unsafe.Pointer(namePtr)))
-	if handle == 0 {
+	h, _, callErr := procLoadLibrary.Call(uintptr(unsafe.Pointer(namePtr)))
+	if h == 0 {
 		return nil, &LibraryError{
 			Operation: "load",
 			Name:      name,
-			Err:       err,
+			Err:       dlErrno(callErr),
 		}
 	}
 
-	// go vet: "possible misuse of unsafe.Pointer" — false positive.
 	// Windows DLL handles are opaque OS values, not Go heap pointers.
-	return unsafe.Pointer(handle), nil
+	handle = foreignPointer(h)
+	trackLoad(handle)
+	return checkLoadedPolicy(name, handle)
 }
 
 // GetSymbol retrieves a function pointer from a loaded library using GetProcAddress.
@@ -70,20 +78,26 @@ func LoadLibrary(name string) (unsafe.Pointer, error) {
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
-func GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+func GetSymbol(handle unsafe.Pointer, name string) (sym unsafe.Pointer, err error) {
+	defer traceSymbol(handle, name, &sym, &err)
+	defer trackSymbol(handle, name, &sym, &err)
+
+	if err := checkSymbolPolicy(name); err != nil {
+		return nil, err
+	}
+
 	namePtr := unsafe.Pointer(syscall.StringBytePtr(name))
 	proc, _, err := procGetProcAddress.Call(uintptr(handle), uintptr(namePtr))
 	if proc == 0 {
 		return nil, &LibraryError{
 			Operation: "symbol",
 			Name:      name,
-			Err:       err,
+			Err:       dlErrno(err),
 		}
 	}
 
-	// go vet: "possible misuse of unsafe.Pointer" — false positive.
 	// GetProcAddress returns a function pointer, not a Go heap pointer.
-	return unsafe.Pointer(proc), nil
+	return foreignPointer(proc), nil
 }
 
 // FreeLibrary unloads a previously loaded library using FreeLibrary.
@@ -107,21 +121,34 @@ func GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
 //	defer ffi.FreeLibrary(handle)
 //
 // Safety:
-//   - Do not use function pointers obtained from this library after FreeLibrary
+//   - Function pointers obtained with GetSymbol fail with ErrLibraryUnloaded
+//     in CallFunction and its variants once the library is unloaded; do not
+//     use them or other pointers into the library in any other way
 //   - Always pair LoadLibrary with FreeLibrary to prevent resource leaks
 //   - Safe to call with nil handle (returns nil without error)
-func FreeLibrary(handle unsafe.Pointer) error {
+//   - Fails with ErrLibraryInUse instead of unloading a library that
+//     LibraryRefs still reference (see RetainLibrary, FreeLibraryWhenUnused)
+//     or that a call through one of its symbols is still in progress in,
+//     such as a callback the library is running
+func FreeLibrary(handle unsafe.Pointer) (err error) {
+	defer traceUnload(handle, &err)
+
 	if handle == nil {
 		return nil // Allow nil handle for convenience
 	}
+	if err := beginFree(handle); err != nil {
+		return err
+	}
+	defer func() { endFree(handle, err) }()
 
 	ret, _, err := procFreeLibrary.Call(uintptr(handle))
 	if ret == 0 {
 		return &LibraryError{
 			Operation: "free",
 			Name:      "<library handle>",
-			Err:       err,
+			Err:       dlErrno(err),
 		}
 	}
+	removeTempLibrary(handle)
 	return nil
 }
@@ -0,0 +1,95 @@
+package ffi
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Errno is an operating system error number as reported by native code: the
+// C errno on Unix, the GetLastError value on Windows. It has the same values
+// as syscall.Errno on the current platform.
+//
+// Errno interoperates with the standard library error values:
+//
+//	errors.Is(err, syscall.ENOENT)   // same number
+//	errors.Is(err, fs.ErrNotExist)   // same classification as syscall.Errno
+type Errno uintptr
+
+// Error returns the platform's description of the error number.
+func (e Errno) Error() string {
+	return syscall.Errno(e).Error()
+}
+
+// Is reports whether e matches target. A syscall.Errno or Errno matches if it
+// has the same number; other targets (fs.ErrNotExist, fs.ErrPermission, ...)
+// are matched the way syscall.Errno matches them.
+func (e Errno) Is(target error) bool {
+	switch t := target.(type) {
+	case Errno:
+		return e == t
+	case syscall.Errno:
+		return syscall.Errno(e) == t
+	}
+	return errnoIs(syscall.Errno(e), target)
+}
+
+// Temporary reports whether retrying the operation may succeed (EINTR,
+// EAGAIN, ...).
+func (e Errno) Temporary() bool {
+	return syscall.Errno(e).Temporary()
+}
+
+// Timeout reports whether the error represents a timeout.
+func (e Errno) Timeout() bool {
+	return syscall.Errno(e).Timeout()
+}
+
+// CallWithErrno executes a C function call like CallFunction and returns the
+// error number the callee left behind.
+//
+// The call takes the same path as CallFunction: reentrancy checks,
+// interceptors, tracing and call stubs all apply. The error number is read
+// on the thread that ran the callee, right after it returns and before
+// anything else can overwrite it. On Unix errno is cleared before the call,
+// so a zero result means the callee did not set it; on Windows the returned
+// value is GetLastError. As in C, the value is only meaningful if the
+// function's return value says it failed:
+//
+//	var fd int32
+//	errno, err := ffi.CallWithErrno(&cif, openPtr, unsafe.Pointer(&fd), args)
+//	if err == nil && fd < 0 {
+//	    return errno // e.g. errors.Is(errno, fs.ErrNotExist)
+//	}
+//
+// windows/arm64 is not supported yet and returns types.ErrUnsupportedArchitecture,
+// as do functions routed through a CallStub on Windows, whose last-error value
+// is only available to goffi's own call stub.
+func CallWithErrno(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) (Errno, error) {
+	if cif == nil {
+		return 0, &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	if fn == nil {
+		return 0, &InvalidCallInterfaceError{
+			Field:  "fn",
+			Reason: "function pointer must not be nil",
+			Index:  -1,
+		}
+	}
+	if err := validateArgs(cif, avalue); err != nil {
+		return 0, err
+	}
+	var errno Errno
+	err := executeFunction(cif, fn, rvalue, avalue, &callOutputs{errno: &errno})
+	return errno, err
+}
@@ -0,0 +1,21 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm) || windows)
+
+package ffi
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/types"
+)
+
+func executeErrno(
+	caller arch.FunctionCaller,
+	errno *Errno,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	return types.ErrUnsupportedArchitecture
+}
@@ -0,0 +1,100 @@
+//go:build !plan9
+
+package ffi
+
+import (
+	"errors"
+	"io/fs"
+	"runtime"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestErrno(t *testing.T) {
+	var err error = Errno(syscall.ENOENT)
+
+	if !errors.Is(err, syscall.ENOENT) {
+		t.Error("expected errors.Is(Errno(ENOENT), syscall.ENOENT)")
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Error("expected errors.Is(Errno(ENOENT), fs.ErrNotExist)")
+	}
+	if errors.Is(err, syscall.EACCES) {
+		t.Error("Errno(ENOENT) must not match EACCES")
+	}
+	if err.Error() != syscall.ENOENT.Error() {
+		t.Errorf("Error() = %q, want %q", err.Error(), syscall.ENOENT.Error())
+	}
+	if !Errno(syscall.EAGAIN).Temporary() || Errno(syscall.ENOENT).Temporary() {
+		t.Error("Temporary() does not match syscall.Errno")
+	}
+	if Errno(syscall.ETIMEDOUT).Timeout() != syscall.ETIMEDOUT.Timeout() {
+		t.Error("Timeout() does not match syscall.Errno")
+	}
+}
+
+func TestCallWithErrno(t *testing.T) {
+	var lib, sym string
+	var want syscall.Errno
+	switch runtime.GOOS {
+	case "linux", "darwin", "freebsd":
+		lib, _ = testLibAndSymbol()
+		sym, want = "close", syscall.EBADF
+	case "windows":
+		if runtime.GOARCH != "amd64" {
+			t.Skip("CallWithErrno not supported on windows/" + runtime.GOARCH)
+		}
+		lib, sym, want = "kernel32.dll", "CloseHandle", syscall.Errno(6) // ERROR_INVALID_HANDLE
+	default:
+		t.Skip("unsupported platform")
+	}
+
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Fatalf("LoadLibrary(%q) failed: %v", lib, err)
+	}
+	defer FreeLibrary(handle)
+	fn, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%q) failed: %v", sym, err)
+	}
+
+	// close(-1) and CloseHandle on a bogus handle both fail with an
+	// "invalid handle" error number.
+	fd, bogus := int32(-1), uintptr(0x1234)
+	argType, argPtr := types.SInt32TypeDescriptor, unsafe.Pointer(&fd)
+	if runtime.GOOS == "windows" {
+		argType, argPtr = types.PointerTypeDescriptor, unsafe.Pointer(&bogus)
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{argType}); err != nil {
+		t.Fatal(err)
+	}
+
+	// CallWithErrno takes the CallFunction path, so interceptors see the call.
+	intercepted := 0
+	remove, err := InterceptLibrary(handle, func(c *Call, next CallInvoker) error {
+		intercepted++
+		return next(c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remove()
+
+	var ret int32
+	errno, err := CallWithErrno(&cif, fn, unsafe.Pointer(&ret), []unsafe.Pointer{argPtr})
+	if err != nil {
+		t.Fatalf("CallWithErrno failed: %v", err)
+	}
+	if !errors.Is(errno, want) {
+		t.Errorf("errno = %d (%v), want %d (%v)", errno, errno, want, want)
+	}
+	if intercepted != 1 {
+		t.Errorf("interceptor ran %d times, want 1", intercepted)
+	}
+}
@@ -0,0 +1,80 @@
+//go:build ((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm)
+
+package ffi
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/internal/dl"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// errnoLocationSymbol returns a pointer to the calling thread's errno.
+var errnoLocationSymbol = map[string]string{
+	"linux":   "__errno_location",
+	"darwin":  "__error",
+	"freebsd": "__error",
+}[runtime.GOOS]
+
+var errnoLocation struct {
+	once sync.Once
+	fn   unsafe.Pointer
+	cif  types.CallInterface
+	err  error
+}
+
+func initErrnoLocation() {
+	sym, err := dl.Dlsym(dl.RTLD_DEFAULT, errnoLocationSymbol)
+	if err == nil && sym == 0 {
+		err = fmt.Errorf("symbol not found")
+	}
+	if err != nil {
+		errnoLocation.err = &LibraryError{Operation: "symbol", Name: errnoLocationSymbol, Err: err}
+		return
+	}
+	errnoLocation.fn = foreignPointer(sym)
+	errnoLocation.err = PrepareCallInterface(&errnoLocation.cif, types.DefaultCall, types.PointerTypeDescriptor, nil)
+}
+
+// threadErrno returns the address of errno for the current OS thread. The
+// caller must have locked the goroutine to its thread.
+func threadErrno() (*int32, error) {
+	errnoLocation.once.Do(initErrnoLocation)
+	if errnoLocation.err != nil {
+		return nil, errnoLocation.err
+	}
+	var loc unsafe.Pointer
+	if err := executeCall(&errnoLocation.cif, errnoLocation.fn, unsafe.Pointer(&loc), nil, nil); err != nil {
+		return nil, err
+	}
+	return (*int32)(loc), nil
+}
+
+// executeErrno runs the call through caller and stores the callee's errno in
+// *errno. The goroutine is pinned to its thread: errno is thread-local, so
+// clearing it, the callee's write and reading it back must all happen on the
+// same thread.
+func executeErrno(
+	caller arch.FunctionCaller,
+	errno *Errno,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	loc, err := threadErrno()
+	if err != nil {
+		return err
+	}
+	*loc = 0
+	err = caller.Execute(cif, fn, rvalue, avalue)
+	*errno = Errno(*loc)
+	return err
+}
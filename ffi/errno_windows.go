@@ -0,0 +1,41 @@
+//go:build windows
+
+package ffi
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// executeErrno runs the call through caller and stores the thread's last-error
+// value in *errno. The value is read by the call stub itself, right after the
+// callee returns, so only callers implementing arch.ErrnoCaller support it.
+func executeErrno(
+	caller arch.FunctionCaller,
+	errno *Errno,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	ec, ok := caller.(arch.ErrnoCaller)
+	if !ok {
+		return types.ErrUnsupportedArchitecture
+	}
+	lastErr, err := ec.ExecuteErrno(cif, fn, rvalue, avalue)
+	*errno = Errno(lastErr)
+	return err
+}
+
+// dlErrno converts the syscall.Errno reported by the loader APIs to Errno.
+func dlErrno(err error) error {
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		return Errno(errno)
+	}
+	return err
+}
@@ -0,0 +1,9 @@
+//go:build !plan9
+
+package ffi
+
+import "syscall"
+
+func errnoIs(e syscall.Errno, target error) bool {
+	return e.Is(target)
+}
@@ -0,0 +1,9 @@
+package ffi
+
+import "syscall"
+
+// errnoIs reports false: plan9 errors are strings, so an Errno has no
+// fs error classification there.
+func errnoIs(syscall.Errno, error) bool {
+	return false
+}
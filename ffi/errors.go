@@ -2,6 +2,9 @@ package ffi
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/go-webgpu/goffi/types"
 )
 
 // InvalidCallInterfaceError indicates CallInterface preparation failed due to
@@ -79,7 +82,7 @@ func (e *UnsupportedPlatformError) Is(target error) bool {
 //	    fmt.Printf("OS error: %v\n", libErr.Err)
 //	}
 type LibraryError struct {
-	Operation string // "load", "symbol", or "free"
+	Operation string // "load", "symbol", "free", "retain", "export", "tls", "version" or "call"
 	Name      string // Library path or symbol name
 	Err       error  // Underlying OS error (can be nil)
 }
@@ -152,6 +155,289 @@ func (e *TypeValidationError) Is(target error) bool {
 	return ok
 }
 
+// SignalStateError reports signal handlers or mask bits that a native call
+// changed while running under WithSignalGuard.
+//
+// The guard has already restored the previous state when this error is
+// returned, and the call's return value is valid.
+//
+// Example:
+//
+//	var sigErr *SignalStateError
+//	if errors.As(err, &sigErr) {
+//	    for _, c := range sigErr.Changes {
+//	        log.Printf("restored %v", c)
+//	    }
+//	}
+type SignalStateError struct {
+	Changes []SignalChange // Every modification detected (and reverted)
+}
+
+func (e *SignalStateError) Error() string {
+	parts := make([]string, len(e.Changes))
+	for i, c := range e.Changes {
+		parts[i] = c.String()
+	}
+	return fmt.Sprintf("native call modified signal state (restored): %s", strings.Join(parts, ", "))
+}
+
+// Is implements error equality for errors.Is().
+func (e *SignalStateError) Is(target error) bool {
+	_, ok := target.(*SignalStateError)
+	return ok
+}
+
+// APIUnavailableError reports an API group from ProbeAPI that the library
+// does not fully provide.
+//
+// Example:
+//
+//	var apiErr *APIUnavailableError
+//	if errors.As(err, &apiErr) {
+//	    log.Printf("%s unavailable, missing %v", apiErr.Group, apiErr.Missing)
+//	}
+type APIUnavailableError struct {
+	Group   string   // Name of the API group
+	Missing []string // Symbols of the group the library does not export (nil for an unknown group)
+}
+
+func (e *APIUnavailableError) Error() string {
+	if e.Missing == nil {
+		return fmt.Sprintf("API group %q was not probed", e.Group)
+	}
+	return fmt.Sprintf("API group %q unavailable: missing %s", e.Group, strings.Join(e.Missing, ", "))
+}
+
+// Is implements error equality for errors.Is().
+func (e *APIUnavailableError) Is(target error) bool {
+	_, ok := target.(*APIUnavailableError)
+	return ok
+}
+
+// LayoutMismatchError reports the first place where a Go struct is laid out
+// differently from the descriptor of its C counterpart, as found by
+// CheckLayout.
+//
+// Example:
+//
+//	var layoutErr *LayoutMismatchError
+//	if errors.As(err, &layoutErr) {
+//	    log.Printf("%s.%s is at %d in Go, %d in C", layoutErr.Type, layoutErr.Field,
+//	        layoutErr.GoOffset, layoutErr.COffset)
+//	}
+type LayoutMismatchError struct {
+	Type     string  // Go struct type
+	Field    string  // Field path such as "Limits.Scale" or "RGB[2]"; "" for the struct size
+	GoOffset uintptr // Offset of the field in the Go struct
+	COffset  uintptr // Offset of the matching member in the C struct
+	GoSize   uintptr // Size of the field (or struct) in Go
+	CSize    uintptr // Size of the member (or struct) in C
+}
+
+func (e *LayoutMismatchError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("layout mismatch for %s: size is %d in Go, %d in C", e.Type, e.GoSize, e.CSize)
+	}
+	return fmt.Sprintf("layout mismatch for %s.%s: Go offset %d size %d, C offset %d size %d",
+		e.Type, e.Field, e.GoOffset, e.GoSize, e.COffset, e.CSize)
+}
+
+// Is implements error equality for errors.Is().
+func (e *LayoutMismatchError) Is(target error) bool {
+	_, ok := target.(*LayoutMismatchError)
+	return ok
+}
+
+// ThreadConfigError reports a ThreadConfig that ApplyThreadConfig rejects.
+//
+// Example:
+//
+//	var tcErr *ThreadConfigError
+//	if errors.As(err, &tcErr) && tcErr.Field == "Affinity" {
+//	    log.Printf("bad CPU at index %d: %s", tcErr.Index, tcErr.Reason)
+//	}
+type ThreadConfigError struct {
+	Field  string // "Class" or "Affinity"
+	Reason string // Why it was rejected
+	Index  int    // Index into Affinity (-1 if not applicable)
+}
+
+func (e *ThreadConfigError) Error() string {
+	if e.Index >= 0 {
+		return fmt.Sprintf("invalid thread config: %s[%d]: %s", e.Field, e.Index, e.Reason)
+	}
+	return fmt.Sprintf("invalid thread config: %s: %s", e.Field, e.Reason)
+}
+
+// Is implements error equality for errors.Is().
+func (e *ThreadConfigError) Is(target error) bool {
+	_, ok := target.(*ThreadConfigError)
+	return ok
+}
+
+// PolicyError reports a malformed pattern in a Policy passed to SetPolicy.
+//
+// Example:
+//
+//	var polErr *PolicyError
+//	if errors.As(err, &polErr) {
+//	    log.Printf("%s[%d] = %q: %v", polErr.List, polErr.Index, polErr.Pattern, polErr.Err)
+//	}
+type PolicyError struct {
+	List    string // "Libraries", "DenyLibraries", "Symbols" or "DenySymbols"
+	Index   int    // Index of the pattern in the list
+	Pattern string // The malformed pattern
+	Err     error  // Error from path.Match (path.ErrBadPattern)
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("policy %s[%d]: pattern %q: %v", e.List, e.Index, e.Pattern, e.Err)
+}
+
+// Unwrap returns the underlying error for errors.Unwrap().
+func (e *PolicyError) Unwrap() error {
+	return e.Err
+}
+
+// Is implements error equality for errors.Is().
+func (e *PolicyError) Is(target error) bool {
+	_, ok := target.(*PolicyError)
+	return ok
+}
+
+// ReentrancyError reports a native call made from a callback that
+// SetReentrancyCheck flags: a call back into a library marked non-reentrant
+// while one of its functions is still running on the same goroutine, or a
+// chain of nested calls deeper than the configured limit.
+//
+// Example:
+//
+//	var reErr *ReentrancyError
+//	if errors.As(err, &reErr) {
+//	    log.Printf("%v\n%s", reErr, reErr.Stack)
+//	}
+type ReentrancyError struct {
+	Reason string           // What the check detected
+	Chain  []ReentrantFrame // Active native calls, outermost first, ending with the offending one
+	Stack  []byte           // Go stack of the goroutine at the offending call
+}
+
+func (e *ReentrancyError) Error() string {
+	names := make([]string, len(e.Chain))
+	for i, f := range e.Chain {
+		names[i] = f.String()
+	}
+	return fmt.Sprintf("reentrant native call: %s (%s)", e.Reason, strings.Join(names, " -> callback -> "))
+}
+
+// Is implements error equality for errors.Is().
+func (e *ReentrancyError) Is(target error) bool {
+	_, ok := target.(*ReentrancyError)
+	return ok
+}
+
+// ManifestError reports the libraries of a manifest that LoadManifest could
+// not load, with every name it tried for each.
+//
+// Example:
+//
+//	var manErr *ManifestError
+//	if errors.As(err, &manErr) {
+//	    for _, f := range manErr.Failures {
+//	        log.Printf("%s: tried %v: %v", f.ID, f.Tried, f.Err)
+//	    }
+//	}
+type ManifestError struct {
+	Failures []ManifestFailure // Required libraries that failed, in manifest order
+}
+
+// ManifestFailure is one library a manifest could not load.
+type ManifestFailure struct {
+	ID    string   // LibrarySpec.ID
+	Tried []string // Names tried, in order; nil if skipped
+	Err   error    // Error of the last name tried, or why the library was skipped
+}
+
+func (e *ManifestError) Error() string {
+	parts := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		if len(f.Tried) == 0 {
+			parts[i] = fmt.Sprintf("%s: %v", f.ID, f.Err)
+		} else {
+			parts[i] = fmt.Sprintf("%s (tried %s): %v", f.ID, strings.Join(f.Tried, ", "), f.Err)
+		}
+	}
+	return "loading libraries failed: " + strings.Join(parts, "; ")
+}
+
+// Unwrap returns the errors of the individual failures.
+func (e *ManifestError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// Is implements error equality for errors.Is().
+func (e *ManifestError) Is(target error) bool {
+	_, ok := target.(*ManifestError)
+	return ok
+}
+
+// ObjCExceptionError is an Objective-C exception (NSException) that a native
+// call made under CatchObjC or WithObjCExceptions threw and did not catch.
+//
+// Example:
+//
+//	var exc *ObjCExceptionError
+//	if errors.As(err, &exc) && exc.Name == "NSRangeException" {
+//	    log.Printf("index out of range: %s", exc.Reason)
+//	}
+type ObjCExceptionError struct {
+	Name   string // Exception name, e.g. "NSInvalidArgumentException"
+	Reason string // Exception reason, as the exception describes itself
+}
+
+func (e *ObjCExceptionError) Error() string {
+	return fmt.Sprintf("objective-c exception %s: %s", e.Name, e.Reason)
+}
+
+// Is implements error equality for errors.Is().
+func (e *ObjCExceptionError) Is(target error) bool {
+	_, ok := target.(*ObjCExceptionError)
+	return ok
+}
+
+// CxxExceptionError is a C++ exception that escaped a native call made
+// under CatchCxx or WithCxxExceptions.
+//
+// Example:
+//
+//	var exc *CxxExceptionError
+//	if errors.As(err, &exc) {
+//	    log.Printf("native code threw %s", exc.Type)
+//	}
+type CxxExceptionError struct {
+	Type string // Demangled type of the thrown object, e.g. "std::runtime_error"
+}
+
+func (e *CxxExceptionError) Error() string {
+	return fmt.Sprintf("c++ exception of type %s", e.Type)
+}
+
+// Is implements error equality for errors.Is().
+func (e *CxxExceptionError) Is(target error) bool {
+	_, ok := target.(*CxxExceptionError)
+	return ok
+}
+
+// RegisterClobberError is returned by calls made with WithRegisterCheck (or
+// CallInterface.CheckRegisters) when the callee did not preserve one or more
+// callee-saved registers. The registers have been restored and the call's
+// return value is valid.
+type RegisterClobberError = types.RegisterClobberError
+
 // Deprecated: Legacy sentinel errors kept for backwards compatibility.
 // Use typed errors above with errors.As() for better error handling.
 var (
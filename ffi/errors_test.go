@@ -3,6 +3,7 @@ package ffi
 import (
 	"errors"
 	"testing"
+	"unsafe"
 
 	"github.com/go-webgpu/goffi/types"
 )
@@ -112,6 +113,37 @@ func TestTypedErrors(t *testing.T) {
 		}
 	})
 
+	t.Run("InvalidCallInterfaceError_Arguments", func(t *testing.T) {
+		cif := &types.CallInterface{}
+		err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor,
+			[]*types.TypeDescriptor{types.SInt32TypeDescriptor, types.PointerTypeDescriptor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var x int32
+		fn := unsafe.Pointer(&x) // never called: validation fails first
+
+		tests := []struct {
+			name      string
+			avalue    []unsafe.Pointer
+			wantIndex int
+		}{
+			{"too few", []unsafe.Pointer{unsafe.Pointer(&x)}, -1},
+			{"too many", []unsafe.Pointer{unsafe.Pointer(&x), unsafe.Pointer(&x), unsafe.Pointer(&x)}, -1},
+			{"nil entry", []unsafe.Pointer{unsafe.Pointer(&x), nil}, 1},
+		}
+		for _, tt := range tests {
+			err := CallFunction(cif, fn, nil, tt.avalue)
+			var icErr *InvalidCallInterfaceError
+			if !errors.As(err, &icErr) {
+				t.Fatalf("%s: expected InvalidCallInterfaceError, got %T: %v", tt.name, err, err)
+			}
+			if icErr.Field != "avalue" || icErr.Index != tt.wantIndex {
+				t.Errorf("%s: got Field=%q Index=%d, want avalue/%d", tt.name, icErr.Field, icErr.Index, tt.wantIndex)
+			}
+		}
+	})
+
 	t.Run("ErrorIs", func(t *testing.T) {
 		// Test errors.Is() works with all typed errors
 
@@ -0,0 +1,57 @@
+package ffi
+
+import "unsafe"
+
+// ErrorTranslator converts the integer return value of a call to symbol into
+// an error, or returns nil if code signals success.
+type ErrorTranslator func(symbol string, code int64) error
+
+// NegativeErrno is an ErrorTranslator for the convention of returning -errno
+// on failure, as Linux system call wrappers and many libraries do: a
+// negative code becomes the Errno -code, anything else is success.
+func NegativeErrno(_ string, code int64) error {
+	if code < 0 {
+		return Errno(-code)
+	}
+	return nil
+}
+
+// TranslateErrors installs t on the library handle: after every call to one
+// of its functions whose return type is an integer, t is applied to the
+// returned value, and the error it reports is returned from the call as a
+// *LibraryError with Operation "call" and the symbol as Name, wrapping it.
+// The return value is stored in rvalue as usual. Binding code then checks
+// err instead of switching on result codes at every call site:
+//
+//	// VkResult: negative values are errors.
+//	remove, err := ffi.TranslateErrors(vulkan, func(sym string, code int64) error {
+//	    if code < 0 {
+//	        return vkError(code)
+//	    }
+//	    return nil
+//	})
+//	...
+//	err = ffi.CallFunction(&cif, vkCreateInstance, unsafe.Pointer(&res), args)
+//	if errors.Is(err, errOutOfHostMemory) { ... }
+//
+// Translation is an interceptor (see InterceptLibrary), installed after the
+// ones already present, and covers the same calls. The returned function
+// uninstalls it.
+func TranslateErrors(handle unsafe.Pointer, t ErrorTranslator) (remove func(), err error) {
+	if t == nil {
+		return nil, &InvalidCallInterfaceError{Field: "t", Reason: "error translator must not be nil", Index: -1}
+	}
+	return InterceptLibrary(handle, func(call *Call, next CallInvoker) error {
+		if err := next(call); err != nil {
+			return err
+		}
+		rt := call.CIF.ReturnType
+		if rt == nil || call.RValue == nil || !isIntegerKind(rt.Kind) {
+			return nil
+		}
+		if err := t(call.Symbol, loadInt(call.RValue, rt)); err != nil {
+			return &LibraryError{Operation: "call", Name: call.Symbol, Err: err}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestTranslateErrors(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	atoi, err := GetSymbol(handle, "atoi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	call := func(s string) (int32, error) {
+		b := append([]byte(s), 0)
+		p := unsafe.Pointer(&b[0])
+		var n int32
+		err := CallFunction(cif, atoi, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)})
+		return n, err
+	}
+
+	remove, err := TranslateErrors(handle, NegativeErrno)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := call("17"); err != nil || n != 17 {
+		t.Errorf("atoi(17) = %d, %v", n, err)
+	}
+	n, err := call("-2")
+	if n != -2 {
+		t.Errorf("return value = %d, want -2 stored despite the error", n)
+	}
+	var libErr *LibraryError
+	if !errors.As(err, &libErr) || libErr.Operation != "call" || libErr.Name != "atoi" {
+		t.Errorf("err = %v, want a LibraryError for the atoi call", err)
+	}
+	if !errors.Is(err, Errno(syscall.ENOENT)) {
+		t.Errorf("err = %v does not match Errno(ENOENT)", err)
+	}
+
+	remove()
+	if _, err := call("-2"); err != nil {
+		t.Errorf("after remove: %v", err)
+	}
+
+	if _, err := TranslateErrors(handle, nil); err == nil {
+		t.Error("nil translator accepted")
+	}
+}
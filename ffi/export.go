@@ -0,0 +1,91 @@
+package ffi
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"unsafe"
+)
+
+// DefaultExportSoname is the DT_SONAME ExportSymbols uses when none is given.
+const DefaultExportSoname = "libgoffi-exports.so"
+
+// ExportedSymbols is a set of function pointers, typically Go callbacks from
+// NewCallback, published under C symbol names by ExportSymbols.
+type ExportedSymbols struct {
+	handle unsafe.Pointer // shim library handle
+	slots  unsafe.Pointer // jump table, one uintptr per name
+	index  map[string]int // symbol name -> slot
+}
+
+// ExportSymbols makes each fn in symbols resolvable under its name, for
+// native frameworks that discover hooks with dlsym(RTLD_DEFAULT, name) or by
+// dlopen-ing a well-known library and looking names up in it.
+//
+// goffi generates a small shared library in memory whose exported functions
+// jump straight to the given pointers and loads it with RTLD_GLOBAL. Its
+// DT_SONAME is soname (DefaultExportSoname if empty), so a later
+// dlopen(soname) by the host returns the same library instead of searching
+// the file system. The library stays loaded for the life of the process,
+// like the callbacks it points to.
+//
+// Example:
+//
+//	exports, err := ffi.ExportSymbols("libhost_api.so", map[string]uintptr{
+//	    "host_log":      ffi.NewCallback(hostLog),
+//	    "host_get_time": ffi.NewCallback(hostGetTime),
+//	})
+//
+// Symbols are only exported on Linux (amd64, arm64); other platforms return
+// *UnsupportedPlatformError.
+func ExportSymbols(soname string, symbols map[string]uintptr) (*ExportedSymbols, error) {
+	if soname == "" {
+		soname = DefaultExportSoname
+	}
+	names := make([]string, 0, len(symbols))
+	for name, fn := range symbols {
+		if fn == 0 {
+			return nil, &LibraryError{
+				Operation: "export",
+				Name:      soname,
+				Err:       fmt.Errorf("symbol %q: function pointer must not be nil", name),
+			}
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	handle, slots, err := loadExportShim(soname, names)
+	if err != nil {
+		return nil, err
+	}
+	e := &ExportedSymbols{handle: handle, slots: slots, index: make(map[string]int, len(names))}
+	for i, name := range names {
+		e.index[name] = i
+		e.store(i, symbols[name])
+	}
+	return e, nil
+}
+
+// Handle returns the handle of the generated library, usable with GetSymbol.
+func (e *ExportedSymbols) Handle() unsafe.Pointer {
+	return e.handle
+}
+
+// Set points the exported symbol name at fn. Native code that already
+// resolved the symbol follows the change on its next call.
+func (e *ExportedSymbols) Set(name string, fn uintptr) error {
+	i, ok := e.index[name]
+	if !ok {
+		return fmt.Errorf("goffi: symbol %q is not exported", name)
+	}
+	if fn == 0 {
+		return fmt.Errorf("goffi: symbol %q: function pointer must not be nil", name)
+	}
+	e.store(i, fn)
+	return nil
+}
+
+func (e *ExportedSymbols) store(i int, fn uintptr) {
+	atomic.StoreUintptr((*uintptr)(unsafe.Add(e.slots, i*int(unsafe.Sizeof(uintptr(0))))), fn)
+}
@@ -0,0 +1,32 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/elfshim"
+)
+
+// loadExportShim builds the ELF shim for names, loads it and returns its
+// handle and jump table.
+func loadExportShim(soname string, names []string) (handle, slots unsafe.Pointer, err error) {
+	machine := elfshim.MachineX86_64
+	if runtime.GOARCH == "arm64" {
+		machine = elfshim.MachineAArch64
+	}
+	image, err := elfshim.Build(machine, soname, names)
+	if err != nil {
+		return nil, nil, &LibraryError{Operation: "export", Name: soname, Err: err}
+	}
+	handle, err = loadLibraryData(soname, image)
+	if err != nil {
+		return nil, nil, err
+	}
+	slots, err = GetSymbol(handle, elfshim.SlotsSymbol)
+	if err != nil {
+		return nil, nil, err
+	}
+	return handle, slots, nil
+}
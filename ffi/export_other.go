@@ -0,0 +1,13 @@
+//go:build !(linux && (amd64 || arm64))
+
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// loadExportShim reports that symbols cannot be exported here.
+func loadExportShim(soname string, names []string) (handle, slots unsafe.Pointer, err error) {
+	return nil, nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
@@ -0,0 +1,100 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestExportSymbols(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", sym, err)
+	}
+	var seen uintptr
+	goStrlen := NewCallback(func(p uintptr) uintptr {
+		seen = p
+		return 0
+	})
+
+	const soname = "libgoffi_export_test.so"
+	exports, err := ExportSymbols(soname, map[string]uintptr{
+		"goffi_test_export_len":   uintptr(strlen),
+		"goffi_test_export_other": goStrlen,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Resolvable from the process-wide namespace, as a plugin host would.
+	global, err := dl.Dlsym(dl.RTLD_DEFAULT, "goffi_test_export_len")
+	if err != nil {
+		t.Fatalf("dlsym(RTLD_DEFAULT): %v", err)
+	}
+	fn, err := GetSymbol(exports.Handle(), "goffi_test_export_len")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uintptr(fn) != global {
+		t.Errorf("GetSymbol = %p, dlsym(RTLD_DEFAULT) = %#x", fn, global)
+	}
+
+	// dlopen by soname finds the already loaded shim.
+	byName, err := LoadLibrary(soname)
+	if err != nil {
+		t.Fatalf("LoadLibrary(%s): %v", soname, err)
+	}
+	if byName != exports.Handle() {
+		t.Errorf("LoadLibrary(%s) = %p, want %p", soname, byName, exports.Handle())
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	str := []byte("exported\x00")
+	strPtr := unsafe.Pointer(&str[0])
+	var n uint64
+	if err := CallFunction(cif, fn, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&strPtr)}); err != nil {
+		t.Fatal(err)
+	}
+	if n != 8 {
+		t.Errorf("goffi_test_export_len = %d, want 8", n)
+	}
+
+	// Rebinding redirects callers that already resolved the symbol.
+	if err := exports.Set("goffi_test_export_len", goStrlen); err != nil {
+		t.Fatal(err)
+	}
+	if err := CallFunction(cif, fn, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&strPtr)}); err != nil {
+		t.Fatal(err)
+	}
+	if seen != uintptr(strPtr) {
+		t.Errorf("Go callback saw %#x, want %p", seen, strPtr)
+	}
+	runtime.KeepAlive(str)
+
+	if err := exports.Set("goffi_test_not_exported", goStrlen); err == nil {
+		t.Error("expected error for unknown symbol")
+	}
+}
+
+func TestExportSymbolsInvalid(t *testing.T) {
+	if _, err := ExportSymbols("", map[string]uintptr{"goffi_test_nil": 0}); err == nil {
+		t.Error("expected error for nil function pointer")
+	}
+	if _, err := ExportSymbols("", map[string]uintptr{"": 1}); err == nil {
+		t.Error("expected error for empty symbol name")
+	}
+}
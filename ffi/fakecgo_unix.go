@@ -1,4 +1,4 @@
-//go:build (linux || darwin || freebsd) && !cgo && !nofakecgo
+//go:build (((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm)) && !cgo && !nofakecgo
 
 package ffi
 
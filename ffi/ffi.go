@@ -162,6 +162,13 @@ func PrepareCallInterface(
 // so that variadic arguments land on the stack even when registers are available.
 // On all other platforms, this function behaves identically to PrepareCallInterface.
 //
+// The variadic arguments are declared with their Go types and promoted at
+// call time as C's default argument promotions require: float and Float16
+// arguments are passed as double, and 8- and 16-bit integers as int. On
+// x86-64 System V, AL carries an upper bound on the vector registers used,
+// and on Windows x64 floating-point arguments are also passed in the
+// matching integer registers, as variadic callees expect.
+//
 // Example:
 //
 //	// Prepare for: int64_t sum_variadic(int64_t count, ...)
@@ -210,13 +217,16 @@ func PrepareVariadicCallInterface(
 //   - ctx: Context for cancellation and timeout control (use context.Background() if not needed)
 //   - cif: Prepared call interface (from PrepareCallInterface)
 //   - fn: Function pointer obtained from GetSymbol (must not be nil)
-//   - rvalue: Pointer to buffer for return value (can be nil for void functions)
+//   - rvalue: Pointer to buffer for return value (can be nil for void functions;
+//     a *string for C or wide string returns, see types.CStringTypeDescriptor)
 //   - avalue: Slice of pointers to argument values (length must match argCount from PrepareCallInterface)
 //
 // Returns:
 //   - nil on success
 //   - ctx.Err() if context is cancelled or deadline exceeded before call starts
 //   - ErrInvalidCallInterface if cif or fn is nil
+//   - *InvalidCallInterfaceError with Field "avalue" if len(avalue) differs
+//     from the argument count or an argument pointer is nil
 //   - ErrFunctionCallFailed if the call execution fails
 //
 // Example:
@@ -274,8 +284,11 @@ func CallFunctionContext(
 			Index:  -1,
 		}
 	}
+	if err := validateArgs(cif, avalue); err != nil {
+		return err
+	}
 
-	return executeFunction(cif, fn, rvalue, avalue)
+	return executeFunction(cif, fn, rvalue, avalue, nil)
 }
 
 // CallFunction executes a C function call without context support.
@@ -286,12 +299,15 @@ func CallFunctionContext(
 // Parameters:
 //   - cif: Prepared call interface (from PrepareCallInterface)
 //   - fn: Function pointer obtained from GetSymbol (must not be nil)
-//   - rvalue: Pointer to buffer for return value (can be nil for void functions)
+//   - rvalue: Pointer to buffer for return value (can be nil for void functions;
+//     a *string for C or wide string returns, see types.CStringTypeDescriptor)
 //   - avalue: Slice of pointers to argument values (length must match argCount from PrepareCallInterface)
 //
 // Returns:
 //   - nil on success
 //   - ErrInvalidCallInterface if cif or fn is nil
+//   - *InvalidCallInterfaceError with Field "avalue" if len(avalue) differs
+//     from the argument count or an argument pointer is nil
 //   - ErrFunctionCallFailed if the call execution fails
 //
 // Example:
@@ -885,16 +885,14 @@ func TestWindowsStackArguments10Args(t *testing.T) {
 // math.Float32bits (preserving the 32-bit IEEE-754 bit pattern) rather than
 // widening to float64, which would corrupt the value seen by the callee.
 //
-// Uses modff(float, *float) -> float on Unix systems to verify both argument
-// encoding and that the intpart output pointer receives the correct value.
-//
-// On Windows, float return values from XMM0 are a known limitation of
-// syscall.SyscallN (TASK-019, GAP-7). We verify only the intpart output.
+// Uses modff(float, *float) -> float to verify argument encoding, the float
+// return value in XMM0 and that the intpart output pointer receives the
+// correct value.
 //
 // Regression test for TASK-013 / GAP-3.
 func TestFloat32ArgEncoding(t *testing.T) {
-	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" {
-		t.Skip("Test requires Linux or macOS (modff with float return, Unix ABI)")
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" && runtime.GOOS != "windows" {
+		t.Skip("Test requires Linux, macOS or Windows (modff with float return)")
 	}
 
 	// Use modff: modff(float, *float) -> float
@@ -905,6 +903,8 @@ func TestFloat32ArgEncoding(t *testing.T) {
 		libName = "libm.so.6"
 	case "darwin":
 		libName = "libSystem.B.dylib"
+	case "windows":
+		libName = "msvcrt.dll"
 	default:
 		t.Skip("Unsupported OS")
 	}
@@ -921,7 +921,7 @@ func TestFloat32ArgEncoding(t *testing.T) {
 	}
 
 	cif := &types.CallInterface{}
-	err = PrepareCallInterface(cif, types.UnixCallingConvention,
+	err = PrepareCallInterface(cif, types.DefaultCall,
 		types.FloatTypeDescriptor,
 		[]*types.TypeDescriptor{types.FloatTypeDescriptor, types.PointerTypeDescriptor},
 	)
@@ -989,7 +989,7 @@ func TestOverflowDetection(t *testing.T) {
 	// Build a CIF with 20 pointer arguments — far beyond any platform's capacity.
 	// System V AMD64: 6 GP regs + 9 stack slots = 15 max GP args.
 	// ARM64: 8 GP regs + 7 stack slots = 15 max GP args.
-	// Windows: syscall.SyscallN supports up to 15 args.
+	// Windows: 4 register slots + 11 stack slots = 15 max args (MaxWin64Args).
 	const tooMany = 20
 
 	argTypes := make([]*types.TypeDescriptor, tooMany)
@@ -0,0 +1,483 @@
+// Package ffireplay records the native calls made through goffi and replays
+// them later without loading the native library, so binding layers can be
+// tested deterministically on machines that lack the library, a GPU or a
+// driver.
+//
+// Record once on a machine that has the library, by handing a Recorder to
+// the binding code in place of ffi.Native:
+//
+//	f, err := os.Create("testdata/wgpu.replay")
+//	...
+//	rec := ffireplay.NewRecorder(ffi.Native{}, ffi.Native{}, f)
+//	version, err := mybinding.Version(rec, rec)
+//
+// Then replay the file anywhere:
+//
+//	f, err := os.Open("testdata/wgpu.replay")
+//	...
+//	rp, err := ffireplay.NewReplayer(f)
+//	version, err := mybinding.Version(rp, rp)
+//
+// A recording holds, per call, the library and symbol name, each argument's
+// bytes as described by the call interface, and the returned bytes. Only
+// these are reproduced: memory written by the native code through pointer
+// arguments is not recorded, and pointers returned by a replayed call are
+// the addresses of the recording run, usable as opaque handles but not
+// dereferenceable.
+//
+// The file is a sequence of JSON lines, one per library load, symbol lookup
+// and call, in the order they happened.
+package ffireplay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// formatVersion is written in the header line of every recording.
+const formatVersion = 1
+
+// Entry operations.
+const (
+	opHeader = "goffi-replay"
+	opLoad   = "load"
+	opSymbol = "symbol"
+	opCall   = "call"
+)
+
+// entry is one line of a recording. Byte slices are base64 in JSON.
+type entry struct {
+	Op      string   `json:"op"`
+	Version int      `json:"version,omitempty"`
+	Library string   `json:"library,omitempty"`
+	Symbol  string   `json:"symbol,omitempty"`
+	Args    [][]byte `json:"args,omitempty"`
+	Ret     []byte   `json:"ret,omitempty"`
+	Err     string   `json:"err,omitempty"`
+}
+
+var (
+	// ErrUnknownFunction is returned by CallFunction for a function pointer
+	// that was not obtained from the same Recorder's or Replayer's GetSymbol.
+	ErrUnknownFunction = errors.New("ffireplay: unknown function pointer")
+
+	// ErrExhausted is returned by Replayer.CallFunction when every recorded
+	// call of the function has already been replayed.
+	ErrExhausted = errors.New("ffireplay: no recorded call left")
+)
+
+// MismatchError is returned by Replayer.CallFunction when the arguments of
+// a call differ from those of the next recorded call of the function.
+type MismatchError struct {
+	Symbol string
+	Index  int // Argument index, -1 for a different argument count
+}
+
+func (e *MismatchError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("ffireplay: %s: argument count differs from the recording", e.Symbol)
+	}
+	return fmt.Sprintf("ffireplay: %s: argument %d differs from the recording", e.Symbol, e.Index)
+}
+
+// Recorder is an ffi.Loader and ffi.Caller that forwards to another one and
+// writes every successful load and lookup, and every call, to a recording.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	loader ffi.Loader
+	caller ffi.Caller
+
+	mu      sync.Mutex
+	w       io.Writer
+	err     error                     // First write error
+	header  bool                      // Header line written
+	libs    map[unsafe.Pointer]string // Handle -> library name
+	symbols map[unsafe.Pointer]recordedSymbol
+}
+
+type recordedSymbol struct {
+	library, name string
+}
+
+var (
+	_ ffi.Loader = (*Recorder)(nil)
+	_ ffi.Caller = (*Recorder)(nil)
+)
+
+// NewRecorder returns a Recorder that loads libraries with loader, calls
+// functions with caller and writes the recording to w. Pass ffi.Native{} for
+// both to record real native calls.
+func NewRecorder(loader ffi.Loader, caller ffi.Caller, w io.Writer) *Recorder {
+	return &Recorder{
+		loader:  loader,
+		caller:  caller,
+		w:       w,
+		libs:    make(map[unsafe.Pointer]string),
+		symbols: make(map[unsafe.Pointer]recordedSymbol),
+	}
+}
+
+// Err returns the first error writing the recording, if any. Once writing
+// failed, the Recorder still forwards calls but records nothing more.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// write appends e to the recording. r.mu must be held.
+func (r *Recorder) write(e *entry) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.header {
+		r.header = true
+		if r.err = writeEntry(r.w, &entry{Op: opHeader, Version: formatVersion}); r.err != nil {
+			return r.err
+		}
+	}
+	r.err = writeEntry(r.w, e)
+	return r.err
+}
+
+func writeEntry(w io.Writer, e *entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(line, '\n'))
+	return err
+}
+
+// LoadLibrary loads name with the wrapped loader and records it.
+func (r *Recorder) LoadLibrary(name string) (unsafe.Pointer, error) {
+	handle, err := r.loader.LoadLibrary(name)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.libs[handle] = name
+	return handle, r.write(&entry{Op: opLoad, Library: name})
+}
+
+// GetSymbol resolves name with the wrapped loader and records it.
+func (r *Recorder) GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	sym, err := r.loader.GetSymbol(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lib := r.libs[handle]
+	r.symbols[sym] = recordedSymbol{library: lib, name: name}
+	return sym, r.write(&entry{Op: opSymbol, Library: lib, Symbol: name})
+}
+
+// FreeLibrary forwards to the wrapped loader. Unloads are not recorded.
+func (r *Recorder) FreeLibrary(handle unsafe.Pointer) error {
+	return r.loader.FreeLibrary(handle)
+}
+
+// CallFunction calls fn with the wrapped caller and records its arguments,
+// return value and error. fn must come from this Recorder's GetSymbol, or
+// the call could not be named in the recording.
+//
+// The native call has happened even when CallFunction returns an error
+// writing the recording.
+func (r *Recorder) CallFunction(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	r.mu.Lock()
+	sym, ok := r.symbols[fn]
+	r.mu.Unlock()
+	if !ok {
+		return ErrUnknownFunction
+	}
+
+	// Capture the arguments before the call, which may modify them.
+	var args [][]byte
+	if cif != nil && len(avalue) == len(cif.ArgTypes) {
+		args = make([][]byte, len(avalue))
+		for i, t := range cif.ArgTypes {
+			if avalue[i] != nil {
+				args[i] = bytes.Clone(unsafe.Slice((*byte)(avalue[i]), t.Size))
+			}
+		}
+	}
+
+	callErr := r.caller.CallFunction(cif, fn, rvalue, avalue)
+
+	e := &entry{Op: opCall, Library: sym.library, Symbol: sym.name, Args: args}
+	if callErr != nil {
+		e.Err = callErr.Error()
+	} else if rvalue != nil {
+		e.Ret = returnBytes(cif.ReturnType, rvalue)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.write(e); err != nil {
+		return err
+	}
+	return callErr
+}
+
+// returnBytes copies the return value at rvalue. String returns were decoded
+// into a Go string, whose contents are recorded instead of its header.
+func returnBytes(rt *types.TypeDescriptor, rvalue unsafe.Pointer) []byte {
+	switch {
+	case rt == nil || rt.Kind == types.VoidType:
+		return nil
+	case rt.CString || rt.WString:
+		return []byte(*(*string)(rvalue))
+	default:
+		return bytes.Clone(unsafe.Slice((*byte)(rvalue), rt.Size))
+	}
+}
+
+// Replayer is an ffi.Loader and ffi.Caller that serves a recording made by a
+// Recorder. It loads no native code: LoadLibrary succeeds for the libraries
+// in the recording, GetSymbol for their recorded symbols, and CallFunction
+// returns the recorded results. A Replayer is safe for concurrent use.
+//
+// Each function's recorded calls are replayed in order. A call must pass the
+// same argument bytes as its recording, except for pointers, whose values
+// differ between runs and are not compared.
+type Replayer struct {
+	mu      sync.Mutex
+	libs    map[string]*replayLibrary
+	handles map[unsafe.Pointer]*replayLibrary
+	funcs   map[unsafe.Pointer]*replaySymbol
+}
+
+type replayLibrary struct {
+	name    string
+	symbols map[string]*replaySymbol
+	refs    int
+}
+
+// replaySymbol is a recorded function; its address serves as the function
+// pointer handed out by GetSymbol.
+type replaySymbol struct {
+	name  string
+	calls []*entry // Recorded calls not replayed yet
+}
+
+var (
+	_ ffi.Loader = (*Replayer)(nil)
+	_ ffi.Caller = (*Replayer)(nil)
+)
+
+// NewReplayer reads a recording written by a Recorder.
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	p := &Replayer{
+		libs:    make(map[string]*replayLibrary),
+		handles: make(map[unsafe.Pointer]*replayLibrary),
+		funcs:   make(map[unsafe.Pointer]*replaySymbol),
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 64<<20)
+	for n := 1; sc.Scan(); n++ {
+		var e entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("ffireplay: line %d: %w", n, err)
+		}
+		switch e.Op {
+		case opHeader:
+			if e.Version != formatVersion {
+				return nil, fmt.Errorf("ffireplay: unsupported recording version %d", e.Version)
+			}
+		case opLoad:
+			p.library(e.Library)
+		case opSymbol:
+			p.symbol(e.Library, e.Symbol)
+		case opCall:
+			sym := p.symbol(e.Library, e.Symbol)
+			sym.calls = append(sym.calls, &e)
+		default:
+			return nil, fmt.Errorf("ffireplay: line %d: unknown operation %q", n, e.Op)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("ffireplay: %w", err)
+	}
+	return p, nil
+}
+
+func (p *Replayer) library(name string) *replayLibrary {
+	lib, ok := p.libs[name]
+	if !ok {
+		lib = &replayLibrary{name: name, symbols: make(map[string]*replaySymbol)}
+		p.libs[name] = lib
+	}
+	return lib
+}
+
+func (p *Replayer) symbol(library, name string) *replaySymbol {
+	lib := p.library(library)
+	sym, ok := lib.symbols[name]
+	if !ok {
+		sym = &replaySymbol{name: name}
+		lib.symbols[name] = sym
+		p.funcs[unsafe.Pointer(sym)] = sym
+	}
+	return sym
+}
+
+// LoadLibrary returns a handle for a library in the recording, or an
+// *ffi.LibraryError like the real loader for any other name.
+func (p *Replayer) LoadLibrary(name string) (unsafe.Pointer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lib, ok := p.libs[name]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "load", Name: name, Err: errors.New("library not in the recording")}
+	}
+	lib.refs++
+	handle := unsafe.Pointer(lib)
+	p.handles[handle] = lib
+	return handle, nil
+}
+
+// GetSymbol returns the function pointer for a symbol in the recording.
+func (p *Replayer) GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lib, ok := p.handles[handle]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: errors.New("invalid library handle")}
+	}
+	sym, ok := lib.symbols[name]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: errors.New("symbol not in the recording")}
+	}
+	return unsafe.Pointer(sym), nil
+}
+
+// FreeLibrary releases a handle from LoadLibrary. Like the real FreeLibrary
+// it accepts nil.
+func (p *Replayer) FreeLibrary(handle unsafe.Pointer) error {
+	if handle == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lib, ok := p.handles[handle]
+	if !ok {
+		return &ffi.LibraryError{Operation: "free", Name: "<library handle>", Err: errors.New("invalid library handle")}
+	}
+	lib.refs--
+	if lib.refs == 0 {
+		delete(p.handles, handle)
+	}
+	return nil
+}
+
+// CallFunction replays the next recorded call of fn: it checks the
+// arguments against the recording, stores the recorded return value in
+// rvalue and returns the recorded error, if any. A recorded error comes back
+// with its message only, not its original type.
+func (p *Replayer) CallFunction(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	if cif == nil || cif.ReturnType == nil {
+		return &ffi.InvalidCallInterfaceError{Field: "cif", Reason: "must be prepared", Index: -1}
+	}
+	if len(avalue) != len(cif.ArgTypes) {
+		return &ffi.InvalidCallInterfaceError{
+			Field:  "avalue",
+			Reason: fmt.Sprintf("got %d arguments, call interface has %d", len(avalue), len(cif.ArgTypes)),
+			Index:  -1,
+		}
+	}
+	for i, arg := range avalue {
+		if arg == nil {
+			return &ffi.InvalidCallInterfaceError{Field: "avalue", Reason: "argument pointer is nil", Index: i}
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sym, ok := p.funcs[fn]
+	if !ok {
+		return ErrUnknownFunction
+	}
+	if len(sym.calls) == 0 {
+		return fmt.Errorf("%w for %s", ErrExhausted, sym.name)
+	}
+	rec := sym.calls[0]
+
+	if len(rec.Args) != len(avalue) {
+		return &MismatchError{Symbol: sym.name, Index: -1}
+	}
+	for i, t := range cif.ArgTypes {
+		if !argumentsEqual(t, unsafe.Slice((*byte)(avalue[i]), t.Size), rec.Args[i]) {
+			return &MismatchError{Symbol: sym.name, Index: i}
+		}
+	}
+	sym.calls = sym.calls[1:]
+
+	if rec.Err != "" {
+		return errors.New(rec.Err)
+	}
+	if rvalue != nil && rec.Ret != nil {
+		if rt := cif.ReturnType; rt.CString || rt.WString {
+			*(*string)(rvalue) = string(rec.Ret)
+		} else {
+			copy(unsafe.Slice((*byte)(rvalue), rt.Size), rec.Ret)
+		}
+	}
+	return nil
+}
+
+// Remaining returns the number of recorded calls not replayed yet, so tests
+// can check that the binding made every call it made while recording.
+func (p *Replayer) Remaining() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := 0
+	for _, sym := range p.funcs {
+		n += len(sym.calls)
+	}
+	return n
+}
+
+// argumentsEqual compares an argument of type t with its recording,
+// skipping the bytes of pointers, including pointer members of structs.
+func argumentsEqual(t *types.TypeDescriptor, got, want []byte) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	masked := bytes.Clone(got)
+	wantMasked := bytes.Clone(want)
+	maskPointers(t, 0, masked)
+	maskPointers(t, 0, wantMasked)
+	return bytes.Equal(masked, wantMasked)
+}
+
+// maskPointers zeroes the pointers of type t stored at off in b. Struct
+// member offsets follow the C layout rules, as in the struct descriptors.
+func maskPointers(t *types.TypeDescriptor, off uintptr, b []byte) {
+	switch t.Kind {
+	case types.PointerType:
+		if end := off + t.Size; end <= uintptr(len(b)) {
+			clear(b[off:end])
+		}
+	case types.StructType:
+		var pos uintptr
+		for _, m := range t.Members {
+			if a := t.MemberAlignment(m); a > 1 {
+				pos = (pos + a - 1) &^ (a - 1)
+			}
+			maskPointers(m, off+pos, b)
+			pos += m.Size
+		}
+	}
+}
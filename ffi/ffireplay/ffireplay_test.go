@@ -0,0 +1,188 @@
+package ffireplay_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/ffireplay"
+	"github.com/go-webgpu/goffi/ffi/ffitest"
+	"github.com/go-webgpu/goffi/types"
+)
+
+var (
+	addCIF  types.CallInterface
+	nameCIF types.CallInterface
+)
+
+func init() {
+	if err := ffi.PrepareCallInterface(&addCIF, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt32TypeDescriptor, types.PointerTypeDescriptor}); err != nil {
+		panic(err)
+	}
+	if err := ffi.PrepareCallInterface(&nameCIF, types.DefaultCall, types.CStringTypeDescriptor, nil); err != nil {
+		panic(err)
+	}
+}
+
+// binding is code written against the interfaces, as a consumer would. It
+// adds a to the int32 at p and returns the library's name.
+func binding(loader ffi.Loader, caller ffi.Caller, a int32, p *int32) (int32, string, error) {
+	lib, err := loader.LoadLibrary("libdemo.so")
+	if err != nil {
+		return 0, "", err
+	}
+	defer loader.FreeLibrary(lib)
+	add, err := loader.GetSymbol(lib, "add")
+	if err != nil {
+		return 0, "", err
+	}
+	name, err := loader.GetSymbol(lib, "name")
+	if err != nil {
+		return 0, "", err
+	}
+
+	var sum int32
+	if err := caller.CallFunction(&addCIF, add, unsafe.Pointer(&sum),
+		[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&p)}); err != nil {
+		return 0, "", err
+	}
+	var s string
+	if err := caller.CallFunction(&nameCIF, name, unsafe.Pointer(&s), nil); err != nil {
+		return 0, "", err
+	}
+	return sum, s, nil
+}
+
+func newFake() *ffitest.Fake {
+	fake := ffitest.New()
+	fake.Library("libdemo.so").
+		Func("add", func(cif *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+			p := ffitest.Arg[*int32](avalue, 1)
+			ffitest.SetReturn(rvalue, ffitest.Arg[int32](avalue, 0)+*p)
+			return nil
+		}).
+		Func("name", func(cif *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+			ffitest.SetReturn(rvalue, "demo")
+			return nil
+		})
+	return fake
+}
+
+func record(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	fake := newFake()
+	rec := ffireplay.NewRecorder(fake, fake, &buf)
+	b := int32(40)
+	if sum, name, err := binding(rec, rec, 2, &b); err != nil || sum != 42 || name != "demo" {
+		t.Fatalf("recording: binding = %d, %q, %v", sum, name, err)
+	}
+	if err := rec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRecordReplay(t *testing.T) {
+	rp, err := ffireplay.NewReplayer(bytes.NewReader(record(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A different pointer is fine: pointer arguments are not compared.
+	other := int32(1000)
+	sum, name, err := binding(rp, rp, 2, &other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != 42 || name != "demo" {
+		t.Errorf("replay = %d, %q, want the recorded 42, \"demo\"", sum, name)
+	}
+	if n := rp.Remaining(); n != 0 {
+		t.Errorf("Remaining = %d, want 0", n)
+	}
+
+	if _, _, err := binding(rp, rp, 2, &other); !errors.Is(err, ffireplay.ErrExhausted) {
+		t.Errorf("second replay err = %v, want ErrExhausted", err)
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	rp, err := ffireplay.NewReplayer(bytes.NewReader(record(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := int32(40)
+	_, _, err = binding(rp, rp, 3, &b)
+	var mm *ffireplay.MismatchError
+	if !errors.As(err, &mm) || mm.Symbol != "add" || mm.Index != 0 {
+		t.Fatalf("err = %v, want MismatchError for argument 0 of add", err)
+	}
+	if n := rp.Remaining(); n != 2 {
+		t.Errorf("Remaining = %d, want the mismatched call left in place", n)
+	}
+}
+
+func TestReplayUnknownLibrary(t *testing.T) {
+	rp, err := ffireplay.NewReplayer(bytes.NewReader(record(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rp.LoadLibrary("libother.so")
+	var le *ffi.LibraryError
+	if !errors.As(err, &le) || le.Operation != "load" {
+		t.Errorf("err = %v, want *ffi.LibraryError", err)
+	}
+}
+
+func TestRecordedError(t *testing.T) {
+	fake := ffitest.New()
+	fake.Library("libdemo.so").Func("fail", func(*types.CallInterface, unsafe.Pointer, []unsafe.Pointer) error {
+		return errors.New("device lost")
+	})
+	var buf bytes.Buffer
+	rec := ffireplay.NewRecorder(fake, fake, &buf)
+	lib, _ := rec.LoadLibrary("libdemo.so")
+	fn, _ := rec.GetSymbol(lib, "fail")
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.CallFunction(&cif, fn, nil, nil); err == nil {
+		t.Fatal("recording: expected the call's error")
+	}
+
+	rp, err := ffireplay.NewReplayer(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lib, _ = rp.LoadLibrary("libdemo.so")
+	fn, _ = rp.GetSymbol(lib, "fail")
+	if err := rp.CallFunction(&cif, fn, nil, nil); err == nil || err.Error() != "device lost" {
+		t.Errorf("replay err = %v, want \"device lost\"", err)
+	}
+}
+
+func TestRecorderUnknownFunction(t *testing.T) {
+	fake := newFake()
+	rec := ffireplay.NewRecorder(fake, fake, &bytes.Buffer{})
+	var x int
+	if err := rec.CallFunction(&nameCIF, unsafe.Pointer(&x), nil, nil); !errors.Is(err, ffireplay.ErrUnknownFunction) {
+		t.Errorf("err = %v, want ErrUnknownFunction", err)
+	}
+}
+
+func TestReplayerRejectsBadInput(t *testing.T) {
+	for _, in := range []string{
+		"not json\n",
+		`{"op":"goffi-replay","version":99}` + "\n",
+		`{"op":"teleport"}` + "\n",
+	} {
+		if _, err := ffireplay.NewReplayer(bytes.NewBufferString(in)); err == nil {
+			t.Errorf("NewReplayer(%q) succeeded", in)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Package ffislog routes goffi trace events to log/slog, so native calls,
+// library loads and callback registrations land in the application's
+// regular logging pipeline:
+//
+//	ffi.SetTraceHook(ffislog.Hook(slog.Default()))
+//
+// Every record carries the attribute keys below. Calls are logged at
+// slog.LevelDebug since they are frequent; everything else at
+// slog.LevelInfo, and any event that reports an error at slog.LevelError.
+//
+// The hook remembers the names of libraries and symbols it has seen loaded
+// and resolved, so call and unload records name their target even though
+// the events themselves only carry its address.
+package ffislog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/go-webgpu/goffi/ffi"
+)
+
+// Attribute keys used in every record.
+const (
+	KeyEvent    = "ffi.event"    // TraceKind name: "call", "load", "unload", ...
+	KeyLibrary  = "ffi.library"  // Library name
+	KeySymbol   = "ffi.symbol"   // Symbol name
+	KeyAddr     = "ffi.addr"     // Function, symbol, handle or callback address, in hex
+	KeyArgs     = "ffi.args"     // Number of call arguments
+	KeyDuration = "ffi.duration" // Time spent in the operation
+	KeyError    = "ffi.error"    // Error reported by the operation
+)
+
+// Options configures HookWithOptions. A nil *Options or zero field selects
+// the default.
+type Options struct {
+	CallLevel  slog.Leveler // Level of successful call records; default slog.LevelDebug
+	Level      slog.Leveler // Level of other successful records; default slog.LevelInfo
+	ErrorLevel slog.Leveler // Level of records reporting an error; default slog.LevelError
+}
+
+// Hook returns an ffi.TraceHook that logs every event to logger with the
+// default levels.
+func Hook(logger *slog.Logger) ffi.TraceHook {
+	return HookWithOptions(logger, nil)
+}
+
+// HookWithOptions is Hook with configurable levels.
+func HookWithOptions(logger *slog.Logger, opts *Options) ffi.TraceHook {
+	a := &adapter{
+		logger:     logger,
+		callLevel:  slog.LevelDebug,
+		level:      slog.LevelInfo,
+		errorLevel: slog.LevelError,
+	}
+	if opts != nil {
+		if opts.CallLevel != nil {
+			a.callLevel = opts.CallLevel
+		}
+		if opts.Level != nil {
+			a.level = opts.Level
+		}
+		if opts.ErrorLevel != nil {
+			a.errorLevel = opts.ErrorLevel
+		}
+	}
+	return a.handle
+}
+
+type adapter struct {
+	logger                       *slog.Logger
+	callLevel, level, errorLevel slog.Leveler
+	libraries, symbols           sync.Map // uintptr -> string
+}
+
+func (a *adapter) handle(ev ffi.TraceEvent) {
+	// Keep the name maps current even when the record itself is filtered
+	// out: a later call may be logged at a more verbose level.
+	switch ev.Kind {
+	case ffi.TraceLoad:
+		if ev.Err == nil {
+			a.libraries.Store(ev.Addr, ev.Name)
+		}
+	case ffi.TraceSymbol:
+		if ev.Err == nil {
+			a.symbols.Store(ev.Addr, ev.Name)
+		}
+	}
+
+	level := a.level.Level()
+	switch {
+	case ev.Err != nil:
+		level = a.errorLevel.Level()
+	case ev.Kind == ffi.TraceCall:
+		level = a.callLevel.Level()
+	}
+
+	ctx := context.Background()
+	if !a.logger.Enabled(ctx, level) {
+		if ev.Kind == ffi.TraceUnload && ev.Err == nil {
+			a.libraries.Delete(ev.Addr)
+		}
+		return
+	}
+
+	attrs := make([]slog.Attr, 0, 6)
+	attrs = append(attrs, slog.String(KeyEvent, ev.Kind.String()))
+	switch ev.Kind {
+	case ffi.TraceLoad:
+		attrs = append(attrs, slog.String(KeyLibrary, ev.Name))
+	case ffi.TraceSymbol:
+		attrs = append(attrs, slog.String(KeySymbol, ev.Name))
+	case ffi.TraceUnload:
+		if name, ok := a.libraries.Load(ev.Addr); ok {
+			attrs = append(attrs, slog.String(KeyLibrary, name.(string)))
+		}
+		if ev.Err == nil {
+			a.libraries.Delete(ev.Addr)
+		}
+	case ffi.TraceCall:
+		if name, ok := a.symbols.Load(ev.Addr); ok {
+			attrs = append(attrs, slog.String(KeySymbol, name.(string)))
+		}
+		attrs = append(attrs, slog.Int(KeyArgs, ev.Args))
+	}
+	attrs = append(attrs, slog.String(KeyAddr, fmt.Sprintf("%#x", ev.Addr)))
+	if ev.Kind == ffi.TraceCall || ev.Kind == ffi.TraceLoad {
+		attrs = append(attrs, slog.Duration(KeyDuration, ev.Duration))
+	}
+	if ev.Err != nil {
+		attrs = append(attrs, slog.String(KeyError, ev.Err.Error()))
+	}
+
+	a.logger.LogAttrs(ctx, level, "ffi "+ev.Kind.String(), attrs...)
+}
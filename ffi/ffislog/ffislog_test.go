@@ -0,0 +1,107 @@
+package ffislog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/ffislog"
+)
+
+// records decodes the JSON lines written by a slog.JSONHandler.
+func records(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var out []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("decode %q: %v", line, err)
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func newHook(level slog.Level) (ffi.TraceHook, *bytes.Buffer) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: level}))
+	return ffislog.Hook(logger), &buf
+}
+
+func TestHookNamesCallsAndUnloads(t *testing.T) {
+	hook, buf := newHook(slog.LevelDebug)
+	hook(ffi.TraceEvent{Kind: ffi.TraceLoad, Name: "libm.so.6", Addr: 0x1000})
+	hook(ffi.TraceEvent{Kind: ffi.TraceSymbol, Name: "cos", Addr: 0x2000})
+	hook(ffi.TraceEvent{Kind: ffi.TraceCall, Addr: 0x2000, Args: 1})
+	hook(ffi.TraceEvent{Kind: ffi.TraceUnload, Addr: 0x1000})
+
+	recs := records(t, buf)
+	if len(recs) != 4 {
+		t.Fatalf("got %d records, want 4", len(recs))
+	}
+	call := recs[2]
+	if call["msg"] != "ffi call" || call[ffislog.KeyEvent] != "call" || call["level"] != "DEBUG" {
+		t.Errorf("call record = %v", call)
+	}
+	if call[ffislog.KeySymbol] != "cos" || call[ffislog.KeyAddr] != "0x2000" || call[ffislog.KeyArgs] != 1.0 {
+		t.Errorf("call record = %v, want symbol cos, addr 0x2000, 1 arg", call)
+	}
+	if recs[3][ffislog.KeyLibrary] != "libm.so.6" || recs[3]["level"] != "INFO" {
+		t.Errorf("unload record = %v, want library libm.so.6 at INFO", recs[3])
+	}
+}
+
+func TestHookErrorLevel(t *testing.T) {
+	hook, buf := newHook(slog.LevelInfo)
+	hook(ffi.TraceEvent{Kind: ffi.TraceLoad, Name: "libmissing.so", Err: errors.New("not found")})
+	hook(ffi.TraceEvent{Kind: ffi.TraceCall, Addr: 0x2000}) // Below Info, dropped
+
+	recs := records(t, buf)
+	if len(recs) != 1 {
+		t.Fatalf("got %d records, want 1", len(recs))
+	}
+	if recs[0]["level"] != "ERROR" || recs[0][ffislog.KeyError] != "not found" {
+		t.Errorf("record = %v, want ERROR with %s", recs[0], ffislog.KeyError)
+	}
+}
+
+func TestHookRemembersFilteredSymbols(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	hook := ffislog.HookWithOptions(logger, &ffislog.Options{
+		Level:     slog.LevelDebug - 1, // Symbols are dropped by the handler
+		CallLevel: slog.LevelWarn,
+	})
+	hook(ffi.TraceEvent{Kind: ffi.TraceSymbol, Name: "sin", Addr: 0x3000})
+	hook(ffi.TraceEvent{Kind: ffi.TraceCall, Addr: 0x3000})
+
+	recs := records(t, &buf)
+	if len(recs) != 1 || recs[0][ffislog.KeySymbol] != "sin" || recs[0]["level"] != "WARN" {
+		t.Errorf("records = %v, want one WARN call naming sin", recs)
+	}
+}
+
+func TestHookWithSetTraceHook(t *testing.T) {
+	hook, buf := newHook(slog.LevelDebug)
+	prev := ffi.SetTraceHook(hook)
+	defer ffi.SetTraceHook(prev)
+
+	if _, err := ffi.LoadLibrary("libgoffi-does-not-exist.so"); err == nil {
+		t.Skip("library unexpectedly loaded")
+	}
+	recs := records(t, buf)
+	if len(recs) == 0 {
+		t.Fatal("no record for the failed load")
+	}
+	last := recs[len(recs)-1]
+	if last[ffislog.KeyEvent] != "load" || last[ffislog.KeyLibrary] != "libgoffi-does-not-exist.so" || last[ffislog.KeyError] == nil {
+		t.Errorf("record = %v, want failed load", last)
+	}
+}
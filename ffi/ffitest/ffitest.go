@@ -0,0 +1,232 @@
+// Package ffitest provides an in-memory implementation of ffi.Loader and
+// ffi.Caller for unit-testing code built on goffi without loading native
+// libraries.
+//
+// Register fake libraries and functions, hand the Fake to the code under test
+// in place of ffi.Native, then inspect the recorded calls:
+//
+//	fake := ffitest.New()
+//	fake.Library("libwgpu_native.so").
+//	    Func("wgpuGetVersion", func(cif *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+//	        ffitest.SetReturn(rvalue, uint32(0x00190000))
+//	        return nil
+//	    })
+//
+//	version, err := mybinding.Version(fake, fake)
+//	// fake.Calls() lists every call made, with its arguments.
+package ffitest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Func is the Go implementation of a fake native function. It receives the
+// call exactly as ffi.CallFunction would: rvalue points to the return buffer
+// (nil for void) and avalue[i] points to the i-th argument.
+type Func func(cif *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error
+
+// Call is one recorded call to a fake function.
+type Call struct {
+	Library string
+	Symbol  string
+	CIF     *types.CallInterface
+	Args    []unsafe.Pointer // avalue as passed; the values may have changed since
+}
+
+// Fake is an in-memory ffi.Loader and ffi.Caller. The zero value is not
+// usable; create one with New. A Fake is safe for concurrent use.
+type Fake struct {
+	mu      sync.Mutex
+	libs    map[string]*Library
+	handles map[unsafe.Pointer]*Library
+	funcs   map[unsafe.Pointer]*symbol
+	calls   []Call
+}
+
+// Library is a fake shared library registered with Fake.Library.
+type Library struct {
+	fake    *Fake
+	name    string
+	symbols map[string]*symbol
+	refs    int
+}
+
+// symbol is a registered function; its address serves as the fake function
+// pointer.
+type symbol struct {
+	lib  *Library
+	name string
+	impl Func
+}
+
+var (
+	_ ffi.Loader = (*Fake)(nil)
+	_ ffi.Caller = (*Fake)(nil)
+)
+
+// ErrUnknownFunction is returned by CallFunction for a function pointer that
+// was not obtained from this Fake's GetSymbol.
+var ErrUnknownFunction = errors.New("ffitest: unknown function pointer")
+
+// New returns an empty Fake.
+func New() *Fake {
+	return &Fake{
+		libs:    make(map[string]*Library),
+		handles: make(map[unsafe.Pointer]*Library),
+		funcs:   make(map[unsafe.Pointer]*symbol),
+	}
+}
+
+// Library registers (or returns the already registered) fake library name,
+// which LoadLibrary(name) will then succeed for.
+func (f *Fake) Library(name string) *Library {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lib, ok := f.libs[name]
+	if !ok {
+		lib = &Library{fake: f, name: name, symbols: make(map[string]*symbol)}
+		f.libs[name] = lib
+	}
+	return lib
+}
+
+// Func registers impl as the function name in the library and returns the
+// library for chaining. Registering a name again replaces its implementation;
+// a nil impl makes calls succeed without touching rvalue.
+func (l *Library) Func(name string, impl Func) *Library {
+	l.fake.mu.Lock()
+	defer l.fake.mu.Unlock()
+	if sym, ok := l.symbols[name]; ok {
+		sym.impl = impl
+		return l
+	}
+	sym := &symbol{lib: l, name: name, impl: impl}
+	l.symbols[name] = sym
+	l.fake.funcs[unsafe.Pointer(sym)] = sym
+	return l
+}
+
+// LoadLibrary returns a handle for a registered library, or an
+// *ffi.LibraryError like the real loader if name is unknown.
+func (f *Fake) LoadLibrary(name string) (unsafe.Pointer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lib, ok := f.libs[name]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "load", Name: name, Err: errors.New("library not registered with ffitest.Fake")}
+	}
+	lib.refs++
+	handle := unsafe.Pointer(lib)
+	f.handles[handle] = lib
+	return handle, nil
+}
+
+// GetSymbol returns the fake function pointer for a registered function.
+func (f *Fake) GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lib, ok := f.handles[handle]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: errors.New("invalid library handle")}
+	}
+	sym, ok := lib.symbols[name]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("symbol not found")}
+	}
+	return unsafe.Pointer(sym), nil
+}
+
+// FreeLibrary releases a handle from LoadLibrary. Like the real FreeLibrary it
+// accepts nil. Freeing a handle more often than it was loaded is an error.
+func (f *Fake) FreeLibrary(handle unsafe.Pointer) error {
+	if handle == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lib, ok := f.handles[handle]
+	if !ok {
+		return &ffi.LibraryError{Operation: "free", Name: "<library handle>", Err: errors.New("invalid library handle")}
+	}
+	lib.refs--
+	if lib.refs == 0 {
+		delete(f.handles, handle)
+	}
+	return nil
+}
+
+// CallFunction records the call and runs the registered implementation of fn.
+// It validates its arguments the way ffi.CallFunction does.
+func (f *Fake) CallFunction(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	if cif == nil {
+		return &ffi.InvalidCallInterfaceError{Field: "cif", Reason: "must not be nil", Index: -1}
+	}
+	if fn == nil {
+		return &ffi.InvalidCallInterfaceError{Field: "fn", Reason: "function pointer must not be nil", Index: -1}
+	}
+	if len(avalue) != cif.ArgCount {
+		return &ffi.InvalidCallInterfaceError{
+			Field:  "avalue",
+			Reason: fmt.Sprintf("got %d argument pointers, call interface expects %d", len(avalue), cif.ArgCount),
+			Index:  -1,
+		}
+	}
+	for i, arg := range avalue {
+		if arg == nil {
+			return &ffi.InvalidCallInterfaceError{Field: "avalue", Reason: "argument pointer must not be nil", Index: i}
+		}
+	}
+
+	f.mu.Lock()
+	sym, ok := f.funcs[fn]
+	if !ok {
+		f.mu.Unlock()
+		return ErrUnknownFunction
+	}
+	f.calls = append(f.calls, Call{Library: sym.lib.name, Symbol: sym.name, CIF: cif, Args: avalue})
+	impl := sym.impl
+	f.mu.Unlock()
+
+	if impl == nil {
+		return nil
+	}
+	return impl(cif, rvalue, avalue)
+}
+
+// Calls returns the calls recorded so far, oldest first.
+func (f *Fake) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Call(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *Fake) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Arg returns the i-th argument of a call as a T. It panics if i is out of
+// range. T must match the argument's C type.
+func Arg[T any](avalue []unsafe.Pointer, i int) T {
+	return *(*T)(avalue[i])
+}
+
+// SetReturn stores v in the return buffer. It is a no-op if rvalue is nil.
+func SetReturn[T any](rvalue unsafe.Pointer, v T) {
+	if rvalue != nil {
+		*(*T)(rvalue) = v
+	}
+}
@@ -0,0 +1,95 @@
+package ffitest_test
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/ffitest"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// add is binding code written against the interfaces, as a consumer would.
+func add(loader ffi.Loader, caller ffi.Caller, a, b int32) (int32, error) {
+	lib, err := loader.LoadLibrary("libmath.so")
+	if err != nil {
+		return 0, err
+	}
+	defer loader.FreeLibrary(lib)
+	fn, err := loader.GetSymbol(lib, "add")
+	if err != nil {
+		return 0, err
+	}
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt32TypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+		return 0, err
+	}
+	var sum int32
+	err = caller.CallFunction(&cif, fn, unsafe.Pointer(&sum),
+		[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b)})
+	return sum, err
+}
+
+func TestFake(t *testing.T) {
+	fake := ffitest.New()
+	fake.Library("libmath.so").Func("add", func(_ *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+		ffitest.SetReturn(rvalue, ffitest.Arg[int32](avalue, 0)+ffitest.Arg[int32](avalue, 1))
+		return nil
+	})
+
+	sum, err := add(fake, fake, 2, 40)
+	if err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	if sum != 42 {
+		t.Errorf("add(2, 40) = %d, want 42", sum)
+	}
+
+	calls := fake.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("recorded %d calls, want 1", len(calls))
+	}
+	if calls[0].Library != "libmath.so" || calls[0].Symbol != "add" || len(calls[0].Args) != 2 {
+		t.Errorf("unexpected call record %+v", calls[0])
+	}
+	fake.Reset()
+	if len(fake.Calls()) != 0 {
+		t.Error("Reset did not clear recorded calls")
+	}
+}
+
+func TestFakeErrors(t *testing.T) {
+	fake := ffitest.New()
+	fake.Library("libempty.so")
+
+	var libErr *ffi.LibraryError
+	if _, err := fake.LoadLibrary("libmissing.so"); !errors.As(err, &libErr) || libErr.Operation != "load" {
+		t.Errorf("LoadLibrary(missing) = %v, want load LibraryError", err)
+	}
+
+	handle, err := fake.LoadLibrary("libempty.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fake.GetSymbol(handle, "nope"); !errors.As(err, &libErr) || libErr.Operation != "symbol" {
+		t.Errorf("GetSymbol(missing) = %v, want symbol LibraryError", err)
+	}
+
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	var x int
+	if err := fake.CallFunction(&cif, unsafe.Pointer(&x), nil, nil); !errors.Is(err, ffitest.ErrUnknownFunction) {
+		t.Errorf("CallFunction(unknown) = %v, want ErrUnknownFunction", err)
+	}
+
+	if err := fake.FreeLibrary(handle); err != nil {
+		t.Errorf("FreeLibrary failed: %v", err)
+	}
+	if err := fake.FreeLibrary(handle); err == nil {
+		t.Error("expected error freeing a handle twice")
+	}
+}
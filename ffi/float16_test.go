@@ -0,0 +1,68 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func requireFloat16Symbol(t *testing.T, name string) unsafe.Pointer {
+	t.Helper()
+	requireStructLib(t)
+	sym, err := GetSymbol(structTestLib, name)
+	if err != nil {
+		t.Skipf("%s not built (compiler without _Float16): %v", name, err)
+	}
+	return sym
+}
+
+func TestFloat16Scalars(t *testing.T) {
+	sym := requireFloat16Symbol(t, "half_fma")
+
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.Float16TypeDescriptor,
+		[]*types.TypeDescriptor{types.Float16TypeDescriptor, types.FloatTypeDescriptor, types.Float16TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	a, b, c := types.Float16FromFloat32(1.5), float32(4), types.Float16FromFloat32(-0.25)
+	var result types.Float16
+	if err := CallFunction(&cif, sym, unsafe.Pointer(&result),
+		[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&c)}); err != nil {
+		t.Fatal(err)
+	}
+	if got := result.Float32(); got != 5.75 {
+		t.Errorf("half_fma(1.5, 4, -0.25) = %v, want 5.75", got)
+	}
+}
+
+func TestFloat16Struct(t *testing.T) {
+	sym := requireFloat16Symbol(t, "half2_sum")
+
+	half2 := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.Float16TypeDescriptor, types.Float16TypeDescriptor},
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.Float16TypeDescriptor,
+		[]*types.TypeDescriptor{half2, types.SInt64TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	if half2.Size != 4 || half2.Alignment != 2 {
+		t.Fatalf("struct half2 layout = %d/%d, want 4/2", half2.Size, half2.Alignment)
+	}
+
+	v := [2]types.Float16{types.Float16FromFloat32(0.5), types.Float16FromFloat32(2.25)}
+	scale := int64(3)
+	var result types.Float16
+	if err := CallFunction(&cif, sym, unsafe.Pointer(&result),
+		[]unsafe.Pointer{unsafe.Pointer(&v), unsafe.Pointer(&scale)}); err != nil {
+		t.Fatal(err)
+	}
+	if got := result.Float32(); got != 8.25 {
+		t.Errorf("half2_sum({0.5, 2.25}, 3) = %v, want 8.25", got)
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// TestFloatReturns calls functions returning float and double with float
+// arguments mixed among integers, some of them on the stack.
+func TestFloatReturns(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("double", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "mixed_args")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.DoubleTypeDescriptor, []*types.TypeDescriptor{
+			types.SInt32TypeDescriptor, types.DoubleTypeDescriptor, types.FloatTypeDescriptor,
+			types.SInt64TypeDescriptor, types.FloatTypeDescriptor, types.DoubleTypeDescriptor,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range []struct {
+			a    int32
+			b    float64
+			c    float32
+			d    int64
+			e    float32
+			f    float64
+			want float64
+		}{
+			{1, 0.5, 0.25, 2, 0.125, 1.5, 1 + 1 + 1 + 16 + 2 + 48},
+			{-3, 0, 0, 0, 0, 0, -3},
+			{0, 0, 0.5, 0, 0, -0.25, 2 - 8},
+		} {
+			var got float64
+			if err := CallFunction(&cif, sym, unsafe.Pointer(&got), []unsafe.Pointer{
+				unsafe.Pointer(&tt.a), unsafe.Pointer(&tt.b), unsafe.Pointer(&tt.c),
+				unsafe.Pointer(&tt.d), unsafe.Pointer(&tt.e), unsafe.Pointer(&tt.f),
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mixed_args(%d, %v, %v, %d, %v, %v) = %v, want %v",
+					tt.a, tt.b, tt.c, tt.d, tt.e, tt.f, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("float", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "float_scale")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.FloatTypeDescriptor,
+			[]*types.TypeDescriptor{types.FloatTypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		x, n := float32(1.25), int32(-3)
+		var got float32
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+			[]unsafe.Pointer{unsafe.Pointer(&x), unsafe.Pointer(&n)}); err != nil {
+			t.Fatal(err)
+		}
+		if got != -3.75 {
+			t.Errorf("float_scale(1.25, -3) = %v, want -3.75", got)
+		}
+	})
+}
@@ -0,0 +1,15 @@
+//go:build (linux || darwin || freebsd) && amd64
+
+#include "textflag.h"
+#include "cet_amd64.h"
+
+// forkChildHandler is registered with pthread_atfork as the child handler.
+// It runs in the child on the forking thread with C calling convention and
+// must not touch the Go runtime: it only stores 1 into ·forkedChild.
+GLOBL ·forkChildHandlerABI0(SB), NOPTR|RODATA, $8
+DATA ·forkChildHandlerABI0(SB)/8, $forkChildHandler(SB)
+
+TEXT forkChildHandler(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
+	MOVL $1, ·forkedChild(SB)
+	RET
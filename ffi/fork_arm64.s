@@ -0,0 +1,16 @@
+//go:build (linux || darwin || freebsd) && arm64
+
+#include "textflag.h"
+
+// forkChildHandler is registered with pthread_atfork as the child handler.
+// It runs in the child on the forking thread with C calling convention and
+// must not touch the Go runtime: it only stores 1 into ·forkedChild.
+// Only caller-saved registers (R0, R1) are used.
+GLOBL ·forkChildHandlerABI0(SB), NOPTR|RODATA, $8
+DATA ·forkChildHandlerABI0(SB)/8, $forkChildHandler(SB)
+
+TEXT forkChildHandler(SB), NOSPLIT|NOFRAME, $0
+	MOVD $·forkedChild(SB), R0
+	MOVW $1, R1
+	MOVW R1, (R0)
+	RET
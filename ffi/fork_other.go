@@ -0,0 +1,20 @@
+//go:build !(((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm))
+
+package ffi
+
+import "runtime"
+
+// EnableForkSafety is only implemented on Unix platforms with pthread_atfork.
+func EnableForkSafety() error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// InForkedChild always reports false where fork safety is unavailable.
+func InForkedChild() bool {
+	return false
+}
+
+// checkForkedChild is a no-op where fork safety is unavailable.
+func checkForkedChild() error {
+	return nil
+}
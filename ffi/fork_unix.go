@@ -0,0 +1,120 @@
+//go:build ((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm)
+
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// forkedChild is set to 1 by forkChildHandler in the child after fork().
+// It is written from assembly without touching the Go runtime.
+var forkedChild uint32
+
+// forkChildHandlerABI0 is the address of the C-callable atfork child handler
+// implemented in fork_{amd64,arm64}.s.
+var forkChildHandlerABI0 uintptr
+
+var forkSafety struct {
+	once sync.Once
+	err  error
+}
+
+// forkLibraries lists where pthread_atfork lives on each platform.
+func forkLibraries() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{"/usr/lib/libSystem.B.dylib"}
+	case "freebsd":
+		return []string{"libthr.so.3", "libc.so.7"}
+	default:
+		return []string{"libc.so.6", "libpthread.so.0", "libc.so"}
+	}
+}
+
+// EnableForkSafety registers a pthread_atfork child handler so that a process
+// forked by native code (a library calling fork() or popen-style helpers) is
+// detected: every subsequent CallFunction in the child fails with
+// ErrForkedChild instead of running on a half-dead runtime.
+//
+// The handler is a few instructions of assembly that only sets a flag, so it
+// is async-signal-safe and never re-enters Go in the child. Registration
+// happens once per process; later calls return the first result.
+//
+// Forks performed by the Go runtime itself (os/exec, syscall.ForkExec) exec
+// immediately and are unaffected.
+func EnableForkSafety() error {
+	forkSafety.once.Do(func() {
+		forkSafety.err = registerAtfork()
+	})
+	return forkSafety.err
+}
+
+// InForkedChild reports whether the current process is a child created by
+// fork() after EnableForkSafety was called.
+func InForkedChild() bool {
+	return atomic.LoadUint32(&forkedChild) != 0
+}
+
+// checkForkedChild returns ErrForkedChild once the atfork handler has fired.
+func checkForkedChild() error {
+	if atomic.LoadUint32(&forkedChild) != 0 {
+		return ErrForkedChild
+	}
+	return nil
+}
+
+// registerAtfork installs forkChildHandler via pthread_atfork, falling back to
+// glibc's __register_atfork, which is what pthread_atfork expands to there.
+func registerAtfork() error {
+	var lastErr error
+	for _, name := range forkLibraries() {
+		handle, err := LoadLibrary(name)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		// int pthread_atfork(void (*prepare)(void), void (*parent)(void), void (*child)(void))
+		// int __register_atfork(prepare, parent, child, void *dso_handle)
+		args := []*types.TypeDescriptor{
+			types.PointerTypeDescriptor, types.PointerTypeDescriptor,
+			types.PointerTypeDescriptor, types.PointerTypeDescriptor,
+		}
+		fn, err := GetSymbol(handle, "pthread_atfork")
+		if err != nil {
+			fn, err = GetSymbol(handle, "__register_atfork")
+		} else {
+			args = args[:3]
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt32TypeDescriptor, args); err != nil {
+			return err
+		}
+		var prepare, parent, dso uintptr
+		child := forkChildHandlerABI0
+		avalue := []unsafe.Pointer{
+			unsafe.Pointer(&prepare), unsafe.Pointer(&parent),
+			unsafe.Pointer(&child), unsafe.Pointer(&dso),
+		}
+		var rc int32
+		if err := CallFunction(&cif, fn, unsafe.Pointer(&rc), avalue[:len(args)]); err != nil {
+			return err
+		}
+		if rc != 0 {
+			return &LibraryError{Operation: "symbol", Name: "pthread_atfork", Err: errors.New("registration failed")}
+		}
+		return nil
+	}
+	return lastErr
+}
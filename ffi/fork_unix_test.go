@@ -0,0 +1,37 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"sync/atomic"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestEnableForkSafety_ChildHandler(t *testing.T) {
+	if err := EnableForkSafety(); err != nil {
+		t.Fatalf("EnableForkSafety: %v", err)
+	}
+	if InForkedChild() {
+		t.Fatal("InForkedChild reported true in the parent")
+	}
+	defer atomic.StoreUint32(&forkedChild, 0)
+
+	// Invoke the registered child handler directly, as libc would in the child.
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	handler := forkChildHandlerABI0
+	if err := CallFunction(&cif, *(*unsafe.Pointer)(unsafe.Pointer(&handler)), nil, nil); err != nil {
+		t.Fatalf("calling child handler: %v", err)
+	}
+	if !InForkedChild() {
+		t.Fatal("child handler did not set the forked flag")
+	}
+	if err := CallFunction(&cif, *(*unsafe.Pointer)(unsafe.Pointer(&handler)), nil, nil); err != ErrForkedChild {
+		t.Fatalf("CallFunction after fork = %v, want ErrForkedChild", err)
+	}
+}
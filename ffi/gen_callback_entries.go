@@ -0,0 +1,65 @@
+//go:build ignore
+
+// gen_callback_entries writes the callback_entries_*.h files, the trampoline
+// table entries included by callback_table*_{amd64,arm,arm64}.s. The table is
+// split at 256 and 2000 so each maxCallbacks build size includes a prefix of
+// the same files.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+)
+
+// ranges are the [start, end) entry ranges of the generated files.
+var ranges = [][2]int{{0, 256}, {256, 2000}, {2000, 8000}}
+
+// entries writes the instructions of entry i for each architecture. Every
+// entry of an architecture must have the same size; callbackTrampoline's
+// comment in the matching callback_table*.s gives the layout.
+var entries = map[string]func(w *bufio.Writer, i int){
+	"amd64": func(w *bufio.Writer, i int) {
+		fmt.Fprintf(w, "\tENDBR64; BYTE $0x41; BYTE $0xbb; LONG $%d; JMP ·callbackDispatcher(SB); BYTE $0x90\n", i)
+	},
+	"arm64": func(w *bufio.Writer, i int) {
+		fmt.Fprintf(w, "\tMOVD $%d, R12\n", i)
+		fmt.Fprintf(w, "\tB ·callbackDispatcher(SB)\n")
+	},
+	// arm entries are identical: the dispatcher derives the index from the
+	// return address the BL leaves in R14.
+	"arm": func(w *bufio.Writer, _ int) {
+		fmt.Fprintf(w, "\tMOVW R14, R12\n")
+		fmt.Fprintf(w, "\tBL ·callbackDispatcher(SB)\n")
+	},
+}
+
+func main() {
+	for arch, entry := range entries {
+		for _, r := range ranges {
+			name := fmt.Sprintf("callback_entries_%d_%d_%s.h", r[0], r[1], arch)
+			if err := write(name, arch, r[0], r[1], entry); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
+
+func write(name, arch string, start, end int, entry func(*bufio.Writer, int)) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "// Code generated by 'go generate' with gen_callback_entries.go. DO NOT EDIT.\n")
+	fmt.Fprintf(w, "// Trampoline entries %d to %d, included by callback_table*_%s.s.\n", start, end-1, arch)
+	for i := start; i < end; i++ {
+		entry(w, i)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,105 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"bytes"
+	"debug/elf"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// ifuncResolvers returns, for each GNU IFUNC symbol of the loaded library
+// handle, the addresses of its resolvers (one per symbol version). The load
+// bias is derived from anchor, an ordinary function of the same library.
+func ifuncResolvers(t *testing.T, handle unsafe.Pointer, anchor string) map[string][]uintptr {
+	t.Helper()
+	v, err := QueryLibraryVersion(handle)
+	if err != nil {
+		t.Skipf("QueryLibraryVersion: %v", err)
+	}
+	f, err := elf.Open(v.Path)
+	if err != nil {
+		t.Skipf("open %s: %v", v.Path, err)
+	}
+	defer f.Close()
+	syms, err := f.DynamicSymbols()
+	if err != nil {
+		t.Skipf("dynamic symbols of %s: %v", v.Path, err)
+	}
+
+	anchorAddr, err := GetSymbol(handle, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bias uintptr
+	for _, s := range syms {
+		if s.Name == anchor && elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Section != elf.SHN_UNDEF {
+			bias = uintptr(anchorAddr) - uintptr(s.Value)
+		}
+	}
+	if bias == 0 {
+		t.Skipf("%s is not a plain function of %s", anchor, v.Path)
+	}
+
+	resolvers := make(map[string][]uintptr)
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_GNU_IFUNC && s.Section != elf.SHN_UNDEF {
+			resolvers[s.Name] = append(resolvers[s.Name], bias+uintptr(s.Value))
+		}
+	}
+	return resolvers
+}
+
+// TestGetSymbolIFUNC checks that symbols implemented with GNU indirect
+// functions, such as glibc's memcpy, resolve to the selected implementation
+// and not to the resolver, which would return a function pointer instead of
+// doing the work when called.
+func TestGetSymbolIFUNC(t *testing.T) {
+	handle, err := LoadLibrary("libc.so.6")
+	if err != nil {
+		t.Skipf("glibc not available: %v", err)
+	}
+	defer FreeLibrary(handle)
+
+	resolvers := ifuncResolvers(t, handle, "qsort")
+	if len(resolvers["memcpy"]) == 0 {
+		t.Skip("memcpy is not an IFUNC in this C library")
+	}
+
+	for name, addrs := range resolvers {
+		sym, err := GetSymbol(handle, name)
+		if err != nil {
+			continue // Versioned-only or hidden symbols
+		}
+		for _, r := range addrs {
+			if uintptr(sym) == r {
+				t.Errorf("GetSymbol(%s) = %p, the IFUNC resolver", name, sym)
+			}
+		}
+	}
+
+	memcpy, err := GetSymbol(handle, "memcpy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cif types.CallInterface
+	ptr := types.PointerTypeDescriptor
+	if err := PrepareCallInterface(&cif, types.DefaultCall, ptr,
+		[]*types.TypeDescriptor{ptr, ptr, types.UInt64TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	src := []byte("indirect function")
+	dst := make([]byte, len(src))
+	d, s, n := unsafe.Pointer(&dst[0]), unsafe.Pointer(&src[0]), uint64(len(src))
+	var ret unsafe.Pointer
+	if err := CallFunction(&cif, memcpy, unsafe.Pointer(&ret),
+		[]unsafe.Pointer{unsafe.Pointer(&d), unsafe.Pointer(&s), unsafe.Pointer(&n)}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dst, src) || ret != d {
+		t.Errorf("memcpy copied %q and returned %p, want %q and %p", dst, ret, src, d)
+	}
+}
@@ -0,0 +1,5 @@
+//go:build arm
+
+package ffi
+
+import _ "github.com/go-webgpu/goffi/internal/arch/arm"
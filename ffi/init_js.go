@@ -0,0 +1,5 @@
+//go:build js
+
+package ffi
+
+import _ "github.com/go-webgpu/goffi/internal/arch/wasm"
@@ -0,0 +1,5 @@
+//go:build !amd64 && !arm64 && !arm && !js
+
+package ffi
+
+import _ "github.com/go-webgpu/goffi/internal/arch/stubs"
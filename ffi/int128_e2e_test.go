@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"math/big"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestInt128(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("register pair", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "i128_mul")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt128TypeDescriptor,
+			[]*types.TypeDescriptor{types.SInt128TypeDescriptor, types.SInt64TypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		a, b := types.Int128{Lo: 3, Hi: 1}, int64(-4) // 2^64 + 3
+		var got types.Int128
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+			[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b)}); err != nil {
+			t.Fatal(err)
+		}
+		want := new(big.Int).Mul(a.Big(), big.NewInt(b))
+		if got.Big().Cmp(want) != 0 {
+			t.Errorf("i128_mul(2^64+3, -4) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("after five integers", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "u128_after_five")
+		if err != nil {
+			t.Fatal(err)
+		}
+		argTypes := make([]*types.TypeDescriptor, 7)
+		ints := []int64{1, 2, 3, 4, 5, 0, 10}
+		avalue := make([]unsafe.Pointer, 7)
+		for i := range ints {
+			argTypes[i] = types.SInt64TypeDescriptor
+			avalue[i] = unsafe.Pointer(&ints[i])
+		}
+		x := types.UInt128{Lo: 1 << 63, Hi: 7}
+		argTypes[5], avalue[5] = types.UInt128TypeDescriptor, unsafe.Pointer(&x)
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.UInt128TypeDescriptor, argTypes); err != nil {
+			t.Fatal(err)
+		}
+		var got types.UInt128
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got), avalue); err != nil {
+			t.Fatal(err)
+		}
+		if want := (types.UInt128{Lo: 1<<63 + 150, Hi: 7}); got != want {
+			t.Errorf("u128_after_five = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("struct member", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "i128_box_sum")
+		if err != nil {
+			t.Fatal(err)
+		}
+		box := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+			types.SInt32TypeDescriptor, types.SInt128TypeDescriptor,
+		}}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt128TypeDescriptor,
+			[]*types.TypeDescriptor{box}); err != nil {
+			t.Fatal(err)
+		}
+		arg := struct {
+			Tag int32
+			_   [12]byte
+			V   types.Int128
+		}{Tag: 5, V: types.Int128FromInt64(-9)}
+		var got types.Int128
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got), []unsafe.Pointer{unsafe.Pointer(&arg)}); err != nil {
+			t.Fatal(err)
+		}
+		if got != types.Int128FromInt64(-4) {
+			t.Errorf("i128_box_sum = %v, want -4", got)
+		}
+	})
+
+	t.Run("RegisterFunc", func(t *testing.T) {
+		var mul func(types.Int128, int64) types.Int128
+		if err := RegisterLibFunc(&mul, structTestLib, "i128_mul"); err != nil {
+			t.Fatal(err)
+		}
+		if got := mul(types.Int128FromInt64(-3), 1<<40); got != types.Int128FromInt64(-3<<40) {
+			t.Errorf("mul(-3, 2^40) = %v, want %d", got, int64(-3<<40))
+		}
+	})
+}
+
+func TestInt128UnsupportedConvention(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("arm64 has a single convention")
+	}
+	var cif types.CallInterface
+	err := PrepareCallInterface(&cif, types.WindowsCallingConvention, types.SInt128TypeDescriptor, nil)
+	var tve *TypeValidationError
+	if !errors.As(err, &tve) {
+		t.Fatalf("PrepareCallInterface with Win64 = %v, want a *TypeValidationError", err)
+	}
+}
@@ -0,0 +1,176 @@
+package ffi
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Call is one native call as seen by an Interceptor. Interceptors may
+// replace CIF, RValue and AValue (or the values AValue points to) before
+// passing the call on; the changed call is validated again before it runs.
+type Call struct {
+	Library unsafe.Pointer // Handle of the library Fn was resolved from
+	Symbol  string         // Name Fn was resolved under
+	CIF     *types.CallInterface
+	Fn      unsafe.Pointer
+	RValue  unsafe.Pointer
+	AValue  []unsafe.Pointer
+}
+
+// CallInvoker performs call: either the next interceptor of the chain or,
+// after the last one, the native call itself.
+type CallInvoker func(call *Call) error
+
+// Interceptor observes or alters a call made to a function of the library
+// it is installed on. It passes the call on by calling next, at most once;
+// returning without calling next short-circuits the call, in which case the
+// interceptor fills call.RValue itself. Interceptors run on the calling
+// goroutine and must be safe for concurrent use.
+//
+//	// A validation layer: reject NULL handles before they reach the driver.
+//	func validate(call *ffi.Call, next ffi.CallInvoker) error {
+//	    if len(call.AValue) > 0 && *(*uintptr)(call.AValue[0]) == 0 {
+//	        return fmt.Errorf("%s: NULL handle", call.Symbol)
+//	    }
+//	    return next(call)
+//	}
+type Interceptor func(call *Call, next CallInvoker) error
+
+// ErrNilInterceptor is returned by InterceptLibrary for a nil interceptor.
+var ErrNilInterceptor = errors.New("goffi: interceptor must not be nil")
+
+// symbolOwner records where GetSymbol found a function.
+type symbolOwner struct {
+	handle   unsafe.Pointer
+	name     string
+	usage    *libraryUsage // nil for handles LoadLibrary did not return
+	unloaded bool          // The library has been unloaded since
+}
+
+// interceptorEntry gives an installed interceptor an identity to remove it by.
+type interceptorEntry struct {
+	fn Interceptor
+}
+
+// symbolOwners maps function addresses returned by GetSymbol to their
+// library. chains holds the interceptors per library handle and is replaced
+// wholesale on every change, so the call path reads it without locking;
+// interceptorCount lets it skip both maps while nothing is installed.
+var (
+	symbolOwners     sync.Map // uintptr -> symbolOwner
+	chains           atomic.Pointer[map[unsafe.Pointer][]*interceptorEntry]
+	interceptorCount atomic.Int32
+	interceptorMu    sync.Mutex // serializes changes to chains
+)
+
+// trackSymbol records the library of a successful GetSymbol. It is deferred
+// with pointers to the call's named results.
+func trackSymbol(handle unsafe.Pointer, name string, sym *unsafe.Pointer, err *error) {
+	if *err == nil {
+		symbolOwners.Store(uintptr(*sym), symbolOwner{handle: handle, name: name, usage: libraryState(handle)})
+	}
+}
+
+// markSymbolsUnloaded marks the symbols of an unloaded library, so that
+// calls through them fail with ErrLibraryUnloaded. A later GetSymbol that
+// resolves the same address replaces the entry.
+func markSymbolsUnloaded(handle unsafe.Pointer) {
+	symbolOwners.Range(func(k, v any) bool {
+		if owner := v.(symbolOwner); owner.handle == handle && !owner.unloaded {
+			owner.unloaded = true
+			symbolOwners.CompareAndSwap(k, v, owner)
+		}
+		return true
+	})
+}
+
+// InterceptLibrary installs interceptors on every call made to a function
+// resolved from the library handle with GetSymbol (directly or through
+// LazySymbol, LookupSymbol or RegisterCallStub), whichever of CallFunction,
+// CallFunctionContext, CallFunctionWithOptions or CallFunctionArgs makes it.
+// Binding code does not change: validation layers, call recording or
+// timing are layered on from outside.
+//
+// Interceptors run in installation order, the first installed outermost,
+// and wrap call stubs and the trace hook. CallFunctionRegisters,
+// CallWithErrno and CallRaw are not intercepted. The returned function
+// removes the interceptors installed by this call; they otherwise outlive
+// FreeLibrary.
+func InterceptLibrary(handle unsafe.Pointer, interceptors ...Interceptor) (remove func(), err error) {
+	entries := make([]*interceptorEntry, len(interceptors))
+	for i, fn := range interceptors {
+		if fn == nil {
+			return nil, ErrNilInterceptor
+		}
+		entries[i] = &interceptorEntry{fn: fn}
+	}
+
+	updateChains(func(m map[unsafe.Pointer][]*interceptorEntry) {
+		m[handle] = append(slices.Clip(m[handle]), entries...)
+	})
+	interceptorCount.Add(int32(len(entries)))
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			updateChains(func(m map[unsafe.Pointer][]*interceptorEntry) {
+				chain := slices.DeleteFunc(slices.Clone(m[handle]), func(e *interceptorEntry) bool {
+					return slices.Contains(entries, e)
+				})
+				if len(chain) == 0 {
+					delete(m, handle)
+				} else {
+					m[handle] = chain
+				}
+			})
+			interceptorCount.Add(-int32(len(entries)))
+		})
+	}, nil
+}
+
+// updateChains applies change to a copy of the chains and publishes it.
+func updateChains(change func(map[unsafe.Pointer][]*interceptorEntry)) {
+	interceptorMu.Lock()
+	defer interceptorMu.Unlock()
+	m := make(map[unsafe.Pointer][]*interceptorEntry)
+	if old := chains.Load(); old != nil {
+		for k, v := range *old {
+			m[k] = v
+		}
+	}
+	change(m)
+	chains.Store(&m)
+}
+
+// interceptedCall returns the call to fn wrapped in the interceptors of its
+// library, or false if there are none.
+func interceptedCall(fn unsafe.Pointer) (*Call, []*interceptorEntry, bool) {
+	if interceptorCount.Load() == 0 {
+		return nil, nil, false
+	}
+	v, ok := symbolOwners.Load(uintptr(fn))
+	if !ok {
+		return nil, nil, false
+	}
+	owner := v.(symbolOwner)
+	chain := (*chains.Load())[owner.handle]
+	if len(chain) == 0 {
+		return nil, nil, false
+	}
+	return &Call{Library: owner.handle, Symbol: owner.name, Fn: fn}, chain, true
+}
+
+// runChain passes call through chain, ending in invoke.
+func runChain(call *Call, chain []*interceptorEntry, invoke CallInvoker) error {
+	if len(chain) == 0 {
+		return invoke(call)
+	}
+	return chain[0].fn(call, func(call *Call) error {
+		return runChain(call, chain[1:], invoke)
+	})
+}
@@ -0,0 +1,128 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestInterceptLibrary(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	call := func(s string) uint64 {
+		t.Helper()
+		b := append([]byte(s), 0)
+		p := unsafe.Pointer(&b[0])
+		var n uint64
+		if err := CallFunction(cif, strlen, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)}); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	var order []string
+	removeOuter, err := InterceptLibrary(handle, func(c *Call, next CallInvoker) error {
+		order = append(order, "outer "+c.Symbol)
+		if c.Library != handle {
+			t.Errorf("Call.Library = %p, want %p", c.Library, handle)
+		}
+		return next(c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	longer := append([]byte("replaced"), 0)
+	removeInner, err := InterceptLibrary(handle, func(c *Call, next CallInvoker) error {
+		order = append(order, "inner")
+		p := unsafe.Pointer(&longer[0])
+		c.AValue = []unsafe.Pointer{unsafe.Pointer(&p)}
+		return next(c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := call("abc"); n != 8 {
+		t.Errorf("strlen with replaced argument = %d, want 8", n)
+	}
+	if want := []string{"outer " + sym, "inner"}; !slices.Equal(order, want) {
+		t.Errorf("interceptor order = %q, want %q", order, want)
+	}
+
+	removeInner()
+	removeInner()
+	order = nil
+	if n := call("abc"); n != 3 || len(order) != 1 {
+		t.Errorf("after removing inner: strlen = %d, %d interceptors ran", n, len(order))
+	}
+	removeOuter()
+	order = nil
+	if n := call("abc"); n != 3 || len(order) != 0 {
+		t.Errorf("after removing all: strlen = %d, %d interceptors ran", n, len(order))
+	}
+}
+
+func TestInterceptLibraryShortCircuit(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	errNull := errors.New("NULL string")
+	remove, err := InterceptLibrary(handle, func(c *Call, next CallInvoker) error {
+		if *(*unsafe.Pointer)(c.AValue[0]) == nil {
+			return errNull
+		}
+		return next(c)
+	}, func(c *Call, next CallInvoker) error {
+		*(*uint64)(c.RValue) = 42
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remove()
+
+	var p unsafe.Pointer
+	var n uint64
+	if err := CallFunction(cif, strlen, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)}); !errors.Is(err, errNull) {
+		t.Errorf("validation layer: err = %v, want %v", err, errNull)
+	}
+	b := []byte("x\x00")
+	p = unsafe.Pointer(&b[0])
+	if err := CallFunction(cif, strlen, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)}); err != nil || n != 42 {
+		t.Errorf("short-circuited call = %d, %v; want 42", n, err)
+	}
+}
+
+func TestInterceptLibraryNil(t *testing.T) {
+	if _, err := InterceptLibrary(nil, nil); !errors.Is(err, ErrNilInterceptor) {
+		t.Errorf("err = %v, want ErrNilInterceptor", err)
+	}
+}
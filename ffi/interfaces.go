@@ -0,0 +1,58 @@
+package ffi
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Loader is the library-loading half of goffi's call surface.
+//
+// Packages built on goffi can depend on Loader and Caller instead of the
+// package-level functions so that their binding logic can be unit-tested
+// against the in-memory fake in package ffitest, without native libraries.
+// Native is the real implementation.
+type Loader interface {
+	LoadLibrary(name string) (unsafe.Pointer, error)
+	GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error)
+	FreeLibrary(handle unsafe.Pointer) error
+}
+
+// Caller is the function-calling half of goffi's call surface. See Loader.
+type Caller interface {
+	CallFunction(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error
+}
+
+// Native implements Loader and Caller with the package-level functions of
+// the same names, i.e. with real libraries and native calls.
+type Native struct{}
+
+var (
+	_ Loader = Native{}
+	_ Caller = Native{}
+)
+
+// LoadLibrary calls the package-level LoadLibrary.
+func (Native) LoadLibrary(name string) (unsafe.Pointer, error) {
+	return LoadLibrary(name)
+}
+
+// GetSymbol calls the package-level GetSymbol.
+func (Native) GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	return GetSymbol(handle, name)
+}
+
+// FreeLibrary calls the package-level FreeLibrary.
+func (Native) FreeLibrary(handle unsafe.Pointer) error {
+	return FreeLibrary(handle)
+}
+
+// CallFunction calls the package-level CallFunction.
+func (Native) CallFunction(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	return CallFunction(cif, fn, rvalue, avalue)
+}
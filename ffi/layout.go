@@ -0,0 +1,99 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// CheckLayout reports whether values of the Go struct type goType can be
+// passed to C as they are: every field must sit at the offset, and have the
+// size, of the member of desc it matches (fields match members as for
+// Marshal), and the structs must have the same size. The first divergent
+// field is reported as a *LayoutMismatchError; fields with no C
+// representation, such as strings and slices, as a *TypeValidationError.
+//
+// Run it once at init time for every struct passed by pointer or copied with
+// Var, SharedView or CopyOut, to catch Go padding that differs from C:
+//
+//	func init() {
+//	    if err := ffi.CheckLayout(reflect.TypeFor[Extent3D](), extent3DDesc); err != nil {
+//	        panic(err)
+//	    }
+//	}
+//
+// The check is for the target being built: on 32-bit ARM, for instance, Go
+// aligns 64-bit fields to 4 bytes and C to 8.
+func CheckLayout(goType reflect.Type, desc *types.TypeDescriptor) error {
+	if goType == nil || goType.Kind() != reflect.Struct {
+		return &TypeValidationError{TypeName: fmt.Sprint(goType), Kind: int(types.StructType), Reason: "not a struct type", Index: -1}
+	}
+	if err := prepareMarshalType("desc", desc); err != nil {
+		return err
+	}
+	if desc.Kind != types.StructType {
+		return newInvalidTypeError("desc", int(desc.Kind), "expected StructType")
+	}
+	if err := checkLayout(goType.String(), "", goType, desc, 0, 0); err != nil {
+		return err
+	}
+	if goType.Size() != desc.Size {
+		return &LayoutMismatchError{Type: goType.String(), GoSize: goType.Size(), CSize: desc.Size}
+	}
+	return nil
+}
+
+// checkLayout compares the fields of t, placed at goBase within the root
+// struct, with the members of d, placed at cBase.
+func checkLayout(root, prefix string, t reflect.Type, d *types.TypeDescriptor, goBase, cBase uintptr) error {
+	bindings, err := bindFields(t, d)
+	if err != nil {
+		return err
+	}
+	for i := range bindings {
+		b := &bindings[i]
+		name, goOff, ft := fieldLayout(t, b.path)
+		name, goOff = prefix+name, goBase+goOff
+		switch ft.Kind() {
+		case reflect.String, reflect.Slice, reflect.Func, reflect.Map, reflect.Chan, reflect.Interface:
+			return &TypeValidationError{
+				TypeName: root,
+				Kind:     int(b.desc.Kind),
+				Reason:   fmt.Sprintf("field %s (%s) has no C layout", name, ft),
+				Index:    b.member,
+			}
+		}
+		cOff := cBase + b.offset
+		if goOff != cOff || ft.Size() != b.desc.Size {
+			return &LayoutMismatchError{Type: root, Field: name, GoOffset: goOff, COffset: cOff, GoSize: ft.Size(), CSize: b.desc.Size}
+		}
+		if b.desc.Kind == types.StructType {
+			if err := checkLayout(root, name+".", ft, b.desc, goOff, cOff); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fieldLayout returns the name, offset and type of the field at path in t.
+func fieldLayout(t reflect.Type, path []int) (name string, offset uintptr, ft reflect.Type) {
+	ft = t
+	for _, i := range path {
+		if ft.Kind() == reflect.Struct {
+			f := ft.Field(i)
+			if name != "" {
+				name += "."
+			}
+			name += f.Name
+			offset += f.Offset
+			ft = f.Type
+		} else {
+			name += fmt.Sprintf("[%d]", i)
+			offset += uintptr(i) * ft.Elem().Size()
+			ft = ft.Elem()
+		}
+	}
+	return name, offset, ft
+}
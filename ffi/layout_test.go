@@ -0,0 +1,92 @@
+package ffi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCheckLayout(t *testing.T) {
+	type extent struct {
+		Width, Height uint32
+		Depth         uint16
+		Origin        struct{ X, Y int16 }
+		RGB           [3]uint8
+	}
+	desc, err := DescribeStruct(reflect.TypeFor[extent]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckLayout(reflect.TypeFor[extent](), desc); err != nil {
+		t.Errorf("matching layout: %v", err)
+	}
+}
+
+func TestCheckLayoutMismatch(t *testing.T) {
+	// C: struct { uint8_t tag; struct { uint8_t a; uint32_t b; } inner; };
+	desc := &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.UInt8TypeDescriptor,
+			{Kind: types.StructType, Members: []*types.TypeDescriptor{types.UInt8TypeDescriptor, types.UInt32TypeDescriptor}},
+		},
+	}
+
+	// Go: inner.B declared as uint16, so it sits at offset 2 instead of 4.
+	type inner struct {
+		A uint8
+		B uint16
+	}
+	type outer struct {
+		Tag   uint8
+		Inner inner
+	}
+	err := CheckLayout(reflect.TypeFor[outer](), desc)
+	var lm *LayoutMismatchError
+	if !errors.As(err, &lm) {
+		t.Fatalf("err = %v, want *LayoutMismatchError", err)
+	}
+	// Inner itself is smaller (4 vs 8 bytes) and is reported first.
+	if lm.Field != "Inner" || lm.GoSize != 4 || lm.CSize != 8 {
+		t.Errorf("mismatch = %+v", *lm)
+	}
+
+	type outer2 struct {
+		Tag   uint8
+		Inner struct {
+			A uint8
+			B uint32
+		}
+	}
+	if err := CheckLayout(reflect.TypeFor[outer2](), desc); err != nil {
+		t.Errorf("matching nested layout: %v", err)
+	}
+
+	// Same sizes, but a blank Go field shifts B from offset 4 to 8.
+	flat := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt8TypeDescriptor, types.UInt32TypeDescriptor},
+	}
+	type shifted struct {
+		A uint8
+		_ uint32
+		B uint32
+	}
+	err = CheckLayout(reflect.TypeFor[shifted](), flat)
+	if !errors.As(err, &lm) || lm.Field != "B" || lm.GoOffset != 8 || lm.COffset != 4 {
+		t.Errorf("err = %v, want B at Go offset 8, C offset 4", err)
+	}
+}
+
+func TestCheckLayoutErrors(t *testing.T) {
+	var tve *TypeValidationError
+	desc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.PointerTypeDescriptor}}
+	if err := CheckLayout(reflect.TypeFor[struct{ S string }](), desc); !errors.As(err, &tve) {
+		t.Errorf("string field: err = %v, want *TypeValidationError", err)
+	}
+	if err := CheckLayout(reflect.TypeFor[int](), desc); !errors.As(err, &tve) {
+		t.Errorf("non-struct: err = %v, want *TypeValidationError", err)
+	}
+}
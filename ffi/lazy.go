@@ -0,0 +1,67 @@
+package ffi
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// LazySymbol is a function resolved on its first call, like a PLT entry:
+// a binding table can declare hundreds of entry points at startup and only
+// pay the symbol lookup for the ones the application actually calls.
+//
+//	var wgpuCreateInstance = ffi.NewLazySymbol(lib, "wgpuCreateInstance")
+//	...
+//	err := wgpuCreateInstance.Call(&cif, unsafe.Pointer(&inst), args)
+//
+// RegisterLazyFunc attaches one to a Go func variable the way RegisterFunc
+// does for a resolved address, so the func looks the symbol up on its first
+// call.
+//
+// The lookup runs at most once; its result, error included, is reused by
+// every later call. A LazySymbol is safe for concurrent use.
+type LazySymbol struct {
+	handle unsafe.Pointer
+	name   string
+
+	once sync.Once
+	lib  *libraryUsage
+	addr unsafe.Pointer
+	err  error
+}
+
+// NewLazySymbol returns a LazySymbol for name in the library handle. No
+// lookup happens until Addr or Call.
+func NewLazySymbol(handle unsafe.Pointer, name string) *LazySymbol {
+	return &LazySymbol{handle: handle, name: name}
+}
+
+// Name returns the symbol name.
+func (s *LazySymbol) Name() string {
+	return s.name
+}
+
+// Addr resolves the symbol with GetSymbol on first use and returns its
+// address, or the *LibraryError of the lookup. Once FreeLibrary has
+// unloaded the library, it fails with ErrLibraryUnloaded instead of
+// returning a dangling address.
+func (s *LazySymbol) Addr() (unsafe.Pointer, error) {
+	s.once.Do(func() {
+		s.lib = libraryState(s.handle)
+		s.addr, s.err = GetSymbol(s.handle, s.name)
+	})
+	if s.err == nil && s.lib.isUnloaded() {
+		return nil, &LibraryError{Operation: "symbol", Name: s.name, Err: ErrLibraryUnloaded}
+	}
+	return s.addr, s.err
+}
+
+// Call resolves the symbol if needed and calls it like CallFunction.
+func (s *LazySymbol) Call(cif *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	fn, err := s.Addr()
+	if err != nil {
+		return err
+	}
+	return CallFunction(cif, fn, rvalue, avalue)
+}
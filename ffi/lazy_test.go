@@ -0,0 +1,69 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestLazySymbol(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+
+	lookups := 0
+	prev := SetTraceHook(func(ev TraceEvent) {
+		if ev.Kind == TraceSymbol {
+			lookups++
+		}
+	})
+	defer SetTraceHook(prev)
+
+	strlen := NewLazySymbol(handle, sym)
+	if lookups != 0 {
+		t.Fatalf("NewLazySymbol looked up the symbol")
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"lazy", "binding"} {
+		b := append([]byte(s), 0)
+		p := unsafe.Pointer(&b[0])
+		var n uint64
+		if err := strlen.Call(cif, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)}); err != nil {
+			t.Fatal(err)
+		}
+		if n != uint64(len(s)) {
+			t.Errorf("strlen(%q) = %d", s, n)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("%d lookups for two calls, want 1", lookups)
+	}
+}
+
+func TestLazySymbolMissing(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	s := NewLazySymbol(handle, "goffi_no_such_symbol")
+	_, err = s.Addr()
+	var le *LibraryError
+	if !errors.As(err, &le) || le.Operation != "symbol" {
+		t.Fatalf("Addr err = %v, want *LibraryError", err)
+	}
+	if err := s.Call(&types.CallInterface{}, nil, nil); !errors.Is(err, le) {
+		t.Errorf("Call err = %v, want the cached lookup error", err)
+	}
+}
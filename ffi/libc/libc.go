@@ -0,0 +1,245 @@
+// Package libc exposes a handful of C runtime functions that most bindings
+// end up needing, already bound through goffi with the right library name for
+// each platform (libc.so.6, libc.so.7, libSystem.B.dylib, msvcrt.dll).
+//
+// The functions are bound on first use. A C runtime that cannot be loaded
+// makes every function except Snprintf panic with the binding error; call
+// Init first to get that error as a value instead.
+//
+// Example:
+//
+//	buf := libc.Malloc(256)
+//	defer libc.Free(buf)
+//	libc.Memset(buf, 0, 256)
+package libc
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// binding is one bound C function.
+type binding struct {
+	fn  unsafe.Pointer
+	cif types.CallInterface
+}
+
+var (
+	initOnce sync.Once
+	initErr  error
+
+	memcpy, memset, malloc, free, qsort, getenv, snprintf binding
+)
+
+// library returns the C runtime of the current platform and its name for
+// snprintf, which msvcrt only exports with a leading underscore.
+func library() (lib, snprintfName string) {
+	switch runtime.GOOS {
+	case "windows":
+		return "msvcrt.dll", "_snprintf"
+	case "darwin":
+		return "/usr/lib/libSystem.B.dylib", "snprintf"
+	case "freebsd":
+		return "libc.so.7", "snprintf"
+	default:
+		return "libc.so.6", "snprintf"
+	}
+}
+
+// Init loads the C runtime and binds all functions of the package. It is
+// safe to call more than once and returns the same result every time.
+func Init() error {
+	initOnce.Do(func() {
+		initErr = bindAll()
+	})
+	return initErr
+}
+
+func bindAll() error {
+	lib, snprintfName := library()
+	handle, err := ffi.LoadLibrary(lib)
+	if err != nil {
+		return err
+	}
+
+	ptr := types.PointerTypeDescriptor
+	size := types.UInt64TypeDescriptor
+	for _, b := range []struct {
+		dst  *binding
+		name string
+		ret  *types.TypeDescriptor
+		args []*types.TypeDescriptor
+	}{
+		{&memcpy, "memcpy", ptr, []*types.TypeDescriptor{ptr, ptr, size}},
+		{&memset, "memset", ptr, []*types.TypeDescriptor{ptr, types.SInt32TypeDescriptor, size}},
+		{&malloc, "malloc", ptr, []*types.TypeDescriptor{size}},
+		{&free, "free", types.VoidTypeDescriptor, []*types.TypeDescriptor{ptr}},
+		{&qsort, "qsort", types.VoidTypeDescriptor, []*types.TypeDescriptor{ptr, size, size, ptr}},
+		{&getenv, "getenv", ptr, []*types.TypeDescriptor{ptr}},
+		{&snprintf, snprintfName, nil, nil}, // prepared per call, see Snprintf
+	} {
+		fn, err := ffi.GetSymbol(handle, b.name)
+		if err != nil {
+			return err
+		}
+		b.dst.fn = fn
+		if b.ret == nil {
+			continue
+		}
+		if err := ffi.PrepareCallInterface(&b.dst.cif, types.DefaultCall, b.ret, b.args); err != nil {
+			return fmt.Errorf("goffi: libc: %s: %w", b.name, err)
+		}
+	}
+	return nil
+}
+
+// call invokes b, panicking if the package could not be initialized.
+func (b *binding) call(rvalue unsafe.Pointer, avalue ...unsafe.Pointer) {
+	if err := Init(); err != nil {
+		panic(err)
+	}
+	if err := ffi.CallFunction(&b.cif, b.fn, rvalue, avalue); err != nil {
+		panic(err)
+	}
+}
+
+// Memcpy copies n bytes from src to dst, which must not overlap, and
+// returns dst.
+func Memcpy(dst, src unsafe.Pointer, n uintptr) unsafe.Pointer {
+	var ret unsafe.Pointer
+	memcpy.call(unsafe.Pointer(&ret), unsafe.Pointer(&dst), unsafe.Pointer(&src), unsafe.Pointer(&n))
+	return ret
+}
+
+// Memset fills n bytes at dst with the byte c and returns dst.
+func Memset(dst unsafe.Pointer, c byte, n uintptr) unsafe.Pointer {
+	var ret unsafe.Pointer
+	v := int32(c)
+	memset.call(unsafe.Pointer(&ret), unsafe.Pointer(&dst), unsafe.Pointer(&v), unsafe.Pointer(&n))
+	return ret
+}
+
+// Malloc allocates size bytes of C memory, which the Go garbage collector
+// does not manage. It returns nil if the allocation fails.
+func Malloc(size uintptr) unsafe.Pointer {
+	var ret unsafe.Pointer
+	malloc.call(unsafe.Pointer(&ret), unsafe.Pointer(&size))
+	return ret
+}
+
+// Free releases memory obtained from Malloc or from a C function documented
+// to return malloc'd memory. Free(nil) does nothing.
+func Free(p unsafe.Pointer) {
+	free.call(nil, unsafe.Pointer(&p))
+}
+
+// Qsort sorts nmemb elements of size bytes at base in place with the C
+// comparison function compar, typically created with ffi.NewCallback:
+//
+//	cmp := ffi.NewCallback(func(a, b *int32) int32 { return *a - *b })
+//	libc.Qsort(unsafe.Pointer(&xs[0]), uintptr(len(xs)), 4, cmp)
+func Qsort(base unsafe.Pointer, nmemb, size uintptr, compar uintptr) {
+	qsort.call(nil, unsafe.Pointer(&base), unsafe.Pointer(&nmemb), unsafe.Pointer(&size), unsafe.Pointer(&compar))
+}
+
+// Getenv looks name up in the C runtime's environment, which native code
+// reads and may have modified independently of os.Getenv.
+func Getenv(name string) (string, bool) {
+	cname := append([]byte(name), 0)
+	p := unsafe.Pointer(&cname[0])
+	var ret unsafe.Pointer
+	getenv.call(unsafe.Pointer(&ret), unsafe.Pointer(&p))
+	runtime.KeepAlive(cname)
+	if ret == nil {
+		return "", false
+	}
+	return goString(ret), true
+}
+
+// Snprintf formats args according to the C format string into buf, always
+// NUL-terminating it, and returns the formatted string without the NUL.
+//
+// Arguments are passed as C variadic arguments: Go integer kinds are passed
+// as 64-bit integers (use %lld, %llu, %llx), float32 and float64 as double,
+// string as a temporary NUL-terminated copy (%s), and unsafe.Pointer or
+// uintptr as a pointer (%p). Other types are an error.
+//
+// On Windows the underlying function is msvcrt's _snprintf.
+func Snprintf(buf []byte, format string, args ...any) (string, error) {
+	if err := Init(); err != nil {
+		return "", err
+	}
+	if len(buf) == 0 {
+		return "", nil
+	}
+
+	cformat := append([]byte(format), 0)
+	bufPtr, formatPtr := unsafe.Pointer(&buf[0]), unsafe.Pointer(&cformat[0])
+	size := uint64(len(buf))
+	if runtime.GOOS == "windows" {
+		size-- // _snprintf does not terminate truncated output
+	}
+
+	argTypes := []*types.TypeDescriptor{types.PointerTypeDescriptor, types.UInt64TypeDescriptor, types.PointerTypeDescriptor}
+	avalue := []unsafe.Pointer{unsafe.Pointer(&bufPtr), unsafe.Pointer(&size), unsafe.Pointer(&formatPtr)}
+	var keep [][]byte
+	for i, arg := range args {
+		var t *types.TypeDescriptor
+		var v unsafe.Pointer
+		switch rv := reflect.ValueOf(arg); rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n := rv.Int()
+			t, v = types.SInt64TypeDescriptor, unsafe.Pointer(&n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n := rv.Uint()
+			t, v = types.UInt64TypeDescriptor, unsafe.Pointer(&n)
+		case reflect.Float32, reflect.Float64:
+			f := rv.Float()
+			t, v = types.DoubleTypeDescriptor, unsafe.Pointer(&f)
+		case reflect.String:
+			s := append([]byte(rv.String()), 0)
+			keep = append(keep, s)
+			p := unsafe.Pointer(&s[0])
+			t, v = types.PointerTypeDescriptor, unsafe.Pointer(&p)
+		case reflect.Uintptr:
+			p := uintptr(rv.Uint())
+			t, v = types.PointerTypeDescriptor, unsafe.Pointer(&p)
+		case reflect.UnsafePointer:
+			p := rv.UnsafePointer()
+			t, v = types.PointerTypeDescriptor, unsafe.Pointer(&p)
+		default:
+			return "", fmt.Errorf("goffi: libc: Snprintf argument %d: unsupported type %T", i, arg)
+		}
+		argTypes = append(argTypes, t)
+		avalue = append(avalue, v)
+	}
+
+	var cif types.CallInterface
+	if err := ffi.PrepareVariadicCallInterface(&cif, types.DefaultCall, 3, types.SInt32TypeDescriptor, argTypes); err != nil {
+		return "", err
+	}
+	var n int32
+	buf[len(buf)-1] = 0
+	err := ffi.CallFunction(&cif, snprintf.fn, unsafe.Pointer(&n), avalue)
+	runtime.KeepAlive(cformat)
+	runtime.KeepAlive(keep)
+	if err != nil {
+		return "", err
+	}
+	return goString(bufPtr), nil
+}
+
+// goString copies the NUL-terminated string at p.
+func goString(p unsafe.Pointer) string {
+	n := 0
+	for *(*byte)(unsafe.Add(p, n)) != 0 {
+		n++
+	}
+	return string(unsafe.Slice((*byte)(p), n))
+}
@@ -0,0 +1,100 @@
+package libc
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+)
+
+func TestInit(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Skipf("C runtime not available: %v", err)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Skipf("C runtime not available: %v", err)
+	}
+	p := Malloc(16)
+	if p == nil {
+		t.Fatal("Malloc returned nil")
+	}
+	defer Free(p)
+
+	if got := Memset(p, 0xab, 16); got != p {
+		t.Errorf("Memset returned %p, want %p", got, p)
+	}
+	dst := make([]byte, 16)
+	if got := Memcpy(unsafe.Pointer(&dst[0]), p, 16); got != unsafe.Pointer(&dst[0]) {
+		t.Errorf("Memcpy returned %p, want %p", got, &dst[0])
+	}
+	for i, b := range dst {
+		if b != 0xab {
+			t.Fatalf("dst[%d] = %#x, want 0xab", i, b)
+		}
+	}
+	Free(nil)
+}
+
+func TestQsort(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Skipf("C runtime not available: %v", err)
+	}
+	cmp := ffi.NewCallback(func(a, b *int32) int32 {
+		switch {
+		case *a < *b:
+			return -1
+		case *a > *b:
+			return 1
+		}
+		return 0
+	})
+	xs := []int32{5, -3, 9, 0, 2, 2, -8}
+	Qsort(unsafe.Pointer(&xs[0]), uintptr(len(xs)), 4, cmp)
+	if !slices.IsSorted(xs) {
+		t.Errorf("Qsort result %v is not sorted", xs)
+	}
+}
+
+func TestGetenv(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Skipf("C runtime not available: %v", err)
+	}
+	// PATH is inherited from the parent and visible to both runtimes.
+	want, ok := os.LookupEnv("PATH")
+	if !ok {
+		t.Skip("PATH not set")
+	}
+	if got, ok := Getenv("PATH"); !ok || got != want {
+		t.Errorf("Getenv(PATH) = %q, %v; want %q", got, ok, want)
+	}
+	if _, ok := Getenv("GOFFI_LIBC_TEST_UNSET_12345"); ok {
+		t.Error("Getenv reported an unset variable")
+	}
+}
+
+func TestSnprintf(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Skipf("C runtime not available: %v", err)
+	}
+	buf := make([]byte, 64)
+	got, err := Snprintf(buf, "%s=%lld %.2f %llx", "answer", 42, 1.5, uint32(255))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "answer=42 1.50 ff"; got != want {
+		t.Errorf("Snprintf = %q, want %q", got, want)
+	}
+
+	small := make([]byte, 4)
+	if got, err := Snprintf(small, "%s", "truncated"); err != nil || got != "tru" {
+		t.Errorf("truncated Snprintf = %q, %v; want \"tru\"", got, err)
+	}
+	if _, err := Snprintf(buf, "%d", []int{1}); err == nil {
+		t.Error("expected error for unsupported argument type")
+	}
+}
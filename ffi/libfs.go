@@ -0,0 +1,69 @@
+//go:build ((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm) || windows
+
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"unsafe"
+)
+
+// LoadLibraryFS loads a shared library stored in fsys, typically an embed.FS,
+// so that applications can ship native dependencies such as wgpu-native
+// inside the Go binary:
+//
+//	//go:embed lib/libwgpu_native.so
+//	var libs embed.FS
+//
+//	handle, err := ffi.LoadLibraryFS(libs, "lib/libwgpu_native.so")
+//
+// On Linux amd64 and arm64 the library is copied into an anonymous in-memory
+// file (memfd) and loaded from there, so nothing touches the disk. Elsewhere,
+// or if memfd is unavailable, it is written to a fresh directory readable only by the current
+// user. On Unix that directory is removed as soon as the library is loaded; on
+// Windows, where a loaded DLL cannot be deleted, it is removed by FreeLibrary.
+//
+// The library's own dependencies are resolved by the system loader as usual;
+// they are not looked up in fsys. Load embedded dependencies first, leaf
+// libraries last. The returned handle is used with GetSymbol and FreeLibrary
+// like any other.
+func LoadLibraryFS(fsys fs.FS, name string) (unsafe.Pointer, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, &LibraryError{Operation: "load", Name: name, Err: err}
+	}
+	handle, err := loadLibraryData(path.Base(name), data)
+	if err != nil {
+		// Report the name the caller knows, not the temporary path.
+		var libErr *LibraryError
+		if errors.As(err, &libErr) {
+			err = libErr.Err
+		}
+		return nil, &LibraryError{Operation: "load", Name: name, Err: err}
+	}
+	return handle, nil
+}
+
+// loadLibraryTemp writes data to base inside a new private temporary
+// directory and loads it from there. The caller owns dir.
+func loadLibraryTemp(base string, data []byte) (handle unsafe.Pointer, dir string, err error) {
+	dir, err = os.MkdirTemp("", "goffi-lib-")
+	if err != nil {
+		return nil, "", fmt.Errorf("goffi: create temp dir: %w", err)
+	}
+	file := filepath.Join(dir, base)
+	if err := os.WriteFile(file, data, 0o500); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, "", fmt.Errorf("goffi: write temp library: %w", err)
+	}
+	handle, err = LoadLibrary(file)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, "", err
+	}
+	return handle, dir, nil
+}
@@ -0,0 +1,89 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+	"github.com/go-webgpu/goffi/internal/execmem"
+)
+
+// memfdLibraries keeps the memfd of each library loaded from memory open
+// until the dynamic loader has unloaded it. The loader recognizes already
+// loaded objects by path, so a /proc/self/fd/N name must not be handed to
+// another library while the first one is still loaded.
+var memfdLibraries sync.Map // unsafe.Pointer -> int
+
+// loadLibraryData loads the library from a memfd through its /proc/self/fd
+// path, falling back to a temporary file when memfd_create or /proc is not
+// available (old kernels, some sandboxes).
+func loadLibraryData(base string, data []byte) (unsafe.Pointer, error) {
+	if handle, ok, err := loadLibraryMemfd(base, data); ok {
+		return handle, err
+	}
+	handle, dir, err := loadLibraryTemp(base, data)
+	if err != nil {
+		return nil, err
+	}
+	// The mapping keeps the file alive; the directory entry is not needed.
+	_ = os.RemoveAll(dir)
+	return handle, nil
+}
+
+// loadLibraryMemfd reports ok=false if the in-memory route is unavailable
+// and the caller should fall back; otherwise err is the final result.
+func loadLibraryMemfd(base string, data []byte) (handle unsafe.Pointer, ok bool, err error) {
+	fd, err := execmem.MemfdCreate(base)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	for rest := data; len(rest) > 0; {
+		n, err := syscall.Write(fd, rest)
+		if err != nil {
+			_ = syscall.Close(fd)
+			return nil, false, nil
+		}
+		rest = rest[n:]
+	}
+	file := "/proc/self/fd/" + strconv.Itoa(fd)
+	if _, err := os.Stat(file); err != nil {
+		_ = syscall.Close(fd)
+		return nil, false, nil
+	}
+	handle, err = LoadLibrary(file)
+	if err != nil {
+		_ = syscall.Close(fd)
+		return nil, true, err
+	}
+	memfdLibraries.Store(handle, fd)
+	return handle, true, nil
+}
+
+// closeUnloadedMemfds releases the memfds of libraries loaded from memory
+// that the loader has unloaded. FreeLibrary calls it after every dlclose.
+// dlclose only drops a reference: an image stays mapped while other handles
+// or dependent libraries hold it, or when it cannot be unloaded at all, so
+// the loader is asked whether each path is still in use before its fd
+// number is given up. A library that outlives the FreeLibrary of its own
+// handle is thereby released by a later FreeLibrary, whichever library that
+// unloads.
+func closeUnloadedMemfds() {
+	memfdLibraries.Range(func(k, v any) bool {
+		fd := v.(int)
+		file := "/proc/self/fd/" + strconv.Itoa(fd)
+		if loaded, err := dl.Dlopen(file, dl.RTLD_LAZY|dl.RTLD_NOLOAD); err == nil {
+			_ = dl.Dlclose(loaded)
+			return true
+		}
+		if memfdLibraries.CompareAndDelete(k, v) {
+			_ = syscall.Close(fd)
+		}
+		return true
+	})
+}
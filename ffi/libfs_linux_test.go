@@ -0,0 +1,77 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/execmem"
+)
+
+func TestLoadLibraryFSMemfdOutlivesLoadedImage(t *testing.T) {
+	requireStructLib(t)
+	fsys := os.DirFS("testdata")
+
+	handle, err := LoadLibraryFS(fsys, "libstructtest.so")
+	if err != nil {
+		t.Fatalf("LoadLibraryFS failed: %v", err)
+	}
+	v, ok := memfdLibraries.Load(handle)
+	if !ok {
+		t.Skip("library was not loaded from a memfd")
+	}
+	fd := v.(int)
+
+	// Keep the image loaded past FreeLibrary with a second reference to the
+	// same path, as another dlopen of it or a dependent library would.
+	if _, err := LoadLibrary("/proc/self/fd/" + strconv.Itoa(fd)); err != nil {
+		t.Fatalf("LoadLibrary of the memfd path failed: %v", err)
+	}
+	if err := FreeLibrary(handle); err != nil {
+		t.Fatalf("FreeLibrary failed: %v", err)
+	}
+	if _, err := os.Readlink("/proc/self/fd/" + strconv.Itoa(fd)); err != nil {
+		t.Fatalf("memfd of a still loaded library was closed: %v", err)
+	}
+
+	// A second in-memory library must not be given the same path.
+	other, err := LoadLibraryFS(fsys, "libstructtest.so")
+	if err != nil {
+		t.Fatalf("second LoadLibraryFS failed: %v", err)
+	}
+	defer FreeLibrary(other)
+	if other == handle {
+		t.Error("second in-memory library resolved to the first one")
+	}
+}
+
+func TestLoadLibraryFSMemfdReleasedByLaterFree(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	other, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+
+	// An entry whose library the loader no longer has, as left behind when
+	// a library outlived the FreeLibrary of its handle and went away later.
+	fd, err := execmem.MemfdCreate("goffi-test")
+	if err != nil {
+		t.Skipf("memfd_create: %v", err)
+	}
+	var key byte
+	memfdLibraries.Store(unsafe.Pointer(&key), fd)
+
+	// The next FreeLibrary, of any library, releases it.
+	if err := FreeLibrary(other); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := memfdLibraries.Load(unsafe.Pointer(&key)); ok {
+		memfdLibraries.Delete(unsafe.Pointer(&key))
+		_ = syscall.Close(fd)
+		t.Error("memfd of an unloaded library was kept open")
+	}
+}
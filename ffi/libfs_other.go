@@ -0,0 +1,15 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm) || windows)
+
+package ffi
+
+import (
+	"io/fs"
+	"runtime"
+	"unsafe"
+)
+
+// LoadLibraryFS fails: libraries cannot be loaded from a file system on this
+// platform. The error is a *LibraryError wrapping an *UnsupportedPlatformError.
+func LoadLibraryFS(fsys fs.FS, name string) (unsafe.Pointer, error) {
+	return nil, &LibraryError{Operation: "load", Name: name, Err: &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}}
+}
@@ -0,0 +1,62 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestLoadLibraryFS(t *testing.T) {
+	requireStructLib(t)
+
+	var name string
+	switch runtime.GOOS {
+	case "darwin":
+		name = "libstructtest.dylib"
+	case "windows":
+		name = "structtest.dll"
+	default:
+		name = "libstructtest.so"
+	}
+	fsys := os.DirFS("testdata")
+
+	handle, err := LoadLibraryFS(fsys, name)
+	if err != nil {
+		t.Fatalf("LoadLibraryFS(%q) failed: %v", name, err)
+	}
+	defer FreeLibrary(handle)
+
+	if handle == structTestLib {
+		t.Error("expected a separate copy of the library, got the on-disk one")
+	}
+	if _, err := GetSymbol(handle, "take_struct_8"); err != nil {
+		t.Errorf("GetSymbol on embedded library failed: %v", err)
+	}
+	if runtime.GOOS == "linux" {
+		// The in-memory route shows up as a memfd mapping.
+		maps, err := os.ReadFile("/proc/self/maps")
+		if err == nil && !strings.Contains(string(maps), "/memfd:"+name) {
+			t.Errorf("expected %s to be mapped from a memfd", name)
+		}
+	}
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := LoadLibraryFS(fsys, "does_not_exist.so")
+
+		var libErr *LibraryError
+		if !errors.As(err, &libErr) {
+			t.Fatalf("Expected LibraryError, got %T: %v", err, err)
+		}
+		if libErr.Operation != "load" || libErr.Name != "does_not_exist.so" {
+			t.Errorf("Operation=%q Name=%q", libErr.Operation, libErr.Name)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected fs.ErrNotExist, got %v", err)
+		}
+	})
+}
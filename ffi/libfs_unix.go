@@ -0,0 +1,23 @@
+//go:build ((darwin || freebsd) && (amd64 || arm64)) || (linux && arm)
+
+package ffi
+
+import (
+	"os"
+	"unsafe"
+)
+
+// loadLibraryData loads the library from a temporary file that is unlinked
+// right after loading; the loader's mapping keeps the contents alive.
+func loadLibraryData(base string, data []byte) (unsafe.Pointer, error) {
+	handle, dir, err := loadLibraryTemp(base, data)
+	if err != nil {
+		return nil, err
+	}
+	_ = os.RemoveAll(dir)
+	return handle, nil
+}
+
+// closeUnloadedMemfds has nothing to release: libraries are never loaded
+// from a memfd here.
+func closeUnloadedMemfds() {}
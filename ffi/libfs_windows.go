@@ -0,0 +1,31 @@
+//go:build windows
+
+package ffi
+
+import (
+	"os"
+	"sync"
+	"unsafe"
+)
+
+// tempLibraryDirs maps handles from LoadLibraryFS to the temporary directory
+// holding the DLL. Windows refuses to delete a loaded DLL, so the directory is
+// removed by FreeLibrary instead.
+var tempLibraryDirs sync.Map // unsafe.Pointer -> string
+
+func loadLibraryData(base string, data []byte) (unsafe.Pointer, error) {
+	handle, dir, err := loadLibraryTemp(base, data)
+	if err != nil {
+		return nil, err
+	}
+	tempLibraryDirs.Store(handle, dir)
+	return handle, nil
+}
+
+// removeTempLibrary deletes the temporary directory of a DLL loaded by
+// LoadLibraryFS once FreeLibrary has unloaded it.
+func removeTempLibrary(handle unsafe.Pointer) {
+	if dir, ok := tempLibraryDirs.LoadAndDelete(handle); ok {
+		_ = os.RemoveAll(dir.(string))
+	}
+}
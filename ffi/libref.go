@@ -0,0 +1,225 @@
+package ffi
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+var (
+	// ErrLibraryInUse is returned by FreeLibrary when the call would unload
+	// a library that RetainLibrary references still depend on, or while a
+	// call through one of its symbols is in progress.
+	ErrLibraryInUse = errors.New("goffi: library is still referenced")
+
+	// ErrLibraryUnloaded is returned for a library that has been unloaded,
+	// or was not loaded with LoadLibrary, and by objects that depended on it.
+	ErrLibraryUnloaded = errors.New("goffi: library has been unloaded")
+)
+
+// libraryUsage tracks one library loaded with LoadLibrary.
+type libraryUsage struct {
+	loads    int          // LoadLibrary calls not yet matched by FreeLibrary
+	refs     int          // Outstanding LibraryRefs
+	deferred bool         // FreeLibraryWhenUnused is waiting for refs to drop to 0
+	closing  atomic.Bool  // The final FreeLibrary is in progress or succeeded
+	unloaded bool         // The final FreeLibrary succeeded
+	seq      uint64       // Order of the first load, for Shutdown
+	calls    atomic.Int32 // Calls in progress through symbols of the library
+}
+
+var libraries struct {
+	mu    sync.Mutex
+	usage map[unsafe.Pointer]*libraryUsage
+	seq   uint64
+}
+
+// trackLoad records a successful LoadLibrary. The loader returns the same
+// handle for every load of a library, so loads are counted.
+func trackLoad(handle unsafe.Pointer) {
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	if libraries.usage == nil {
+		libraries.usage = make(map[unsafe.Pointer]*libraryUsage)
+	}
+	u := libraries.usage[handle]
+	if u == nil {
+		libraries.seq++
+		u = &libraryUsage{seq: libraries.seq}
+		libraries.usage[handle] = u
+	}
+	u.loads++
+}
+
+// beginFree reserves one load of handle for FreeLibrary, refusing the final
+// one while references are outstanding or calls through the library's
+// symbols are in progress; the latter include the callbacks the library
+// makes during such a call, so a callback cannot unload the library that
+// called it. Handles LoadLibrary did not return are not tracked. The caller
+// reports the outcome with endFree.
+func beginFree(handle unsafe.Pointer) error {
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	u := libraries.usage[handle]
+	if u == nil {
+		return nil
+	}
+	if u.loads == 1 {
+		if u.refs > 0 {
+			return &LibraryError{Operation: "free", Name: "<library handle>", Err: ErrLibraryInUse}
+		}
+		// Closing first and checking calls after pairs with beginCall,
+		// which counts itself first and checks closing after: either the
+		// call sees closing or beginFree sees the call.
+		u.closing.Store(true)
+		if u.calls.Load() > 0 {
+			u.closing.Store(false)
+			return &LibraryError{Operation: "free", Name: "<library handle>", Err: ErrLibraryInUse}
+		}
+	}
+	u.loads--
+	return nil
+}
+
+// endFree completes a beginFree. When the final load was released, the
+// library is marked unloaded and forgotten, along with the name
+// MustLoadLibrary recorded for it, so that a later load of the same handle
+// starts afresh.
+func endFree(handle unsafe.Pointer, err error) {
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	u := libraries.usage[handle]
+	if u == nil {
+		return
+	}
+	if err != nil {
+		u.loads++
+		u.closing.Store(false)
+		return
+	}
+	if u.loads == 0 {
+		u.unloaded = true
+		delete(libraries.usage, handle)
+		markSymbolsUnloaded(handle)
+		mustLibraries.Delete(handle)
+	}
+}
+
+// libraryState returns the tracking state of handle, or nil for handles
+// that LoadLibrary did not return.
+func libraryState(handle unsafe.Pointer) *libraryUsage {
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	return libraries.usage[handle]
+}
+
+// beginCall records a call through a symbol of the library u tracks. It
+// reports false, recording nothing, once the final FreeLibrary has begun.
+// The caller ends a recorded call with endCall.
+func (u *libraryUsage) beginCall() bool {
+	u.calls.Add(1)
+	if u.closing.Load() {
+		u.calls.Add(-1)
+		return false
+	}
+	return true
+}
+
+// endCall ends a call recorded by beginCall.
+func (u *libraryUsage) endCall() {
+	u.calls.Add(-1)
+}
+
+// isUnloaded reports whether the library u tracks has been unloaded. A nil u
+// never is.
+func (u *libraryUsage) isUnloaded() bool {
+	if u == nil {
+		return false
+	}
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	return u.unloaded
+}
+
+// LibraryRef keeps a library from being unloaded while objects derived from
+// it are alive: callbacks the library holds, tables of symbols or call
+// interfaces, handles to objects it created. Take one with RetainLibrary and
+// release it when the dependent object is destroyed:
+//
+//	ref, err := ffi.RetainLibrary(lib)
+//	if err != nil {
+//	    return err
+//	}
+//	device := &Device{ref: ref, ...}
+//	// in Device.Release: ref.Release()
+//
+// While a reference is outstanding, the FreeLibrary call that would unload
+// the library fails with ErrLibraryInUse; FreeLibraryWhenUnused instead
+// postpones the unload until the last reference is released.
+type LibraryRef struct {
+	handle unsafe.Pointer
+	usage  *libraryUsage
+	once   sync.Once
+}
+
+// RetainLibrary returns a new reference to handle, a library loaded with
+// LoadLibrary. It fails with ErrLibraryUnloaded if the library has been (or
+// is being) unloaded, or was not loaded with LoadLibrary.
+func RetainLibrary(handle unsafe.Pointer) (*LibraryRef, error) {
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	u := libraries.usage[handle]
+	if u == nil || u.closing.Load() {
+		return nil, &LibraryError{Operation: "retain", Name: "<library handle>", Err: ErrLibraryUnloaded}
+	}
+	u.refs++
+	return &LibraryRef{handle: handle, usage: u}, nil
+}
+
+// Handle returns the referenced library handle.
+func (r *LibraryRef) Handle() unsafe.Pointer {
+	return r.handle
+}
+
+// Err returns ErrLibraryUnloaded once the library has been unloaded, which
+// can only happen after Release, and nil before.
+func (r *LibraryRef) Err() error {
+	if r.usage.isUnloaded() {
+		return ErrLibraryUnloaded
+	}
+	return nil
+}
+
+// Release drops the reference. Releasing the last reference of a library
+// passed to FreeLibraryWhenUnused unloads it, and the error of that
+// FreeLibrary is returned. Further calls do nothing.
+func (r *LibraryRef) Release() error {
+	var free bool
+	r.once.Do(func() {
+		libraries.mu.Lock()
+		r.usage.refs--
+		free = r.usage.refs == 0 && r.usage.deferred
+		r.usage.deferred = r.usage.deferred && !free
+		libraries.mu.Unlock()
+	})
+	if free {
+		return FreeLibrary(r.handle)
+	}
+	return nil
+}
+
+// FreeLibraryWhenUnused is FreeLibrary for a library that may still be
+// referenced: if the call would unload it while LibraryRefs are
+// outstanding, the unload is postponed until the last of them is released.
+// It returns the error of an immediate FreeLibrary, or nil when postponed.
+func FreeLibraryWhenUnused(handle unsafe.Pointer) error {
+	libraries.mu.Lock()
+	if u := libraries.usage[handle]; u != nil && u.loads == 1 && u.refs > 0 {
+		u.deferred = true
+		libraries.mu.Unlock()
+		return nil
+	}
+	libraries.mu.Unlock()
+	return FreeLibrary(handle)
+}
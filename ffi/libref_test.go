@@ -0,0 +1,215 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// isolateLibrary gives handle, just loaded by the test, fresh tracking state
+// with a single load: other tests load the same library without freeing it,
+// and get back the symbols the test's unload marks as unloaded.
+func isolateLibrary(t *testing.T, handle unsafe.Pointer) {
+	t.Helper()
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	orig := libraries.usage[handle]
+	libraries.usage[handle] = &libraryUsage{loads: 1}
+	owners := make(map[any]any)
+	symbolOwners.Range(func(k, v any) bool {
+		if v.(symbolOwner).handle == handle {
+			owners[k] = v
+		}
+		return true
+	})
+	t.Cleanup(func() {
+		for k, v := range owners {
+			symbolOwners.Store(k, v)
+		}
+		libraries.mu.Lock()
+		defer libraries.mu.Unlock()
+		orig.loads-- // the test's own load, freed under the fresh state
+		if orig.loads > 0 {
+			libraries.usage[handle] = orig
+		} else {
+			delete(libraries.usage, handle)
+		}
+	})
+}
+
+func TestFreeLibraryWhileReferenced(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	isolateLibrary(t, handle)
+	lazy := NewLazySymbol(handle, sym)
+	if _, err := lazy.Addr(); err != nil {
+		t.Fatal(err)
+	}
+
+	ref, err := RetainLibrary(handle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := FreeLibrary(handle); !errors.Is(err, ErrLibraryInUse) {
+		t.Fatalf("FreeLibrary while referenced: err = %v, want ErrLibraryInUse", err)
+	}
+	if _, err := lazy.Addr(); err != nil {
+		t.Errorf("symbol invalidated by a refused FreeLibrary: %v", err)
+	}
+
+	if err := ref.Release(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ref.Release(); err != nil {
+		t.Errorf("second Release: %v", err)
+	}
+	if err := FreeLibrary(handle); err != nil {
+		t.Fatalf("FreeLibrary after Release: %v", err)
+	}
+	if _, err := lazy.Addr(); !errors.Is(err, ErrLibraryUnloaded) {
+		t.Errorf("LazySymbol after unload: err = %v, want ErrLibraryUnloaded", err)
+	}
+	if _, err := RetainLibrary(handle); !errors.Is(err, ErrLibraryUnloaded) {
+		t.Errorf("RetainLibrary after unload: err = %v, want ErrLibraryUnloaded", err)
+	}
+}
+
+func TestFreeLibraryNestedLoads(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	first, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	isolateLibrary(t, first)
+	second, err := LoadLibrary(lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := RetainLibrary(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Not the last load: the library stays loaded, so this is allowed.
+	if err := FreeLibrary(second); err != nil {
+		t.Fatalf("FreeLibrary of an inner load: %v", err)
+	}
+	if err := FreeLibrary(first); !errors.Is(err, ErrLibraryInUse) {
+		t.Errorf("FreeLibrary of the last load: err = %v, want ErrLibraryInUse", err)
+	}
+	ref.Release()
+	if err := FreeLibrary(first); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFreeLibraryWhenUnused(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	isolateLibrary(t, handle)
+	ref, err := RetainLibrary(handle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := FreeLibraryWhenUnused(handle); err != nil {
+		t.Fatal(err)
+	}
+	if err := ref.Err(); err != nil {
+		t.Fatalf("library unloaded while referenced: %v", err)
+	}
+	if err := ref.Release(); err != nil {
+		t.Fatalf("deferred FreeLibrary: %v", err)
+	}
+	if err := ref.Err(); !errors.Is(err, ErrLibraryUnloaded) {
+		t.Errorf("Err after the deferred unload = %v, want ErrLibraryUnloaded", err)
+	}
+}
+
+func TestCallAfterFreeLibrary(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	isolateLibrary(t, handle)
+	fn, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := FreeLibrary(handle); err != nil {
+		t.Fatal(err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := CallFunction(cif, fn, nil, nil); !errors.Is(err, ErrLibraryUnloaded) {
+		t.Fatalf("call through a symbol of an unloaded library: err = %v, want ErrLibraryUnloaded", err)
+	}
+}
+
+func TestFreeLibraryDuringCall(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	isolateLibrary(t, handle)
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol(qsort): %v", err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The comparator runs inside qsort, so the library must not go away.
+	var freeErr error
+	cmp := NewCallback(func(a, b *int32) int32 {
+		if freeErr == nil {
+			freeErr = FreeLibrary(handle)
+		}
+		return *a - *b
+	})
+	defer releaseCallback(cmp)
+	xs := []int32{2, 1}
+	base := unsafe.Pointer(&xs[0])
+	n, size := uint64(len(xs)), uint64(4)
+	if err := CallFunction(cif, qsort, nil, []unsafe.Pointer{
+		unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(freeErr, ErrLibraryInUse) {
+		t.Fatalf("FreeLibrary from a callback of the library: err = %v, want ErrLibraryInUse", freeErr)
+	}
+	if err := FreeLibrary(handle); err != nil {
+		t.Errorf("FreeLibrary after the call: %v", err)
+	}
+}
+
+func TestFreeLibraryForgetsMustName(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle := MustLoadLibrary(lib)
+	isolateLibrary(t, handle)
+	if err := FreeLibrary(handle); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := mustLibraries.Load(handle); ok {
+		t.Error("MustLoadLibrary name kept after the library was unloaded")
+	}
+}
@@ -0,0 +1,106 @@
+package ffi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// LibraryVersion is the version metadata of a loaded library, normalized to
+// major.minor.patch so bindings can gate behavior on it.
+type LibraryVersion struct {
+	Path   string // File the library was loaded from
+	Soname string // DT_SONAME on ELF, install name on macOS, empty on Windows
+	Raw    string // Version as found: file name or soname suffix, current_version, VERSIONINFO file version
+	Major  int
+	Minor  int
+	Patch  int
+}
+
+// QueryLibraryVersion reports the version of the library handle:
+//
+//   - Linux, FreeBSD: the numeric suffix of the real file name
+//     (libwgpu_native.so.22.1.0), falling back to that of DT_SONAME
+//   - macOS: the current_version of the library's LC_ID_DYLIB command
+//   - Windows: the file version of the VERSIONINFO resource
+//
+// A library without any version information is not an error: Known reports
+// false and the numbers are zero.
+//
+// Example:
+//
+//	v, err := ffi.QueryLibraryVersion(lib)
+//	if err == nil && v.AtLeast(22, 1, 0) {
+//	    useNewAPI()
+//	}
+func QueryLibraryVersion(handle unsafe.Pointer) (*LibraryVersion, error) {
+	if handle == nil {
+		return nil, &LibraryError{Operation: "version", Name: "<library handle>", Err: fmt.Errorf("handle must not be nil")}
+	}
+	v, err := queryLibraryVersion(handle)
+	if err != nil {
+		return nil, &LibraryError{Operation: "version", Name: "<library handle>", Err: err}
+	}
+	return v, nil
+}
+
+// Known reports whether version information was found.
+func (v *LibraryVersion) Known() bool {
+	return v.Raw != ""
+}
+
+// String returns the normalized version as "major.minor.patch".
+func (v *LibraryVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or +1 as v is older than, equal to or newer than
+// major.minor.patch.
+func (v *LibraryVersion) Compare(major, minor, patch int) int {
+	for _, d := range [][2]int{{v.Major, major}, {v.Minor, minor}, {v.Patch, patch}} {
+		switch {
+		case d[0] < d[1]:
+			return -1
+		case d[0] > d[1]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is major.minor.patch or newer.
+func (v *LibraryVersion) AtLeast(major, minor, patch int) bool {
+	return v.Compare(major, minor, patch) >= 0
+}
+
+// setNumbers parses up to three dot-separated leading numbers of raw into v,
+// ignoring anything after the first non-numeric component.
+func (v *LibraryVersion) setNumbers(raw string) {
+	v.Raw = raw
+	dst := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range strings.SplitN(raw, ".", len(dst)+1) {
+		if i == len(dst) {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		*dst[i] = n
+	}
+}
+
+// sonameVersion returns the numeric suffix after ".so." in an ELF file name,
+// or "" if there is none.
+func sonameVersion(name string) string {
+	i := strings.LastIndex(name, ".so.")
+	if i < 0 {
+		return ""
+	}
+	suffix := name[i+len(".so."):]
+	if suffix == "" || suffix[0] < '0' || suffix[0] > '9' {
+		return ""
+	}
+	return suffix
+}
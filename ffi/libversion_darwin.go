@@ -0,0 +1,121 @@
+//go:build darwin && (amd64 || arm64)
+
+package ffi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+	"github.com/go-webgpu/goffi/types"
+)
+
+const (
+	rtldNoload  = 0x10       // RTLD_NOLOAD: only return an already loaded image
+	mhMagic64   = 0xfeedfacf // MH_MAGIC_64
+	lcIDDylib   = 0xd        // LC_ID_DYLIB
+	machHdrSize = 32         // sizeof(struct mach_header_64)
+)
+
+// dyld image enumeration: uint32_t _dyld_image_count(void),
+// const char *_dyld_get_image_name(uint32_t) and
+// const struct mach_header *_dyld_get_image_header(uint32_t).
+var dyldImages struct {
+	once                sync.Once
+	count, name, header unsafe.Pointer
+	countCIF, indexCIF  types.CallInterface
+	err                 error
+}
+
+func initDyldImages() {
+	for _, s := range []struct {
+		dst  *unsafe.Pointer
+		name string
+	}{
+		{&dyldImages.count, "_dyld_image_count"},
+		{&dyldImages.name, "_dyld_get_image_name"},
+		{&dyldImages.header, "_dyld_get_image_header"},
+	} {
+		sym, err := dl.Dlsym(dl.RTLD_DEFAULT, s.name)
+		if err != nil {
+			dyldImages.err = err
+			return
+		}
+		*s.dst = foreignPointer(sym)
+	}
+	if err := PrepareCallInterface(&dyldImages.countCIF, types.DefaultCall, types.UInt32TypeDescriptor, nil); err != nil {
+		dyldImages.err = err
+		return
+	}
+	dyldImages.err = PrepareCallInterface(&dyldImages.indexCIF, types.DefaultCall, types.PointerTypeDescriptor,
+		[]*types.TypeDescriptor{types.UInt32TypeDescriptor})
+}
+
+// findImage returns the path and Mach-O header of the image handle refers to.
+func findImage(handle unsafe.Pointer) (string, unsafe.Pointer, error) {
+	dyldImages.once.Do(initDyldImages)
+	if dyldImages.err != nil {
+		return "", nil, dyldImages.err
+	}
+	var count uint32
+	if err := CallFunction(&dyldImages.countCIF, dyldImages.count, unsafe.Pointer(&count), nil); err != nil {
+		return "", nil, err
+	}
+	for i := uint32(0); i < count; i++ {
+		var namePtr unsafe.Pointer
+		if err := CallFunction(&dyldImages.indexCIF, dyldImages.name, unsafe.Pointer(&namePtr),
+			[]unsafe.Pointer{unsafe.Pointer(&i)}); err != nil {
+			return "", nil, err
+		}
+		name := goStringN(namePtr, 0)
+		// dlopen of a loaded image returns its existing handle.
+		h, err := dl.Dlopen(name, rtldNoload|dl.RTLD_LAZY)
+		if err != nil || h != uintptr(handle) {
+			continue
+		}
+		var header unsafe.Pointer
+		if err := CallFunction(&dyldImages.indexCIF, dyldImages.header, unsafe.Pointer(&header),
+			[]unsafe.Pointer{unsafe.Pointer(&i)}); err != nil {
+			return "", nil, err
+		}
+		return name, header, nil
+	}
+	return "", nil, fmt.Errorf("handle does not belong to a loaded image")
+}
+
+func queryLibraryVersion(handle unsafe.Pointer) (*LibraryVersion, error) {
+	path, header, err := findImage(handle)
+	if err != nil {
+		return nil, err
+	}
+	v := &LibraryVersion{Path: path}
+
+	le := binary.LittleEndian
+	hdr := unsafe.Slice((*byte)(header), machHdrSize)
+	if le.Uint32(hdr) != mhMagic64 {
+		return v, nil
+	}
+	ncmds, sizeofcmds := le.Uint32(hdr[16:]), le.Uint32(hdr[20:])
+	cmds := unsafe.Slice((*byte)(unsafe.Add(header, machHdrSize)), sizeofcmds)
+	for off, k := uint32(0), uint32(0); k < ncmds && off+8 <= sizeofcmds; k++ {
+		cmd, size := le.Uint32(cmds[off:]), le.Uint32(cmds[off+4:])
+		if size < 8 || off+size > sizeofcmds {
+			break
+		}
+		if cmd == lcIDDylib && size >= 24 {
+			// struct dylib_command { cmd, cmdsize; struct dylib { name.offset,
+			// timestamp, current_version, compatibility_version } }
+			c := cmds[off : off+size]
+			if nameOff := le.Uint32(c[8:]); nameOff < size {
+				v.Soname = goStringN(unsafe.Pointer(&c[nameOff]), int(size-nameOff))
+			}
+			cur := le.Uint32(c[16:])
+			v.setNumbers(fmt.Sprintf("%d.%d.%d", cur>>16, cur>>8&0xff, cur&0xff))
+			break
+		}
+		off += size
+	}
+	return v, nil
+}
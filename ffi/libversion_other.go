@@ -0,0 +1,12 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm) || windows)
+
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+func queryLibraryVersion(unsafe.Pointer) (*LibraryVersion, error) {
+	return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
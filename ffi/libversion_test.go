@@ -0,0 +1,73 @@
+package ffi
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestQueryLibraryVersion(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+
+	v, err := QueryLibraryVersion(handle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Path == "" {
+		t.Error("Path is empty")
+	}
+	if !v.Known() {
+		t.Fatalf("no version found for %s (%+v)", lib, v)
+	}
+	t.Logf("%s: %s (raw %q, soname %q)", v.Path, v, v.Raw, v.Soname)
+
+	switch runtime.GOOS {
+	case "linux", "freebsd":
+		if v.Soname != lib {
+			t.Errorf("Soname = %q, want %q", v.Soname, lib)
+		}
+		if want := int(lib[len(lib)-1] - '0'); v.Major != want {
+			t.Errorf("Major = %d, want %d", v.Major, want)
+		}
+	case "darwin":
+		if !strings.HasSuffix(v.Soname, "libSystem.B.dylib") {
+			t.Errorf("Soname = %q, want the libSystem install name", v.Soname)
+		}
+	}
+}
+
+func TestLibraryVersionParsing(t *testing.T) {
+	tests := []struct {
+		name string
+		want string // "" if no version
+	}{
+		{"libwgpu_native.so.22.1.0", "22.1.0"},
+		{"libc.so.6", "6.0.0"},
+		{"libfoo.so.1.2.3.4", "1.2.3"},
+		{"libfoo.so.3.0-rc1", "3.0.0"},
+		{"libfoo.so", ""},
+		{"libfoo.so.debug", ""},
+	}
+	for _, tt := range tests {
+		var v LibraryVersion
+		if raw := sonameVersion(tt.name); raw != "" {
+			v.setNumbers(raw)
+		}
+		got := ""
+		if v.Known() {
+			got = v.String()
+		}
+		if got != tt.want {
+			t.Errorf("%s: version %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	v := LibraryVersion{Raw: "22.1.0", Major: 22, Minor: 1}
+	if !v.AtLeast(22, 0, 5) || !v.AtLeast(22, 1, 0) || v.AtLeast(22, 1, 1) || v.AtLeast(23, 0, 0) {
+		t.Errorf("AtLeast comparisons wrong for %s", v.String())
+	}
+}
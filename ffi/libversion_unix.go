@@ -0,0 +1,91 @@
+//go:build ((linux || freebsd) && (amd64 || arm64)) || (linux && arm)
+
+package ffi
+
+import (
+	"debug/elf"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// rtldDILinkmap is the dlinfo request for the handle's struct link_map, the
+// same value on glibc, musl and FreeBSD. Its second field, l_name, is the
+// path the library was loaded from.
+const rtldDILinkmap = 2
+
+var dlinfoFunc struct {
+	once sync.Once
+	fn   unsafe.Pointer
+	cif  types.CallInterface
+	err  error
+}
+
+func initDlinfo() {
+	sym, err := dl.Dlsym(dl.RTLD_DEFAULT, "dlinfo")
+	if err != nil {
+		dlinfoFunc.err = err
+		return
+	}
+	dlinfoFunc.fn = foreignPointer(sym)
+	dlinfoFunc.err = PrepareCallInterface(&dlinfoFunc.cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.SInt32TypeDescriptor, types.PointerTypeDescriptor})
+}
+
+// libraryPath returns the path handle was loaded from.
+func libraryPath(handle unsafe.Pointer) (string, error) {
+	dlinfoFunc.once.Do(initDlinfo)
+	if dlinfoFunc.err != nil {
+		return "", dlinfoFunc.err
+	}
+	var linkMap unsafe.Pointer
+	request := int32(rtldDILinkmap)
+	out := unsafe.Pointer(&linkMap)
+	var ret int32
+	err := CallFunction(&dlinfoFunc.cif, dlinfoFunc.fn, unsafe.Pointer(&ret),
+		[]unsafe.Pointer{unsafe.Pointer(&handle), unsafe.Pointer(&request), unsafe.Pointer(&out)})
+	if err != nil {
+		return "", err
+	}
+	if ret != 0 || linkMap == nil {
+		return "", fmt.Errorf("dlinfo(RTLD_DI_LINKMAP) failed")
+	}
+	return goStringN(*(*unsafe.Pointer)(unsafe.Add(linkMap, unsafe.Sizeof(uintptr(0)))), 0), nil
+}
+
+func queryLibraryVersion(handle unsafe.Pointer) (*LibraryVersion, error) {
+	path, err := libraryPath(handle)
+	if err != nil {
+		return nil, err
+	}
+	v := &LibraryVersion{Path: path}
+	if path == "" {
+		return v, nil // the main program
+	}
+
+	if f, err := elf.Open(path); err == nil {
+		if names, err := f.DynString(elf.DT_SONAME); err == nil && len(names) > 0 {
+			v.Soname = names[0]
+		}
+		f.Close()
+	}
+
+	// The real file usually carries the full version (libfoo.so.1.2.3) while
+	// the soname only has the major (libfoo.so.1).
+	real := path
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		real = resolved
+	}
+	raw := sonameVersion(filepath.Base(real))
+	if raw == "" {
+		raw = sonameVersion(v.Soname)
+	}
+	if raw != "" {
+		v.setNumbers(raw)
+	}
+	return v, nil
+}
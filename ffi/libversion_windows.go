@@ -0,0 +1,67 @@
+//go:build windows
+
+package ffi
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	procGetModuleFileName = modkernel32.NewProc("GetModuleFileNameW")
+
+	modversion                 = syscall.NewLazyDLL("version.dll")
+	procGetFileVersionInfoSize = modversion.NewProc("GetFileVersionInfoSizeW")
+	procGetFileVersionInfo     = modversion.NewProc("GetFileVersionInfoW")
+	procVerQueryValue          = modversion.NewProc("VerQueryValueW")
+)
+
+const (
+	vsFixedFileInfoSignature = 0xfeef04bd
+	maxModulePath            = 32768 // longest extended-length path, in UTF-16 units
+)
+
+// vsFixedFileInfo is VS_FIXEDFILEINFO, the root block of a VERSIONINFO resource.
+type vsFixedFileInfo struct {
+	Signature, StrucVersion            uint32
+	FileVersionMS, FileVersionLS       uint32
+	ProductVersionMS, ProductVersionLS uint32
+	FileFlagsMask, FileFlags           uint32
+	FileOS, FileType, FileSubtype      uint32
+	FileDateMS, FileDateLS             uint32
+}
+
+func queryLibraryVersion(handle unsafe.Pointer) (*LibraryVersion, error) {
+	buf := make([]uint16, maxModulePath)
+	n, _, err := procGetModuleFileName.Call(uintptr(handle), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	if n == 0 {
+		return nil, dlErrno(err)
+	}
+	path := syscall.UTF16ToString(buf[:n])
+	v := &LibraryVersion{Path: path}
+
+	pathPtr := &buf[0]
+	size, _, _ := procGetFileVersionInfoSize.Call(uintptr(unsafe.Pointer(pathPtr)), 0)
+	if size == 0 {
+		return v, nil // no VERSIONINFO resource
+	}
+	data := make([]byte, size)
+	if ok, _, err := procGetFileVersionInfo.Call(uintptr(unsafe.Pointer(pathPtr)), 0, size, uintptr(unsafe.Pointer(&data[0]))); ok == 0 {
+		return nil, dlErrno(err)
+	}
+	root, _ := syscall.UTF16PtrFromString(`\`)
+	var info unsafe.Pointer
+	var infoLen uint32
+	if ok, _, _ := procVerQueryValue.Call(uintptr(unsafe.Pointer(&data[0])), uintptr(unsafe.Pointer(root)),
+		uintptr(unsafe.Pointer(&info)), uintptr(unsafe.Pointer(&infoLen))); ok == 0 || uintptr(infoLen) < unsafe.Sizeof(vsFixedFileInfo{}) {
+		return v, nil
+	}
+	fixed := (*vsFixedFileInfo)(info)
+	if fixed.Signature != vsFixedFileInfoSignature {
+		return nil, fmt.Errorf("malformed VERSIONINFO resource")
+	}
+	v.setNumbers(fmt.Sprintf("%d.%d.%d.%d",
+		fixed.FileVersionMS>>16, fixed.FileVersionMS&0xffff, fixed.FileVersionLS>>16, fixed.FileVersionLS&0xffff))
+	return v, nil
+}
@@ -0,0 +1,110 @@
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// ErrLibraryTooOld is returned by LoadLibraryWithOptions when the library
+// does not meet a WithMinVersion or WithProbeSymbols requirement.
+var ErrLibraryTooOld = errors.New("goffi: library is older than required")
+
+// LoadOption configures LoadLibraryWithOptions.
+type LoadOption func(*loadConfig)
+
+// loadConfig collects the effect of all LoadOptions for one load.
+type loadConfig struct {
+	minVersion *[3]int
+	probes     []string
+	flags      *int
+}
+
+// WithMinVersion requires the library to report version major.minor.patch or
+// newer through QueryLibraryVersion. A library without version information
+// fails the requirement too; use WithProbeSymbols for those.
+func WithMinVersion(major, minor, patch int) LoadOption {
+	return func(c *loadConfig) {
+		c.minVersion = &[3]int{major, minor, patch}
+	}
+}
+
+// WithProbeSymbols requires the library to export every one of names, such
+// as an entry point introduced by the oldest release the binding supports.
+func WithProbeSymbols(names ...string) LoadOption {
+	return func(c *loadConfig) {
+		c.probes = append(c.probes, names...)
+	}
+}
+
+// WithDlopenFlags loads the library with the dlopen mode flags instead of
+// LoadLibrary's RTLD_NOW|RTLD_GLOBAL, for instance RTLD_NOW|RTLD_LOCAL to keep
+// a plugin's symbols from clashing with another's, or RTLD_NODELETE for a
+// library that must not be unmapped while threads it started still run.
+// Flags are only available on Unix; elsewhere loading fails with an
+// *UnsupportedPlatformError.
+func WithDlopenFlags(flags int) LoadOption {
+	return func(c *loadConfig) {
+		c.flags = &flags
+	}
+}
+
+// LoadLibraryWithOptions loads name like LoadLibrary, with the dlopen flags
+// of WithDlopenFlags if given, and checks the requirements given as options
+// before returning it. If one is not met, the
+// library is freed again and a *LibraryError with Operation "version" that
+// wraps ErrLibraryTooOld explains what is missing, instead of the binding
+// crashing later on an entry point the library lacks:
+//
+//	lib, err := ffi.LoadLibraryWithOptions("libwgpu_native.so",
+//	    ffi.WithMinVersion(22, 1, 0),
+//	    ffi.WithProbeSymbols("wgpuInstanceRequestAdapter"))
+//	if errors.Is(err, ffi.ErrLibraryTooOld) {
+//	    log.Fatalf("please upgrade wgpu-native: %v", err)
+//	}
+func LoadLibraryWithOptions(name string, opts ...LoadOption) (unsafe.Pointer, error) {
+	var cfg loadConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	load := LoadLibrary
+	if cfg.flags != nil {
+		flags := *cfg.flags
+		load = func(name string) (unsafe.Pointer, error) { return loadLibraryFlags(name, flags) }
+	}
+	handle, err := load(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLoadRequirements(handle, &cfg); err != nil {
+		FreeLibrary(handle)
+		return nil, &LibraryError{Operation: "version", Name: name, Err: err}
+	}
+	return handle, nil
+}
+
+// checkLoadRequirements returns why handle does not meet cfg, or nil.
+func checkLoadRequirements(handle unsafe.Pointer, cfg *loadConfig) error {
+	if m := cfg.minVersion; m != nil {
+		v, err := queryLibraryVersion(handle)
+		if err != nil {
+			return fmt.Errorf("%w: cannot determine version: %v", ErrLibraryTooOld, err)
+		}
+		if !v.Known() {
+			return fmt.Errorf("%w: need %d.%d.%d, library reports no version", ErrLibraryTooOld, m[0], m[1], m[2])
+		}
+		if !v.AtLeast(m[0], m[1], m[2]) {
+			return fmt.Errorf("%w: need %d.%d.%d, found %s (%s)", ErrLibraryTooOld, m[0], m[1], m[2], v, v.Path)
+		}
+	}
+	var missing []string
+	for _, sym := range cfg.probes {
+		if _, err := GetSymbol(handle, sym); err != nil {
+			missing = append(missing, sym)
+		}
+	}
+	if missing != nil {
+		return fmt.Errorf("%w: missing %q", ErrLibraryTooOld, missing)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+)
+
+// buildFlagsTestLib compiles a library exporting only the function name, so
+// whether name resolves globally tells how the library was loaded.
+func buildFlagsTestLib(t *testing.T, name string) string {
+	t.Helper()
+	cc := os.Getenv("CC")
+	if cc == "" {
+		cc = "gcc"
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, name+".c")
+	if err := os.WriteFile(src, []byte("int "+name+"(void) { return 42; }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	so := filepath.Join(dir, "lib"+name+".so")
+	if out, err := exec.Command(cc, "-shared", "-fPIC", "-o", so, src).CombinedOutput(); err != nil {
+		t.Skipf("cannot build test library: %v\n%s", err, out)
+	}
+	return so
+}
+
+func TestLoadLibraryWithDlopenFlags(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		flags  int
+		global bool
+	}{
+		{"local", RTLD_NOW | RTLD_LOCAL, false},
+		{"global", RTLD_LAZY | RTLD_GLOBAL, true},
+		{"nodelete", RTLD_NOW | RTLD_LOCAL | RTLD_NODELETE, false},
+		{"deepbind", RTLD_NOW | RTLD_LOCAL | RTLD_DEEPBIND, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sym := "goffi_flags_" + tc.name
+			h, err := LoadLibraryWithOptions(buildFlagsTestLib(t, sym), WithDlopenFlags(tc.flags))
+			if err != nil {
+				t.Fatalf("LoadLibraryWithOptions: %v", err)
+			}
+			defer FreeLibrary(h)
+			if _, err := GetSymbol(h, sym); err != nil {
+				t.Errorf("GetSymbol: %v", err)
+			}
+			_, err = dl.Dlsym(dl.RTLD_DEFAULT, sym)
+			if global := err == nil; global != tc.global {
+				t.Errorf("%s resolves globally = %v, want %v", sym, global, tc.global)
+			}
+		})
+	}
+}
+
+func TestLoadLibraryWithDlopenFlagsInvalid(t *testing.T) {
+	// dlopen requires one of RTLD_LAZY and RTLD_NOW.
+	lib, _ := testLibAndSymbol()
+	_, err := LoadLibraryWithOptions(lib, WithDlopenFlags(RTLD_GLOBAL))
+	var lerr *LibraryError
+	if !errors.As(err, &lerr) || lerr.Operation != "load" {
+		t.Fatalf("err = %v, want a load *LibraryError", err)
+	}
+}
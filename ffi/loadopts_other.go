@@ -0,0 +1,13 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm))
+
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// loadLibraryFlags reports that there are no dlopen flags to load with.
+func loadLibraryFlags(string, int) (unsafe.Pointer, error) {
+	return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
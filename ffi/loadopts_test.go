@@ -0,0 +1,50 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLoadLibraryWithOptions(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	v, err := QueryLibraryVersion(handle)
+	if err != nil || !v.Known() {
+		t.Skipf("no version for %s: %v", lib, err)
+	}
+
+	h, err := LoadLibraryWithOptions(lib, WithMinVersion(v.Major, v.Minor, v.Patch), WithProbeSymbols(sym))
+	if err != nil {
+		t.Fatalf("requirements met by %s failed: %v", v, err)
+	}
+	FreeLibrary(h)
+
+	for _, tc := range []struct {
+		name string
+		opt  LoadOption
+		want string
+	}{
+		{"version", WithMinVersion(v.Major+1, 0, 0), "found " + v.String()},
+		{"probe", WithProbeSymbols(sym, "goffi_no_such_symbol"), "goffi_no_such_symbol"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := LoadLibraryWithOptions(lib, tc.opt)
+			if h != nil {
+				t.Error("handle returned for a failed requirement")
+			}
+			var libErr *LibraryError
+			if !errors.As(err, &libErr) || libErr.Operation != "version" || !errors.Is(err, ErrLibraryTooOld) {
+				t.Fatalf("err = %v (%T), want a version *LibraryError wrapping ErrLibraryTooOld", err, err)
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("err = %q, want it to mention %q", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build (linux || darwin || freebsd) && amd64
+
+package ffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// extended returns 1 + 2^-60 scaled by 2^exp, a value double cannot hold.
+func extended(exp int) types.LongDouble {
+	var x types.LongDouble
+	binary.LittleEndian.PutUint64(x[0:], 1<<63|1<<3)
+	binary.LittleEndian.PutUint16(x[8:], uint16(0x3fff+exp))
+	return x
+}
+
+func TestLongDouble(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("register arguments", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "ld_scale")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.LongDoubleTypeDescriptor,
+			[]*types.TypeDescriptor{types.LongDoubleTypeDescriptor, types.SInt64TypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		// More calls than the 8 x87 registers: a result left on the x87
+		// stack would overflow it and turn later results into NaNs.
+		for range 10 {
+			x, n := extended(0), int64(4)
+			var got types.LongDouble
+			if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+				[]unsafe.Pointer{unsafe.Pointer(&x), unsafe.Pointer(&n)}); err != nil {
+				t.Fatal(err)
+			}
+			if want := extended(2); got != want {
+				t.Fatalf("ld_scale(1+2^-60, 4) = % x, want % x", got[:10], want[:10])
+			}
+		}
+	})
+
+	t.Run("aligned stack slot", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "ld_after_stack")
+		if err != nil {
+			t.Fatal(err)
+		}
+		argTypes := make([]*types.TypeDescriptor, 8)
+		ints := make([]int64, 7)
+		avalue := make([]unsafe.Pointer, 8)
+		for i := range ints {
+			argTypes[i] = types.SInt64TypeDescriptor
+			ints[i] = int64(i + 1)
+			avalue[i] = unsafe.Pointer(&ints[i])
+		}
+		argTypes[7] = types.LongDoubleTypeDescriptor
+		x := types.LongDoubleFromFloat64(0.5)
+		avalue[7] = unsafe.Pointer(&x)
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.LongDoubleTypeDescriptor, argTypes); err != nil {
+			t.Fatal(err)
+		}
+		var got types.LongDouble
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got), avalue); err != nil {
+			t.Fatal(err)
+		}
+		if f := got.Float64(); f != 147.5 {
+			t.Errorf("ld_after_stack = %v, want 147.5", f)
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "ld_box_half")
+		if err != nil {
+			t.Fatal(err)
+		}
+		box := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.LongDoubleTypeDescriptor}}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, box, []*types.TypeDescriptor{box}); err != nil {
+			t.Fatal(err)
+		}
+		x := types.LongDoubleFromFloat64(-3)
+		var got types.LongDouble
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got), []unsafe.Pointer{unsafe.Pointer(&x)}); err != nil {
+			t.Fatal(err)
+		}
+		if f := got.Float64(); f != -1.5 {
+			t.Errorf("ld_box_half(-3) = %v, want -1.5", f)
+		}
+	})
+
+}
+
+func TestLongDoubleUnsupportedConvention(t *testing.T) {
+	var cif types.CallInterface
+	err := PrepareCallInterface(&cif, types.WindowsCallingConvention, types.LongDoubleTypeDescriptor, nil)
+	var tve *TypeValidationError
+	if !errors.As(err, &tve) {
+		t.Fatalf("PrepareCallInterface with Win64 = %v, want a *TypeValidationError", err)
+	}
+}
@@ -0,0 +1,160 @@
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"unsafe"
+)
+
+// LibrarySpec is one entry of a manifest passed to LoadManifest.
+type LibrarySpec struct {
+	// ID names the library in the LibrarySet and in DependsOn.
+	ID string
+
+	// Names lists the file names to try, in order, per platform. Keys are
+	// "GOOS/GOARCH" or "GOOS"; the most specific key present is used, and
+	// "" applies to all other platforms.
+	Names map[string][]string
+
+	// Optional libraries may fail to load without failing the manifest.
+	Optional bool
+
+	// DependsOn lists the IDs of earlier entries this library needs. If
+	// one of them was not loaded, this one is not attempted.
+	DependsOn []string
+}
+
+// names returns the candidates of s for the running platform.
+func (s *LibrarySpec) names() []string {
+	for _, key := range []string{runtime.GOOS + "/" + runtime.GOARCH, runtime.GOOS, ""} {
+		if names, ok := s.Names[key]; ok {
+			return names
+		}
+	}
+	return nil
+}
+
+// LibrarySet holds the libraries loaded by LoadManifest.
+type LibrarySet struct {
+	handles map[string]unsafe.Pointer
+	order   []string
+	missing []ManifestFailure
+}
+
+// LoadManifest loads the libraries of an ordered manifest, such as a driver
+// and the runtimes it links against, in manifest order, which must list
+// dependencies first:
+//
+//	libs, err := ffi.LoadManifest([]ffi.LibrarySpec{
+//	    {ID: "vulkan", Names: map[string][]string{
+//	        "windows": {"vulkan-1.dll"},
+//	        "darwin":  {"libvulkan.1.dylib", "libMoltenVK.dylib"},
+//	        "":        {"libvulkan.so.1", "libvulkan.so"}}},
+//	    {ID: "wgpu", Names: map[string][]string{"": {"libwgpu_native.so"}}, DependsOn: []string{"vulkan"}},
+//	    {ID: "renderdoc", Names: map[string][]string{"": {"librenderdoc.so"}}, Optional: true},
+//	})
+//	if err != nil {
+//	    return err // *ManifestError listing every library that failed and the names tried
+//	}
+//	defer libs.Close()
+//	wgpu := libs.Handle("wgpu")
+//
+// Every entry is attempted, so a failure reports all missing libraries at
+// once. If a required library fails, the libraries already loaded are
+// freed again and the *ManifestError is returned. A malformed manifest
+// (empty or duplicate IDs, a DependsOn that does not name an earlier entry)
+// is reported as an *InvalidCallInterfaceError before anything is loaded.
+func LoadManifest(manifest []LibrarySpec) (*LibrarySet, error) {
+	seen := make(map[string]bool, len(manifest))
+	for i := range manifest {
+		spec := &manifest[i]
+		if spec.ID == "" || seen[spec.ID] {
+			return nil, &InvalidCallInterfaceError{Field: "manifest", Reason: fmt.Sprintf("empty or duplicate ID %q", spec.ID), Index: i}
+		}
+		for _, dep := range spec.DependsOn {
+			if !seen[dep] {
+				return nil, &InvalidCallInterfaceError{
+					Field:  "manifest",
+					Reason: fmt.Sprintf("%s depends on %q, which is not listed before it", spec.ID, dep),
+					Index:  i,
+				}
+			}
+		}
+		seen[spec.ID] = true
+	}
+
+	set := &LibrarySet{handles: make(map[string]unsafe.Pointer, len(manifest))}
+	var failures []ManifestFailure
+	for i := range manifest {
+		spec := &manifest[i]
+		handle, failure := set.load(spec)
+		switch {
+		case failure == nil:
+			set.handles[spec.ID] = handle
+			set.order = append(set.order, spec.ID)
+		case spec.Optional:
+			set.missing = append(set.missing, *failure)
+		default:
+			failures = append(failures, *failure)
+		}
+	}
+	if failures != nil {
+		set.Close()
+		return nil, &ManifestError{Failures: failures}
+	}
+	return set, nil
+}
+
+// load loads the library of spec, trying its names in order.
+func (s *LibrarySet) load(spec *LibrarySpec) (unsafe.Pointer, *ManifestFailure) {
+	for _, dep := range spec.DependsOn {
+		if !s.Loaded(dep) {
+			return nil, &ManifestFailure{ID: spec.ID, Err: fmt.Errorf("depends on %q, which was not loaded", dep)}
+		}
+	}
+	names := spec.names()
+	if len(names) == 0 {
+		return nil, &ManifestFailure{ID: spec.ID, Err: &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}}
+	}
+	var err error
+	for _, name := range names {
+		var handle unsafe.Pointer
+		if handle, err = LoadLibrary(name); err == nil {
+			return handle, nil
+		}
+	}
+	return nil, &ManifestFailure{ID: spec.ID, Tried: names, Err: err}
+}
+
+// Handle returns the handle of the library id, or nil if it was not loaded.
+func (s *LibrarySet) Handle(id string) unsafe.Pointer {
+	return s.handles[id]
+}
+
+// Loaded reports whether the library id was loaded.
+func (s *LibrarySet) Loaded(id string) bool {
+	_, ok := s.handles[id]
+	return ok
+}
+
+// Missing returns the optional libraries that were not loaded, and why.
+func (s *LibrarySet) Missing() []ManifestFailure {
+	return slices.Clone(s.missing)
+}
+
+// Close frees the libraries in reverse load order, so that dependents are
+// unloaded before their dependencies, and returns the errors of all
+// FreeLibrary calls joined. The set is empty afterwards.
+func (s *LibrarySet) Close() error {
+	var errs []error
+	for _, id := range slices.Backward(s.order) {
+		if err := FreeLibrary(s.handles[id]); err != nil {
+			errs = append(errs, err)
+		}
+		delete(s.handles, id)
+	}
+	s.order = nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,74 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestLoadManifest(t *testing.T) {
+	libc, _ := testLibAndSymbol()
+	libs, err := LoadManifest([]LibrarySpec{
+		{ID: "libc", Names: map[string][]string{
+			runtime.GOOS: {"goffi-no-such-lib.so", libc},
+			"":           {"goffi-wrong-platform.so"},
+		}},
+		{ID: "plugin", Names: map[string][]string{"": {"goffi-no-such-plugin.so"}}, Optional: true},
+		{ID: "plugin-ext", Names: map[string][]string{"": {libc}}, Optional: true, DependsOn: []string{"plugin"}},
+	})
+	if err != nil {
+		t.Skipf("cannot load %s: %v", libc, err)
+	}
+	if !libs.Loaded("libc") || libs.Handle("libc") == nil {
+		t.Error("libc not loaded")
+	}
+	if libs.Loaded("plugin") || libs.Loaded("plugin-ext") {
+		t.Error("missing optional library or its dependent reported as loaded")
+	}
+	missing := libs.Missing()
+	if len(missing) != 2 || missing[0].ID != "plugin" || !slices.Equal(missing[0].Tried, []string{"goffi-no-such-plugin.so"}) ||
+		missing[1].ID != "plugin-ext" || missing[1].Tried != nil {
+		t.Errorf("Missing = %+v", missing)
+	}
+	if err := libs.Close(); err != nil {
+		t.Error(err)
+	}
+	if libs.Loaded("libc") {
+		t.Error("libc still listed after Close")
+	}
+}
+
+func TestLoadManifestFailure(t *testing.T) {
+	libc, _ := testLibAndSymbol()
+	_, err := LoadManifest([]LibrarySpec{
+		{ID: "libc", Names: map[string][]string{"": {libc}}},
+		{ID: "driver", Names: map[string][]string{"": {"goffi-no-such-driver.so", "goffi-no-such-driver.so.1"}}},
+		{ID: "wrapper", Names: map[string][]string{"": {libc}}, DependsOn: []string{"driver"}},
+	})
+	var manErr *ManifestError
+	if !errors.As(err, &manErr) {
+		t.Fatalf("err = %v, want *ManifestError", err)
+	}
+	if len(manErr.Failures) != 2 || manErr.Failures[0].ID != "driver" || len(manErr.Failures[0].Tried) != 2 ||
+		manErr.Failures[1].ID != "wrapper" {
+		t.Errorf("failures = %+v", manErr.Failures)
+	}
+	var libErr *LibraryError
+	if !errors.As(err, &libErr) {
+		t.Errorf("the loader error is not reachable through %v", err)
+	}
+
+	var icie *InvalidCallInterfaceError
+	for _, bad := range [][]LibrarySpec{
+		{{ID: ""}},
+		{{ID: "a"}, {ID: "a"}},
+		{{ID: "a", DependsOn: []string{"b"}}, {ID: "b"}},
+	} {
+		if _, err := LoadManifest(bad); !errors.As(err, &icie) {
+			t.Errorf("LoadManifest(%+v): err = %v, want *InvalidCallInterfaceError", bad, err)
+		}
+	}
+}
@@ -0,0 +1,443 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Marshal writes the Go value v into the C object at dst, laid out as desc,
+// allocating everything the object points to from arena. It automates the
+// construction of descriptor-heavy structs such as WGPUDeviceDescriptor:
+//
+//	// struct { const char *label; size_t featureCount; const uint32_t *features;
+//	//          void (*lost)(int reason, void *userdata); };
+//	type deviceDesc struct {
+//	    Label    string
+//	    Features []uint32
+//	    Lost     func(reason int32, userdata unsafe.Pointer)
+//	}
+//	desc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+//	    types.PointerTypeDescriptor,
+//	    types.UInt64TypeDescriptor, types.PointerTo(types.UInt32TypeDescriptor),
+//	    types.PointerTypeDescriptor}}
+//	arena := ffi.NewArena()
+//	defer arena.Free()
+//	var buf [4]uint64 // the C struct, 32 bytes on 64-bit targets
+//	err := ffi.Marshal(unsafe.Pointer(&buf), desc, &deviceDesc{...}, arena)
+//
+// The exported fields of a Go struct match the members of a struct
+// descriptor in order; blank fields and fields tagged `ffi:"-"` are skipped,
+// a field tagged `ffi:"padding=N"` skips N members first, and a Go array
+// matches that many consecutive members. DescribeStruct derives a matching
+// descriptor from the Go type. Values convert as follows:
+//
+//   - bool, integers and floats go to integer and floating-point members,
+//     converted as by a C cast; float32/float64 also go to Float16 members
+//   - nested structs go to struct members
+//   - strings go to pointer members as NUL-terminated copies (wchar_t for
+//     WString members)
+//   - a slice matches two members, a count followed by a pointer, as in C
+//     APIs such as WebGPU and Vulkan; the elements are copied to an array
+//     laid out as the pointer's Elem (see types.PointerTo), and nil or empty
+//     slices become a zero count and NULL
+//   - a pointer *T goes to a pointer member whose Elem describes T; the
+//     pointee is copied, and nil becomes NULL
+//   - funcs become callbacks registered with arena.Callback, nil becomes NULL
+//   - uintptr and unsafe.Pointer values are stored as they are
+//
+// Elem may be omitted for pointers to scalars; it is then derived from the Go
+// element type. dst must hold desc.Size bytes; a struct desc is laid out on
+// first use as for PrepareCallInterface. v may be a pointer to the value.
+func Marshal(dst unsafe.Pointer, desc *types.TypeDescriptor, v any, arena *Arena) error {
+	if dst == nil {
+		return &InvalidCallInterfaceError{Field: "dst", Reason: "must not be nil", Index: -1}
+	}
+	if arena == nil {
+		return &InvalidCallInterfaceError{Field: "arena", Reason: "must not be nil", Index: -1}
+	}
+	if err := prepareMarshalType("desc", desc); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && desc.Kind != types.PointerType {
+		if rv.IsNil() {
+			return &InvalidCallInterfaceError{Field: "v", Reason: "must not be a nil pointer", Index: -1}
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return &InvalidCallInterfaceError{Field: "v", Reason: "must not be nil", Index: -1}
+	}
+	return marshalValue(dst, desc, rv, arena)
+}
+
+// prepareMarshalType lays out a struct descriptor on first use and rejects
+// descriptors that cannot hold a value.
+func prepareMarshalType(name string, t *types.TypeDescriptor) error {
+	if t == nil {
+		return &TypeValidationError{TypeName: name, Kind: 0, Reason: "type descriptor is nil", Index: -1}
+	}
+	if t.Size == 0 && t.Kind == types.StructType {
+		if err := initializeCompositeType(t); err != nil {
+			return err
+		}
+	}
+	if !isValidType(t) || t.Kind == types.VoidType {
+		return newInvalidTypeError(name, int(t.Kind), "unsupported type kind")
+	}
+	return nil
+}
+
+// fieldBinding ties a Go struct field, or an element of an array field, to
+// the descriptor member it is marshaled to.
+type fieldBinding struct {
+	path   []int // field indices, and element indices for arrays
+	member int
+	desc   *types.TypeDescriptor
+	offset uintptr
+
+	// For slices, the count member preceding the pointer member.
+	countDesc   *types.TypeDescriptor
+	countOffset uintptr
+}
+
+// field returns the Go value b refers to in the struct v.
+func (b *fieldBinding) field(v reflect.Value) reflect.Value {
+	for _, i := range b.path {
+		if v.Kind() == reflect.Struct {
+			v = v.Field(i)
+		} else {
+			v = v.Index(i)
+		}
+	}
+	return v
+}
+
+// bindFields matches the fields of the Go struct type t with the members of
+// the struct descriptor d.
+func bindFields(t reflect.Type, d *types.TypeDescriptor) ([]fieldBinding, error) {
+	mismatch := func(reason string, index int) error {
+		return &TypeValidationError{TypeName: t.String(), Kind: int(d.Kind), Reason: reason, Index: index}
+	}
+	offsets := make([]uintptr, len(d.Members))
+	var pos uintptr
+	for i, m := range d.Members {
+		pos = align(pos, d.MemberAlignment(m))
+		offsets[i] = pos
+		pos += m.Size
+	}
+
+	var bindings []fieldBinding
+	next := 0
+	var bind func(ft reflect.Type, path []int) error
+	bind = func(ft reflect.Type, path []int) error {
+		if ft.Kind() == reflect.Array && ft != reflect.TypeFor[types.LongDouble]() {
+			for i := range ft.Len() {
+				if err := bind(ft.Elem(), append(path[:len(path):len(path)], i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		b := fieldBinding{path: path}
+		if ft.Kind() == reflect.Slice {
+			if next >= len(d.Members) {
+				return mismatch("more fields than descriptor members", next)
+			}
+			if !isIntegerKind(d.Members[next].Kind) {
+				return mismatch("slice count member is not an integer", next)
+			}
+			b.countDesc, b.countOffset = d.Members[next], offsets[next]
+			next++
+		}
+		if next >= len(d.Members) {
+			return mismatch("more fields than descriptor members", next)
+		}
+		b.member, b.desc, b.offset = next, d.Members[next], offsets[next]
+		next++
+		bindings = append(bindings, b)
+		return nil
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Name == "_" {
+			continue
+		}
+		tag, err := parseFFITag(f)
+		if err != nil {
+			return nil, mismatch(err.Error(), -1)
+		}
+		if tag.skip {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, mismatch(fmt.Sprintf("field %s is unexported; tag it `ffi:\"-\"` to skip it", f.Name), -1)
+		}
+		// Padding members have no Go counterpart; Marshal leaves them alone.
+		if next += tag.padding; next > len(d.Members) {
+			return nil, mismatch("padding exceeds the descriptor members", -1)
+		}
+		if err := bind(f.Type, []int{i}); err != nil {
+			return nil, err
+		}
+	}
+	if next != len(d.Members) {
+		return nil, mismatch(fmt.Sprintf("%d descriptor members, fields cover %d", len(d.Members), next), -1)
+	}
+	return bindings, nil
+}
+
+// elemType returns the element descriptor of the pointer type d for Go
+// elements of type goElem: d.Elem, or one derived from a scalar goElem.
+func elemType(d *types.TypeDescriptor, goElem reflect.Type) (*types.TypeDescriptor, error) {
+	if d.Elem != nil {
+		return d.Elem, prepareMarshalType("elem", d.Elem)
+	}
+	if e := scalarTypeFor(goElem); e != nil {
+		return e, nil
+	}
+	return nil, &TypeValidationError{
+		TypeName: goElem.String(),
+		Kind:     int(d.Kind),
+		Reason:   "pointer member needs an element descriptor (types.PointerTo)",
+		Index:    -1,
+	}
+}
+
+// scalarTypeFor returns the predefined descriptor matching a Go scalar
+// type, or nil.
+func scalarTypeFor(t reflect.Type) *types.TypeDescriptor {
+	switch t {
+	case reflect.TypeFor[types.Int128]():
+		return types.SInt128TypeDescriptor
+	case reflect.TypeFor[types.UInt128]():
+		return types.UInt128TypeDescriptor
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.BoolTypeDescriptor
+	case reflect.Uint8:
+		return types.UInt8TypeDescriptor
+	case reflect.Int8:
+		return types.SInt8TypeDescriptor
+	case reflect.Int16:
+		return types.SInt16TypeDescriptor
+	case reflect.Uint16:
+		return types.UInt16TypeDescriptor
+	case reflect.Int32:
+		return types.SInt32TypeDescriptor
+	case reflect.Uint32:
+		return types.UInt32TypeDescriptor
+	case reflect.Int64:
+		return types.SInt64TypeDescriptor
+	case reflect.Uint64:
+		return types.UInt64TypeDescriptor
+	case reflect.Int:
+		return types.IntPtrTypeDescriptor
+	case reflect.Uint, reflect.Uintptr:
+		return types.UIntPtrTypeDescriptor
+	case reflect.Float32:
+		return types.FloatTypeDescriptor
+	case reflect.Float64:
+		return types.DoubleTypeDescriptor
+	case reflect.UnsafePointer:
+		return types.PointerTypeDescriptor
+	}
+	return nil
+}
+
+// marshalValue writes v to p as d.
+func marshalValue(p unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value, a *Arena) error {
+	switch d.Kind {
+	case types.StructType:
+		if v.Kind() != reflect.Struct {
+			return marshalMismatch(v, d, "struct member needs a Go struct")
+		}
+		bindings, err := bindFields(v.Type(), d)
+		if err != nil {
+			return err
+		}
+		for i := range bindings {
+			b := &bindings[i]
+			fv := b.field(v)
+			if b.countDesc != nil {
+				storeInt(unsafe.Add(p, b.countOffset), b.countDesc.Size, uint64(fv.Len()))
+				err = marshalArray(unsafe.Add(p, b.offset), b.desc, fv, a)
+			} else {
+				err = marshalValue(unsafe.Add(p, b.offset), b.desc, fv, a)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case types.PointerType:
+		return marshalPointer(p, d, v, a)
+	default:
+		return marshalScalar(p, d, v)
+	}
+}
+
+// marshalPointer writes the pointer member p of type d for the Go value v.
+func marshalPointer(p unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value, a *Arena) error {
+	var ptr uintptr
+	switch v.Kind() {
+	case reflect.Uintptr:
+		ptr = uintptr(v.Uint())
+	case reflect.UnsafePointer:
+		ptr = uintptr(v.UnsafePointer())
+	case reflect.String:
+		var s unsafe.Pointer
+		var err error
+		if d.WString {
+			s, err = a.WideCString(v.String())
+		} else {
+			s, err = a.CString(v.String())
+		}
+		if err != nil {
+			return err
+		}
+		ptr = uintptr(s)
+	case reflect.Func:
+		if !v.IsNil() {
+			ptr = a.Callback(v.Interface())
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			break
+		}
+		elem, err := elemType(d, v.Type().Elem())
+		if err != nil {
+			return err
+		}
+		q := a.Alloc(elem.Size, elem.Alignment)
+		if err := marshalValue(q, elem, v.Elem(), a); err != nil {
+			return err
+		}
+		ptr = uintptr(q)
+	case reflect.Slice:
+		// A slice without a count member, for arrays whose length the C
+		// side knows otherwise.
+		return marshalArray(p, d, v, a)
+	default:
+		return marshalMismatch(v, d, "cannot be stored in a pointer member")
+	}
+	*(*uintptr)(p) = ptr
+	return nil
+}
+
+// marshalArray copies the elements of the slice v to an array allocated from
+// a and stores its address at p.
+func marshalArray(p unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value, a *Arena) error {
+	if d.Kind != types.PointerType {
+		return marshalMismatch(v, d, "slice needs a pointer member")
+	}
+	if v.Len() == 0 {
+		*(*uintptr)(p) = 0
+		return nil
+	}
+	elem, err := elemType(d, v.Type().Elem())
+	if err != nil {
+		return err
+	}
+	q := a.Alloc(elem.Size*uintptr(v.Len()), elem.Alignment)
+	for i := range v.Len() {
+		if err := marshalValue(unsafe.Add(q, uintptr(i)*elem.Size), elem, v.Index(i), a); err != nil {
+			return err
+		}
+	}
+	*(*uintptr)(p) = uintptr(q)
+	return nil
+}
+
+// marshalScalar writes the Go bool, integer or float v to p as d.
+func marshalScalar(p unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value) error {
+	switch d.Kind {
+	case types.FloatType, types.DoubleType, types.Float16Type, types.LongDoubleType:
+		var f float64
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			f = v.Float()
+		case reflect.Uint16:
+			if d.Kind == types.Float16Type { // types.Float16
+				storeInt(p, 2, v.Uint())
+				return nil
+			}
+			return marshalMismatch(v, d, "floating-point member needs a Go float")
+		case reflect.Array:
+			if d.Kind == types.LongDoubleType && v.Type() == reflect.TypeFor[types.LongDouble]() {
+				*(*types.LongDouble)(p) = v.Interface().(types.LongDouble)
+				return nil
+			}
+			return marshalMismatch(v, d, "floating-point member needs a Go float")
+		default:
+			return marshalMismatch(v, d, "floating-point member needs a Go float")
+		}
+		switch d.Kind {
+		case types.FloatType:
+			*(*float32)(p) = float32(f)
+		case types.DoubleType:
+			*(*float64)(p) = f
+		case types.LongDoubleType:
+			*(*types.LongDouble)(p) = types.LongDoubleFromFloat64(f)
+		default:
+			*(*types.Float16)(p) = types.Float16FromFloat32(float32(f))
+		}
+		return nil
+	}
+
+	if d.Kind == types.SInt128Type || d.Kind == types.UInt128Type {
+		if !isInt128Type(v.Type()) {
+			return marshalMismatch(v, d, "128-bit member needs a types.Int128 or types.UInt128")
+		}
+		*(*[2]uint64)(p) = [2]uint64{v.Field(0).Uint(), v.Field(1).Uint()}
+		return nil
+	}
+	if !isIntegerKind(d.Kind) && d.Kind != types.BoolType {
+		return marshalMismatch(v, d, "unsupported member kind")
+	}
+	var u uint64
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			u = 1
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		u = uint64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u = v.Uint()
+	default:
+		return marshalMismatch(v, d, "integer member needs a Go bool or integer")
+	}
+	if d.Kind == types.BoolType && u != 0 {
+		u = 1
+	}
+	storeInt(p, d.Size, u)
+	return nil
+}
+
+// isInt128Type reports whether t is types.Int128 or types.UInt128.
+func isInt128Type(t reflect.Type) bool {
+	return t == reflect.TypeFor[types.Int128]() || t == reflect.TypeFor[types.UInt128]()
+}
+
+// storeInt writes the low size bytes of u to p.
+func storeInt(p unsafe.Pointer, size uintptr, u uint64) {
+	switch size {
+	case 1:
+		*(*uint8)(p) = uint8(u)
+	case 2:
+		*(*uint16)(p) = uint16(u)
+	case 4:
+		*(*uint32)(p) = uint32(u)
+	case 8:
+		*(*uint64)(p) = u
+	}
+}
+
+func marshalMismatch(v reflect.Value, d *types.TypeDescriptor, reason string) error {
+	return &TypeValidationError{TypeName: v.Type().String(), Kind: int(d.Kind), Reason: reason, Index: -1}
+}
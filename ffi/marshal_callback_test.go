@@ -0,0 +1,43 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestMarshalCallback(t *testing.T) {
+	desc := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.PointerTypeDescriptor},
+	}
+	type s struct{ Compare func(a, b int) int }
+
+	before := CallbackCount()
+	arena := NewArena()
+	var fn uintptr
+	if err := Marshal(unsafe.Pointer(&fn), desc, s{Compare: func(a, b int) int { return a - b }}, arena); err != nil {
+		t.Fatal(err)
+	}
+	if fn == 0 {
+		t.Fatal("callback pointer is NULL")
+	}
+
+	// Simulate C calling the callback, as the callback tests do.
+	var frame [128]uintptr
+	frame[callbackIntRegIndex(0)] = 10
+	frame[callbackIntRegIndex(1)] = 3
+	args := &callbackArgs{index: callbackIndex(fn), args: unsafe.Pointer(&frame)}
+	callbackWrap(args)
+	if got := int32(args.result); got != 7 {
+		t.Errorf("callback returned %d, want 7", got)
+	}
+
+	arena.Free()
+	if n := CallbackCount(); n != before {
+		t.Errorf("CallbackCount after Free = %d, want %d", n, before)
+	}
+}
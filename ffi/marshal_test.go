@@ -0,0 +1,173 @@
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// struct limits { uint32_t maxTextures; double scale; };
+func limitsDesc() *types.TypeDescriptor {
+	return &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt32TypeDescriptor, types.DoubleTypeDescriptor},
+	}
+}
+
+type limits struct {
+	MaxTextures uint32
+	Scale       float64
+}
+
+func TestMarshalStruct(t *testing.T) {
+	if unsafe.Sizeof(uintptr(0)) != 8 {
+		t.Skip("layout checks assume 64-bit pointers")
+	}
+	// struct {
+	//     const char *label;
+	//     size_t featureCount; const uint32_t *features;
+	//     const struct limits *required;
+	//     uint8_t flags[2];
+	//     struct limits defaults;
+	// };
+	desc := &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.PointerTypeDescriptor,
+			types.UInt64TypeDescriptor, types.PointerTo(types.UInt32TypeDescriptor),
+			types.PointerTo(limitsDesc()),
+			types.UInt8TypeDescriptor, types.UInt8TypeDescriptor,
+			limitsDesc(),
+		},
+	}
+	type device struct {
+		Label    string
+		Features []uint32
+		Required *limits
+		Flags    [2]bool
+		Defaults limits
+		Cache    map[string]int `ffi:"-"`
+	}
+	v := device{
+		Label:    "main",
+		Features: []uint32{7, 9},
+		Required: &limits{MaxTextures: 16, Scale: 0.5},
+		Flags:    [2]bool{false, true},
+		Defaults: limits{MaxTextures: 4, Scale: 2},
+	}
+
+	arena := NewArena()
+	defer arena.Free()
+	var buf [8]uint64
+	if err := Marshal(unsafe.Pointer(&buf), desc, &v, arena); err != nil {
+		t.Fatal(err)
+	}
+	if desc.Size != 56 {
+		t.Fatalf("desc.Size = %d, want 56", desc.Size)
+	}
+
+	if s := goStringN(*(*unsafe.Pointer)(unsafe.Pointer(&buf[0])), 0); s != "main" {
+		t.Errorf("label = %q", s)
+	}
+	if buf[1] != 2 {
+		t.Errorf("featureCount = %d", buf[1])
+	}
+	if f := unsafe.Slice((*uint32)(*(*unsafe.Pointer)(unsafe.Pointer(&buf[2]))), 2); f[0] != 7 || f[1] != 9 {
+		t.Errorf("features = %v", f)
+	}
+	if req := (*limits)(*(*unsafe.Pointer)(unsafe.Pointer(&buf[3]))); *req != *v.Required {
+		t.Errorf("required = %+v", *req)
+	}
+	if flags := *(*[2]uint8)(unsafe.Pointer(&buf[4])); flags != [2]uint8{0, 1} {
+		t.Errorf("flags = %v", flags)
+	}
+	if def := *(*limits)(unsafe.Pointer(&buf[5])); def != v.Defaults {
+		t.Errorf("defaults = %+v", def)
+	}
+}
+
+func TestMarshalNil(t *testing.T) {
+	if unsafe.Sizeof(uintptr(0)) != 8 {
+		t.Skip("layout checks assume 64-bit pointers")
+	}
+	desc := &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.UInt32TypeDescriptor, types.PointerTo(limitsDesc()),
+			types.PointerTo(limitsDesc()),
+			types.PointerTypeDescriptor,
+		},
+	}
+	type s struct {
+		List []limits
+		One  *limits
+		Fn   func()
+	}
+	buf := [4]uint64{1, 1, 1, 1}
+	arena := NewArena()
+	if err := Marshal(unsafe.Pointer(&buf), desc, s{}, arena); err != nil {
+		t.Fatal(err)
+	}
+	if uint32(buf[0]) != 0 || buf[1] != 0 || buf[2] != 0 || buf[3] != 0 {
+		t.Errorf("buf = %v, want zero count and NULL pointers", buf)
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	arena := NewArena()
+	var buf [4]uint64
+	dst := unsafe.Pointer(&buf)
+	var tve *TypeValidationError
+
+	two := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt32TypeDescriptor, types.UInt32TypeDescriptor},
+	}
+	if err := Marshal(dst, two, struct{ A uint32 }{}, arena); !errors.As(err, &tve) {
+		t.Errorf("too few fields: err = %v", err)
+	}
+	if err := Marshal(dst, two, struct {
+		A uint32
+		b uint32
+	}{}, arena); !errors.As(err, &tve) {
+		t.Errorf("unexported field: err = %v", err)
+	}
+	if err := Marshal(dst, two, struct{ A, B string }{}, arena); !errors.As(err, &tve) {
+		t.Errorf("string in integer member: err = %v", err)
+	}
+
+	ptr := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.PointerTypeDescriptor},
+	}
+	if err := Marshal(dst, ptr, struct{ L *limits }{&limits{}}, arena); !errors.As(err, &tve) {
+		t.Errorf("untyped struct pointer: err = %v", err)
+	}
+	if err := Marshal(dst, ptr, struct{ S string }{"a\x00b"}, arena); !errors.Is(err, ErrNULInString) {
+		t.Errorf("NUL in string: err = %v, want ErrNULInString", err)
+	}
+	if err := Marshal(dst, ptr, struct{ S string }{}, nil); err == nil {
+		t.Error("nil arena: expected an error")
+	}
+}
+
+func TestArenaAlloc(t *testing.T) {
+	arena := NewArena()
+	defer arena.Free()
+	for _, align := range []uintptr{0, 1, 8, 16, 64} {
+		p := arena.Alloc(24, align)
+		if align > 0 && uintptr(p)%align != 0 {
+			t.Errorf("Alloc(24, %d) = %p, misaligned", align, p)
+		}
+		for _, b := range unsafe.Slice((*byte)(p), 24) {
+			if b != 0 {
+				t.Fatalf("Alloc(24, %d) not zeroed", align)
+			}
+		}
+	}
+	if p := arena.Alloc(0, 8); p != nil {
+		t.Errorf("Alloc(0) = %p, want nil", p)
+	}
+}
@@ -0,0 +1,158 @@
+package ffi
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// BindError is the panic value of MustLoadLibrary and MustSymbol.
+//
+// It carries everything needed to diagnose a failed binding at a glance: the
+// library and symbol involved, where the dynamic loader would have looked
+// for the library and the underlying loader error.
+type BindError struct {
+	Library     string   // Library name or path ("" if unknown)
+	Symbol      string   // Symbol name ("" for library load failures)
+	SearchPaths []string // Locations the loader searches for Library, in order
+	Err         error    // Underlying *LibraryError
+}
+
+func (e *BindError) Error() string {
+	var b strings.Builder
+	if e.Symbol != "" {
+		b.WriteString("goffi: cannot resolve symbol " + quote(e.Symbol))
+		if e.Library != "" {
+			b.WriteString(" in " + quote(e.Library))
+		}
+	} else {
+		b.WriteString("goffi: cannot load library " + quote(e.Library))
+	}
+	if e.Err != nil {
+		b.WriteString(": " + e.Err.Error())
+	}
+	if len(e.SearchPaths) > 0 {
+		b.WriteString("\n\tsearched:")
+		for _, p := range e.SearchPaths {
+			b.WriteString("\n\t\t" + p)
+		}
+	}
+	return b.String()
+}
+
+// Unwrap returns the underlying error for errors.Unwrap().
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+func quote(s string) string {
+	return `"` + s + `"`
+}
+
+// mustLibraries maps handles returned by MustLoadLibrary to the library name,
+// so MustSymbol can say which library a missing symbol was looked up in.
+var mustLibraries sync.Map // unsafe.Pointer -> string
+
+// MustLoadLibrary is like LoadLibrary but panics with a *BindError if the
+// library cannot be loaded. It is meant for initialization-time binding code
+// where a missing library is fatal anyway:
+//
+//	var (
+//	    wgpu            = ffi.MustLoadLibrary("libwgpu_native.so")
+//	    wgpuCreateInstance = ffi.MustSymbol(wgpu, "wgpuCreateInstance")
+//	)
+func MustLoadLibrary(name string) unsafe.Pointer {
+	handle, err := LoadLibrary(name)
+	if err != nil {
+		panic(&BindError{
+			Library:     name,
+			SearchPaths: librarySearchPaths(name),
+			Err:         err,
+		})
+	}
+	mustLibraries.Store(handle, name)
+	return handle
+}
+
+// MustSymbol is like GetSymbol but panics with a *BindError if the symbol
+// cannot be resolved. If handle came from MustLoadLibrary the panic names the
+// library as well.
+func MustSymbol(handle unsafe.Pointer, name string) unsafe.Pointer {
+	sym, err := GetSymbol(handle, name)
+	if err != nil {
+		lib, _ := mustLibraries.Load(handle)
+		libName, _ := lib.(string)
+		panic(&BindError{
+			Library: libName,
+			Symbol:  name,
+			Err:     err,
+		})
+	}
+	return sym
+}
+
+// librarySearchPaths lists, best effort, where the platform's dynamic loader
+// looks for name. Caches such as ld.so.cache and the dyld shared cache are
+// listed by name only.
+func librarySearchPaths(name string) []string {
+	if strings.ContainsRune(name, '/') || (runtime.GOOS == "windows" && strings.ContainsAny(name, `\:`)) {
+		return []string{name}
+	}
+
+	var dirs []string
+	addEnv := func(key string) {
+		for _, dir := range filepath.SplitList(os.Getenv(key)) {
+			if dir != "" {
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	var paths []string
+	switch runtime.GOOS {
+	case "linux":
+		addEnv("LD_LIBRARY_PATH")
+		paths = joinAll(dirs, name)
+		paths = append(paths, "/etc/ld.so.cache")
+		dirs = dirs[:0]
+		multiarch := map[string]string{"amd64": "x86_64-linux-gnu", "arm64": "aarch64-linux-gnu"}[runtime.GOARCH]
+		if multiarch != "" {
+			dirs = append(dirs, "/lib/"+multiarch, "/usr/lib/"+multiarch)
+		}
+		dirs = append(dirs, "/lib64", "/usr/lib64", "/lib", "/usr/lib")
+	case "freebsd":
+		addEnv("LD_LIBRARY_PATH")
+		dirs = append(dirs, "/lib", "/usr/lib", "/usr/local/lib")
+	case "darwin":
+		addEnv("DYLD_LIBRARY_PATH")
+		if os.Getenv("DYLD_FALLBACK_LIBRARY_PATH") != "" {
+			addEnv("DYLD_FALLBACK_LIBRARY_PATH")
+		} else {
+			dirs = append(dirs, "/usr/local/lib", "/usr/lib")
+		}
+		paths = append(joinAll(dirs, name), "dyld shared cache")
+		return paths
+	case "windows":
+		if exe, err := os.Executable(); err == nil {
+			dirs = append(dirs, filepath.Dir(exe))
+		}
+		if root := os.Getenv("SystemRoot"); root != "" {
+			dirs = append(dirs, filepath.Join(root, "System32"), root)
+		}
+		if wd, err := os.Getwd(); err == nil {
+			dirs = append(dirs, wd)
+		}
+		addEnv("PATH")
+	}
+	return append(paths, joinAll(dirs, name)...)
+}
+
+func joinAll(dirs []string, name string) []string {
+	paths := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	return paths
+}
@@ -0,0 +1,68 @@
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// recoverBindError runs fn and returns the *BindError it panicked with.
+func recoverBindError(t *testing.T, fn func()) (bindErr *BindError) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic")
+		}
+		err, ok := r.(*BindError)
+		if !ok {
+			t.Fatalf("panic value is %T, want *BindError", r)
+		}
+		bindErr = err
+	}()
+	fn()
+	return nil
+}
+
+func TestMustLoadLibrary(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+
+	handle := MustLoadLibrary(lib)
+	defer FreeLibrary(handle)
+	if MustSymbol(handle, sym) == nil {
+		t.Fatalf("MustSymbol(%q) returned nil", sym)
+	}
+
+	t.Run("MissingLibrary", func(t *testing.T) {
+		const name = "libgoffi_does_not_exist.so"
+		bindErr := recoverBindError(t, func() { MustLoadLibrary(name) })
+
+		if bindErr.Library != name || bindErr.Symbol != "" {
+			t.Errorf("Library=%q Symbol=%q", bindErr.Library, bindErr.Symbol)
+		}
+		var libErr *LibraryError
+		if !errors.As(bindErr, &libErr) || libErr.Operation != "load" {
+			t.Errorf("expected wrapped LibraryError(load), got %v", bindErr.Err)
+		}
+		if len(bindErr.SearchPaths) == 0 {
+			t.Error("expected search paths")
+		}
+		msg := bindErr.Error()
+		if !strings.Contains(msg, name) || !strings.Contains(msg, "searched:") {
+			t.Errorf("message lacks context: %s", msg)
+		}
+	})
+
+	t.Run("MissingSymbol", func(t *testing.T) {
+		const name = "goffi_no_such_symbol"
+		bindErr := recoverBindError(t, func() { MustSymbol(handle, name) })
+
+		if bindErr.Library != lib || bindErr.Symbol != name {
+			t.Errorf("Library=%q Symbol=%q", bindErr.Library, bindErr.Symbol)
+		}
+		msg := bindErr.Error()
+		if !strings.Contains(msg, lib) || !strings.Contains(msg, name) {
+			t.Errorf("message lacks context: %s", msg)
+		}
+	})
+}
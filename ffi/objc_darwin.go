@@ -0,0 +1,175 @@
+//go:build darwin && (amd64 || arm64)
+
+package ffi
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// objcCatch is the state of the uncaught exception handler that CatchObjC
+// installs on first use.
+var objcCatch struct {
+	mu        sync.Mutex
+	installed bool
+	active    map[uint64]int // Goroutines inside CatchObjC, with nesting depth
+
+	setHandler unsafe.Pointer // objc_setUncaughtExceptionHandler
+	msgSend    unsafe.Pointer // objc_msgSend
+	prev       uintptr        // Handler installed before ours
+	selName    uintptr
+	selReason  uintptr
+	selUTF8    uintptr
+
+	cifSend types.CallInterface // id objc_msgSend(id, SEL)
+	cifPtr  types.CallInterface // void *(void *)
+	cifVoid types.CallInterface // void (void *)
+}
+
+// objcThrow is the panic value that carries an exception from the handler
+// to the CatchObjC that recovers it.
+type objcThrow struct {
+	err *ObjCExceptionError
+}
+
+func (objcThrow) nativeException() {}
+
+// CatchObjC runs fn and returns its error, or an *ObjCExceptionError if an
+// Objective-C exception thrown by a native call fn makes on the calling
+// goroutine is not caught natively, instead of the process terminating:
+//
+//	err := ffi.CatchObjC(func() error {
+//	    return ffi.CallFunction(cif, msgSend, &obj, []unsafe.Pointer{&array, &selObjectAtIndex, &index})
+//	})
+//
+// A Go program cannot host the @try landing pad the unwinder looks for, so
+// the exception is caught where the Objective-C runtime reports it as
+// uncaught: CatchObjC installs a handler with
+// objc_setUncaughtExceptionHandler that, on a goroutine inside CatchObjC,
+// abandons the native frames between fn and the throw and resumes fn's
+// caller. Those frames are not unwound: @finally blocks, C++ destructors
+// and autorelease pool pops in them do not run, and locks they hold stay
+// held, so treat the object the call worked on as unusable. Exceptions
+// thrown on other threads, such as dispatch queues, are handed to the
+// previously installed handler and terminate the process as before.
+func CatchObjC(fn func() error) (err error) {
+	if err := installObjCHandler(); err != nil {
+		return err
+	}
+	gid := goroutineID()
+	objcCatch.mu.Lock()
+	objcCatch.active[gid]++
+	objcCatch.mu.Unlock()
+	defer func() {
+		objcCatch.mu.Lock()
+		if objcCatch.active[gid]--; objcCatch.active[gid] == 0 {
+			delete(objcCatch.active, gid)
+		}
+		objcCatch.mu.Unlock()
+		if r := recover(); r != nil {
+			t, ok := r.(objcThrow)
+			if !ok {
+				panic(r)
+			}
+			err = t.err
+		}
+	}()
+	return fn()
+}
+
+// installObjCHandler installs the uncaught exception handler unless it is
+// in place. Shutdown puts the previous handler back.
+func installObjCHandler() error {
+	objcCatch.mu.Lock()
+	defer objcCatch.mu.Unlock()
+	if objcCatch.installed {
+		return nil
+	}
+
+	lib, err := LoadLibrary("/usr/lib/libobjc.A.dylib")
+	if err != nil {
+		return err
+	}
+	syms := map[string]*unsafe.Pointer{
+		"objc_setUncaughtExceptionHandler": &objcCatch.setHandler,
+		"objc_msgSend":                     &objcCatch.msgSend,
+	}
+	for name, dst := range syms {
+		if *dst, err = GetSymbol(lib, name); err != nil {
+			return err
+		}
+	}
+	selRegisterName, err := GetSymbol(lib, "sel_registerName")
+	if err != nil {
+		return err
+	}
+	ptr := types.PointerTypeDescriptor
+	if err := PrepareCallInterface(&objcCatch.cifSend, types.DefaultCall, ptr, []*types.TypeDescriptor{ptr, ptr}); err != nil {
+		return err
+	}
+	if err := PrepareCallInterface(&objcCatch.cifPtr, types.DefaultCall, ptr, []*types.TypeDescriptor{ptr}); err != nil {
+		return err
+	}
+	if err := PrepareCallInterface(&objcCatch.cifVoid, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{ptr}); err != nil {
+		return err
+	}
+	for name, dst := range map[string]*uintptr{
+		"name\x00":       &objcCatch.selName,
+		"reason\x00":     &objcCatch.selReason,
+		"UTF8String\x00": &objcCatch.selUTF8,
+	} {
+		cname := unsafe.Pointer(unsafe.StringData(name))
+		if err := CallFunction(&objcCatch.cifPtr, selRegisterName, unsafe.Pointer(dst), []unsafe.Pointer{unsafe.Pointer(&cname)}); err != nil {
+			return err
+		}
+	}
+
+	handler := NewCallback(objcExceptionHandler)
+	if err := CallFunction(&objcCatch.cifPtr, objcCatch.setHandler, unsafe.Pointer(&objcCatch.prev), []unsafe.Pointer{unsafe.Pointer(&handler)}); err != nil {
+		return err
+	}
+	objcCatch.active = make(map[uint64]int)
+	objcCatch.installed = true
+	OnShutdown(func() error {
+		objcCatch.mu.Lock()
+		defer objcCatch.mu.Unlock()
+		var ignored uintptr
+		objcCatch.installed = false
+		return CallFunction(&objcCatch.cifPtr, objcCatch.setHandler, unsafe.Pointer(&ignored), []unsafe.Pointer{unsafe.Pointer(&objcCatch.prev)})
+	})
+	return nil
+}
+
+// objcExceptionHandler is the uncaught exception handler. It runs on the
+// thread that threw, before the runtime terminates the process.
+func objcExceptionHandler(exc uintptr) {
+	gid := goroutineID()
+	objcCatch.mu.Lock()
+	caught, prev := objcCatch.active[gid] > 0, objcCatch.prev
+	objcCatch.mu.Unlock()
+	if caught {
+		panic(objcThrow{&ObjCExceptionError{
+			Name:   objcString(exc, objcCatch.selName),
+			Reason: objcString(exc, objcCatch.selReason),
+		}})
+	}
+	if prev != 0 {
+		CallFunction(&objcCatch.cifVoid, foreignPointer(prev), nil, []unsafe.Pointer{unsafe.Pointer(&exc)})
+	}
+}
+
+// objcString sends sel to obj and returns the NSString result as a Go
+// string.
+func objcString(obj, sel uintptr) string {
+	var str, utf8 uintptr
+	if err := CallFunction(&objcCatch.cifSend, objcCatch.msgSend, unsafe.Pointer(&str), []unsafe.Pointer{unsafe.Pointer(&obj), unsafe.Pointer(&sel)}); err != nil || str == 0 {
+		return ""
+	}
+	selUTF8 := objcCatch.selUTF8
+	if err := CallFunction(&objcCatch.cifSend, objcCatch.msgSend, unsafe.Pointer(&utf8), []unsafe.Pointer{unsafe.Pointer(&str), unsafe.Pointer(&selUTF8)}); err != nil {
+		return ""
+	}
+	return goStringN(foreignPointer(utf8), 0)
+}
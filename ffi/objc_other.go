@@ -0,0 +1,9 @@
+//go:build !(darwin && (amd64 || arm64))
+
+package ffi
+
+// CatchObjC runs fn and returns its error. Without an Objective-C runtime
+// there are no exceptions to catch.
+func CatchObjC(fn func() error) error {
+	return fn()
+}
@@ -0,0 +1,246 @@
+package ffi
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// CallOption configures a single call made through CallFunctionWithOptions.
+//
+// Options are diagnostic or scheduling switches that most callers never need;
+// the plain CallFunction/CallFunctionContext path is unaffected by them.
+type CallOption func(*callConfig)
+
+// callConfig collects the effect of all CallOptions for one call.
+type callConfig struct {
+	signalGuard bool          // snapshot and restore signal state around the call
+	pool        *BlockingPool // run the call on a dedicated pool thread
+	blocking    bool          // hand off the P for the duration of the call
+	checkRegs   bool          // verify callee-saved registers, see WithRegisterCheck
+	catchObjC   bool          // turn uncaught Objective-C exceptions into errors
+	catchCxx    bool          // turn escaping C++ exceptions into errors
+	scoped      []scopedCallback
+
+	checkReturnSize bool    // verify returnSize against the return type
+	returnSize      uintptr // size of the rvalue buffer, see WithReturnSize
+}
+
+// newCallConfig applies opts in order and returns the resulting configuration.
+func newCallConfig(opts []CallOption) callConfig {
+	var cfg callConfig
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+// WithSignalGuard snapshots the process signal dispositions and the calling
+// thread's signal mask before the native call and compares them afterwards.
+//
+// Some libraries (JVMs, crash reporters such as breakpad/crashpad, sound
+// servers) install their own signal handlers during initialization, which
+// silently breaks the Go runtime's preemption, profiling and panic handling.
+// With the guard enabled, any handler or mask modified by the callee is put
+// back to its previous value and the call returns a *SignalStateError listing
+// what was changed. The return value buffer is still filled in that case.
+//
+// The guard costs one sigaction syscall per signal and is intended for
+// initialization-time calls, not hot paths. It is currently implemented on
+// Linux; on other platforms the call fails with *UnsupportedPlatformError.
+func WithSignalGuard() CallOption {
+	return func(c *callConfig) {
+		c.signalGuard = true
+	}
+}
+
+// WithObjCExceptions makes the call return an *ObjCExceptionError when it
+// throws an Objective-C exception that it does not catch, such as an
+// objc_msgSend to a Cocoa API rejecting its arguments, instead of the
+// process terminating. See CatchObjC for how and with what caveats; on
+// platforms without Objective-C the option has no effect.
+func WithObjCExceptions() CallOption {
+	return func(c *callConfig) {
+		c.catchObjC = true
+	}
+}
+
+// WithCxxExceptions makes the call return a *CxxExceptionError when a C++
+// exception escapes it, instead of std::terminate aborting the process. See
+// CatchCxx for how and with what caveats; on platforms where goffi cannot
+// install a terminate handler the option has no effect.
+func WithCxxExceptions() CallOption {
+	return func(c *callConfig) {
+		c.catchCxx = true
+	}
+}
+
+// WithBlockingCall marks the call as expected to block for a long time
+// (file pickers, device enumeration, waiting on a fence). It has the same
+// effect as setting Blocking on the CallInterface, for this call only.
+//
+// Ordinary calls are treated like short cgo calls: the calling goroutine's P
+// stays attached to the thread and the scheduler only takes it back after the
+// call has been running for a while. A blocking call hands the P off before
+// the native code starts, so other goroutines keep running on it immediately.
+// The call is still made on the calling thread, and the callee may call back
+// into Go.
+//
+// Blocking calls are implemented on Linux, macOS and FreeBSD on amd64. On
+// other platforms the option is accepted but has no effect.
+func WithBlockingCall() CallOption {
+	return func(c *callConfig) {
+		c.blocking = true
+	}
+}
+
+// WithRegisterCheck runs the call in a diagnostic mode that verifies the
+// callee preserved the callee-saved registers of the platform ABI (RBX, RBP,
+// R12-R15 on amd64; X19-X28 and D8-D15 on arm64). It has the same effect as
+// setting CheckRegisters on the CallInterface, for this call only.
+//
+// A native function that trashes these registers breaks the Go code it
+// returns to in ways that surface far from the cause. In this mode the
+// registers are loaded with canaries before the call and compared afterwards;
+// any that changed are restored and reported as a *RegisterClobberError. The
+// return value buffer is still filled in that case.
+//
+// The check adds a handful of instructions per call, but is meant for
+// debugging suspicious drivers rather than production. windows/amd64 is not
+// supported.
+func WithRegisterCheck() CallOption {
+	return func(c *callConfig) {
+		c.checkRegs = true
+	}
+}
+
+// scopedCallback is a callback registered for the duration of one call.
+type scopedCallback struct {
+	fn  any
+	dst *uintptr
+}
+
+// WithScopedCallback registers fn as a C callback for the duration of the
+// call only. The trampoline pointer is stored in *dst before the call starts,
+// so dst is normally the variable an avalue entry points to, and the
+// trampoline slot is released when CallFunctionWithOptions returns.
+//
+// Use it for APIs that invoke the callback only while the call is running,
+// such as qsort, bsearch or enumeration functions, to avoid exhausting the
+// callback registry with one permanent NewCallback per call:
+//
+//	var cmp uintptr
+//	err := ffi.CallFunctionWithOptions(ctx, &qsortCIF, qsort, nil,
+//	    []unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&n),
+//	        unsafe.Pointer(&size), unsafe.Pointer(&cmp)},
+//	    ffi.WithScopedCallback(func(a, b *int32) int32 { return *a - *b }, &cmp))
+//
+// fn has the same requirements as for NewCallback. Native code must not keep
+// the pointer past the call. On Windows on arm64 the slot cannot be
+// reclaimed and stays in use as with NewCallback.
+func WithScopedCallback(fn any, dst *uintptr) CallOption {
+	return func(c *callConfig) {
+		c.scoped = append(c.scoped, scopedCallback{fn: fn, dst: dst})
+	}
+}
+
+// CallFunctionWithOptions executes a C function call like CallFunctionContext,
+// applying the given per-call options.
+//
+// Example:
+//
+//	err := ffi.CallFunctionWithOptions(ctx, &cif, initPtr, nil, nil, ffi.WithSignalGuard())
+//	var sigErr *ffi.SignalStateError
+//	if errors.As(err, &sigErr) {
+//	    log.Printf("library touched signal handlers: %v", sigErr.Changes)
+//	}
+func CallFunctionWithOptions(
+	ctx context.Context,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+	opts ...CallOption,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if cif == nil {
+		return &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	if fn == nil {
+		return &InvalidCallInterfaceError{
+			Field:  "fn",
+			Reason: "function pointer must not be nil",
+			Index:  -1,
+		}
+	}
+	if err := validateArgs(cif, avalue); err != nil {
+		return err
+	}
+
+	cfg := newCallConfig(opts)
+	if cfg.checkReturnSize {
+		if err := validateReturnSize(cif, rvalue, cfg.returnSize); err != nil {
+			return err
+		}
+	}
+	for _, sc := range cfg.scoped {
+		if sc.dst == nil {
+			return &InvalidCallInterfaceError{
+				Field:  "dst",
+				Reason: "scoped callback destination must not be nil",
+				Index:  -1,
+			}
+		}
+	}
+	for _, sc := range cfg.scoped {
+		ptr := NewCallback(sc.fn)
+		defer releaseScopedCallback(ptr)
+		*sc.dst = ptr
+	}
+	return executeWithConfig(ctx, &cfg, cif, fn, rvalue, avalue)
+}
+
+// executeWithConfig wraps executeFunction with the behavior requested by cfg.
+func executeWithConfig(
+	ctx context.Context,
+	cfg *callConfig,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	if (cfg.blocking && !cif.Blocking) || (cfg.checkRegs && !cif.CheckRegisters) {
+		callCIF := *cif
+		callCIF.Blocking = callCIF.Blocking || cfg.blocking
+		callCIF.CheckRegisters = callCIF.CheckRegisters || cfg.checkRegs
+		cif = &callCIF
+	}
+	call := func() error {
+		return executeFunction(cif, fn, rvalue, avalue, nil)
+	}
+	if cfg.signalGuard {
+		guarded := call
+		call = func() error { return callWithSignalGuard(guarded) }
+	}
+	if cfg.catchObjC {
+		inner := call
+		call = func() error { return CatchObjC(inner) }
+	}
+	if cfg.catchCxx {
+		inner := call
+		call = func() error { return CatchCxx(inner) }
+	}
+	if cfg.pool != nil {
+		return cfg.pool.submit(ctx, call)
+	}
+	return call()
+}
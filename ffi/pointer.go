@@ -0,0 +1,67 @@
+package ffi
+
+import (
+	"unsafe"
+)
+
+// Handle is the address of foreign (non-Go) memory: a library handle, a
+// symbol, or a pointer returned by a C function.
+//
+// Keeping foreign addresses as a Handle instead of unsafe.Pointer keeps
+// builds instrumented with -d=checkptr and `go vet` (unsafeptr) clean: the
+// value is an integer to the compiler, so it is never mistaken for a Go
+// pointer, and it converts to unsafe.Pointer only at the point of use through
+// Pointer, which performs the checkptr-safe conversion.
+//
+// Handle is uintptr-sized, so it can be used directly as a CallFunction return
+// buffer or argument for types.PointerTypeDescriptor, and as a callback
+// argument or return type.
+type Handle uintptr
+
+// IsNil reports whether h is the NULL address.
+func (h Handle) IsNil() bool {
+	return h == 0
+}
+
+// Pointer converts h to an unsafe.Pointer for use with CallFunction avalue
+// slots or for dereferencing foreign memory.
+//
+// The caller is responsible for the memory being valid; goffi never inspects it.
+func (h Handle) Pointer() unsafe.Pointer {
+	return foreignPointer(uintptr(h))
+}
+
+// HandleOf converts a pointer obtained from LoadLibrary, GetSymbol or a C call
+// into a Handle. It must not be used on pointers to Go memory, which the
+// garbage collector may move or free once no unsafe.Pointer references them.
+func HandleOf(p unsafe.Pointer) Handle {
+	return Handle(uintptr(p))
+}
+
+// LookupSymbol is GetSymbol returning a Handle instead of unsafe.Pointer.
+//
+// Example:
+//
+//	sym, err := ffi.LookupSymbol(lib, "wgpuCreateInstance")
+//	if err != nil {
+//	    return err
+//	}
+//	err = ffi.CallFunction(&cif, sym.Pointer(), unsafe.Pointer(&instance), nil)
+func LookupSymbol(handle unsafe.Pointer, name string) (Handle, error) {
+	sym, err := GetSymbol(handle, name)
+	if err != nil {
+		return 0, err
+	}
+	return HandleOf(sym), nil
+}
+
+// foreignPointer converts an address produced outside the Go heap (dlopen,
+// dlsym, C return registers) into an unsafe.Pointer.
+//
+// A direct unsafe.Pointer(addr) conversion is flagged by vet's unsafeptr check
+// and can trip checkptr's arithmetic validation. Reinterpreting the bits
+// through double indirection is the pattern recommended in go.dev/issue/58625
+// for pointers the garbage collector does not own.
+func foreignPointer(addr uintptr) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
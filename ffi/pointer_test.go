@@ -0,0 +1,62 @@
+package ffi
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestHandleRoundTrip(t *testing.T) {
+	var h Handle
+	if !h.IsNil() || h.Pointer() != nil {
+		t.Fatal("zero Handle must be nil")
+	}
+
+	const addr = uintptr(0x7f001000)
+	h = Handle(addr)
+	if h.IsNil() {
+		t.Fatal("non-zero Handle reported nil")
+	}
+	if got := uintptr(h.Pointer()); got != addr {
+		t.Errorf("Pointer() = %#x, want %#x", got, addr)
+	}
+	if got := HandleOf(h.Pointer()); got != h {
+		t.Errorf("HandleOf(Pointer()) = %#x, want %#x", got, h)
+	}
+}
+
+func TestLookupSymbol(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	defer FreeLibrary(handle)
+
+	h, err := LookupSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("LookupSymbol(%s): %v", sym, err)
+	}
+	want, _ := GetSymbol(handle, sym)
+	if h.Pointer() != want {
+		t.Errorf("LookupSymbol = %#x, GetSymbol = %p", h, want)
+	}
+
+	if _, err := LookupSymbol(handle, "goffi_no_such_symbol_12345"); err == nil {
+		t.Error("expected error for missing symbol")
+	}
+}
+
+// testLibAndSymbol returns the C runtime library of the current platform and
+// a function symbol that every version of it exports.
+func testLibAndSymbol() (lib, sym string) {
+	switch runtime.GOOS {
+	case "windows":
+		return "msvcrt.dll", "strlen"
+	case "darwin":
+		return "/usr/lib/libSystem.B.dylib", "strlen"
+	case "freebsd":
+		return "libc.so.7", "strlen"
+	default:
+		return "libc.so.6", "strlen"
+	}
+}
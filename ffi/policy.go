@@ -0,0 +1,187 @@
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+var (
+	// ErrPolicyDenied is wrapped by the *LibraryError of a LoadLibrary or
+	// GetSymbol call the installed Policy does not allow.
+	ErrPolicyDenied = errors.New("goffi: denied by policy")
+
+	// ErrPolicyLocked is returned by SetPolicy once a Final policy is
+	// installed.
+	ErrPolicyLocked = errors.New("goffi: policy is final")
+)
+
+// Policy restricts which libraries LoadLibrary may load and which symbols
+// GetSymbol may resolve. Plugin hosts install one to constrain what
+// third-party Go code using goffi can reach:
+//
+//	err := ffi.SetPolicy(&ffi.Policy{
+//	    Libraries:   []string{"libwgpu_native.*", "/usr/lib/*/libvulkan.so.1"},
+//	    DenySymbols: []string{"system", "exec*", "dlopen"},
+//	    Final:       true,
+//	})
+//
+// Patterns use path.Match syntax. A library pattern matches the name passed
+// to LoadLibrary, its base name, or the file the loader actually mapped
+// (where the platform reports it, see QueryLibraryVersion); a pattern
+// containing a slash matches only full names and paths. A symbol pattern
+// matches the symbol name. Deny patterns win over allow patterns, and an
+// empty allow list allows everything that is not denied.
+//
+// The name is checked before loading; the mapped file right after, and a
+// denied library is unloaded again, although its initializers have run by
+// then. With an allow list set, a library whose mapped file cannot be
+// determined is denied. A policy governs goffi's entry points only: code that calls the
+// platform loader by other means is not constrained.
+type Policy struct {
+	Libraries     []string // Allowed libraries; empty allows all
+	DenyLibraries []string // Denied libraries
+	Symbols       []string // Allowed symbols; empty allows all
+	DenySymbols   []string // Denied symbols
+
+	// Final makes the policy permanent: later SetPolicy calls fail with
+	// ErrPolicyLocked.
+	Final bool
+}
+
+var policy struct {
+	mu sync.RWMutex
+	p  *Policy
+}
+
+// SetPolicy installs p for all later LoadLibrary and GetSymbol calls; nil
+// removes the policy. Libraries already loaded and symbols already resolved
+// are not affected. The policy is copied, so later changes to p have no
+// effect. It fails with ErrPolicyLocked if the installed policy is Final,
+// and with a *PolicyError for a malformed pattern.
+func SetPolicy(p *Policy) error {
+	if p != nil {
+		lists := []struct {
+			name     string
+			patterns []string
+		}{
+			{"Libraries", p.Libraries},
+			{"DenyLibraries", p.DenyLibraries},
+			{"Symbols", p.Symbols},
+			{"DenySymbols", p.DenySymbols},
+		}
+		for _, list := range lists {
+			for i, pattern := range list.patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return &PolicyError{List: list.name, Index: i, Pattern: pattern, Err: err}
+				}
+			}
+		}
+		c := *p
+		c.Libraries = slices.Clone(p.Libraries)
+		c.DenyLibraries = slices.Clone(p.DenyLibraries)
+		c.Symbols = slices.Clone(p.Symbols)
+		c.DenySymbols = slices.Clone(p.DenySymbols)
+		p = &c
+	}
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+	if policy.p != nil && policy.p.Final {
+		return ErrPolicyLocked
+	}
+	policy.p = p
+	return nil
+}
+
+// currentPolicy returns the installed policy, or nil.
+func currentPolicy() *Policy {
+	policy.mu.RLock()
+	defer policy.mu.RUnlock()
+	return policy.p
+}
+
+// checkLibraryPolicy reports whether the library name may be loaded.
+func checkLibraryPolicy(name string) error {
+	p := currentPolicy()
+	if p == nil {
+		return nil
+	}
+	if reason := judge(p.Libraries, p.DenyLibraries, name, libraryMatch); reason != "" {
+		return &LibraryError{Operation: "load", Name: name, Err: fmt.Errorf("%w: %s", ErrPolicyDenied, reason)}
+	}
+	return nil
+}
+
+// checkLoadedPolicy checks the file the loader mapped for the library name
+// against the policy, unloading it if denied. When an allow list is set and
+// the mapped file cannot be determined, the library is denied too. It
+// returns the results for LoadLibrary.
+func checkLoadedPolicy(name string, handle unsafe.Pointer) (unsafe.Pointer, error) {
+	p := currentPolicy()
+	if p == nil {
+		return handle, nil
+	}
+	v, err := queryLibraryVersion(handle)
+	if err == nil && v.Path == name {
+		return handle, nil
+	}
+	var reason string
+	switch {
+	case err == nil && v.Path != "":
+		reason = judge(p.Libraries, p.DenyLibraries, v.Path, libraryMatch)
+	case len(p.Libraries) > 0:
+		reason = "the file mapped for " + name + " is unknown"
+	}
+	if reason != "" {
+		_ = FreeLibrary(handle)
+		return nil, &LibraryError{Operation: "load", Name: name, Err: fmt.Errorf("%w: %s", ErrPolicyDenied, reason)}
+	}
+	return handle, nil
+}
+
+// checkSymbolPolicy reports whether the symbol name may be resolved.
+func checkSymbolPolicy(name string) error {
+	p := currentPolicy()
+	if p == nil {
+		return nil
+	}
+	if reason := judge(p.Symbols, p.DenySymbols, name, path.Match); reason != "" {
+		return &LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("%w: %s", ErrPolicyDenied, reason)}
+	}
+	return nil
+}
+
+// judge returns why name is refused by the allow and deny lists, or "".
+func judge(allow, deny []string, name string, match func(pattern, name string) (bool, error)) string {
+	for _, pattern := range deny {
+		if ok, _ := match(pattern, name); ok {
+			return fmt.Sprintf("%s matches deny pattern %q", name, pattern)
+		}
+	}
+	if len(allow) == 0 {
+		return ""
+	}
+	for _, pattern := range allow {
+		if ok, _ := match(pattern, name); ok {
+			return ""
+		}
+	}
+	return name + " is not allowed"
+}
+
+// libraryMatch matches a library pattern against a name or path, and
+// against its base name if the pattern has no directory.
+func libraryMatch(pattern, name string) (bool, error) {
+	if ok, err := path.Match(pattern, filepath.ToSlash(name)); ok || err != nil {
+		return ok, err
+	}
+	if strings.Contains(pattern, "/") {
+		return false, nil
+	}
+	return path.Match(pattern, filepath.Base(name))
+}
@@ -0,0 +1,76 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"path"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicy(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	t.Cleanup(func() {
+		policy.mu.Lock()
+		policy.p = nil
+		policy.mu.Unlock()
+	})
+	denied := func(t *testing.T, err error, op string) {
+		t.Helper()
+		var libErr *LibraryError
+		if !errors.As(err, &libErr) || libErr.Operation != op || !errors.Is(err, ErrPolicyDenied) {
+			t.Errorf("err = %v, want a %s *LibraryError wrapping ErrPolicyDenied", err, op)
+		}
+	}
+
+	if err := SetPolicy(&Policy{Libraries: []string{"libgoffi_only.so"}}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := LoadLibrary(lib)
+	denied(t, err, "load")
+
+	if err := SetPolicy(&Policy{
+		Libraries:   []string{filepath.Base(lib)},
+		Symbols:     []string{"str*"},
+		DenySymbols: []string{"strcpy"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Fatalf("allowed library: %v", err)
+	}
+	defer FreeLibrary(handle)
+	if _, err := GetSymbol(handle, sym); err != nil {
+		t.Errorf("allowed symbol: %v", err)
+	}
+	_, err = GetSymbol(handle, "strcpy")
+	denied(t, err, "symbol")
+	_, err = GetSymbol(handle, "malloc")
+	denied(t, err, "symbol")
+
+	var polErr *PolicyError
+	if err := SetPolicy(&Policy{Symbols: []string{"str*", "["}}); !errors.As(err, &polErr) ||
+		polErr.List != "Symbols" || polErr.Index != 1 || !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("malformed pattern: err = %v, want *PolicyError for Symbols[1]", err)
+	}
+
+	// The installed policy must not share the caller's lists.
+	deny := []string{"strcpy"}
+	if err := SetPolicy(&Policy{DenySymbols: deny}); err != nil {
+		t.Fatal(err)
+	}
+	deny[0] = "nothing"
+	_, err = GetSymbol(handle, "strcpy")
+	denied(t, err, "symbol")
+
+	if err := SetPolicy(&Policy{DenyLibraries: []string{"*"}, Final: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetPolicy(nil); !errors.Is(err, ErrPolicyLocked) {
+		t.Errorf("replacing a final policy: %v, want ErrPolicyLocked", err)
+	}
+	_, err = LoadLibrary(lib)
+	denied(t, err, "load")
+}
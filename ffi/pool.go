@@ -0,0 +1,241 @@
+package ffi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// ErrPoolClosed is returned when a call is submitted to a BlockingPool after Close.
+var ErrPoolClosed = errors.New("goffi: blocking pool is closed")
+
+// Job states for poolJob.state.
+const (
+	jobQueued int32 = iota
+	jobRunning
+	jobCancelled
+)
+
+// poolJob is one call waiting for, or running on, a pool thread.
+type poolJob struct {
+	state  atomic.Int32
+	call   func() error
+	err    error
+	doneCh chan struct{}
+}
+
+// BlockingPool is a bounded set of dedicated OS threads for native calls that
+// are expected to block for a long time (file dialogs, device enumeration,
+// waiting on GPU fences, network-backed drivers).
+//
+// Every in-flight cgocall pins an M (OS thread). When many goroutines issue
+// long blocking calls at once, the runtime keeps spawning threads until it
+// hits debug.SetMaxThreads and crashes. Routing such calls through a pool
+// caps the number of threads they can occupy: excess calls wait in a queue
+// while the submitting goroutines stay parked and cost no thread at all.
+//
+// A BlockingPool is safe for concurrent use. Create one with NewBlockingPool
+// and use it through Call or the WithBlockingPool call option.
+type BlockingPool struct {
+	jobs   chan *poolJob
+	size   int
+	mu     sync.RWMutex // guards closed against concurrent submit/Close
+	closed bool
+	wg     sync.WaitGroup
+
+	queued    atomic.Int64
+	active    atomic.Int64
+	completed atomic.Uint64
+	cancelled atomic.Uint64
+}
+
+// PoolStats is a point-in-time snapshot of BlockingPool metrics.
+type PoolStats struct {
+	Size      int    // Number of dedicated threads
+	Queued    int    // Calls waiting for a free thread
+	Active    int    // Calls currently executing native code
+	Completed uint64 // Calls that ran to completion
+	Cancelled uint64 // Calls abandoned by their context while still queued
+}
+
+// NewBlockingPool starts size dedicated OS threads, each locked to its own
+// goroutine, and a queue holding up to queueLen pending calls.
+//
+// size < 1 is treated as runtime.GOMAXPROCS(0); queueLen < 0 as 0 (submitters
+// wait until a thread is free). Call Close to stop the threads.
+func NewBlockingPool(size, queueLen int) *BlockingPool {
+	p, _ := newBlockingPool(size, queueLen, nil)
+	return p
+}
+
+// NewBlockingPoolWithThreadConfig is like NewBlockingPool but applies cfg to
+// every pool thread before it accepts calls, so that audio- or render-critical
+// native work is not scheduled as background work (or the other way around).
+//
+// If cfg cannot be applied to a thread, for example because raising the
+// priority needs privileges the process lacks, the pool is closed again and
+// the error is returned. The threads' previous settings are restored when the
+// pool closes.
+func NewBlockingPoolWithThreadConfig(size, queueLen int, cfg ThreadConfig) (*BlockingPool, error) {
+	return newBlockingPool(size, queueLen, &cfg)
+}
+
+func newBlockingPool(size, queueLen int, cfg *ThreadConfig) (*BlockingPool, error) {
+	if size < 1 {
+		size = runtime.GOMAXPROCS(0)
+	}
+	if queueLen < 0 {
+		queueLen = 0
+	}
+	p := &BlockingPool{
+		jobs: make(chan *poolJob, queueLen),
+		size: size,
+	}
+	ready := make(chan error, size)
+	p.wg.Add(size)
+	for range size {
+		go p.worker(cfg, ready)
+	}
+	if cfg == nil {
+		return p, nil
+	}
+	var firstErr error
+	for range size {
+		if err := <-ready; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		p.Close()
+		return nil, firstErr
+	}
+	return p, nil
+}
+
+// worker runs queued jobs on a goroutine locked to one OS thread.
+//
+// The thread is handed back to the runtime when the pool closes, after the
+// settings from cfg are restored. If they cannot be restored the goroutine
+// exits still locked, so the runtime terminates the thread rather than run
+// other goroutines with the pool's priority or affinity.
+func (p *BlockingPool) worker(cfg *ThreadConfig, ready chan<- error) {
+	runtime.LockOSThread()
+	defer p.wg.Done()
+
+	restore := func() error { return nil }
+	if cfg != nil {
+		var err error
+		restore, err = ApplyThreadConfig(*cfg)
+		ready <- err
+		if err != nil {
+			runtime.UnlockOSThread()
+			return
+		}
+	}
+	defer func() {
+		if restore() == nil {
+			runtime.UnlockOSThread()
+		}
+	}()
+
+	for job := range p.jobs {
+		if !job.state.CompareAndSwap(jobQueued, jobRunning) {
+			continue // cancelled while queued; submitter already returned
+		}
+		p.queued.Add(-1)
+		p.active.Add(1)
+		job.err = job.call()
+		p.active.Add(-1)
+		p.completed.Add(1)
+		close(job.doneCh)
+	}
+}
+
+// Call executes a C function call on one of the pool's threads and waits for
+// it to finish. It has the same contract as CallFunctionContext.
+//
+// ctx only governs the time spent waiting in the queue. Once the native call
+// has started it runs to completion and Call waits for it, because avalue and
+// rvalue must stay valid until the callee returns.
+func (p *BlockingPool) Call(
+	ctx context.Context,
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	return CallFunctionWithOptions(ctx, cif, fn, rvalue, avalue, WithBlockingPool(p))
+}
+
+// submit queues call and waits for its result.
+func (p *BlockingPool) submit(ctx context.Context, call func() error) error {
+	job := &poolJob{call: call, doneCh: make(chan struct{})}
+
+	p.mu.RLock()
+	if p.closed {
+		p.mu.RUnlock()
+		return ErrPoolClosed
+	}
+	p.queued.Add(1)
+	select {
+	case p.jobs <- job:
+		p.mu.RUnlock()
+	case <-ctx.Done():
+		p.mu.RUnlock()
+		p.queued.Add(-1)
+		p.cancelled.Add(1)
+		return ctx.Err()
+	}
+
+	select {
+	case <-job.doneCh:
+		return job.err
+	case <-ctx.Done():
+		if job.state.CompareAndSwap(jobQueued, jobCancelled) {
+			p.queued.Add(-1)
+			p.cancelled.Add(1)
+			return ctx.Err()
+		}
+		// Already running: the callee may still be using our buffers.
+		<-job.doneCh
+		return job.err
+	}
+}
+
+// Stats returns the pool's current metrics.
+func (p *BlockingPool) Stats() PoolStats {
+	return PoolStats{
+		Size:      p.size,
+		Queued:    int(p.queued.Load()),
+		Active:    int(p.active.Load()),
+		Completed: p.completed.Load(),
+		Cancelled: p.cancelled.Load(),
+	}
+}
+
+// Close stops accepting calls, lets queued calls finish and waits for the
+// pool's threads to exit. It is safe to call more than once.
+func (p *BlockingPool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.jobs)
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+// WithBlockingPool runs the call on one of pool's dedicated threads instead
+// of the calling goroutine's thread. See BlockingPool.
+func WithBlockingPool(pool *BlockingPool) CallOption {
+	return func(c *callConfig) {
+		c.pool = pool
+	}
+}
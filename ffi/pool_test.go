@@ -0,0 +1,150 @@
+package ffi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// sleepFunc returns a native function that sleeps for its uint32 argument
+// (microseconds on Unix, milliseconds on Windows) plus the scale to convert
+// a millisecond count into that unit.
+func sleepFunc(t *testing.T) (unsafe.Pointer, uint32) {
+	t.Helper()
+	lib, name, scale := "libc.so.6", "usleep", uint32(1000)
+	switch runtime.GOOS {
+	case "windows":
+		lib, name, scale = "kernel32.dll", "Sleep", 1
+	case "darwin":
+		lib = "/usr/lib/libSystem.B.dylib"
+	case "freebsd":
+		lib = "libc.so.7"
+	}
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	fn, err := GetSymbol(handle, name)
+	if err != nil {
+		t.Skipf("%s not available: %v", name, err)
+	}
+	return fn, scale
+}
+
+func TestBlockingPool(t *testing.T) {
+	sleepFn, scale := sleepFunc(t)
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.UInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := NewBlockingPool(2, 8)
+	defer pool.Close()
+
+	const calls = 6
+	var wg sync.WaitGroup
+	errs := make(chan error, calls)
+	for range calls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d := 20 * scale
+			var rc int32
+			errs <- pool.Call(context.Background(), cif, sleepFn, unsafe.Pointer(&rc), []unsafe.Pointer{unsafe.Pointer(&d)})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("pool call: %v", err)
+		}
+	}
+
+	st := pool.Stats()
+	if st.Size != 2 || st.Completed != calls || st.Active != 0 || st.Queued != 0 {
+		t.Errorf("unexpected stats after drain: %+v", st)
+	}
+}
+
+func TestBlockingPool_CancelWhileQueued(t *testing.T) {
+	sleepFn, scale := sleepFunc(t)
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.UInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := NewBlockingPool(1, 4)
+	defer pool.Close()
+
+	// Occupy the only thread.
+	started := make(chan struct{})
+	go func() {
+		d := 200 * scale
+		var rc int32
+		close(started)
+		_ = pool.Call(context.Background(), cif, sleepFn, unsafe.Pointer(&rc), []unsafe.Pointer{unsafe.Pointer(&d)})
+	}()
+	<-started
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	d := scale
+	var rc int32
+	err := CallFunctionWithOptions(ctx, cif, sleepFn, unsafe.Pointer(&rc), []unsafe.Pointer{unsafe.Pointer(&d)}, WithBlockingPool(pool))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("queued call error = %v, want DeadlineExceeded", err)
+	}
+	if st := pool.Stats(); st.Cancelled != 1 {
+		t.Errorf("Cancelled = %d, want 1", st.Cancelled)
+	}
+}
+
+func TestBlockingPool_Closed(t *testing.T) {
+	pool := NewBlockingPool(1, 0)
+	pool.Close()
+	pool.Close()
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	fn := unsafe.Pointer(&cif) // never called
+	if err := pool.Call(context.Background(), cif, fn, nil, nil); !errors.Is(err, ErrPoolClosed) {
+		t.Fatalf("Call after Close = %v, want ErrPoolClosed", err)
+	}
+}
+
+// TestLockedThreadExit checks that a thread which ran native calls can be
+// torn down by the runtime, which BlockingPool relies on when it cannot
+// restore a worker's thread settings.
+func TestLockedThreadExit(t *testing.T) {
+	sleepFn, _ := sleepFunc(t)
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.UInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 20 {
+		errs := make(chan error, 1)
+		go func() {
+			runtime.LockOSThread() // never unlocked: the thread exits with the goroutine
+			var d uint32
+			var rc int32
+			errs <- CallFunction(cif, sleepFn, unsafe.Pointer(&rc), []unsafe.Pointer{unsafe.Pointer(&d)})
+		}()
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package ffi
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// APIGroup is a named set of symbols that together make up one API level of
+// a library, typically the functions added in one release.
+type APIGroup struct {
+	Name    string
+	Symbols []string
+}
+
+// APICapabilities records which API groups a loaded library provides, as
+// determined by ProbeAPI.
+type APICapabilities struct {
+	groups  map[string]*probedGroup
+	ordered []string
+}
+
+type probedGroup struct {
+	syms    map[string]unsafe.Pointer
+	missing []string
+}
+
+// ProbeAPI resolves every symbol of every group in the library handle and
+// records which groups are complete. Unlike MustSymbol or a chain of
+// GetSymbol calls it does not stop at the first missing symbol, so a binding
+// can support several library versions and enable features per group.
+//
+// Example:
+//
+//	caps := ffi.ProbeAPI(lib,
+//	    ffi.APIGroup{Name: "1.1", Symbols: []string{"vkEnumerateInstanceVersion"}},
+//	    ffi.APIGroup{Name: "1.3", Symbols: []string{"vkCmdBeginRendering", "vkCmdEndRendering"}},
+//	)
+//	if caps.Has("1.3") {
+//	    beginRendering, _ = caps.Symbol("1.3", "vkCmdBeginRendering")
+//	}
+func ProbeAPI(handle unsafe.Pointer, groups ...APIGroup) *APICapabilities {
+	c := &APICapabilities{groups: make(map[string]*probedGroup, len(groups))}
+	for _, g := range groups {
+		pg := &probedGroup{syms: make(map[string]unsafe.Pointer, len(g.Symbols)), missing: []string{}}
+		for _, name := range g.Symbols {
+			if p, err := GetSymbol(handle, name); err == nil {
+				pg.syms[name] = p
+			} else {
+				pg.missing = append(pg.missing, name)
+			}
+		}
+		if _, dup := c.groups[g.Name]; !dup {
+			c.ordered = append(c.ordered, g.Name)
+		}
+		c.groups[g.Name] = pg
+	}
+	return c
+}
+
+// Has reports whether every symbol of the group was found.
+func (c *APICapabilities) Has(group string) bool {
+	g, ok := c.groups[group]
+	return ok && len(g.missing) == 0
+}
+
+// Available returns the names of the complete groups in probe order.
+func (c *APICapabilities) Available() []string {
+	var names []string
+	for _, name := range c.ordered {
+		if c.Has(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Missing returns the symbols of the group the library does not export, or
+// nil if the group was not probed.
+func (c *APICapabilities) Missing(group string) []string {
+	if g, ok := c.groups[group]; ok {
+		return g.missing
+	}
+	return nil
+}
+
+// Require returns nil if the group is complete and *APIUnavailableError
+// otherwise, for gating the bindings of a group in one place.
+func (c *APICapabilities) Require(group string) error {
+	if c.Has(group) {
+		return nil
+	}
+	return &APIUnavailableError{Group: group, Missing: c.Missing(group)}
+}
+
+// Symbol returns a symbol of a complete group. It fails with
+// *APIUnavailableError if the group is incomplete, even if this particular
+// symbol exists, so a binding never mixes functions of partially present
+// API levels.
+func (c *APICapabilities) Symbol(group, name string) (unsafe.Pointer, error) {
+	if err := c.Require(group); err != nil {
+		return nil, err
+	}
+	p, ok := c.groups[group].syms[name]
+	if !ok {
+		return nil, &LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("not part of API group %q", group)}
+	}
+	return p, nil
+}
@@ -0,0 +1,42 @@
+package ffi
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestProbeAPI(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+
+	caps := ProbeAPI(handle,
+		APIGroup{Name: "base", Symbols: []string{sym}},
+		APIGroup{Name: "future", Symbols: []string{sym, "goffi_no_such_symbol_v2", "goffi_no_such_symbol_v3"}},
+	)
+
+	if !caps.Has("base") || caps.Has("future") || caps.Has("unknown") {
+		t.Errorf("Has: base=%v future=%v unknown=%v, want true false false",
+			caps.Has("base"), caps.Has("future"), caps.Has("unknown"))
+	}
+	if got := caps.Available(); !slices.Equal(got, []string{"base"}) {
+		t.Errorf("Available() = %v, want [base]", got)
+	}
+	if got, want := caps.Missing("future"), []string{"goffi_no_such_symbol_v2", "goffi_no_such_symbol_v3"}; !slices.Equal(got, want) {
+		t.Errorf("Missing(future) = %v, want %v", got, want)
+	}
+
+	if p, err := caps.Symbol("base", sym); err != nil || p == nil {
+		t.Errorf("Symbol(base, %s) = %v, %v", sym, p, err)
+	}
+	var apiErr *APIUnavailableError
+	if _, err := caps.Symbol("future", sym); !errors.As(err, &apiErr) || apiErr.Group != "future" {
+		t.Errorf("Symbol from incomplete group: err = %v, want *APIUnavailableError", err)
+	}
+	if err := caps.Require("unknown"); !errors.As(err, &apiErr) || apiErr.Missing != nil {
+		t.Errorf("Require(unknown) = %v, want *APIUnavailableError without Missing", err)
+	}
+}
@@ -0,0 +1,95 @@
+package ffi
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Proc is a function exported by a library, called with uintptr arguments
+// like golang.org/x/sys/windows.Proc, but on every platform goffi supports.
+// Code written against the Windows syscall idiom carries over unchanged:
+//
+//	getTick, err := ffi.FindProc(kernel32, "GetTickCount64")
+//	...
+//	r1, _, lastErr := getTick.Call()
+//	if r1 == 0 {
+//	    return lastErr
+//	}
+type Proc struct {
+	Name string
+	addr unsafe.Pointer
+}
+
+// procCIFs caches the call interface of Proc calls per argument count: every
+// argument and the result are pointer-sized integers.
+var procCIFs sync.Map // int -> *types.CallInterface
+
+// FindProc resolves name in the library handle.
+func FindProc(handle unsafe.Pointer, name string) (*Proc, error) {
+	addr, err := GetSymbol(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	return &Proc{Name: name, addr: addr}, nil
+}
+
+// MustFindProc is like FindProc but panics with a *BindError, as MustSymbol
+// does, if name cannot be resolved.
+func MustFindProc(handle unsafe.Pointer, name string) *Proc {
+	return &Proc{Name: name, addr: MustSymbol(handle, name)}
+}
+
+// Addr returns the address of the function.
+func (p *Proc) Addr() uintptr {
+	return uintptr(p.addr)
+}
+
+// Call calls the function with args, each passed as a pointer-sized integer
+// argument, and returns its result in r1. lastErr is always non-nil, as in
+// x/sys/windows: the Errno the function left behind (GetLastError on
+// Windows, errno elsewhere; zero if it set none), meaningful only when r1
+// says the call failed. If the call could not be made, r1 is 0 and lastErr
+// is the reason. r2 is always 0; use CallFunctionRegisters for functions
+// returning a value in two registers.
+//
+// Like CallFunctionArgs, Call is marked //go:uintptrescapes: a buffer passed
+// as uintptr(unsafe.Pointer(p)) in the call expression stays alive and in
+// place until Call returns.
+//
+//go:uintptrescapes
+func (p *Proc) Call(args ...uintptr) (r1, r2 uintptr, lastErr error) {
+	cif, err := procCIF(len(args))
+	if err != nil {
+		return 0, 0, err
+	}
+	avalue := make([]unsafe.Pointer, len(args))
+	for i := range args {
+		avalue[i] = unsafe.Pointer(&args[i])
+	}
+	errno, err := CallWithErrno(cif, p.addr, unsafe.Pointer(&r1), avalue)
+	runtime.KeepAlive(args)
+	if err != nil {
+		return 0, 0, err
+	}
+	return r1, 0, errno
+}
+
+// procCIF returns the call interface for a Proc call with n arguments.
+func procCIF(n int) (*types.CallInterface, error) {
+	if cif, ok := procCIFs.Load(n); ok {
+		return cif.(*types.CallInterface), nil
+	}
+	argTypes := make([]*types.TypeDescriptor, n)
+	for i := range argTypes {
+		argTypes[i] = types.PointerTypeDescriptor
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.PointerTypeDescriptor, argTypes); err != nil {
+		return nil, err
+	}
+	actual, _ := procCIFs.LoadOrStore(n, cif)
+	return actual.(*types.CallInterface), nil
+}
@@ -0,0 +1,46 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+func TestProc(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := FindProc(handle, sym)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := GetSymbol(handle, sym); strlen.Addr() != uintptr(want) || strlen.Name != sym {
+		t.Errorf("Proc = %s at %#x, want %s at %p", strlen.Name, strlen.Addr(), sym, want)
+	}
+
+	s := []byte("proc call\x00")
+	r1, r2, lastErr := strlen.Call(uintptr(unsafe.Pointer(&s[0])))
+	if r1 != 9 || r2 != 0 {
+		t.Errorf("strlen = %d, %d; want 9, 0", r1, r2)
+	}
+	var errno Errno
+	if !errors.As(lastErr, &errno) {
+		t.Errorf("lastErr = %v (%T), want an Errno", lastErr, lastErr)
+	}
+
+	if _, err := FindProc(handle, "goffi_no_such_symbol"); err == nil {
+		t.Error("FindProc of a missing symbol succeeded")
+	}
+	func() {
+		defer func() {
+			if _, ok := recover().(*BindError); !ok {
+				t.Error("MustFindProc of a missing symbol did not panic with *BindError")
+			}
+		}()
+		MustFindProc(handle, "goffi_no_such_symbol")
+	}()
+}
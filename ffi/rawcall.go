@@ -0,0 +1,107 @@
+package ffi
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// RawCall is the register-level description of a native call made with
+// CallRaw. Values are loaded into the machine registers verbatim; no type
+// classification, widening or struct splitting takes place.
+//
+// Register mapping:
+//
+//	           GPR[0..]             FPR[0..7]     Sret
+//	amd64      RDI RSI RDX RCX R8 R9  XMM0-XMM7     - (pass it in GPR[0])
+//	arm64      X0-X7                D0-D7         X8
+//
+// GPR entries beyond the ABI's register count must be zero. FPR holds raw
+// bit patterns: math.Float64bits for doubles, math.Float32bits in the low 32
+// bits for floats.
+type RawCall struct {
+	GPR   [8]uint64 // Integer argument registers
+	FPR   [8]uint64 // Floating-point argument registers, raw bits
+	Stack []uint64  // Stack argument words, Stack[0] at the lowest address; at most MaxRawStackWords
+	Sret  uintptr   // Indirect result register (X8); must be zero on amd64
+}
+
+// RawResult holds the return registers after a CallRaw.
+type RawResult struct {
+	R1 uint64    // RAX on amd64, X0 on arm64
+	R2 uint64    // RDX on amd64, X1 on arm64
+	F  [4]uint64 // XMM0-XMM1 on amd64 (F[2], F[3] zero), D0-D3 on arm64; raw bits
+}
+
+// CallRaw calls fn with the registers and stack words given in call and
+// returns the raw return registers.
+//
+// It is an escape hatch for emulators, language runtimes and other users who
+// already perform their own ABI lowering; everyone else should use
+// CallFunction. The caller is responsible for the call matching the callee's
+// real signature: CallRaw cannot detect a mismatch. Pointers passed in
+// registers or stack words must be kept alive by the caller, for example
+// with runtime.KeepAlive or runtime.Pinner.
+//
+// Variadic callees on amd64 read an upper bound on the number of vector
+// registers used from AL; CallRaw sets it to 8, which is valid for any call.
+//
+// windows/amd64 is not supported and returns types.ErrUnsupportedArchitecture.
+func CallRaw(fn unsafe.Pointer, call *RawCall) (RawResult, error) {
+	if fn == nil {
+		return RawResult{}, &InvalidCallInterfaceError{
+			Field:  "fn",
+			Reason: "function pointer must not be nil",
+			Index:  -1,
+		}
+	}
+	if call == nil {
+		return RawResult{}, &InvalidCallInterfaceError{
+			Field:  "call",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	for k := rawGPRCount; k < len(call.GPR); k++ {
+		if call.GPR[k] != 0 {
+			return RawResult{}, &InvalidCallInterfaceError{
+				Field:  "GPR",
+				Reason: fmt.Sprintf("%s has only %d integer argument registers", runtime.GOARCH, rawGPRCount),
+				Index:  k,
+			}
+		}
+	}
+	if len(call.Stack) > MaxRawStackWords {
+		return RawResult{}, &InvalidCallInterfaceError{
+			Field:  "Stack",
+			Reason: fmt.Sprintf("%d words exceed the limit of %d", len(call.Stack), MaxRawStackWords),
+			Index:  -1,
+		}
+	}
+	if call.Sret != 0 && !rawHasSret {
+		return RawResult{}, &InvalidCallInterfaceError{
+			Field:  "Sret",
+			Reason: fmt.Sprintf("%s has no indirect result register, pass the pointer in GPR[0]", runtime.GOARCH),
+			Index:  -1,
+		}
+	}
+	if err := checkForkedChild(); err != nil {
+		return RawResult{}, err
+	}
+
+	caller, ok := arch.Registry.Caller.(arch.RawCaller)
+	if !ok {
+		return RawResult{}, types.ErrUnsupportedArchitecture
+	}
+	regs := arch.RawRegisters{
+		GPR:   call.GPR,
+		FPR:   call.FPR,
+		Stack: call.Stack,
+		Sret:  call.Sret,
+	}
+	caller.ExecuteRaw(fn, &regs)
+	return RawResult{R1: regs.R1, R2: regs.R2, F: regs.FRet}, nil
+}
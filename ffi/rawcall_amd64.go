@@ -0,0 +1,12 @@
+//go:build amd64
+
+package ffi
+
+// Register-level limits of CallRaw under the System V AMD64 ABI.
+const (
+	// MaxRawStackWords is the number of stack words CallRaw can pass.
+	MaxRawStackWords = 9
+
+	rawGPRCount = 6     // RDI, RSI, RDX, RCX, R8, R9
+	rawHasSret  = false // the result pointer travels in RDI
+)
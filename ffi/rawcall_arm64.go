@@ -0,0 +1,12 @@
+//go:build arm64
+
+package ffi
+
+// Register-level limits of CallRaw under AAPCS64.
+const (
+	// MaxRawStackWords is the number of stack words CallRaw can pass.
+	MaxRawStackWords = 7
+
+	rawGPRCount = 8    // X0-X7
+	rawHasSret  = true // X8
+)
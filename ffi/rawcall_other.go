@@ -0,0 +1,13 @@
+//go:build !amd64 && !arm64
+
+package ffi
+
+// CallRaw is not available on this architecture; it returns
+// types.ErrUnsupportedArchitecture.
+const (
+	// MaxRawStackWords is the number of stack words CallRaw can pass.
+	MaxRawStackWords = 0
+
+	rawGPRCount = 0
+	rawHasSret  = false
+)
@@ -0,0 +1,83 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+func TestCallRaw(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", sym, err)
+	}
+
+	str := []byte("raw registers\x00")
+	res, err := CallRaw(strlen, &RawCall{GPR: [8]uint64{uint64(uintptr(unsafe.Pointer(&str[0])))}})
+	runtime.KeepAlive(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.R1 != 13 {
+		t.Errorf("strlen = %d, want 13", res.R1)
+	}
+}
+
+func TestCallRawFloatReturn(t *testing.T) {
+	requireStructLib(t)
+
+	// struct pair_f64 return_struct_2doubles(double a, double b) comes back
+	// in XMM0:XMM1 / D0:D1.
+	sym, err := GetSymbol(structTestLib, "return_struct_2doubles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := CallRaw(sym, &RawCall{FPR: [8]uint64{math.Float64bits(1.5), math.Float64bits(-2.25)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, b := math.Float64frombits(res.F[0]), math.Float64frombits(res.F[1]); a != 1.5 || b != -2.25 {
+		t.Errorf("return_struct_2doubles = {%v, %v}, want {1.5, -2.25}", a, b)
+	}
+}
+
+func TestCallRawValidation(t *testing.T) {
+	fn := unsafe.Pointer(&struct{ x int }{})
+	type rawCase struct {
+		name  string
+		call  *RawCall
+		field string
+	}
+	tests := []rawCase{
+		{"nil call", nil, "call"},
+		{"stack overflow", &RawCall{Stack: make([]uint64, MaxRawStackWords+1)}, "Stack"},
+	}
+	if runtime.GOARCH == "amd64" {
+		tests = append(tests,
+			rawCase{"GPR beyond RDI..R9", &RawCall{GPR: [8]uint64{6: 1}}, "GPR"},
+			rawCase{"sret", &RawCall{Sret: 1}, "Sret"},
+		)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CallRaw(fn, tt.call)
+			var cifErr *InvalidCallInterfaceError
+			if !errors.As(err, &cifErr) || cifErr.Field != tt.field {
+				t.Errorf("err = %v, want InvalidCallInterfaceError on %s", err, tt.field)
+			}
+		})
+	}
+
+	if _, err := CallRaw(nil, &RawCall{}); err == nil {
+		t.Error("expected error for nil fn")
+	}
+}
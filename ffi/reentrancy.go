@@ -0,0 +1,162 @@
+package ffi
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// ReentrantFrame is one native call in a Go→C→Go→C chain.
+type ReentrantFrame struct {
+	Library unsafe.Pointer // Library Fn was resolved from; nil if not known
+	Symbol  string         // Name Fn was resolved under; "" if not known
+	Fn      unsafe.Pointer
+}
+
+// String returns the symbol name, or the address for unknown functions.
+func (f ReentrantFrame) String() string {
+	if f.Symbol != "" {
+		return f.Symbol
+	}
+	return fmt.Sprintf("%#x", uintptr(f.Fn))
+}
+
+// ReentrancyOptions configures SetReentrancyCheck.
+type ReentrancyOptions struct {
+	// NonReentrant lists library handles whose functions must not be
+	// called from a callback while another of their functions is running
+	// on the same goroutine, as with most GUI toolkits and event loops.
+	NonReentrant []unsafe.Pointer
+
+	// MaxDepth limits how many native calls may be nested through
+	// callbacks on one goroutine; 0 means no limit.
+	MaxDepth int
+
+	// Report, if set, receives each violation and the call proceeds.
+	// Otherwise the offending call is not made and returns the
+	// *ReentrancyError.
+	Report func(*ReentrancyError)
+}
+
+var reentrancyCheck atomic.Pointer[ReentrancyOptions]
+
+// activeCalls holds the native calls in progress per goroutine while the
+// check is enabled. Callbacks run on the goroutine whose native call
+// invoked them, so nested entries are exactly the Go→C→Go→C chain.
+var activeCalls struct {
+	mu          sync.Mutex
+	byGoroutine map[uint64][]ReentrantFrame
+}
+
+// SetReentrancyCheck enables reentrancy diagnostics for all subsequent
+// native calls made through CallFunction, CallFunctionContext,
+// CallFunctionWithOptions and CallFunctionArgs, and returns the previous
+// options. A nil opts disables them, which then costs a single atomic load
+// per call; enabled, every call looks up its goroutine and is meant for
+// debugging deadlocks rather than for production.
+//
+// Symbols are attributed to libraries through GetSymbol, so calls to
+// function pointers obtained otherwise are only subject to MaxDepth.
+// Reports contain the chain of native calls and the Go stack; native frames
+// between them are known by the called symbol only.
+//
+//	ffi.SetReentrancyCheck(&ffi.ReentrancyOptions{
+//	    NonReentrant: []unsafe.Pointer{gtk},
+//	    Report:       func(e *ffi.ReentrancyError) { log.Printf("%v\n%s", e, e.Stack) },
+//	})
+func SetReentrancyCheck(opts *ReentrancyOptions) *ReentrancyOptions {
+	if opts != nil {
+		c := *opts
+		c.NonReentrant = slices.Clone(opts.NonReentrant)
+		opts = &c
+	}
+	return reentrancyCheck.Swap(opts)
+}
+
+// enterCall records a native call to fn on the current goroutine and checks
+// it against opts. The returned function records its end; it is nil when
+// the call must not be made.
+func enterCall(opts *ReentrancyOptions, fn unsafe.Pointer) (leave func(), err error) {
+	frame := ReentrantFrame{Fn: fn}
+	if v, ok := symbolOwners.Load(uintptr(fn)); ok {
+		owner := v.(symbolOwner)
+		frame.Library, frame.Symbol = owner.handle, owner.name
+	}
+	gid := goroutineID()
+
+	activeCalls.mu.Lock()
+	chain := append(slices.Clip(activeCalls.byGoroutine[gid]), frame)
+	reason := reentrancyViolation(opts, chain)
+	if reason == "" || opts.Report != nil {
+		if activeCalls.byGoroutine == nil {
+			activeCalls.byGoroutine = make(map[uint64][]ReentrantFrame)
+		}
+		activeCalls.byGoroutine[gid] = chain
+	}
+	activeCalls.mu.Unlock()
+
+	if reason != "" {
+		rerr := &ReentrancyError{Reason: reason, Chain: chain, Stack: goroutineStack()}
+		if opts.Report == nil {
+			return nil, rerr
+		}
+		opts.Report(rerr)
+	}
+	return func() {
+		activeCalls.mu.Lock()
+		defer activeCalls.mu.Unlock()
+		if c := activeCalls.byGoroutine[gid]; len(c) > 1 {
+			activeCalls.byGoroutine[gid] = c[:len(c)-1]
+		} else {
+			delete(activeCalls.byGoroutine, gid)
+		}
+	}, nil
+}
+
+// reentrancyViolation describes what is wrong with the last call of chain,
+// or returns "".
+func reentrancyViolation(opts *ReentrancyOptions, chain []ReentrantFrame) string {
+	if opts.MaxDepth > 0 && len(chain) > opts.MaxDepth {
+		return fmt.Sprintf("nesting depth %d exceeds %d", len(chain), opts.MaxDepth)
+	}
+	last := chain[len(chain)-1]
+	if last.Library == nil || !slices.Contains(opts.NonReentrant, last.Library) {
+		return ""
+	}
+	for _, f := range chain[:len(chain)-1] {
+		if f.Library == last.Library {
+			return fmt.Sprintf("%s called back into its non-reentrant library", f)
+		}
+	}
+	return ""
+}
+
+// goroutineID returns the id the runtime prints in tracebacks for the
+// current goroutine.
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// goroutineStack returns the traceback of the current goroutine.
+func goroutineStack() []byte {
+	buf := make([]byte, 4096)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
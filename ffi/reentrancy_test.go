@@ -0,0 +1,97 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// qsortCallingStrlen sorts a small array with qsort from libc, calling strlen
+// from libc in every comparison, and returns the first strlen error.
+func qsortCallingStrlen(t *testing.T, handle unsafe.Pointer) error {
+	t.Helper()
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatal(err)
+	}
+	strlen, err := GetSymbol(handle, "strlen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	qsortCIF := &types.CallInterface{}
+	if err := PrepareCallInterface(qsortCIF, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	strlenCIF := &types.CallInterface{}
+	if err := PrepareCallInterface(strlenCIF, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	var inner error
+	str := []byte("x\x00")
+	cmp := NewCallback(func(a, b *int32) int32 {
+		p := unsafe.Pointer(&str[0])
+		var n uint64
+		if err := CallFunction(strlenCIF, strlen, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)}); err != nil && inner == nil {
+			inner = err
+		}
+		return *a - *b
+	})
+	defer releaseCallback(cmp)
+
+	xs := []int32{3, 1, 2}
+	base := unsafe.Pointer(&xs[0])
+	n, size := uint64(len(xs)), uint64(4)
+	if err := CallFunction(qsortCIF, qsort, nil,
+		[]unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp)}); err != nil {
+		t.Fatal(err)
+	}
+	return inner
+}
+
+func TestReentrancyCheck(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	defer SetReentrancyCheck(SetReentrancyCheck(&ReentrancyOptions{NonReentrant: []unsafe.Pointer{handle}}))
+
+	err = qsortCallingStrlen(t, handle)
+	var reErr *ReentrancyError
+	if !errors.As(err, &reErr) {
+		t.Fatalf("strlen from a qsort callback: err = %v, want *ReentrancyError", err)
+	}
+	if len(reErr.Chain) != 2 || reErr.Chain[0].Symbol != "qsort" || reErr.Chain[1].Symbol != "strlen" {
+		t.Errorf("chain = %v, want qsort -> strlen", reErr.Chain)
+	}
+	if !strings.Contains(string(reErr.Stack), "qsortCallingStrlen") {
+		t.Errorf("stack does not show the Go caller:\n%s", reErr.Stack)
+	}
+
+	// Depth only: two nested calls are within the limit, then over it.
+	SetReentrancyCheck(&ReentrancyOptions{MaxDepth: 2})
+	if err := qsortCallingStrlen(t, handle); err != nil {
+		t.Errorf("depth 2 with MaxDepth 2: %v", err)
+	}
+	var reports int
+	SetReentrancyCheck(&ReentrancyOptions{MaxDepth: 1, Report: func(*ReentrancyError) { reports++ }})
+	if err := qsortCallingStrlen(t, handle); err != nil {
+		t.Errorf("reported violation failed the call: %v", err)
+	}
+	if reports == 0 {
+		t.Error("Report was not called")
+	}
+	if n := len(activeCalls.byGoroutine); n != 0 {
+		t.Errorf("%d goroutines still have active calls", n)
+	}
+}
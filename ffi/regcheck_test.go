@@ -0,0 +1,89 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestWithRegisterCheckClean(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", sym, err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	str := []byte("checked\x00")
+	strPtr := unsafe.Pointer(&str[0])
+	for _, blocking := range []bool{false, true} {
+		opts := []CallOption{WithRegisterCheck()}
+		if blocking {
+			opts = append(opts, WithBlockingCall())
+		}
+		var n uint64
+		err := CallFunctionWithOptions(context.Background(), cif, strlen, unsafe.Pointer(&n),
+			[]unsafe.Pointer{unsafe.Pointer(&strPtr)}, opts...)
+		if err != nil {
+			t.Fatalf("blocking=%v: %v", blocking, err)
+		}
+		if n != 7 {
+			t.Errorf("blocking=%v: strlen = %d, want 7", blocking, n)
+		}
+	}
+	if cif.CheckRegisters {
+		t.Error("WithRegisterCheck modified the caller's CallInterface")
+	}
+	runtime.KeepAlive(str)
+}
+
+func TestWithRegisterCheckClobber(t *testing.T) {
+	requireStructLib(t)
+
+	sym, err := GetSymbol(structTestLib, "clobber_callee_saved")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt64TypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var want []string
+	switch runtime.GOARCH {
+	case "amd64":
+		want = []string{"RBX", "R12"}
+	case "arm64":
+		want = []string{"X19", "D8"}
+	}
+
+	var result int64
+	err = CallFunctionWithOptions(context.Background(), cif, sym, unsafe.Pointer(&result), nil, WithRegisterCheck())
+	var clobErr *RegisterClobberError
+	if !errors.As(err, &clobErr) {
+		t.Fatalf("err = %v, want *RegisterClobberError", err)
+	}
+	if !slices.Equal(clobErr.Registers, want) {
+		t.Errorf("Registers = %v, want %v", clobErr.Registers, want)
+	}
+	if result != 7 {
+		t.Errorf("result = %d, want 7", result)
+	}
+}
@@ -0,0 +1,271 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// RegisterFunc makes the Go func variable fptr points to call the C function
+// fn, deriving the call interface from the func's signature instead of
+// hand-built type descriptors and argument arrays:
+//
+//	var strlen func(s string) uintptr
+//	var qsort func(base []int32, n, size uintptr, cmp func(a, b *int32) int32)
+//	if err := ffi.RegisterFunc(&strlen, strlenPtr); err != nil {
+//	    return err
+//	}
+//	n := strlen("hello")
+//
+// Parameters convert as follows:
+//
+//   - bool, integers and floats pass as the same C types (bool as uint8_t;
+//     int, uint and uintptr are pointer-sized, so use int32 for a C int)
+//   - unsafe.Pointer and pointers *T pass the address itself, so the callee
+//     can write through it; slices pass the address of their first element
+//     (NULL for a nil slice)
+//   - strings pass as NUL-terminated copies, valid for the duration of the
+//     call
+//   - funcs pass as callbacks registered for the duration of the call, as
+//     with WithScopedCallback; nil passes NULL
+//   - structs pass by value, laid out as by DescribeStruct and converted as
+//     by Marshal
+//
+// The func may return nothing or one of bool, an integer, a float, uintptr,
+// unsafe.Pointer, a string (a returned char * is copied; NULL gives "") or a
+// struct (converted as by Unmarshal), optionally followed by an error that
+// receives failures to convert the arguments or make the call. A func
+// without an error result panics with them instead.
+func RegisterFunc(fptr any, fn unsafe.Pointer) error {
+	if fn == nil {
+		return &InvalidCallInterfaceError{Field: "fn", Reason: "must not be nil", Index: -1}
+	}
+	return registerFunc(fptr, fn, nil)
+}
+
+// RegisterLibFunc is RegisterFunc for the function name exported by the
+// library handle.
+func RegisterLibFunc(fptr any, handle unsafe.Pointer, name string) error {
+	fn, err := GetSymbol(handle, name)
+	if err != nil {
+		return err
+	}
+	return RegisterFunc(fptr, fn)
+}
+
+// RegisterLazyFunc is RegisterFunc for a symbol resolved on the first call
+// of the func, so a binding table can register every entry point up front
+// and only look up the ones that are called:
+//
+//	var createInstance func(desc unsafe.Pointer) unsafe.Pointer
+//	err := ffi.RegisterLazyFunc(&createInstance, ffi.NewLazySymbol(lib, "wgpuCreateInstance"))
+//
+// A failed lookup is reported by each call like a failed conversion: in the
+// trailing error result, or as a panic for a func without one.
+func RegisterLazyFunc(fptr any, sym *LazySymbol) error {
+	if sym == nil {
+		return &InvalidCallInterfaceError{Field: "sym", Reason: "must not be nil", Index: -1}
+	}
+	return registerFunc(fptr, nil, sym)
+}
+
+// registerFunc binds fptr to fn, or to lazy when fn is nil.
+func registerFunc(fptr any, fn unsafe.Pointer, lazy *LazySymbol) error {
+	pv := reflect.ValueOf(fptr)
+	if pv.Kind() != reflect.Pointer || pv.IsNil() || pv.Elem().Kind() != reflect.Func {
+		return &InvalidCallInterfaceError{Field: "fptr", Reason: "must be a non-nil pointer to a func variable", Index: -1}
+	}
+	ft := pv.Elem().Type()
+	b, err := newFuncBinding(ft, fn)
+	if err != nil {
+		return err
+	}
+	b.lazy = lazy
+	pv.Elem().Set(reflect.MakeFunc(ft, b.call))
+	return nil
+}
+
+// argClass is how a parameter of a registered func is converted.
+type argClass int
+
+const (
+	argValue   argClass = iota // Marshal the value itself
+	argAddress                 // Pass the address of a pointer or slice
+	argString                  // Pass a NUL-terminated copy
+	argFunc                    // Pass a scoped callback
+)
+
+// funcBinding is the call interface and conversions of a registered func.
+type funcBinding struct {
+	cif    types.CallInterface
+	fn     unsafe.Pointer
+	lazy   *LazySymbol // resolves fn on first call when set
+	ft     reflect.Type
+	args   []argClass
+	ret    reflect.Type // nil for no result
+	hasErr bool
+}
+
+var errorType = reflect.TypeFor[error]()
+
+// newFuncBinding derives the call interface of the func type ft.
+func newFuncBinding(ft reflect.Type, fn unsafe.Pointer) (*funcBinding, error) {
+	if ft.IsVariadic() {
+		return nil, &InvalidCallInterfaceError{Field: "fptr", Reason: "variadic funcs are not supported", Index: -1}
+	}
+	b := &funcBinding{fn: fn, ft: ft}
+	d := &describer{structs: make(map[reflect.Type]*types.TypeDescriptor)}
+
+	argTypes := make([]*types.TypeDescriptor, ft.NumIn())
+	b.args = make([]argClass, ft.NumIn())
+	for i := range ft.NumIn() {
+		t := ft.In(i)
+		class, desc := argValue, scalarTypeFor(t)
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice:
+			class, desc = argAddress, types.PointerTypeDescriptor
+		case reflect.String:
+			class, desc = argString, types.PointerTypeDescriptor
+		case reflect.Func:
+			class, desc = argFunc, types.PointerTypeDescriptor
+		case reflect.Struct:
+			if desc != nil {
+				break // types.Int128, types.UInt128
+			}
+			var err error
+			if desc, err = d.structType(t); err != nil {
+				return nil, err
+			}
+		}
+		if desc == nil {
+			return nil, &InvalidCallInterfaceError{Field: "fptr", Reason: fmt.Sprintf("unsupported parameter type %s", t), Index: i}
+		}
+		b.args[i], argTypes[i] = class, desc
+	}
+
+	results := ft.NumOut()
+	if results > 0 && ft.Out(results-1) == errorType {
+		b.hasErr = true
+		results--
+	}
+	retType := types.VoidTypeDescriptor
+	switch results {
+	case 0:
+	case 1:
+		b.ret = ft.Out(0)
+		switch b.ret.Kind() {
+		case reflect.String:
+			retType = types.CStringTypeDescriptor
+		case reflect.Struct:
+			if retType = scalarTypeFor(b.ret); retType != nil {
+				break
+			}
+			var err error
+			if retType, err = d.structType(b.ret); err != nil {
+				return nil, err
+			}
+		default:
+			retType = scalarTypeFor(b.ret)
+		}
+		if retType == nil {
+			return nil, &InvalidCallInterfaceError{Field: "fptr", Reason: fmt.Sprintf("unsupported result type %s", b.ret), Index: -1}
+		}
+	default:
+		return nil, &InvalidCallInterfaceError{Field: "fptr", Reason: "funcs can return at most one value and an error", Index: -1}
+	}
+	if err := PrepareCallInterface(&b.cif, types.DefaultCall, retType, argTypes); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// call is the implementation of the registered func.
+func (b *funcBinding) call(in []reflect.Value) []reflect.Value {
+	out := make([]reflect.Value, b.ft.NumOut())
+	var ret reflect.Value
+	if b.ret != nil {
+		ret = reflect.New(b.ret).Elem()
+	}
+	err := b.invoke(in, ret)
+	runtime.KeepAlive(in)
+	if err != nil && !b.hasErr {
+		panic(err)
+	}
+	i := 0
+	if b.ret != nil {
+		out[0] = ret
+		i++
+	}
+	if b.hasErr {
+		errv := reflect.New(errorType).Elem()
+		if err != nil {
+			errv.Set(reflect.ValueOf(err))
+		}
+		out[i] = errv
+	}
+	return out
+}
+
+// invoke converts in, calls the function and stores its result in ret.
+func (b *funcBinding) invoke(in []reflect.Value, ret reflect.Value) error {
+	fn := b.fn
+	if b.lazy != nil {
+		var err error
+		if fn, err = b.lazy.Addr(); err != nil {
+			return err
+		}
+	}
+	arena := NewArena()
+	defer arena.Free()
+
+	avalue := make([]unsafe.Pointer, len(in))
+	for i, v := range in {
+		desc := b.cif.ArgTypes[i]
+		switch b.args[i] {
+		case argAddress:
+			p := new(unsafe.Pointer)
+			*p = v.UnsafePointer()
+			avalue[i] = unsafe.Pointer(p)
+		case argString:
+			s, err := arena.CString(v.String())
+			if err != nil {
+				return &InvalidCallInterfaceError{Field: "avalue", Reason: err.Error(), Index: i}
+			}
+			avalue[i] = unsafe.Pointer(&s)
+		case argFunc:
+			var cb uintptr
+			if !v.IsNil() {
+				cb = arena.Callback(v.Interface())
+			}
+			avalue[i] = unsafe.Pointer(&cb)
+		default:
+			slot := arena.Alloc(desc.Size, desc.Alignment)
+			if err := marshalValue(slot, desc, v, arena); err != nil {
+				return err
+			}
+			avalue[i] = slot
+		}
+	}
+
+	switch {
+	case b.ret == nil:
+		return CallFunction(&b.cif, fn, nil, avalue)
+	case b.ret.Kind() == reflect.String:
+		var s string
+		if err := CallFunction(&b.cif, fn, unsafe.Pointer(&s), avalue); err != nil {
+			return err
+		}
+		ret.SetString(s)
+		return nil
+	}
+	// Integer results smaller than a register are returned widened.
+	retDesc := b.cif.ReturnType
+	rvalue := arena.Alloc(max(retDesc.Size, unsafe.Sizeof(uint64(0))), retDesc.Alignment)
+	if err := CallFunction(&b.cif, fn, rvalue, avalue); err != nil {
+		return err
+	}
+	return unmarshalValue(rvalue, retDesc, ret)
+}
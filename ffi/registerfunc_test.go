@@ -0,0 +1,114 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRegisterFunc(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+
+	var strlen func(s string) uintptr
+	if err := RegisterLibFunc(&strlen, handle, "strlen"); err != nil {
+		t.Fatal(err)
+	}
+	if n := strlen("register func"); n != 13 {
+		t.Errorf("strlen = %d, want 13", n)
+	}
+
+	var abs func(n int32) int32
+	if err := RegisterLibFunc(&abs, handle, "abs"); err != nil {
+		t.Fatal(err)
+	}
+	if n := abs(-42); n != 42 {
+		t.Errorf("abs(-42) = %d", n)
+	}
+
+	// div_t div(int numer, int denom)
+	type divT struct{ Quot, Rem int32 }
+	var div func(numer, denom int32) divT
+	if err := RegisterLibFunc(&div, handle, "div"); err != nil {
+		t.Fatal(err)
+	}
+	if got := div(17, 5); got != (divT{3, 2}) {
+		t.Errorf("div(17, 5) = %+v", got)
+	}
+
+	var qsort func(base []int32, n, size uintptr, cmp func(a, b *int32) int32)
+	if err := RegisterLibFunc(&qsort, handle, "qsort"); err != nil {
+		t.Fatal(err)
+	}
+	xs := []int32{3, 1, 2}
+	qsort(xs, uintptr(len(xs)), 4, func(a, b *int32) int32 { return *a - *b })
+	if !slices.Equal(xs, []int32{1, 2, 3}) {
+		t.Errorf("qsort sorted %v", xs)
+	}
+
+	var strlenErr func(s string) (uintptr, error)
+	if err := RegisterLibFunc(&strlenErr, handle, "strlen"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := strlenErr("abc"); n != 3 || err != nil {
+		t.Errorf("strlen = %d, %v", n, err)
+	}
+	if _, err := strlenErr("a\x00b"); !errors.Is(err, &InvalidCallInterfaceError{}) {
+		t.Errorf("strlen with NUL: err = %v, want InvalidCallInterfaceError", err)
+	}
+
+	if err := RegisterFunc(&abs, nil); err == nil {
+		t.Error("RegisterFunc accepted a nil function")
+	}
+	fn, _ := GetSymbol(handle, "strlen")
+	for _, fptr := range []any{
+		nil,
+		abs,
+		new(func(...int32)),
+		new(func(map[int]int)),
+		new(func() (int32, int32)),
+		new(func() *int32),
+	} {
+		if err := RegisterFunc(fptr, fn); err == nil {
+			t.Errorf("RegisterFunc accepted %T", fptr)
+		}
+	}
+}
+
+func TestRegisterLazyFunc(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+
+	sym := NewLazySymbol(handle, "strlen")
+	var strlen func(s string) uintptr
+	if err := RegisterLazyFunc(&strlen, sym); err != nil {
+		t.Fatal(err)
+	}
+	if sym.addr != nil {
+		t.Error("RegisterLazyFunc resolved the symbol before the first call")
+	}
+	if n := strlen("lazy"); n != 4 {
+		t.Errorf("strlen = %d, want 4", n)
+	}
+
+	var missing func() (int32, error)
+	if err := RegisterLazyFunc(&missing, NewLazySymbol(handle, "goffi_no_such_symbol")); err != nil {
+		t.Fatal(err)
+	}
+	var libErr *LibraryError
+	if _, err := missing(); !errors.As(err, &libErr) {
+		t.Errorf("missing symbol: err = %v, want *LibraryError", err)
+	}
+
+	if err := RegisterLazyFunc(&strlen, nil); err == nil {
+		t.Error("RegisterLazyFunc accepted a nil symbol")
+	}
+}
@@ -0,0 +1,73 @@
+package ffi
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// ReturnRegisters holds the raw contents of the two integer return registers
+// after a native call.
+type ReturnRegisters struct {
+	R1 uint64 // RAX on amd64, X0 on arm64
+	R2 uint64 // RDX on amd64, X1 on arm64
+}
+
+// Bytes returns R1 followed by R2 in little-endian order: the in-memory
+// layout of a 128-bit integer or a two-register struct returned in them.
+func (r ReturnRegisters) Bytes() [16]byte {
+	var b [16]byte
+	*(*uint64)(unsafe.Pointer(&b[0])) = r.R1
+	*(*uint64)(unsafe.Pointer(&b[8])) = r.R2
+	return b
+}
+
+// CallFunctionRegisters executes a C function call like CallFunction and also
+// returns the raw contents of both integer return registers.
+//
+// This gives access to results that the type system cannot describe yet, such
+// as __int128 / unsigned __int128 returns or 9-16 byte structs of integers,
+// which the System V and AAPCS64 ABIs return split across two registers.
+// Declare the return type as UInt64 (or Void) in the CallInterface and read
+// both halves from the result:
+//
+//	// lldiv_t lldiv(long long numer, long long denom);
+//	regs, err := ffi.CallFunctionRegisters(&cif, lldivPtr, nil,
+//	    []unsafe.Pointer{unsafe.Pointer(&numer), unsafe.Pointer(&denom)})
+//	quot, rem := int64(regs.R1), int64(regs.R2)
+//
+// rvalue may be nil; if given, it is filled according to cif.ReturnType as
+// with CallFunction. R2 has no meaning on windows/amd64, whose ABI returns
+// values in RAX only.
+//
+// The call takes the same path as CallFunction: reentrancy checks,
+// interceptors, tracing and fork safety all apply. A function routed through
+// a CallStub has no registers to report, so the call fails with
+// types.ErrUnsupportedArchitecture, as it does on platforms without support.
+func CallFunctionRegisters(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) (ReturnRegisters, error) {
+	if cif == nil {
+		return ReturnRegisters{}, &InvalidCallInterfaceError{
+			Field:  "cif",
+			Reason: "must not be nil",
+			Index:  -1,
+		}
+	}
+	if fn == nil {
+		return ReturnRegisters{}, &InvalidCallInterfaceError{
+			Field:  "fn",
+			Reason: "function pointer must not be nil",
+			Index:  -1,
+		}
+	}
+	if err := validateArgs(cif, avalue); err != nil {
+		return ReturnRegisters{}, err
+	}
+	var regs ReturnRegisters
+	err := executeFunction(cif, fn, rvalue, avalue, &callOutputs{regs: &regs})
+	return regs, err
+}
@@ -0,0 +1,63 @@
+package ffi
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestCallFunctionRegisters(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Win64 returns 16-byte structs through a hidden pointer")
+	}
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	lldiv, err := GetSymbol(handle, "lldiv")
+	if err != nil {
+		t.Skipf("lldiv not available: %v", err)
+	}
+
+	// lldiv_t is {long long quot; long long rem;}: returned in RAX:RDX / X0:X1.
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt64TypeDescriptor, types.SInt64TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	// CallFunctionRegisters takes the CallFunction path, so interceptors see the call.
+	intercepted := 0
+	remove, err := InterceptLibrary(handle, func(c *Call, next CallInvoker) error {
+		intercepted++
+		return next(c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remove()
+
+	numer, denom := int64(-17), int64(5)
+	var quot int64
+	regs, err := CallFunctionRegisters(cif, lldiv, unsafe.Pointer(&quot),
+		[]unsafe.Pointer{unsafe.Pointer(&numer), unsafe.Pointer(&denom)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(regs.R1) != -3 || int64(regs.R2) != -2 {
+		t.Errorf("lldiv(-17, 5) = {%d, %d}, want {-3, -2}", int64(regs.R1), int64(regs.R2))
+	}
+	if quot != -3 {
+		t.Errorf("rvalue = %d, want -3", quot)
+	}
+	if intercepted != 1 {
+		t.Errorf("interceptor ran %d times, want 1", intercepted)
+	}
+
+	b := regs.Bytes()
+	if *(*int64)(unsafe.Pointer(&b[8])) != -2 {
+		t.Errorf("Bytes()[8:] does not hold R2")
+	}
+}
@@ -0,0 +1,317 @@
+package ffi
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// ErrLibraryNotFound is returned by ResolveLibrary when neither pkg-config
+// nor the standard install prefixes know the library.
+var ErrLibraryNotFound = errors.New("goffi: library not found")
+
+// ResolvedLibrary is a shared library file located by ResolveLibrary.
+type ResolvedLibrary struct {
+	Name      string // Name that was resolved, e.g. "wgpu"
+	Path      string // Full path of the library file
+	Version   string // Version of the .pc file or of the real file name, "" if unknown
+	PkgConfig string // .pc file the library was found through, "" if found in a prefix
+}
+
+// ResolveLibrary locates the shared library for a bare name such as "wgpu"
+// or "libpng16" the way a developer machine has it installed:
+//
+//  1. a pkg-config file name.pc or libname.pc in PKG_CONFIG_PATH, then in
+//     PKG_CONFIG_LIBDIR or the platform's default .pc directories: the
+//     library named by its Libs -l flags, searched in its -L directories
+//     and libdir
+//  2. the directories of LD_LIBRARY_PATH (DYLD_LIBRARY_PATH on macOS) and
+//     the standard install prefixes: /usr/local/lib, the multiarch and
+//     lib64 directories on Linux, Homebrew and MacPorts on macOS
+//
+// A library is looked for as libname.so (then the highest libname.so.N)
+// on Linux and FreeBSD and libname.dylib (then libname.N.dylib) on macOS.
+// LoadLibrary uses ResolveLibrary when dlopen cannot load a bare name
+// (one without a path separator or a dot), so LoadLibrary("wgpu") finds
+// the library without hard-coded paths. Windows finds DLLs by bare name
+// itself; there ResolveLibrary only consults pkg-config.
+//
+// Example:
+//
+//	lib, err := ffi.ResolveLibrary("wgpu")
+//	if err == nil {
+//	    log.Printf("using %s %s", lib.Path, lib.Version)
+//	}
+func ResolveLibrary(name string) (*ResolvedLibrary, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, &LibraryError{Operation: "resolve", Name: name, Err: errors.New("name must be a bare library name")}
+	}
+	base := strings.TrimPrefix(name, "lib")
+
+	for _, pc := range pkgConfigFiles(base) {
+		if lib := resolveFromPkgConfig(pc, base); lib != nil {
+			lib.Name = name
+			return lib, nil
+		}
+	}
+	for _, dir := range libraryPrefixes() {
+		if path := findLibraryFile(dir, base); path != "" {
+			return &ResolvedLibrary{Name: name, Path: path, Version: fileVersion(path)}, nil
+		}
+	}
+	return nil, &LibraryError{Operation: "resolve", Name: name, Err: ErrLibraryNotFound}
+}
+
+// resolveBareName returns the path ResolveLibrary finds for name if name is
+// a bare library name that dlopen cannot load as is, or "".
+func resolveBareName(name string) string {
+	if strings.ContainsAny(name, `/\.`) {
+		return ""
+	}
+	lib, err := ResolveLibrary(name)
+	if err != nil {
+		return ""
+	}
+	return lib.Path
+}
+
+// pkgConfigFiles returns the existing .pc files for base, in search order.
+func pkgConfigFiles(base string) []string {
+	dirs := filepath.SplitList(os.Getenv("PKG_CONFIG_PATH"))
+	if libdir, ok := os.LookupEnv("PKG_CONFIG_LIBDIR"); ok {
+		dirs = append(dirs, filepath.SplitList(libdir)...)
+	} else {
+		dirs = append(dirs, defaultPkgConfigDirs()...)
+	}
+	var files []string
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		for _, pc := range []string{base + ".pc", "lib" + base + ".pc"} {
+			path := filepath.Join(dir, pc)
+			if fi, err := os.Stat(path); err == nil && fi.Mode().IsRegular() {
+				files = append(files, path)
+			}
+		}
+	}
+	return files
+}
+
+// resolveFromPkgConfig returns the library the .pc file at path links, or
+// nil if the file does not name one that exists. Among several -l flags the
+// one matching base wins.
+func resolveFromPkgConfig(path, base string) *ResolvedLibrary {
+	pc, err := parsePkgConfig(path)
+	if err != nil {
+		return nil
+	}
+	var dirs, names []string
+	for _, flag := range strings.Fields(pc.fields["Libs"]) {
+		switch {
+		case strings.HasPrefix(flag, "-L") && len(flag) > 2:
+			dirs = append(dirs, flag[2:])
+		case strings.HasPrefix(flag, "-l") && len(flag) > 2:
+			names = append(names, flag[2:])
+		}
+	}
+	if libdir := pc.vars["libdir"]; libdir != "" {
+		dirs = append(dirs, libdir)
+	}
+	if i := slices.Index(names, base); i > 0 {
+		names[0], names[i] = names[i], names[0]
+	}
+	for _, lname := range names {
+		for _, dir := range dirs {
+			if lib := findLibraryFile(dir, lname); lib != "" {
+				version := pc.fields["Version"]
+				if version == "" {
+					version = fileVersion(lib)
+				}
+				return &ResolvedLibrary{Path: lib, Version: version, PkgConfig: path}
+			}
+		}
+	}
+	return nil
+}
+
+// pkgConfig is a parsed .pc file.
+type pkgConfig struct {
+	vars   map[string]string // name=value lines
+	fields map[string]string // Name: value lines
+}
+
+// parsePkgConfig reads the variables and fields of a .pc file, expanding
+// ${var} references as pkg-config does.
+func parsePkgConfig(path string) (*pkgConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	pc := &pkgConfig{
+		vars:   map[string]string{"pcfiledir": filepath.Dir(path)},
+		fields: map[string]string{},
+	}
+	expand := func(s string) string {
+		return os.Expand(s, func(v string) string { return pc.vars[v] })
+	}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		colon, eq := strings.IndexByte(line, ':'), strings.IndexByte(line, '=')
+		switch {
+		case colon > 0 && (eq < 0 || colon < eq):
+			pc.fields[strings.TrimSpace(line[:colon])] = expand(strings.TrimSpace(line[colon+1:]))
+		case eq > 0:
+			pc.vars[strings.TrimSpace(line[:eq])] = expand(strings.TrimSpace(line[eq+1:]))
+		}
+	}
+	return pc, sc.Err()
+}
+
+// findLibraryFile returns the shared library for lname in dir, preferring
+// the unversioned development name over the newest versioned file, or "".
+func findLibraryFile(dir, lname string) string {
+	var exact, pattern string
+	switch runtime.GOOS {
+	case "darwin":
+		exact, pattern = "lib"+lname+".dylib", "lib"+lname+".*.dylib"
+	case "windows":
+		exact, pattern = lname+".dll", "lib"+lname+".dll"
+	default:
+		exact, pattern = "lib"+lname+".so", "lib"+lname+".so.*"
+	}
+	if path := filepath.Join(dir, exact); isSharedObject(path) {
+		return path
+	}
+	matches, _ := filepath.Glob(filepath.Join(dir, pattern))
+	var best string
+	var bestVersion LibraryVersion
+	for _, m := range matches {
+		if !isSharedObject(m) {
+			continue
+		}
+		var v LibraryVersion
+		v.setNumbers(fileVersion(m))
+		if best == "" || v.Compare(bestVersion.Major, bestVersion.Minor, bestVersion.Patch) > 0 {
+			best, bestVersion = m, v
+		}
+	}
+	return best
+}
+
+// fileVersion returns the version in the name of the file path resolves
+// to, such as 22.1.0 for libwgpu_native.so.22.1.0 or libfoo.22.1.0.dylib,
+// or "".
+func fileVersion(path string) string {
+	if real, err := filepath.EvalSymlinks(path); err == nil {
+		path = real
+	}
+	name := filepath.Base(path)
+	if v := sonameVersion(name); v != "" {
+		return v
+	}
+	if stem, ok := strings.CutSuffix(name, ".dylib"); ok {
+		if i := strings.IndexByte(stem, '.'); i >= 0 && i+1 < len(stem) && stem[i+1] >= '0' && stem[i+1] <= '9' {
+			return stem[i+1:]
+		}
+	}
+	return ""
+}
+
+// isSharedObject reports whether path is an ELF, Mach-O or PE file, which
+// rules out directories and the GNU ld scripts glibc installs as libc.so
+// and libm.so.
+func isSharedObject(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	var magic [4]byte
+	if _, err := io.ReadFull(f, magic[:]); err != nil {
+		return false
+	}
+	switch string(magic[:]) {
+	case "\x7fELF", "\xcf\xfa\xed\xfe", "\xce\xfa\xed\xfe", "\xca\xfe\xba\xbe":
+		return true
+	}
+	return string(magic[:2]) == "MZ"
+}
+
+// defaultPkgConfigDirs returns the directories pkg-config searches when
+// PKG_CONFIG_LIBDIR is not set.
+func defaultPkgConfigDirs() []string {
+	var dirs []string
+	for _, prefix := range standardPrefixes() {
+		dirs = append(dirs, filepath.Join(prefix, "pkgconfig"))
+	}
+	switch runtime.GOOS {
+	case "freebsd":
+		dirs = append(dirs, "/usr/local/libdata/pkgconfig", "/usr/libdata/pkgconfig")
+	case "linux":
+		dirs = append(dirs, "/usr/local/share/pkgconfig", "/usr/share/pkgconfig")
+	}
+	return dirs
+}
+
+// libraryPathEnv returns the directories of the dynamic loader's library
+// path variable.
+func libraryPathEnv() []string {
+	env := "LD_LIBRARY_PATH"
+	switch runtime.GOOS {
+	case "darwin":
+		env = "DYLD_LIBRARY_PATH"
+	case "windows":
+		return nil
+	}
+	return slices.DeleteFunc(filepath.SplitList(os.Getenv(env)), func(dir string) bool { return dir == "" })
+}
+
+// libraryPrefixes returns the directories searched for a library that no
+// .pc file describes: the loader's library path, then the standard install
+// prefixes.
+func libraryPrefixes() []string {
+	return append(libraryPathEnv(), standardPrefixes()...)
+}
+
+// standardPrefixes returns the platform's standard library directories.
+func standardPrefixes() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{"/opt/homebrew/lib", "/usr/local/lib", "/opt/local/lib"}
+	case "freebsd":
+		return []string{"/usr/local/lib", "/usr/lib", "/lib"}
+	case "linux":
+		dirs := []string{"/usr/local/lib"}
+		if triplet := multiarchTriplet(); triplet != "" {
+			dirs = append(dirs, "/usr/lib/"+triplet, "/lib/"+triplet)
+		}
+		return append(dirs, "/usr/lib64", "/usr/lib", "/lib64", "/lib")
+	}
+	return nil
+}
+
+// multiarchTriplet returns the Debian multiarch directory name for this
+// architecture, or "".
+func multiarchTriplet() string {
+	switch runtime.GOARCH {
+	case "amd64":
+		return "x86_64-linux-gnu"
+	case "arm64":
+		return "aarch64-linux-gnu"
+	case "arm":
+		return "arm-linux-gnueabihf"
+	}
+	return ""
+}
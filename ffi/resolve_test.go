@@ -0,0 +1,94 @@
+package ffi
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// touchLibrary creates a file with an ELF header standing in for a shared
+// library.
+func touchLibrary(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("\x7fELF"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveLibrary(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "freebsd" {
+		t.Skip("test uses ELF library file names")
+	}
+
+	t.Run("PkgConfig", func(t *testing.T) {
+		pcDir, prefix := t.TempDir(), t.TempDir()
+		touchLibrary(t, filepath.Join(prefix, "lib", "libwgpu_native.so.22.1.0"))
+		pc := "prefix=" + prefix + "\n" +
+			"libdir=${prefix}/lib # comment\n" +
+			"\n" +
+			"Name: wgpu-native\n" +
+			"Version: 22.1.0.5\n" +
+			"Libs: -L${libdir} -lm -lwgpu_native\n"
+		if err := os.WriteFile(filepath.Join(pcDir, "wgpu.pc"), []byte(pc), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("PKG_CONFIG_PATH", pcDir)
+		t.Setenv("PKG_CONFIG_LIBDIR", "")
+
+		lib, err := ResolveLibrary("wgpu")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := filepath.Join(prefix, "lib", "libwgpu_native.so.22.1.0")
+		if lib.Path != want || lib.Version != "22.1.0.5" || lib.PkgConfig != filepath.Join(pcDir, "wgpu.pc") {
+			t.Errorf("ResolveLibrary(wgpu) = %+v, want Path %s, Version 22.1.0.5 from wgpu.pc", lib, want)
+		}
+	})
+
+	t.Run("Prefix", func(t *testing.T) {
+		dir := t.TempDir()
+		touchLibrary(t, filepath.Join(dir, "libgoffitest.so.9.0.1"))
+		touchLibrary(t, filepath.Join(dir, "libgoffitest.so.10.2.0"))
+		touchLibrary(t, filepath.Join(dir, "libgoffitest.so.10"))
+		t.Setenv("PKG_CONFIG_PATH", "")
+		t.Setenv("PKG_CONFIG_LIBDIR", "")
+		t.Setenv("LD_LIBRARY_PATH", dir)
+
+		lib, err := ResolveLibrary("libgoffitest")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := filepath.Join(dir, "libgoffitest.so.10.2.0"); lib.Path != want || lib.Version != "10.2.0" {
+			t.Errorf("ResolveLibrary = %+v, want the newest file %s", lib, want)
+		}
+
+		// A GNU ld script is not a library dlopen can load.
+		if err := os.WriteFile(filepath.Join(dir, "libgoffitest.so"), []byte("/* GNU ld script */"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if lib, err := ResolveLibrary("goffitest"); err != nil || lib.Path != filepath.Join(dir, "libgoffitest.so.10.2.0") {
+			t.Errorf("ResolveLibrary = %+v, %v, want the linker script skipped", lib, err)
+		}
+		touchLibrary(t, filepath.Join(dir, "libgoffitest.so"))
+		if lib, err := ResolveLibrary("goffitest"); err != nil || lib.Path != filepath.Join(dir, "libgoffitest.so") {
+			t.Errorf("ResolveLibrary = %+v, %v, want the development symlink", lib, err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Setenv("PKG_CONFIG_PATH", "")
+		t.Setenv("PKG_CONFIG_LIBDIR", "")
+		t.Setenv("LD_LIBRARY_PATH", "")
+		if _, err := ResolveLibrary("goffi_no_such_library"); !errors.Is(err, ErrLibraryNotFound) {
+			t.Errorf("err = %v, want ErrLibraryNotFound", err)
+		}
+		if _, err := ResolveLibrary("/usr/lib/libm.so"); err == nil {
+			t.Error("ResolveLibrary accepted a path")
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package ffi
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// ReturnBuffer is a return value buffer that knows its own size, so a call can
+// verify it is large enough for the CallInterface's return type before the
+// native code runs.
+//
+// A plain rvalue pointer carries no size: passing &x where x is an int32 for a
+// function declared to return int64 or a 16-byte struct silently overwrites
+// whatever follows x in memory.
+type ReturnBuffer struct {
+	ptr  unsafe.Pointer
+	size uintptr
+}
+
+// ReturnBufferOf returns a ReturnBuffer covering *p.
+//
+//	var result int64
+//	err := ffi.CallFunctionInto(&cif, fn, ffi.ReturnBufferOf(&result), args)
+func ReturnBufferOf[T any](p *T) ReturnBuffer {
+	return ReturnBuffer{ptr: unsafe.Pointer(p), size: unsafe.Sizeof(*p)}
+}
+
+// ReturnBufferBytes returns a ReturnBuffer covering b.
+func ReturnBufferBytes(b []byte) ReturnBuffer {
+	if len(b) == 0 {
+		return ReturnBuffer{}
+	}
+	return ReturnBuffer{ptr: unsafe.Pointer(&b[0]), size: uintptr(len(b))}
+}
+
+// Pointer returns the start of the buffer, or nil for the zero ReturnBuffer.
+func (b ReturnBuffer) Pointer() unsafe.Pointer {
+	return b.ptr
+}
+
+// Size returns the size of the buffer in bytes.
+func (b ReturnBuffer) Size() uintptr {
+	return b.size
+}
+
+// CallFunctionInto executes a C function call like CallFunction, writing the
+// result into ret after checking that ret can hold cif.ReturnType.
+//
+// The zero ReturnBuffer discards the result, like a nil rvalue.
+func CallFunctionInto(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	ret ReturnBuffer,
+	avalue []unsafe.Pointer,
+) error {
+	if cif != nil {
+		if err := validateReturnSize(cif, ret.ptr, ret.size); err != nil {
+			return err
+		}
+	}
+	return CallFunction(cif, fn, ret.ptr, avalue)
+}
+
+// WithReturnSize declares that the rvalue passed to CallFunctionWithOptions
+// points to size bytes. The call fails with *InvalidCallInterfaceError,
+// without running native code, if that is too small for cif.ReturnType.
+func WithReturnSize(size uintptr) CallOption {
+	return func(c *callConfig) {
+		c.returnSize = size
+		c.checkReturnSize = true
+	}
+}
+
+// validateReturnSize checks that a return buffer of size bytes at rvalue can
+// hold the return value of cif. A nil rvalue discards the result and always
+// passes.
+func validateReturnSize(cif *types.CallInterface, rvalue unsafe.Pointer, size uintptr) error {
+	if rvalue == nil || cif.ReturnType == nil || cif.ReturnType.Kind == types.VoidType {
+		return nil
+	}
+	need := cif.ReturnType.Size
+	if returnsString(cif) {
+		need = stringSize
+	}
+	if size < need {
+		return &InvalidCallInterfaceError{
+			Field:  "rvalue",
+			Reason: fmt.Sprintf("buffer holds %d bytes, return type needs %d", size, need),
+			Index:  -1,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package ffi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func loadAbs(t *testing.T) unsafe.Pointer {
+	t.Helper()
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	abs, err := GetSymbol(handle, "abs")
+	if err != nil {
+		t.Skipf("abs not available: %v", err)
+	}
+	return abs
+}
+
+func TestReturnBufferValidation(t *testing.T) {
+	abs := loadAbs(t)
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	arg := int32(-7)
+	avalue := []unsafe.Pointer{unsafe.Pointer(&arg)}
+
+	var small int32
+	err := CallFunctionInto(cif, abs, ReturnBufferOf(&small), avalue)
+	var icErr *InvalidCallInterfaceError
+	if !errors.As(err, &icErr) || icErr.Field != "rvalue" {
+		t.Fatalf("4-byte buffer for int64 return: got %v, want rvalue error", err)
+	}
+	err = CallFunctionWithOptions(context.Background(), cif, abs, unsafe.Pointer(&small), avalue,
+		WithReturnSize(unsafe.Sizeof(small)))
+	if !errors.As(err, &icErr) || icErr.Field != "rvalue" {
+		t.Fatalf("WithReturnSize(4) for int64 return: got %v, want rvalue error", err)
+	}
+
+	var result int64
+	if err := CallFunctionInto(cif, abs, ReturnBufferOf(&result), avalue); err != nil {
+		t.Fatal(err)
+	}
+	if int32(result) != 7 {
+		t.Errorf("abs(-7) = %d, want 7", int32(result))
+	}
+	if err := CallFunctionInto(cif, abs, ReturnBuffer{}, avalue); err != nil {
+		t.Errorf("zero ReturnBuffer should discard the result: %v", err)
+	}
+}
+
+// TestSmallStructReturnExactSize checks that a struct return smaller than a
+// register only writes the struct's own bytes.
+func TestSmallStructReturnExactSize(t *testing.T) {
+	abs := loadAbs(t)
+	pairI16 := &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.SInt16TypeDescriptor, types.SInt16TypeDescriptor,
+		},
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, pairI16,
+		[]*types.TypeDescriptor{types.SInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	// abs(-0x00020001) == 0x00020001, i.e. struct {1, 2} in the return register.
+	arg := int32(-0x00020001)
+	buf := [8]byte{0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa}
+	if err := CallFunctionInto(cif, abs, ReturnBufferBytes(buf[:4]),
+		[]unsafe.Pointer{unsafe.Pointer(&arg)}); err != nil {
+		t.Fatal(err)
+	}
+	if got := *(*[2]int16)(unsafe.Pointer(&buf[0])); got != [2]int16{1, 2} {
+		t.Errorf("struct = %v, want [1 2]", got)
+	}
+	for i := 4; i < len(buf); i++ {
+		if buf[i] != 0xaa {
+			t.Fatalf("byte %d after the struct was overwritten: % x (%s)", i, buf, runtime.GOARCH)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/libc"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// errExit ends the helper's request loop.
+var errExit = errors.New("sandbox: exit requested")
+
+// Main runs the helper side of the sandbox and exits if the process was
+// started by Start; otherwise it returns immediately. Call it before the
+// program does anything else.
+func Main() {
+	if os.Getenv(envHelper) != "1" {
+		return
+	}
+	if err := runHelper(); err != nil {
+		fmt.Fprintln(os.Stderr, "goffi: sandbox helper:", err)
+		os.Exit(2)
+	}
+	os.Exit(0)
+}
+
+// helper is the child end of the channel.
+type helper struct {
+	shm  []byte
+	in   *os.File
+	out  *os.File
+	libs map[uintptr]unsafe.Pointer
+}
+
+func runHelper() error {
+	size, err := strconv.Atoi(os.Getenv(envBufferSize))
+	if err != nil {
+		return fmt.Errorf("bad %s: %w", envBufferSize, err)
+	}
+	shm, err := mapSharedMemory(os.NewFile(3, "shm"), size)
+	if err != nil {
+		return err
+	}
+	h := &helper{
+		shm:  shm,
+		in:   os.NewFile(4, "requests"),
+		out:  os.NewFile(5, "replies"),
+		libs: make(map[uintptr]unsafe.Pointer),
+	}
+	_, err = h.serve()
+	if err == errExit {
+		return nil
+	}
+	return err
+}
+
+// serve handles requests until the host exits or, while a callback is in
+// progress, returns from it with the callback's result.
+func (h *helper) serve() (uintptr, error) {
+	for {
+		kind, n, err := readDoorbell(h.in)
+		if err != nil {
+			return 0, err // host is gone
+		}
+		if n > len(h.shm) {
+			return 0, fmt.Errorf("message length %d out of range", n)
+		}
+		d := &decoder{b: append([]byte(nil), h.shm[:n]...)}
+		switch kind {
+		case opExit:
+			return 0, errExit
+		case opCallbackReturn:
+			return uintptr(d.u64()), nil
+		}
+
+		var e encoder
+		op, err := h.handle(kind, d, &e)
+		if err == nil {
+			err = d.err
+		}
+		if err != nil {
+			e = encoder{}
+			e.str(op)
+			e.str(err.Error())
+			err = h.send(msgErr, e.b)
+		} else {
+			err = h.send(msgOK, e.b)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (h *helper) send(kind byte, payload []byte) error {
+	if len(payload) > len(h.shm) {
+		return fmt.Errorf("reply of %d bytes exceeds the %d byte buffer", len(payload), len(h.shm))
+	}
+	copy(h.shm, payload)
+	return writeDoorbell(h.out, kind, len(payload))
+}
+
+// handle executes one request and encodes its reply into e. It returns the
+// operation name for error reporting.
+func (h *helper) handle(kind byte, d *decoder, e *encoder) (string, error) {
+	switch kind {
+	case opLoad:
+		lib, err := ffi.LoadLibrary(d.str())
+		if err != nil {
+			return "load", err
+		}
+		h.libs[uintptr(lib)] = lib
+		e.u64(uint64(uintptr(lib)))
+		return "load", nil
+
+	case opSymbol:
+		lib, ok := h.libs[uintptr(d.u64())]
+		name := d.str()
+		if !ok {
+			return "symbol", fmt.Errorf("unknown library handle")
+		}
+		sym, err := ffi.GetSymbol(lib, name)
+		if err != nil {
+			return "symbol", err
+		}
+		e.u64(uint64(uintptr(sym)))
+		return "symbol", nil
+
+	case opFreeLibrary:
+		handle := uintptr(d.u64())
+		lib, ok := h.libs[handle]
+		if !ok {
+			return "free", fmt.Errorf("unknown library handle")
+		}
+		delete(h.libs, handle)
+		return "free", ffi.FreeLibrary(lib)
+
+	case opCall:
+		return "call", h.call(d, e)
+
+	case opMalloc:
+		p := libc.Malloc(uintptr(d.u64()))
+		if p == nil {
+			return "malloc", fmt.Errorf("out of memory")
+		}
+		e.u64(uint64(uintptr(p)))
+		return "malloc", nil
+
+	case opFree:
+		libc.Free(ffi.Handle(d.u64()).Pointer())
+		return "free", nil
+
+	case opRead:
+		addr, n := d.u64(), d.u32()
+		if d.err == nil {
+			e.bytes(unsafe.Slice((*byte)(ffi.Handle(addr).Pointer()), n))
+		}
+		return "read", nil
+
+	case opWrite:
+		addr, data := d.u64(), d.bytes()
+		if d.err == nil {
+			copy(unsafe.Slice((*byte)(ffi.Handle(addr).Pointer()), len(data)), data)
+		}
+		return "write", nil
+
+	case opNewCallback:
+		id, nargs := d.u32(), int(d.u32())
+		if d.err != nil {
+			return "callback", d.err
+		}
+		addr, err := h.newCallback(id, nargs)
+		if err != nil {
+			return "callback", err
+		}
+		e.u64(uint64(addr))
+		return "callback", nil
+	}
+	return "request", fmt.Errorf("unknown request kind %d", kind)
+}
+
+// call decodes and performs an opCall request.
+func (h *helper) call(d *decoder, e *encoder) error {
+	fn := ffi.Handle(d.u64()).Pointer()
+	conv := types.CallingConvention(d.u8())
+	fixed := int(d.u32())
+	wantReturn := d.u8() != 0
+	rtype := d.typ(0)
+	argTypes := make([]*types.TypeDescriptor, d.u32())
+	if len(argTypes) > len(d.b) {
+		return errShortMessage
+	}
+	avalue := make([]unsafe.Pointer, len(argTypes))
+	for i := range argTypes {
+		argTypes[i] = d.typ(0)
+		v := append(make([]byte, 0, 8), d.bytes()...)
+		if d.err != nil {
+			return d.err
+		}
+		if len(v) == 0 {
+			v = make([]byte, 8)
+		}
+		avalue[i] = unsafe.Pointer(&v[0])
+	}
+	if d.err != nil {
+		return d.err
+	}
+
+	var cif types.CallInterface
+	var err error
+	if fixed > 0 {
+		err = ffi.PrepareVariadicCallInterface(&cif, conv, fixed, rtype, argTypes)
+	} else {
+		err = ffi.PrepareCallInterface(&cif, conv, rtype, argTypes)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rtype.CString || rtype.WString {
+		var s string
+		if err := ffi.CallFunction(&cif, fn, unsafe.Pointer(&s), avalue); err != nil {
+			return err
+		}
+		e.str(s)
+		return nil
+	}
+	var rvalue unsafe.Pointer
+	ret := make([]byte, max(rtype.Size, 8))
+	if wantReturn && rtype.Kind != types.VoidType {
+		rvalue = unsafe.Pointer(&ret[0])
+	}
+	if err := ffi.CallFunction(&cif, fn, rvalue, avalue); err != nil {
+		return err
+	}
+	if rvalue == nil {
+		ret = nil
+	}
+	e.bytes(ret[:min(uintptr(len(ret)), rtype.Size)])
+	return nil
+}
+
+// newCallback creates a native callback with nargs uintptr arguments that
+// forwards to host callback id.
+func (h *helper) newCallback(id uint32, nargs int) (uintptr, error) {
+	if nargs < 0 || nargs > 16 {
+		return 0, fmt.Errorf("unsupported argument count %d", nargs)
+	}
+	uintptrType := reflect.TypeFor[uintptr]()
+	in := make([]reflect.Type, nargs)
+	for i := range in {
+		in[i] = uintptrType
+	}
+	ftype := reflect.FuncOf(in, []reflect.Type{uintptrType}, false)
+	fn := reflect.MakeFunc(ftype, func(args []reflect.Value) []reflect.Value {
+		var e encoder
+		e.u32(id)
+		e.u32(uint32(len(args)))
+		for _, a := range args {
+			e.u64(a.Uint())
+		}
+		var ret uintptr
+		if err := h.send(msgCallback, e.b); err == nil {
+			ret, err = h.serve()
+			if err != nil {
+				// The host went away in the middle of a call.
+				os.Exit(0)
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(ret)}
+	})
+	return ffi.NewCallback(fn.Interface()), nil
+}
@@ -0,0 +1,153 @@
+package sandbox
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Message kinds. Requests flow from the host to the helper and are answered
+// with msgOK or msgErr; while answering opCall the helper may interleave
+// msgCallback requests, which the host answers with opCallbackReturn.
+const (
+	opLoad byte = iota + 1
+	opSymbol
+	opFreeLibrary
+	opCall
+	opMalloc
+	opFree
+	opRead
+	opWrite
+	opNewCallback
+	opCallbackReturn
+	opExit
+
+	msgOK
+	msgErr
+	msgCallback
+)
+
+// maxTypeDepth bounds struct nesting in decoded type descriptors.
+const maxTypeDepth = 32
+
+var errShortMessage = errors.New("sandbox: truncated message")
+
+// encoder appends little-endian fields to a message.
+type encoder struct {
+	b []byte
+}
+
+func (e *encoder) u8(v byte)    { e.b = append(e.b, v) }
+func (e *encoder) u32(v uint32) { e.b = binary.LittleEndian.AppendUint32(e.b, v) }
+func (e *encoder) u64(v uint64) { e.b = binary.LittleEndian.AppendUint64(e.b, v) }
+
+func (e *encoder) bytes(v []byte) {
+	e.u32(uint32(len(v)))
+	e.b = append(e.b, v...)
+}
+
+func (e *encoder) str(v string) {
+	e.u32(uint32(len(v)))
+	e.b = append(e.b, v...)
+}
+
+func (e *encoder) typ(t *types.TypeDescriptor) {
+	e.u8(byte(t.Kind))
+	e.u64(uint64(t.Size))
+	e.u64(uint64(t.Alignment))
+	e.u64(uint64(t.PackedAlignment))
+	var flags byte
+	if t.CString {
+		flags |= 1
+	}
+	if t.WString {
+		flags |= 2
+	}
+	e.u8(flags)
+	e.u32(uint32(t.MaxLen))
+	e.u32(uint32(len(t.Members)))
+	for _, m := range t.Members {
+		e.typ(m)
+	}
+}
+
+// decoder reads the fields written by encoder. The first short read sets err
+// and makes every later read return zero values.
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.b) < n {
+		d.err = errShortMessage
+		return nil
+	}
+	v := d.b[:n]
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *decoder) u8() byte {
+	if v := d.take(1); v != nil {
+		return v[0]
+	}
+	return 0
+}
+
+func (d *decoder) u32() uint32 {
+	if v := d.take(4); v != nil {
+		return binary.LittleEndian.Uint32(v)
+	}
+	return 0
+}
+
+func (d *decoder) u64() uint64 {
+	if v := d.take(8); v != nil {
+		return binary.LittleEndian.Uint64(v)
+	}
+	return 0
+}
+
+// bytes returns a view into the message; copy it to keep it past the next
+// message on the channel.
+func (d *decoder) bytes() []byte {
+	return d.take(int(d.u32()))
+}
+
+func (d *decoder) str() string {
+	return string(d.bytes())
+}
+
+func (d *decoder) typ(depth int) *types.TypeDescriptor {
+	if depth > maxTypeDepth {
+		d.err = fmt.Errorf("sandbox: type nesting exceeds %d levels", maxTypeDepth)
+		return nil
+	}
+	t := &types.TypeDescriptor{
+		Kind:      types.TypeKind(d.u8()),
+		Size:      uintptr(d.u64()),
+		Alignment: uintptr(d.u64()),
+	}
+	t.PackedAlignment = uintptr(d.u64())
+	flags := d.u8()
+	t.CString, t.WString = flags&1 != 0, flags&2 != 0
+	t.MaxLen = int(d.u32())
+	n := d.u32()
+	if n > 0 && d.err == nil {
+		if int(n) > len(d.b) {
+			d.err = errShortMessage
+			return nil
+		}
+		t.Members = make([]*types.TypeDescriptor, n)
+		for i := range t.Members {
+			t.Members[i] = d.typ(depth + 1)
+		}
+	}
+	return t
+}
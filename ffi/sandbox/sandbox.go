@@ -0,0 +1,545 @@
+// Package sandbox runs native code in a helper child process so that a crash
+// in an untrusted library (a segfault, an abort, a stack overflow) kills only
+// the helper, not the Go application.
+//
+// A Process implements ffi.Loader and ffi.Caller, so binding code written
+// against those interfaces runs unchanged against the sandbox. Libraries,
+// symbols, argument pointers and memory all live in the helper's address
+// space: allocate argument buffers with Malloc and move data with WriteMemory
+// and ReadMemory. Arguments and return values are copied by value, as
+// described by the call interface.
+//
+// The helper is the application binary itself (os.Executable by default),
+// which must call Main first thing in main, or in TestMain for tests:
+//
+//	func main() {
+//	    sandbox.Main() // returns immediately unless running as a helper
+//	    ...
+//	}
+//
+//	p, err := sandbox.Start(nil)
+//	if err != nil {
+//	    return err
+//	}
+//	defer p.Close()
+//	lib, err := p.LoadLibrary("libplugin.so")
+//
+// Requests and replies travel through a shared memory buffer; a pipe in each
+// direction carries only a five-byte doorbell per message.
+//
+// The sandbox is available on Linux, macOS and FreeBSD. Elsewhere Start
+// returns *ffi.UnsupportedPlatformError.
+package sandbox
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// DefaultBufferSize is the size of the shared message buffer when
+// Options.BufferSize is zero.
+const DefaultBufferSize = 1 << 20
+
+// minBufferSize leaves room for the headers of every message.
+const minBufferSize = 4096
+
+// Environment variables passed to the helper process.
+const (
+	envHelper     = "GOFFI_SANDBOX_HELPER"
+	envBufferSize = "GOFFI_SANDBOX_BUFFER"
+)
+
+// ErrClosed is returned by operations on a Process after Close.
+var ErrClosed = errors.New("goffi: sandbox process is closed")
+
+// CrashError reports that the helper process died, typically because native
+// code crashed it. Every later operation on the Process returns the same error.
+type CrashError struct {
+	State *os.ProcessState // Exit status of the helper; nil if it could not be collected
+}
+
+func (e *CrashError) Error() string {
+	if e.State == nil {
+		return "goffi: sandbox process died"
+	}
+	return "goffi: sandbox process died: " + e.State.String()
+}
+
+// RemoteError is an error returned by the helper process, such as a failed
+// dlopen or an invalid call interface. Only the message crosses the process
+// boundary.
+type RemoteError struct {
+	Op      string // "load", "symbol", "free", "call", "malloc", "read", "write" or "callback"
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return fmt.Sprintf("goffi: sandbox %s: %s", e.Op, e.Message)
+}
+
+// Options configures Start. The zero value is ready to use.
+type Options struct {
+	Path       string    // Helper binary; defaults to os.Executable()
+	Args       []string  // Extra command-line arguments for the helper
+	Env        []string  // Extra environment variables, appended to os.Environ()
+	BufferSize int       // Shared buffer size in bytes; defaults to DefaultBufferSize
+	Stderr     io.Writer // Helper's stdout and stderr; defaults to os.Stderr
+}
+
+// Callback is the host-side implementation of a sandbox callback. args are
+// the raw integer and pointer arguments; pointers refer to helper memory,
+// which mem reads and writes. The return value is passed back to native code.
+type Callback func(mem Memory, args []uintptr) uintptr
+
+// Memory accesses helper memory from inside a Callback, while the Process is
+// busy with the call that triggered it.
+type Memory interface {
+	ReadMemory(addr uintptr, buf []byte) error
+	WriteMemory(addr uintptr, data []byte) error
+}
+
+// Process is a running helper process. It is safe for concurrent use; calls
+// are serialized.
+type Process struct {
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	shm       []byte
+	unmap     func() error
+	toChild   *os.File
+	fromChild *os.File
+	callbacks map[uint32]Callback
+	err       error // sticky: ErrClosed or *CrashError
+}
+
+var (
+	_ ffi.Loader = (*Process)(nil)
+	_ ffi.Caller = (*Process)(nil)
+)
+
+// Start launches a helper process. A nil opts is the same as &Options{}.
+func Start(opts *Options) (*Process, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	size := opts.BufferSize
+	if size == 0 {
+		size = DefaultBufferSize
+	}
+	if size < minBufferSize {
+		return nil, fmt.Errorf("goffi: sandbox buffer size %d is below the minimum of %d", size, minBufferSize)
+	}
+	path := opts.Path
+	if path == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return nil, err
+		}
+		path = exe
+	}
+
+	shmFile, shm, unmap, err := newSharedMemory(size)
+	if err != nil {
+		return nil, err
+	}
+	defer shmFile.Close()
+	reqR, reqW, err := os.Pipe()
+	if err != nil {
+		unmap()
+		return nil, err
+	}
+	respR, respW, err := os.Pipe()
+	if err != nil {
+		unmap()
+		reqR.Close()
+		reqW.Close()
+		return nil, err
+	}
+
+	stderr := opts.Stderr
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+	cmd := exec.Command(path, opts.Args...)
+	cmd.Env = append(os.Environ(), opts.Env...)
+	cmd.Env = append(cmd.Env, envHelper+"=1", fmt.Sprintf("%s=%d", envBufferSize, size))
+	cmd.Stdout, cmd.Stderr = stderr, stderr
+	cmd.ExtraFiles = []*os.File{shmFile, reqR, respW} // fds 3, 4, 5 in the helper
+	err = cmd.Start()
+	reqR.Close()
+	respW.Close()
+	if err != nil {
+		unmap()
+		reqW.Close()
+		respR.Close()
+		return nil, err
+	}
+	return &Process{
+		cmd:       cmd,
+		shm:       shm,
+		unmap:     unmap,
+		toChild:   reqW,
+		fromChild: respR,
+		callbacks: make(map[uint32]Callback),
+	}, nil
+}
+
+// Pid returns the process ID of the helper.
+func (p *Process) Pid() int {
+	return p.cmd.Process.Pid
+}
+
+// Close stops the helper process and releases the channel. Close after a
+// crash only releases resources and returns nil.
+func (p *Process) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == ErrClosed {
+		return nil
+	}
+	crashed := p.err != nil
+	if !crashed {
+		// The helper exits without replying; any error shows up in Wait.
+		_ = p.send(opExit, nil)
+	}
+	p.err = ErrClosed
+	p.toChild.Close()
+	p.fromChild.Close()
+	var err error
+	if !crashed {
+		err = p.cmd.Wait()
+	}
+	if uerr := p.unmap(); err == nil {
+		err = uerr
+	}
+	return err
+}
+
+// LoadLibrary loads a library in the helper process. The returned handle is
+// only meaningful to this Process.
+func (p *Process) LoadLibrary(name string) (unsafe.Pointer, error) {
+	var e encoder
+	e.str(name)
+	d, err := p.request(opLoad, e.b)
+	if err != nil {
+		return nil, err
+	}
+	return ffi.Handle(d.u64()).Pointer(), d.err
+}
+
+// GetSymbol resolves a symbol in a library loaded by this Process.
+func (p *Process) GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	var e encoder
+	e.u64(uint64(uintptr(handle)))
+	e.str(name)
+	d, err := p.request(opSymbol, e.b)
+	if err != nil {
+		return nil, err
+	}
+	return ffi.Handle(d.u64()).Pointer(), d.err
+}
+
+// FreeLibrary unloads a library loaded by this Process.
+func (p *Process) FreeLibrary(handle unsafe.Pointer) error {
+	var e encoder
+	e.u64(uint64(uintptr(handle)))
+	_, err := p.request(opFreeLibrary, e.b)
+	return err
+}
+
+// CallFunction calls fn in the helper process. avalue[i] points to a host
+// copy of argument i, which is sent by value; pointer arguments must hold
+// helper addresses. The return value is copied back into rvalue, and string
+// return types (types.CStringType, types.WStringType) are decoded in the
+// helper and stored as a Go string.
+//
+// cif must have been prepared with ffi.PrepareCallInterface or
+// ffi.PrepareVariadicCallInterface; the helper prepares its own copy.
+func (p *Process) CallFunction(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	if cif == nil || cif.ReturnType == nil {
+		return &ffi.InvalidCallInterfaceError{Field: "cif", Reason: "must be prepared", Index: -1}
+	}
+	if len(avalue) != len(cif.ArgTypes) {
+		return &ffi.InvalidCallInterfaceError{
+			Field:  "avalue",
+			Reason: fmt.Sprintf("got %d arguments, call interface has %d", len(avalue), len(cif.ArgTypes)),
+			Index:  -1,
+		}
+	}
+
+	var e encoder
+	e.u64(uint64(uintptr(fn)))
+	e.u8(byte(cif.Convention))
+	e.u32(uint32(cif.FixedArgCount))
+	e.u8(boolByte(rvalue != nil))
+	e.typ(cif.ReturnType)
+	e.u32(uint32(len(cif.ArgTypes)))
+	for i, t := range cif.ArgTypes {
+		if avalue[i] == nil {
+			return &ffi.InvalidCallInterfaceError{Field: "avalue", Reason: "argument pointer is nil", Index: i}
+		}
+		e.typ(t)
+		e.bytes(unsafe.Slice((*byte)(avalue[i]), t.Size))
+	}
+
+	d, err := p.request(opCall, e.b)
+	if err != nil {
+		return err
+	}
+	ret := d.bytes()
+	if d.err != nil || rvalue == nil {
+		return d.err
+	}
+	if cif.ReturnType.CString || cif.ReturnType.WString {
+		*(*string)(rvalue) = string(ret)
+	} else {
+		copy(unsafe.Slice((*byte)(rvalue), len(ret)), ret)
+	}
+	return nil
+}
+
+// Malloc allocates size bytes of memory in the helper process with the C
+// runtime's malloc.
+func (p *Process) Malloc(size uintptr) (uintptr, error) {
+	var e encoder
+	e.u64(uint64(size))
+	d, err := p.request(opMalloc, e.b)
+	if err != nil {
+		return 0, err
+	}
+	return uintptr(d.u64()), d.err
+}
+
+// Free releases memory obtained from Malloc.
+func (p *Process) Free(addr uintptr) error {
+	var e encoder
+	e.u64(uint64(addr))
+	_, err := p.request(opFree, e.b)
+	return err
+}
+
+// ReadMemory copies len(buf) bytes at addr in the helper process into buf.
+// An invalid address crashes the helper, not the caller.
+func (p *Process) ReadMemory(addr uintptr, buf []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.readMemory(addr, buf)
+}
+
+// WriteMemory copies data to addr in the helper process.
+func (p *Process) WriteMemory(addr uintptr, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.writeMemory(addr, data)
+}
+
+// NewCallback creates a C function pointer in the helper process that takes
+// nargs integer or pointer arguments, returns an integer or pointer, and runs
+// fn in the host. fn runs on the goroutine that made the call into native
+// code; it may use mem, but must not call other methods of p.
+//
+// Like ffi.NewCallback, callbacks are never released.
+func (p *Process) NewCallback(nargs int, fn Callback) (uintptr, error) {
+	if fn == nil {
+		return 0, errors.New("goffi: sandbox callback must not be nil")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	id := uint32(len(p.callbacks))
+	var e encoder
+	e.u32(id)
+	e.u32(uint32(nargs))
+	d, err := p.exchange(opNewCallback, e.b)
+	if err != nil {
+		return 0, err
+	}
+	addr := uintptr(d.u64())
+	if d.err != nil {
+		return 0, d.err
+	}
+	p.callbacks[id] = fn
+	return addr, nil
+}
+
+// nestedMemory is the Memory handed to callbacks. It runs on the goroutine
+// that already holds p.mu.
+type nestedMemory struct {
+	p *Process
+}
+
+func (m nestedMemory) ReadMemory(addr uintptr, buf []byte) error { return m.p.readMemory(addr, buf) }
+
+func (m nestedMemory) WriteMemory(addr uintptr, data []byte) error {
+	return m.p.writeMemory(addr, data)
+}
+
+// chunkSize is the largest memory transfer that fits one message.
+func (p *Process) chunkSize() int {
+	return len(p.shm) - 64
+}
+
+func (p *Process) readMemory(addr uintptr, buf []byte) error {
+	for len(buf) > 0 {
+		n := min(len(buf), p.chunkSize())
+		var e encoder
+		e.u64(uint64(addr))
+		e.u32(uint32(n))
+		d, err := p.exchange(opRead, e.b)
+		if err != nil {
+			return err
+		}
+		copy(buf, d.bytes())
+		if d.err != nil {
+			return d.err
+		}
+		buf, addr = buf[n:], addr+uintptr(n)
+	}
+	return nil
+}
+
+func (p *Process) writeMemory(addr uintptr, data []byte) error {
+	for len(data) > 0 {
+		n := min(len(data), p.chunkSize())
+		var e encoder
+		e.u64(uint64(addr))
+		e.bytes(data[:n])
+		if _, err := p.exchange(opWrite, e.b); err != nil {
+			return err
+		}
+		data, addr = data[n:], addr+uintptr(n)
+	}
+	return nil
+}
+
+// request is exchange under the lock.
+func (p *Process) request(op byte, payload []byte) (*decoder, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.exchange(op, payload)
+}
+
+// exchange sends one request and waits for its reply, serving any callbacks
+// the helper makes in between. The returned decoder owns a copy of the reply.
+func (p *Process) exchange(op byte, payload []byte) (*decoder, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if err := p.send(op, payload); err != nil {
+		return nil, err
+	}
+	for {
+		kind, msg, err := p.receive()
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case msgOK:
+			return &decoder{b: msg}, nil
+		case msgErr:
+			d := &decoder{b: msg}
+			return nil, &RemoteError{Op: d.str(), Message: d.str()}
+		case msgCallback:
+			ret, err := p.runCallback(msg)
+			if err != nil {
+				return nil, err
+			}
+			var e encoder
+			e.u64(uint64(ret))
+			if err := p.send(opCallbackReturn, e.b); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, p.fail(fmt.Errorf("goffi: sandbox: unexpected message kind %d", kind))
+		}
+	}
+}
+
+func (p *Process) runCallback(msg []byte) (uintptr, error) {
+	d := &decoder{b: msg}
+	id := d.u32()
+	args := make([]uintptr, d.u32())
+	for i := range args {
+		args[i] = uintptr(d.u64())
+	}
+	fn, ok := p.callbacks[id]
+	if d.err != nil || !ok {
+		return 0, p.fail(fmt.Errorf("goffi: sandbox: malformed callback request"))
+	}
+	return fn(nestedMemory{p}, args), nil
+}
+
+// send copies payload into the shared buffer and rings the helper's doorbell.
+func (p *Process) send(kind byte, payload []byte) error {
+	if len(payload) > len(p.shm) {
+		return fmt.Errorf("goffi: sandbox message of %d bytes exceeds the %d byte buffer", len(payload), len(p.shm))
+	}
+	copy(p.shm, payload)
+	if err := writeDoorbell(p.toChild, kind, len(payload)); err != nil {
+		return p.died()
+	}
+	return nil
+}
+
+// receive waits for the helper's doorbell and copies its message out of the
+// shared buffer.
+func (p *Process) receive() (byte, []byte, error) {
+	kind, n, err := readDoorbell(p.fromChild)
+	if err != nil {
+		return 0, nil, p.died()
+	}
+	if n > len(p.shm) {
+		return 0, nil, p.fail(fmt.Errorf("goffi: sandbox: message length %d out of range", n))
+	}
+	return kind, append([]byte(nil), p.shm[:n]...), nil
+}
+
+// died records the helper's exit as a CrashError after the channel broke.
+func (p *Process) died() error {
+	p.toChild.Close()
+	p.fromChild.Close()
+	crash := &CrashError{}
+	if err := p.cmd.Wait(); err == nil || errors.As(err, new(*exec.ExitError)) {
+		crash.State = p.cmd.ProcessState
+	}
+	p.err = crash
+	return crash
+}
+
+// fail kills a helper that broke the protocol.
+func (p *Process) fail(err error) error {
+	_ = p.cmd.Process.Kill()
+	p.died()
+	return err
+}
+
+// writeDoorbell announces a message of n bytes in the shared buffer.
+func writeDoorbell(w io.Writer, kind byte, n int) error {
+	var b [5]byte
+	b[0] = kind
+	binary.LittleEndian.PutUint32(b[1:], uint32(n))
+	_, err := w.Write(b[:])
+	return err
+}
+
+func readDoorbell(r io.Reader) (kind byte, n int, err error) {
+	var b [5]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, 0, err
+	}
+	return b[0], int(binary.LittleEndian.Uint32(b[1:])), nil
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
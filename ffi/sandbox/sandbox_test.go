@@ -0,0 +1,207 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package sandbox_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/sandbox"
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestMain(m *testing.M) {
+	sandbox.Main()
+	os.Exit(m.Run())
+}
+
+func libcName() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "/usr/lib/libSystem.B.dylib"
+	case "freebsd":
+		return "libc.so.7"
+	default:
+		return "libc.so.6"
+	}
+}
+
+func startWithLibc(t *testing.T, opts *sandbox.Options) (*sandbox.Process, unsafe.Pointer) {
+	t.Helper()
+	p, err := sandbox.Start(opts)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() { p.Close() })
+	lib, err := p.LoadLibrary(libcName())
+	if err != nil {
+		t.Fatalf("LoadLibrary: %v", err)
+	}
+	return p, lib
+}
+
+func TestSandboxCall(t *testing.T) {
+	p, lib := startWithLibc(t, nil)
+	strlen, err := p.GetSymbol(lib, "strlen")
+	if err != nil {
+		t.Fatalf("GetSymbol: %v", err)
+	}
+
+	s := []byte("sandboxed\x00")
+	buf, err := p.Malloc(uintptr(len(s)))
+	if err != nil {
+		t.Fatalf("Malloc: %v", err)
+	}
+	defer p.Free(buf)
+	if err := p.WriteMemory(buf, s); err != nil {
+		t.Fatalf("WriteMemory: %v", err)
+	}
+
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	var n uint64
+	if err := p.CallFunction(&cif, strlen, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&buf)}); err != nil {
+		t.Fatalf("CallFunction: %v", err)
+	}
+	if n != uint64(len(s)-1) {
+		t.Errorf("strlen = %d, want %d", n, len(s)-1)
+	}
+
+	// String returns are decoded in the helper.
+	strchr, err := p.GetSymbol(lib, "strchr")
+	if err != nil {
+		t.Fatalf("GetSymbol: %v", err)
+	}
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.CStringTypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	var tail string
+	c := int32('b')
+	if err := p.CallFunction(&cif, strchr, unsafe.Pointer(&tail), []unsafe.Pointer{unsafe.Pointer(&buf), unsafe.Pointer(&c)}); err != nil {
+		t.Fatalf("CallFunction: %v", err)
+	}
+	if tail != "boxed" {
+		t.Errorf("strchr = %q, want %q", tail, "boxed")
+	}
+}
+
+func TestSandboxCallback(t *testing.T) {
+	p, lib := startWithLibc(t, nil)
+	qsort, err := p.GetSymbol(lib, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol: %v", err)
+	}
+
+	calls := 0
+	cmp, err := p.NewCallback(2, func(mem sandbox.Memory, args []uintptr) uintptr {
+		calls++
+		var a, b [4]byte
+		if err := mem.ReadMemory(args[0], a[:]); err != nil {
+			t.Errorf("ReadMemory: %v", err)
+		}
+		if err := mem.ReadMemory(args[1], b[:]); err != nil {
+			t.Errorf("ReadMemory: %v", err)
+		}
+		d := int32(binary.LittleEndian.Uint32(a[:])) - int32(binary.LittleEndian.Uint32(b[:]))
+		return uintptr(int64(d))
+	})
+	if err != nil {
+		t.Fatalf("NewCallback: %v", err)
+	}
+
+	values := []int32{5, 3, 9, 1, 7}
+	data := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(data[4*i:], uint32(v))
+	}
+	base, err := p.Malloc(uintptr(len(data)))
+	if err != nil {
+		t.Fatalf("Malloc: %v", err)
+	}
+	defer p.Free(base)
+	if err := p.WriteMemory(base, data); err != nil {
+		t.Fatalf("WriteMemory: %v", err)
+	}
+
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor, types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	nmemb, size := uint64(len(values)), uint64(4)
+	if err := p.CallFunction(&cif, qsort, nil, []unsafe.Pointer{
+		unsafe.Pointer(&base), unsafe.Pointer(&nmemb), unsafe.Pointer(&size), unsafe.Pointer(&cmp),
+	}); err != nil {
+		t.Fatalf("CallFunction: %v", err)
+	}
+	if calls == 0 {
+		t.Fatal("comparison callback was never called")
+	}
+	if err := p.ReadMemory(base, data); err != nil {
+		t.Fatalf("ReadMemory: %v", err)
+	}
+	for i := 1; i < len(values); i++ {
+		if int32(binary.LittleEndian.Uint32(data[4*i-4:])) > int32(binary.LittleEndian.Uint32(data[4*i:])) {
+			t.Errorf("not sorted: % x", data)
+			break
+		}
+	}
+}
+
+func TestSandboxCrash(t *testing.T) {
+	// Keep the helper's crash report out of the test output.
+	p, lib := startWithLibc(t, &sandbox.Options{Stderr: io.Discard})
+	abort, err := p.GetSymbol(lib, "abort")
+	if err != nil {
+		t.Fatalf("GetSymbol: %v", err)
+	}
+	var cif types.CallInterface
+	if err := ffi.PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.CallFunction(&cif, abort, nil, nil)
+	var crash *sandbox.CrashError
+	if !errors.As(err, &crash) {
+		t.Fatalf("CallFunction(abort) = %v, want *CrashError", err)
+	}
+	if crash.State == nil || crash.State.Success() {
+		t.Errorf("crash state = %v, want a failed exit", crash.State)
+	}
+	if _, err := p.LoadLibrary(libcName()); err != crash {
+		t.Errorf("LoadLibrary after crash = %v, want the crash error", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Errorf("Close after crash = %v", err)
+	}
+}
+
+func TestSandboxRemoteError(t *testing.T) {
+	p, lib := startWithLibc(t, nil)
+	_, err := p.GetSymbol(lib, "goffi_no_such_symbol")
+	var remote *sandbox.RemoteError
+	if !errors.As(err, &remote) || remote.Op != "symbol" {
+		t.Fatalf("GetSymbol = %v, want a symbol RemoteError", err)
+	}
+	// The helper survives request errors.
+	if _, err := p.GetSymbol(lib, "strlen"); err != nil {
+		t.Errorf("GetSymbol after error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if _, err := p.GetSymbol(lib, "strlen"); !errors.Is(err, sandbox.ErrClosed) {
+		t.Errorf("GetSymbol after Close = %v, want ErrClosed", err)
+	}
+}
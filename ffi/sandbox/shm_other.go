@@ -0,0 +1,18 @@
+//go:build !(linux || darwin || freebsd)
+
+package sandbox
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/go-webgpu/goffi/ffi"
+)
+
+func newSharedMemory(int) (*os.File, []byte, func() error, error) {
+	return nil, nil, nil, &ffi.UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+func mapSharedMemory(*os.File, int) ([]byte, error) {
+	return nil, &ffi.UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
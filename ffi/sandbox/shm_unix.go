@@ -0,0 +1,33 @@
+//go:build linux || darwin || freebsd
+
+package sandbox
+
+import (
+	"os"
+	"syscall"
+)
+
+// newSharedMemory creates an anonymous file of size bytes and maps it shared.
+// The file is unlinked immediately; the helper maps it through the inherited
+// descriptor.
+func newSharedMemory(size int) (*os.File, []byte, func() error, error) {
+	f, err := os.CreateTemp("", "goffi-sandbox-*")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	os.Remove(f.Name())
+	if err := f.Truncate(int64(size)); err != nil {
+		f.Close()
+		return nil, nil, nil, err
+	}
+	b, err := mapSharedMemory(f, size)
+	if err != nil {
+		f.Close()
+		return nil, nil, nil, err
+	}
+	return f, b, func() error { return syscall.Munmap(b) }, nil
+}
+
+func mapSharedMemory(f *os.File, size int) ([]byte, error) {
+	return syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+}
@@ -0,0 +1,99 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestWithScopedCallback(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	qsort, err := GetSymbol(handle, "qsort")
+	if err != nil {
+		t.Fatalf("GetSymbol(qsort): %v", err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.UInt64TypeDescriptor,
+		types.UInt64TypeDescriptor, types.PointerTypeDescriptor,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	callbacks.mu.Lock()
+	before := callbacks.count
+	callbacks.mu.Unlock()
+	live := CallbackCount()
+
+	// More calls than the registry has slots: each one must give its slot back.
+	for i := range maxCallbacks + 100 {
+		xs := []int32{int32(i), 3, -1, 7, 0}
+		base := unsafe.Pointer(&xs[0])
+		n, size := uint64(len(xs)), uint64(4)
+		var cmp uintptr
+		err := CallFunctionWithOptions(context.Background(), cif, qsort, nil,
+			[]unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&n), unsafe.Pointer(&size), unsafe.Pointer(&cmp)},
+			WithScopedCallback(func(a, b *int32) int32 {
+				switch {
+				case *a < *b:
+					return -1
+				case *a > *b:
+					return 1
+				}
+				return 0
+			}, &cmp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmp == 0 {
+			t.Fatal("callback pointer was not stored in dst")
+		}
+		if !slices.IsSorted(xs) {
+			t.Fatalf("call %d: %v is not sorted", i, xs)
+		}
+	}
+
+	callbacks.mu.Lock()
+	after := callbacks.count
+	callbacks.mu.Unlock()
+	if after-before > 1 {
+		t.Errorf("scoped callbacks used %d registry slots, want at most 1", after-before)
+	}
+	if got := CallbackCount(); got != live {
+		t.Errorf("CallbackCount = %d after scoped calls, want %d", got, live)
+	}
+}
+
+func TestWithScopedCallbackNilDst(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	p := unsafe.Pointer(&[]byte("x\x00")[0])
+	var n uint64
+	err = CallFunctionWithOptions(context.Background(), cif, strlen, unsafe.Pointer(&n),
+		[]unsafe.Pointer{unsafe.Pointer(&p)}, WithScopedCallback(func() {}, nil))
+	if err == nil {
+		t.Error("expected error for nil destination")
+	}
+}
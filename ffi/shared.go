@@ -0,0 +1,177 @@
+package ffi
+
+import (
+	"fmt"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// SharedMemory is a region of memory shared with native code: mapped by
+// MapSharedMemory or MapSharedFile, or provided by a C library and wrapped
+// with SharedMemoryAt. Views overlay type descriptors on it, so Go reads and
+// writes the structures native producer threads fill in place, without
+// copies and without cgo:
+//
+//	// struct ring { _Atomic uint32_t head, tail; float samples[1024]; };
+//	ring := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+//	    types.UInt32TypeDescriptor, types.UInt32TypeDescriptor, samplesDesc}}
+//	mem, err := ffi.MapSharedMemory(4096)
+//	view, err := mem.View(0, ring)
+//	head, err := view.Uint32(0)
+//	// pass mem.Addr() to the producer, then: n := head.Load()
+//
+// Memory mapped by goffi is released by Close; wrapped memory belongs to
+// whoever allocated it.
+type SharedMemory struct {
+	addr    unsafe.Pointer
+	size    uintptr
+	release func() error
+}
+
+// SharedMemoryAt wraps size bytes of native memory at addr, for example a
+// buffer allocated by a C library. Close does not free it.
+func SharedMemoryAt(addr unsafe.Pointer, size uintptr) *SharedMemory {
+	return &SharedMemory{addr: addr, size: size}
+}
+
+// Addr returns the start of the region, to hand to native code.
+func (m *SharedMemory) Addr() unsafe.Pointer {
+	return m.addr
+}
+
+// Size returns the size of the region in bytes.
+func (m *SharedMemory) Size() uintptr {
+	return m.size
+}
+
+// Bytes returns the region as a byte slice. It must not be used after Close.
+func (m *SharedMemory) Bytes() []byte {
+	return unsafe.Slice((*byte)(m.addr), m.size)
+}
+
+// Close unmaps a region mapped by goffi. For wrapped memory it does nothing.
+// Views of the region must not be used afterwards.
+func (m *SharedMemory) Close() error {
+	if m.release == nil {
+		return nil
+	}
+	err := m.release()
+	m.release = nil
+	return err
+}
+
+// View overlays t on the region at offset. Struct descriptors are laid out
+// as for PrepareCallInterface. The value must lie entirely in the region;
+// consecutive elements of an array of t are t.Size bytes apart.
+func (m *SharedMemory) View(offset uintptr, t *types.TypeDescriptor) (*SharedView, error) {
+	if t == nil {
+		return nil, &TypeValidationError{TypeName: "view", Kind: 0, Reason: "type descriptor is nil", Index: -1}
+	}
+	if t.Size == 0 && t.Kind == types.StructType {
+		if err := initializeCompositeType(t); err != nil {
+			return nil, err
+		}
+	}
+	if !isValidType(t) || t.Kind == types.VoidType {
+		return nil, newInvalidTypeError("view", int(t.Kind), "unsupported type kind")
+	}
+	if offset > m.size || t.Size > m.size-offset {
+		return nil, &TypeValidationError{
+			TypeName: "view",
+			Kind:     int(t.Kind),
+			Reason:   fmt.Sprintf("%d bytes at offset %d exceed the %d-byte region", t.Size, offset, m.size),
+			Index:    -1,
+		}
+	}
+	return &SharedView{addr: unsafe.Add(m.addr, offset), typ: t}, nil
+}
+
+// SharedView is a typed window on shared memory, created with
+// SharedMemory.View. Load and Store are plain copies; use the atomic
+// accessors for fields that native threads update concurrently.
+type SharedView struct {
+	addr unsafe.Pointer
+	typ  *types.TypeDescriptor
+}
+
+// Addr returns the address of the viewed value.
+func (v *SharedView) Addr() unsafe.Pointer {
+	return v.addr
+}
+
+// Type returns the descriptor of the view.
+func (v *SharedView) Type() *types.TypeDescriptor {
+	return v.typ
+}
+
+// Load copies the value into dst, which must hold Type().Size bytes.
+func (v *SharedView) Load(dst unsafe.Pointer) {
+	copy(unsafe.Slice((*byte)(dst), v.typ.Size), unsafe.Slice((*byte)(v.addr), v.typ.Size))
+}
+
+// Store copies Type().Size bytes from src into the value.
+func (v *SharedView) Store(src unsafe.Pointer) {
+	copy(unsafe.Slice((*byte)(v.addr), v.typ.Size), unsafe.Slice((*byte)(src), v.typ.Size))
+}
+
+// Member returns the address of member i of a struct view.
+func (v *SharedView) Member(i int) (unsafe.Pointer, error) {
+	offset, err := memberOffset("view", v.typ, i)
+	if err != nil {
+		return nil, err
+	}
+	return unsafe.Add(v.addr, offset), nil
+}
+
+// Uint32 returns member i, a 32-bit integer, for atomic access: the
+// counterpart of a C _Atomic uint32_t or of a field the producer accesses
+// with __atomic builtins.
+func (v *SharedView) Uint32(i int) (*atomic.Uint32, error) {
+	p, err := v.atomicMember(i, 4)
+	return (*atomic.Uint32)(p), err
+}
+
+// Uint64 returns member i, a 64-bit integer, for atomic access like Uint32.
+// The member must be 8-byte aligned in memory, which 32-bit ABIs do not
+// always guarantee.
+func (v *SharedView) Uint64(i int) (*atomic.Uint64, error) {
+	p, err := v.atomicMember(i, 8)
+	return (*atomic.Uint64)(p), err
+}
+
+func (v *SharedView) atomicMember(i int, size uintptr) (unsafe.Pointer, error) {
+	p, err := v.Member(i)
+	if err != nil {
+		return nil, err
+	}
+	m := v.typ.Members[i]
+	if !isIntegerKind(m.Kind) || m.Size != size {
+		return nil, &TypeValidationError{
+			TypeName: "view",
+			Kind:     int(m.Kind),
+			Reason:   fmt.Sprintf("member is not a %d-byte integer", size),
+			Index:    i,
+		}
+	}
+	if uintptr(p)%size != 0 {
+		return nil, &TypeValidationError{
+			TypeName: "view",
+			Kind:     int(m.Kind),
+			Reason:   fmt.Sprintf("member is not %d-byte aligned", size),
+			Index:    i,
+		}
+	}
+	return p, nil
+}
+
+// isIntegerKind reports whether k is a fixed-size integer kind.
+func isIntegerKind(k types.TypeKind) bool {
+	switch k {
+	case types.UInt8Type, types.SInt8Type, types.UInt16Type, types.SInt16Type,
+		types.UInt32Type, types.SInt32Type, types.UInt64Type, types.SInt64Type, types.IntType:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+//go:build !(linux || darwin || freebsd || windows)
+
+package ffi
+
+import (
+	"os"
+	"runtime"
+)
+
+// MapSharedMemory is not available on this platform; it returns
+// *UnsupportedPlatformError.
+func MapSharedMemory(size uintptr) (*SharedMemory, error) {
+	return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// MapSharedFile is not available on this platform; it returns
+// *UnsupportedPlatformError.
+func MapSharedFile(f *os.File, offset int64, size uintptr) (*SharedMemory, error) {
+	return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
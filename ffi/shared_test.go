@@ -0,0 +1,108 @@
+//go:build linux || darwin || freebsd || windows
+
+package ffi
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestSharedMemoryView(t *testing.T) {
+	mem, err := MapSharedMemory(4096)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mem.Close()
+
+	// struct { uint32_t head; uint8_t flag; uint64_t total; };
+	hdr := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt32TypeDescriptor, types.UInt8TypeDescriptor, types.UInt64TypeDescriptor},
+	}
+	view, err := mem.View(64, hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := view.Uint32(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total, err := view.Uint64(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head.Add(3)
+	total.Store(1 << 40)
+
+	b := mem.Bytes()
+	if got := binary.LittleEndian.Uint32(b[64:]); got != 3 {
+		t.Errorf("head in memory = %d, want 3", got)
+	}
+	if got := binary.LittleEndian.Uint64(b[72:]); got != 1<<40 {
+		t.Errorf("total in memory at offset 8 = %d, want 1<<40", got)
+	}
+
+	// Writes through the region are visible through the view.
+	b[68] = 0x5a
+	flag, err := view.Member(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *(*uint8)(flag) != 0x5a {
+		t.Errorf("flag = %#x, want 0x5a", *(*uint8)(flag))
+	}
+
+	if _, err := view.Uint32(1); err == nil {
+		t.Error("Uint32 accepted a 1-byte member")
+	}
+	if _, err := mem.View(4090, hdr); err == nil {
+		t.Error("View accepted a value past the end of the region")
+	}
+}
+
+func TestSharedMemoryAt(t *testing.T) {
+	buf := make([]uint64, 2)
+	mem := SharedMemoryAt(unsafe.Pointer(&buf[0]), 16)
+	view, err := mem.View(8, types.UInt64TypeDescriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := uint64(42)
+	view.Store(unsafe.Pointer(&v))
+	if buf[1] != 42 {
+		t.Errorf("buf = %v, want 42 stored in the second word", buf)
+	}
+	if err := mem.Close(); err != nil {
+		t.Errorf("Close of wrapped memory: %v", err)
+	}
+}
+
+func TestMapSharedFile(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := f.Truncate(4096); err != nil {
+		t.Fatal(err)
+	}
+	a, err := MapSharedFile(f, 0, 4096)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	b, err := MapSharedFile(f, 0, 4096)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	copy(a.Bytes(), "shared")
+	if got := string(b.Bytes()[:6]); got != "shared" {
+		t.Errorf("second mapping reads %q, want \"shared\"", got)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build linux || darwin || freebsd
+
+package ffi
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// MapSharedMemory maps size bytes of zeroed, anonymous shared memory. The
+// mapping is visible to every thread of the process and survives into
+// children created with fork.
+func MapSharedMemory(size uintptr) (*SharedMemory, error) {
+	b, err := syscall.Mmap(-1, 0, int(size), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED|syscall.MAP_ANON)
+	if err != nil {
+		return nil, &LibraryError{Operation: "mmap", Name: "<anonymous>", Err: err}
+	}
+	return sharedMapping(b), nil
+}
+
+// MapSharedFile maps size bytes of f, starting at offset, shared and
+// writable, for memory shared with another process through a file, shm_open
+// or memfd_create descriptor. offset must be a multiple of the page size.
+func MapSharedFile(f *os.File, offset int64, size uintptr) (*SharedMemory, error) {
+	b, err := syscall.Mmap(int(f.Fd()), offset, int(size), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, &LibraryError{Operation: "mmap", Name: f.Name(), Err: err}
+	}
+	return sharedMapping(b), nil
+}
+
+func sharedMapping(b []byte) *SharedMemory {
+	return &SharedMemory{
+		addr:    unsafe.Pointer(unsafe.SliceData(b)),
+		size:    uintptr(len(b)),
+		release: func() error { return syscall.Munmap(b) },
+	}
+}
@@ -0,0 +1,42 @@
+//go:build windows
+
+package ffi
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// MapSharedMemory maps size bytes of zeroed shared memory backed by the
+// paging file. The mapping is visible to every thread of the process.
+func MapSharedMemory(size uintptr) (*SharedMemory, error) {
+	return mapView(syscall.InvalidHandle, "<anonymous>", 0, size)
+}
+
+// MapSharedFile maps size bytes of f, starting at offset, shared and
+// writable, for memory shared with another process through a file. offset
+// must be a multiple of the allocation granularity (64 KiB).
+func MapSharedFile(f *os.File, offset int64, size uintptr) (*SharedMemory, error) {
+	return mapView(syscall.Handle(f.Fd()), f.Name(), offset, size)
+}
+
+func mapView(file syscall.Handle, name string, offset int64, size uintptr) (*SharedMemory, error) {
+	end := uint64(offset) + uint64(size)
+	mapping, err := syscall.CreateFileMapping(file, nil, syscall.PAGE_READWRITE, uint32(end>>32), uint32(end), nil)
+	if err != nil {
+		return nil, &LibraryError{Operation: "mmap", Name: name, Err: err}
+	}
+	addr, err := syscall.MapViewOfFile(mapping, syscall.FILE_MAP_READ|syscall.FILE_MAP_WRITE,
+		uint32(uint64(offset)>>32), uint32(offset), size)
+	// The view keeps the mapping object alive.
+	syscall.CloseHandle(mapping)
+	if err != nil {
+		return nil, &LibraryError{Operation: "mmap", Name: name, Err: err}
+	}
+	return &SharedMemory{
+		addr:    *(*unsafe.Pointer)(unsafe.Pointer(&addr)),
+		size:    size,
+		release: func() error { return syscall.UnmapViewOfFile(addr) },
+	}, nil
+}
@@ -0,0 +1,122 @@
+package ffi
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"unsafe"
+)
+
+// shutdownHooks holds the hooks registered with OnShutdown, in registration
+// order. Cancelled hooks are set to nil.
+var shutdownHooks struct {
+	mu    sync.Mutex
+	hooks []*func() error
+}
+
+// OnShutdown registers fn to run when Shutdown is called, before callbacks
+// are released and libraries unloaded, so it may still call into native
+// code: destroy devices, flush queues, release objects holding LibraryRefs.
+// Hooks run in reverse registration order, like deferred calls. The
+// returned cancel removes the hook if it has not run yet.
+func OnShutdown(fn func() error) (cancel func()) {
+	if fn == nil {
+		panic("ffi: shutdown hook must not be nil")
+	}
+	hook := &fn
+	shutdownHooks.mu.Lock()
+	shutdownHooks.hooks = append(shutdownHooks.hooks, hook)
+	shutdownHooks.mu.Unlock()
+	return func() {
+		shutdownHooks.mu.Lock()
+		defer shutdownHooks.mu.Unlock()
+		if i := slices.Index(shutdownHooks.hooks, hook); i >= 0 {
+			shutdownHooks.hooks[i] = nil
+		}
+	}
+}
+
+// Shutdown tears down everything goffi set up, for programs that must
+// unload native drivers cleanly before exiting (some GPU drivers on Windows
+// misbehave if the process exits with them loaded). In order, it:
+//
+//  1. runs the OnShutdown hooks, most recently registered first;
+//  2. releases every callback registered with NewCallback, so native code
+//     can no longer call into Go (Windows on arm64 cannot reclaim its
+//     callbacks);
+//  3. frees every library loaded with LoadLibrary, most recently loaded
+//     first, calling FreeLibrary as often as it was loaded.
+//
+// Go has no atexit, so call it from main, typically deferred, and not after
+// os.Exit or log.Fatal:
+//
+//	func main() {
+//	    defer ffi.Shutdown()
+//	    ...
+//	}
+//
+// Every step runs even if an earlier one fails; the errors of all hooks and
+// FreeLibrary calls are returned joined. A panicking hook is reported as an
+// error. A library still referenced by a LibraryRef, or still running a
+// call, fails with ErrLibraryInUse and stays loaded. Handles, symbols and callback pointers
+// must not be used afterwards, including passing handles to FreeLibrary.
+// Later registrations and loads start afresh, so Shutdown may be called
+// again.
+func Shutdown() error {
+	shutdownHooks.mu.Lock()
+	hooks := shutdownHooks.hooks
+	shutdownHooks.hooks = nil
+	shutdownHooks.mu.Unlock()
+
+	var errs []error
+	for _, hook := range slices.Backward(hooks) {
+		if hook != nil {
+			if err := runShutdownHook(*hook); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	for _, ptr := range registeredCallbacks() {
+		releaseScopedCallback(ptr)
+	}
+	for _, handle := range loadedLibraries() {
+		for {
+			u := libraryState(handle)
+			if u == nil {
+				break
+			}
+			if err := FreeLibrary(handle); err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runShutdownHook runs fn, reporting a panic as an error.
+func runShutdownHook(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("ffi: shutdown hook panicked: %v", r)
+		}
+	}()
+	return fn()
+}
+
+// loadedLibraries returns the handles of the libraries loaded with
+// LoadLibrary and not yet freed, most recently loaded first.
+func loadedLibraries() []unsafe.Pointer {
+	libraries.mu.Lock()
+	defer libraries.mu.Unlock()
+	handles := make([]unsafe.Pointer, 0, len(libraries.usage))
+	for handle := range libraries.usage {
+		handles = append(handles, handle)
+	}
+	slices.SortFunc(handles, func(a, b unsafe.Pointer) int {
+		return cmp.Compare(libraries.usage[b].seq, libraries.usage[a].seq)
+	})
+	return handles
+}
@@ -0,0 +1,75 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+	"unsafe"
+)
+
+func TestShutdown(t *testing.T) {
+	lib, _ := testLibAndSymbol()
+
+	// Track only the libraries loaded here, so that Shutdown leaves those
+	// of other tests alone.
+	libraries.mu.Lock()
+	saved := libraries.usage
+	libraries.usage = nil
+	libraries.mu.Unlock()
+	t.Cleanup(func() {
+		libraries.mu.Lock()
+		libraries.usage = saved
+		libraries.mu.Unlock()
+	})
+
+	first, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	if _, err := LoadLibrary(lib); err != nil {
+		t.Fatal(err)
+	}
+	other := map[string]string{"linux": "libm.so.6", "freebsd": "libm.so.5", "darwin": "/usr/lib/libobjc.A.dylib"}[runtime.GOOS]
+	second, err := LoadLibrary(other)
+	if err != nil {
+		t.Skipf("cannot load a second library: %v", err)
+	}
+	if got := loadedLibraries(); !slices.Equal(got, []unsafe.Pointer{second, first}) {
+		t.Errorf("loadedLibraries() = %v, want %v (reverse load order)", got, []unsafe.Pointer{second, first})
+	}
+
+	ptr := NewCallback(func(a int32) int32 { return a })
+	var order []int
+	errHook := errors.New("hook failed")
+	OnShutdown(func() error {
+		order = append(order, 1)
+		if libraryState(first) == nil {
+			t.Error("library unloaded before the hooks ran")
+		}
+		return nil
+	})
+	cancel := OnShutdown(func() error { order = append(order, 2); return nil })
+	OnShutdown(func() error { order = append(order, 3); return errHook })
+	OnShutdown(func() error { order = append(order, 4); panic("boom") })
+	cancel()
+
+	err = Shutdown()
+	if !slices.Equal(order, []int{4, 3, 1}) {
+		t.Errorf("hooks ran in order %v, want [4 3 1]", order)
+	}
+	if !errors.Is(err, errHook) {
+		t.Errorf("Shutdown() = %v, want it to include the hook error", err)
+	}
+	if _, ok := LookupCallback(ptr); ok {
+		t.Error("callback still registered after Shutdown")
+	}
+	if n := len(loadedLibraries()); n != 0 {
+		t.Errorf("%d libraries still loaded after Shutdown", n)
+	}
+	if err := Shutdown(); err != nil {
+		t.Errorf("second Shutdown() = %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// kernelSigaction mirrors the kernel's struct sigaction used by rt_sigaction
+// on linux/amd64 and linux/arm64 (both define SA_RESTORER).
+type kernelSigaction struct {
+	handler  uintptr
+	flags    uint64
+	restorer uintptr
+	mask     uint64
+}
+
+// maxSignal is the highest signal number reported by the kernel (_NSIG - 1).
+const maxSignal = 64
+
+// signalSnapshot holds the dispositions of all catchable signals plus the
+// calling thread's blocked-signal mask.
+type signalSnapshot struct {
+	actions [maxSignal + 1]kernelSigaction
+	mask    uint64
+}
+
+// capture reads the current signal state. Signals whose disposition cannot
+// be queried (SIGKILL, SIGSTOP) keep their zero value.
+func (s *signalSnapshot) capture() {
+	for sig := 1; sig <= maxSignal; sig++ {
+		if sig == int(syscall.SIGKILL) || sig == int(syscall.SIGSTOP) {
+			continue
+		}
+		_, _, _ = syscall.RawSyscall6(syscall.SYS_RT_SIGACTION, uintptr(sig),
+			0, uintptr(unsafe.Pointer(&s.actions[sig])), 8, 0, 0)
+	}
+	const sigSetmask = 2
+	_, _, _ = syscall.RawSyscall6(syscall.SYS_RT_SIGPROCMASK, sigSetmask,
+		0, uintptr(unsafe.Pointer(&s.mask)), 8, 0, 0)
+}
+
+// callWithSignalGuard runs call on a locked OS thread, then restores any
+// signal disposition or mask bits the callee changed.
+func callWithSignalGuard(call func() error) error {
+	// The thread mask is per-thread; the native call runs on this M's g0
+	// stack, so pinning the goroutine keeps before/after on the same thread.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var before, after signalSnapshot
+	before.capture()
+	callErr := call()
+	after.capture()
+
+	var changes []SignalChange
+	for sig := 1; sig <= maxSignal; sig++ {
+		b, a := before.actions[sig], after.actions[sig]
+		if b.handler == a.handler && b.flags == a.flags && b.mask == a.mask {
+			continue
+		}
+		_, _, _ = syscall.RawSyscall6(syscall.SYS_RT_SIGACTION, uintptr(sig),
+			uintptr(unsafe.Pointer(&before.actions[sig])), 0, 8, 0, 0)
+		changes = append(changes, SignalChange{
+			Signal: syscall.Signal(sig),
+			Before: b.handler,
+			After:  a.handler,
+		})
+	}
+	if before.mask != after.mask {
+		const sigSetmask = 2
+		_, _, _ = syscall.RawSyscall6(syscall.SYS_RT_SIGPROCMASK, sigSetmask,
+			uintptr(unsafe.Pointer(&before.mask)), 0, 8, 0, 0)
+		changes = append(changes, SignalChange{
+			Before: uintptr(before.mask),
+			After:  uintptr(after.mask),
+		})
+	}
+
+	if callErr != nil {
+		return callErr
+	}
+	if len(changes) > 0 {
+		return &SignalStateError{Changes: changes}
+	}
+	return nil
+}
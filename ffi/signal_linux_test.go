@@ -0,0 +1,76 @@
+//go:build linux && (amd64 || arm64)
+
+package ffi
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestWithSignalGuard(t *testing.T) {
+	handle, err := LoadLibrary("libc.so.6")
+	if err != nil {
+		t.Skipf("libc not available: %v", err)
+	}
+	defer FreeLibrary(handle)
+
+	signalFn, err := GetSymbol(handle, "signal")
+	if err != nil {
+		t.Fatalf("GetSymbol(signal): %v", err)
+	}
+	getpidFn, err := GetSymbol(handle, "getpid")
+	if err != nil {
+		t.Fatalf("GetSymbol(getpid): %v", err)
+	}
+
+	t.Run("NoChange", func(t *testing.T) {
+		cif := &types.CallInterface{}
+		if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor, nil); err != nil {
+			t.Fatal(err)
+		}
+		var pid int32
+		err := CallFunctionWithOptions(context.Background(), cif, getpidFn, unsafe.Pointer(&pid), nil, WithSignalGuard())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if int(pid) != syscall.Getpid() {
+			t.Errorf("getpid = %d, want %d", pid, syscall.Getpid())
+		}
+	})
+
+	t.Run("HandlerRestored", func(t *testing.T) {
+		// sighandler_t signal(int signum, sighandler_t handler)
+		cif := &types.CallInterface{}
+		if err := PrepareCallInterface(cif, types.DefaultCall, types.PointerTypeDescriptor,
+			[]*types.TypeDescriptor{types.SInt32TypeDescriptor, types.PointerTypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		sig := int32(syscall.SIGUSR2)
+		sigIgn := uintptr(1)
+		var prev uintptr
+		err := CallFunctionWithOptions(context.Background(), cif, signalFn, unsafe.Pointer(&prev),
+			[]unsafe.Pointer{unsafe.Pointer(&sig), unsafe.Pointer(&sigIgn)}, WithSignalGuard())
+
+		var sigErr *SignalStateError
+		if !errors.As(err, &sigErr) {
+			t.Fatalf("expected *SignalStateError, got %v", err)
+		}
+		if len(sigErr.Changes) != 1 || sigErr.Changes[0].Signal != syscall.SIGUSR2 {
+			t.Fatalf("unexpected changes: %v", sigErr.Changes)
+		}
+		if sigErr.Changes[0].After != sigIgn || sigErr.Changes[0].Before != prev {
+			t.Errorf("change = %v, want %#x -> %#x", sigErr.Changes[0], prev, sigIgn)
+		}
+
+		var now signalSnapshot
+		now.capture()
+		if now.actions[syscall.SIGUSR2].handler != prev {
+			t.Errorf("SIGUSR2 handler not restored: %#x, want %#x", now.actions[syscall.SIGUSR2].handler, prev)
+		}
+	})
+}
@@ -0,0 +1,10 @@
+//go:build !(linux && (amd64 || arm64))
+
+package ffi
+
+import "runtime"
+
+// callWithSignalGuard reports that signal state cannot be inspected here.
+func callWithSignalGuard(call func() error) error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
@@ -0,0 +1,19 @@
+package ffi
+
+import "fmt"
+
+// SignalChange describes one piece of signal state modified by a native call.
+// Plan 9 has notes rather than signals, so no change is ever reported there.
+type SignalChange struct {
+	Signal int     // Signal number whose disposition changed (0 for the thread signal mask)
+	Before uintptr // Handler address (or mask bits) before the call
+	After  uintptr // Handler address (or mask bits) observed after the call
+}
+
+// String formats the change as "SIGNAL before -> after".
+func (c SignalChange) String() string {
+	if c.Signal == 0 {
+		return fmt.Sprintf("signal mask %#x -> %#x", c.Before, c.After)
+	}
+	return fmt.Sprintf("signal %d handler %#x -> %#x", c.Signal, c.Before, c.After)
+}
@@ -6,6 +6,7 @@
 package ffi
 
 import (
+	"encoding/binary"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -121,9 +122,6 @@ func TestStructArg8B_IntegerPair(t *testing.T) {
 // TestStructArg8B_FloatPair tests SSE classification: struct {float, float}.
 func TestStructArg8B_FloatPair(t *testing.T) {
 	requireStructLib(t)
-	if runtime.GOOS == "windows" {
-		t.Skip("Windows: float struct args/returns not supported via syscall.SyscallN (XMM limitation)")
-	}
 
 	sym, err := GetSymbol(structTestLib, "take_struct_2floats")
 	if err != nil {
@@ -246,6 +244,164 @@ func TestStructArg24B_MemoryClass(t *testing.T) {
 	}
 }
 
+// TestStructReturn12B tests a 9-16 byte integer struct return, whose
+// second word comes back in X1 on ARM64 and RDX on System V.
+func TestStructReturn12B(t *testing.T) {
+	requireStructLib(t)
+
+	sym, err := GetSymbol(structTestLib, "make_struct_12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.SInt64TypeDescriptor, types.SInt32TypeDescriptor},
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, pair,
+		[]*types.TypeDescriptor{types.SInt64TypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	type i64i32 struct {
+		A int64
+		B int32
+		_ int32
+	}
+	a, b := int64(0x0123456789abcdef), int32(-12345)
+	got := i64i32{}
+	if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+		[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b)}); err != nil {
+		t.Fatal(err)
+	}
+	if got.A != a || got.B != b {
+		t.Errorf("make_struct_12(%#x, %d) = {%#x, %d}", a, b, got.A, got.B)
+	}
+}
+
+// TestStructReturnViaPointer tests structs returned through a hidden
+// pointer argument, which shifts the declared arguments by one register.
+func TestStructReturnViaPointer(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("24 bytes", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "make_struct_24")
+		if err != nil {
+			t.Fatal(err)
+		}
+		triple := &types.TypeDescriptor{
+			Kind:    types.StructType,
+			Members: []*types.TypeDescriptor{types.SInt64TypeDescriptor, types.SInt64TypeDescriptor, types.SInt64TypeDescriptor},
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, triple,
+			[]*types.TypeDescriptor{types.SInt64TypeDescriptor, types.SInt64TypeDescriptor, types.SInt64TypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		if cif.Flags&types.ReturnViaPointer == 0 {
+			t.Fatalf("flags = %#x, want ReturnViaPointer", cif.Flags)
+		}
+		a, b, c := int64(7), int64(-8), int64(9)
+		var got [3]int64
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+			[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&c)}); err != nil {
+			t.Fatal(err)
+		}
+		if want := [3]int64{7, -8, 9}; got != want {
+			t.Errorf("make_struct_24(7, -8, 9) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("256 bytes", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "big_ret_fill")
+		if err != nil {
+			t.Fatal(err)
+		}
+		members := make([]*types.TypeDescriptor, 32)
+		for i := range members {
+			members[i] = types.SInt64TypeDescriptor
+		}
+		big := &types.TypeDescriptor{Kind: types.StructType, Members: members}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, big,
+			[]*types.TypeDescriptor{types.SInt64TypeDescriptor, types.SInt64TypeDescriptor}); err != nil {
+			t.Fatal(err)
+		}
+		base, step := int64(1000), int64(3)
+		args := []unsafe.Pointer{unsafe.Pointer(&base), unsafe.Pointer(&step)}
+
+		// A discarded result still needs a buffer the size of the struct.
+		if err := CallFunction(&cif, sym, nil, args); err != nil {
+			t.Fatal(err)
+		}
+		var got [32]int64
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got), args); err != nil {
+			t.Fatal(err)
+		}
+		for i, v := range got {
+			if want := base + int64(i)*step; v != want {
+				t.Fatalf("big_ret_fill(1000, 3).v[%d] = %d, want %d", i, v, want)
+			}
+		}
+	})
+}
+
+// TestPackedStruct passes and returns a #pragma pack(1) struct whose
+// int32_t members sit at offsets 1 and 5.
+func TestPackedStruct(t *testing.T) {
+	requireStructLib(t)
+
+	packed := &types.TypeDescriptor{
+		Kind:            types.StructType,
+		PackedAlignment: 1,
+		Members: []*types.TypeDescriptor{
+			types.UInt8TypeDescriptor,
+			types.SInt32TypeDescriptor,
+			types.SInt32TypeDescriptor,
+		},
+	}
+	var sumCIF, makeCIF types.CallInterface
+	if err := PrepareCallInterface(&sumCIF, types.DefaultCall, types.SInt64TypeDescriptor,
+		[]*types.TypeDescriptor{packed, types.SInt64TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	if packed.Size != 9 || packed.Alignment != 1 {
+		t.Fatalf("packed struct laid out as size %d, alignment %d; want 9, 1", packed.Size, packed.Alignment)
+	}
+	if err := PrepareCallInterface(&makeCIF, types.DefaultCall, packed,
+		[]*types.TypeDescriptor{types.UInt8TypeDescriptor, types.SInt32TypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	sumFn, err := GetSymbol(structTestLib, "packed9_sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	makeFn, err := GetSymbol(structTestLib, "packed9_make")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tag, a, b := uint8(7), int32(-300), int32(100000)
+	var p [9]byte
+	if err := CallFunction(&makeCIF, makeFn, unsafe.Pointer(&p),
+		[]unsafe.Pointer{unsafe.Pointer(&tag), unsafe.Pointer(&a), unsafe.Pointer(&b)}); err != nil {
+		t.Fatal(err)
+	}
+	if p[0] != tag || int32(binary.LittleEndian.Uint32(p[1:])) != a || int32(binary.LittleEndian.Uint32(p[5:])) != b {
+		t.Errorf("packed9_make(%d, %d, %d) = % x", tag, a, b, p)
+	}
+
+	scale := int64(3)
+	var sum int64
+	if err := CallFunction(&sumCIF, sumFn, unsafe.Pointer(&sum),
+		[]unsafe.Pointer{unsafe.Pointer(&p), unsafe.Pointer(&scale)}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (int64(tag) + int64(a) + int64(b)) * scale; sum != want {
+		t.Errorf("packed9_sum = %d, want %d", sum, want)
+	}
+}
+
 // TestStructArgWithScalar tests struct + scalar argument (register allocation).
 func TestStructArgWithScalar(t *testing.T) {
 	requireStructLib(t)
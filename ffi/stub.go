@@ -0,0 +1,116 @@
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// CallStub performs a call to one particular native function in place of
+// the generic argument marshaler. It receives exactly what was passed to
+// CallFunction, after goffi has validated cif and avalue, and must fill
+// rvalue as described by cif.ReturnType. For a C or wide string return type
+// rvalue is a pointer slot; goffi decodes the string after the stub returns.
+//
+// Stubs are meant for extremely hot entry points or ones with an unusual
+// ABI that the classifier cannot describe. A stub typically unpacks avalue
+// for the one signature it knows and forwards to CallRaw or to its own
+// assembly trampoline.
+type CallStub func(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error
+
+// ErrNilCallStub is returned by RegisterCallStub for a nil stub.
+var ErrNilCallStub = errors.New("goffi: call stub must not be nil")
+
+// callStubs maps function addresses to their CallStub. callStubCount lets
+// the call path skip the map entirely while no stub is registered.
+var (
+	callStubs     sync.Map // uintptr -> CallStub
+	callStubCount atomic.Int32
+	callStubMu    sync.Mutex // serializes Register/Unregister
+)
+
+// RegisterCallStub resolves symbol in the library handle and routes every
+// later call to that address made with CallFunction, CallFunctionContext,
+// CallFunctionWithOptions or CallFunctionArgs through stub. Library loading,
+// argument validation, fork safety and call options keep working as for any
+// other call.
+//
+// CallWithErrno also calls the stub and reads errno once it returns, except
+// on Windows: the last error is captured by the call trampoline there, so
+// CallWithErrno fails with types.ErrUnsupportedArchitecture instead.
+// CallFunctionRegisters fails with types.ErrUnsupportedArchitecture, since
+// a stub does not report the raw return registers. CallRaw never goes
+// through stubs.
+//
+// A symbol can have at most one stub; the registration outlives FreeLibrary,
+// so unregister it before unloading the library.
+//
+// Example:
+//
+//	// uint32_t hash32(const void *p, size_t n) is called millions of times.
+//	err := ffi.RegisterCallStub(lib, "hash32", func(_ *types.CallInterface, fn, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+//	    res, err := ffi.CallRaw(fn, &ffi.RawCall{GPR: [8]uint64{
+//	        uint64(*(*uintptr)(avalue[0])),
+//	        *(*uint64)(avalue[1]),
+//	    }})
+//	    *(*uint32)(rvalue) = uint32(res.R1)
+//	    return err
+//	})
+func RegisterCallStub(handle unsafe.Pointer, symbol string, stub CallStub) error {
+	if stub == nil {
+		return ErrNilCallStub
+	}
+	fn, err := GetSymbol(handle, symbol)
+	if err != nil {
+		return err
+	}
+
+	callStubMu.Lock()
+	defer callStubMu.Unlock()
+	if _, loaded := callStubs.LoadOrStore(uintptr(fn), stub); loaded {
+		return fmt.Errorf("goffi: call stub already registered for %q", symbol)
+	}
+	callStubCount.Add(1)
+	return nil
+}
+
+// UnregisterCallStub removes the stub registered for symbol in the library
+// handle, returning calls to it to the generic path. It reports whether a
+// stub was registered.
+func UnregisterCallStub(handle unsafe.Pointer, symbol string) bool {
+	fn, err := GetSymbol(handle, symbol)
+	if err != nil {
+		return false
+	}
+
+	callStubMu.Lock()
+	defer callStubMu.Unlock()
+	if _, loaded := callStubs.LoadAndDelete(uintptr(fn)); !loaded {
+		return false
+	}
+	callStubCount.Add(-1)
+	return true
+}
+
+// lookupCallStub returns the stub registered for fn, if any.
+func lookupCallStub(fn unsafe.Pointer) (CallStub, bool) {
+	if callStubCount.Load() == 0 {
+		return nil, false
+	}
+	v, ok := callStubs.Load(uintptr(fn))
+	if !ok {
+		return nil, false
+	}
+	return v.(CallStub), true
+}
+
+// stubCaller adapts a CallStub to arch.FunctionCaller.
+type stubCaller CallStub
+
+func (s stubCaller) Execute(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	return s(cif, fn, rvalue, avalue)
+}
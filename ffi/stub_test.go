@@ -0,0 +1,86 @@
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestRegisterCallStub(t *testing.T) {
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	strlen, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", sym, err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	stub := func(c *types.CallInterface, fn, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+		calls++
+		if c != cif || fn != strlen {
+			t.Errorf("stub got cif %p fn %p, want %p %p", c, fn, cif, strlen)
+		}
+		*(*uint64)(rvalue) = 99
+		return nil
+	}
+	if err := RegisterCallStub(handle, sym, stub); err != nil {
+		t.Fatal(err)
+	}
+	defer UnregisterCallStub(handle, sym)
+
+	if err := RegisterCallStub(handle, sym, stub); err == nil {
+		t.Error("expected error for duplicate registration")
+	}
+	if err := RegisterCallStub(handle, sym, nil); !errors.Is(err, ErrNilCallStub) {
+		t.Errorf("nil stub: err = %v, want ErrNilCallStub", err)
+	}
+	if err := RegisterCallStub(handle, "goffi_no_such_symbol_12345", stub); err == nil {
+		t.Error("expected error for missing symbol")
+	}
+
+	str := []byte("stubbed\x00")
+	strPtr := unsafe.Pointer(&str[0])
+	avalue := []unsafe.Pointer{unsafe.Pointer(&strPtr)}
+
+	var n uint64
+	if err := CallFunction(cif, strlen, unsafe.Pointer(&n), avalue); err != nil {
+		t.Fatal(err)
+	}
+	if n != 99 || calls != 1 {
+		t.Errorf("stubbed call: n = %d, calls = %d; want 99, 1", n, calls)
+	}
+
+	// Validation still happens before the stub.
+	if err := CallFunction(cif, strlen, unsafe.Pointer(&n), nil); err == nil {
+		t.Error("expected validation error")
+	}
+	if calls != 1 {
+		t.Errorf("stub ran for an invalid call")
+	}
+
+	if !UnregisterCallStub(handle, sym) {
+		t.Fatal("UnregisterCallStub reported no stub")
+	}
+	if UnregisterCallStub(handle, sym) {
+		t.Error("second UnregisterCallStub reported a stub")
+	}
+	if err := CallFunction(cif, strlen, unsafe.Pointer(&n), avalue); err != nil {
+		t.Fatal(err)
+	}
+	runtime.KeepAlive(str)
+	if n != 7 || calls != 1 {
+		t.Errorf("generic call: n = %d, calls = %d; want 7, 1", n, calls)
+	}
+}
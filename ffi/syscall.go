@@ -0,0 +1,34 @@
+package ffi
+
+// Syscall issues the raw Linux system call trap with up to six arguments,
+// using the SYSCALL (amd64), SVC (arm64) or SWI (arm) instruction directly.
+// No C library is involved, so it works in static binaries and for system
+// calls that libc does not wrap yet. Take the numbers from syscall.SYS_* or
+// golang.org/x/sys/unix.
+//
+// Errors are reported as by CallWithErrno: a failing call returns r1 ==
+// ^uintptr(0) (-1) and the error number, a successful one the kernel's
+// result and a zero Errno. err is only set when the call was not made: more
+// than six arguments, or a platform other than linux/amd64, linux/arm64 and
+// linux/arm (types.ErrUnsupportedArchitecture).
+//
+// The runtime treats the call like a syscall.Syscall, so a call that blocks
+// does not stall other goroutines. Like CallFunctionArgs, Syscall is marked
+// //go:uintptrescapes: a buffer passed as uintptr(unsafe.Pointer(p)) in the
+// call expression stays alive and in place until Syscall returns.
+//
+//	pid, _, err := ffi.Syscall(syscall.SYS_GETPID)
+//
+//go:uintptrescapes
+func Syscall(trap uintptr, args ...uintptr) (r1 uintptr, errno Errno, err error) {
+	if len(args) > 6 {
+		return 0, 0, &InvalidCallInterfaceError{
+			Field:  "args",
+			Reason: "a system call takes at most 6 arguments",
+			Index:  -1,
+		}
+	}
+	var a [6]uintptr
+	copy(a[:], args)
+	return rawSyscall(trap, a)
+}
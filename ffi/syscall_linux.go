@@ -0,0 +1,16 @@
+//go:build linux && (amd64 || arm64 || arm)
+
+package ffi
+
+import gosyscall "github.com/go-webgpu/goffi/internal/syscall"
+
+// maxErrno is the largest error number the kernel returns as -errno.
+const maxErrno = 4095
+
+func rawSyscall(trap uintptr, a [6]uintptr) (uintptr, Errno, error) {
+	r1, _ := gosyscall.Syscall6(trap, a[0], a[1], a[2], a[3], a[4], a[5])
+	if r1 > ^uintptr(maxErrno) {
+		return ^uintptr(0), Errno(-r1), nil
+	}
+	return r1, 0, nil
+}
@@ -0,0 +1,49 @@
+//go:build linux && (amd64 || arm64 || arm)
+
+package ffi
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"unsafe"
+)
+
+func TestSyscall(t *testing.T) {
+	pid, errno, err := Syscall(syscall.SYS_GETPID)
+	if err != nil || errno != 0 || int(pid) != os.Getpid() {
+		t.Errorf("getpid = %d, %v, %v, want %d", pid, errno, err, os.Getpid())
+	}
+
+	r1, errno, err := Syscall(syscall.SYS_CLOSE, ^uintptr(0))
+	if err != nil || errno != Errno(syscall.EBADF) || r1 != ^uintptr(0) {
+		t.Errorf("close(-1) = %#x, %v, %v, want -1, EBADF", r1, errno, err)
+	}
+}
+
+func TestSyscallBuffers(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	msg := []byte("raw")
+	n, errno, err := Syscall(syscall.SYS_WRITE, w.Fd(), uintptr(unsafe.Pointer(&msg[0])), uintptr(len(msg)))
+	if err != nil || errno != 0 || n != uintptr(len(msg)) {
+		t.Fatalf("write = %d, %v, %v", n, errno, err)
+	}
+	buf := make([]byte, 8)
+	// Blocks until the data is there; already written, so it returns at once.
+	n, errno, err = Syscall(syscall.SYS_READ, r.Fd(), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	if err != nil || errno != 0 || string(buf[:n]) != "raw" {
+		t.Errorf("read = %q, %v, %v", buf[:n], errno, err)
+	}
+}
+
+func TestSyscallTooManyArgs(t *testing.T) {
+	if _, _, err := Syscall(syscall.SYS_GETPID, 1, 2, 3, 4, 5, 6, 7); err == nil {
+		t.Error("expected an error for 7 arguments")
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !(linux && (amd64 || arm64 || arm))
+
+package ffi
+
+import "github.com/go-webgpu/goffi/types"
+
+func rawSyscall(uintptr, [6]uintptr) (uintptr, Errno, error) {
+	return 0, 0, types.ErrUnsupportedArchitecture
+}
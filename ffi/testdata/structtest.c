@@ -1,5 +1,7 @@
+#include <stdbool.h>
 #include <stdint.h>
 #include <stdarg.h>
+#include <wchar.h>
 
 // ≤ 8 bytes: {int32, uint32} — INTEGER class, single GP register
 struct pair_i32_u32 { int32_t a; uint32_t b; };
@@ -45,6 +47,29 @@ void callback_struct_24(int64_t a, int64_t b, int64_t c, void (*callback)(struct
     callback(s);
 }
 
+// Returned through a hidden pointer (RDI on System V, RCX on Win64, X8 on
+// ARM64) that comes before the arguments.
+struct triple_i64 make_struct_24(int64_t a, int64_t b, int64_t c) {
+    struct triple_i64 s = {.a = a, .b = b, .c = c};
+    return s;
+}
+
+// 12 bytes: X0:X1 on ARM64, RAX:RDX on System V, hidden pointer on Win64.
+struct i64_i32 { int64_t a; int32_t b; };
+struct i64_i32 make_struct_12(int64_t a, int32_t b) {
+    struct i64_i32 s = {.a = a, .b = b};
+    return s;
+}
+
+struct big_ret { int64_t v[32]; };
+struct big_ret big_ret_fill(int64_t base, int64_t step) {
+    struct big_ret r;
+    for (int i = 0; i < 32; i++) {
+        r.v[i] = base + i * step;
+    }
+    return r;
+}
+
 // Mixed: struct arg + scalar args (verify register allocation)
 int64_t take_struct_and_int(struct pair_i32_u32 s, int64_t extra) {
     return (int64_t)s.a + (int64_t)s.b + extra;
@@ -110,3 +135,177 @@ int64_t variadic_two_fixed(int64_t a, int64_t b, ...) {
     va_end(ap);
     return a + b + extra;
 }
+
+// Deliberately violates the ABI: overwrites callee-saved registers without
+// restoring them. Used by the register clobber check tests only.
+// Returns 7.
+#if defined(__x86_64__)
+__attribute__((naked)) int64_t clobber_callee_saved(void) {
+    __asm__ volatile(
+        "movq $42, %rbx\n\t"
+        "movq $42, %r12\n\t"
+        "movl $7, %eax\n\t"
+        "ret");
+}
+#elif defined(__aarch64__)
+__attribute__((naked)) int64_t clobber_callee_saved(void) {
+    __asm__ volatile(
+        "mov x19, #42\n\t"
+        "fmov d8, xzr\n\t"
+        "mov x0, #7\n\t"
+        "ret");
+}
+#endif
+
+// Half precision: _Float16 scalars travel in XMM/H registers, and a struct
+// of halves is SSE class on x86_64 and an HFA on aarch64.
+#if defined(__FLT16_MAX__)
+_Float16 half_fma(_Float16 a, float b, _Float16 c) {
+    return (_Float16)((float)a * b + (float)c);
+}
+
+struct half2 { _Float16 x; _Float16 y; };
+_Float16 half2_sum(struct half2 v, int64_t scale) {
+    return (_Float16)(((float)v.x + (float)v.y) * (float)scale);
+}
+#endif
+
+// C string return: hands its argument back unchanged (NULL stays NULL).
+const char *cstring_echo(const char *s) {
+    return s;
+}
+
+// Wide string round trip: returns s and stores wcslen(s) in *n.
+const wchar_t *wstring_echo(const wchar_t *s, int64_t *n) {
+    *n = s ? (int64_t)wcslen(s) : 0;
+    return s;
+}
+
+// Thread-local state: each thread counts its own calls.
+__thread int64_t tls_counter;
+int64_t tls_bump(void) {
+    return ++tls_counter;
+}
+
+// Exported data symbols: a version struct and a function-pointer table.
+struct lib_version { uint16_t major; uint32_t minor; uint8_t patch; };
+struct lib_version structtest_version = { 1, 22, 3 };
+
+int64_t var_counter = 5;
+int64_t var_counter_get(void) {
+    return var_counter;
+}
+
+struct dispatch { int64_t (*get)(void); int64_t (*bump)(void); };
+struct dispatch structtest_dispatch = { var_counter_get, tls_bump };
+
+// Packed structs: pack(1) leaves the int32_t fields unaligned, which makes
+// the struct MEMORY class on x86_64 even at 9 bytes.
+#pragma pack(push, 1)
+struct packed9 { uint8_t tag; int32_t a; int32_t b; };
+#pragma pack(pop)
+
+int64_t packed9_sum(struct packed9 p, int64_t scale) {
+    return ((int64_t)p.tag + p.a + p.b) * scale;
+}
+
+struct packed9 packed9_make(uint8_t tag, int32_t a, int32_t b) {
+    struct packed9 p = { tag, a, b };
+    return p;
+}
+
+// long double: x87 class arguments go on the stack in 16-byte aligned
+// slots and results come back in ST0.
+long double ld_scale(long double x, int64_t n) {
+    return x * n;
+}
+
+long double ld_after_stack(int64_t a, int64_t b, int64_t c, int64_t d,
+                           int64_t e, int64_t f, int64_t g, long double x) {
+    return x + (a + b + c + d + e + f) * g;
+}
+
+struct ld_box { long double v; };
+struct ld_box ld_box_half(struct ld_box b) {
+    b.v /= 2;
+    return b;
+}
+
+// bool: arguments arrive as 0 or 1 in the low byte. bool_dirty returns
+// low in its low byte and garbage above it, as a callee returning bool may.
+bool bool_xor(bool a, int64_t pad, bool b) {
+    (void)pad;
+    return a != b;
+}
+
+int32_t bool_count(bool a, bool b, bool c) {
+    return a + b + c;
+}
+
+uint64_t bool_dirty(uint8_t low) {
+    return 0xdeadbeef00ull | low;
+}
+
+// Mixed integer and floating-point arguments past the register slots, and
+// float and double results (XMM0 on both x86-64 conventions).
+double mixed_args(int32_t a, double b, float c, int64_t d, float e, double f) {
+    return a + b * 2 + c * 4 + d * 8 + e * 16 + f * 32;
+}
+
+float float_scale(float x, int32_t n) {
+    return x * n;
+}
+
+// __int128: a register pair; i128_after_five starts on an even register
+// on arm64 and goes to a 16-byte aligned stack slot on x86-64.
+__int128 i128_mul(__int128 a, int64_t b) {
+    return a * b;
+}
+
+unsigned __int128 u128_after_five(int64_t a, int64_t b, int64_t c, int64_t d,
+                                  int64_t e, unsigned __int128 x, int64_t f) {
+    return x + (a + b + c + d + e) * f;
+}
+
+struct i128_box { int32_t tag; __int128 v; };
+__int128 i128_box_sum(struct i128_box b) {
+    return b.v + b.tag;
+}
+
+// 128-bit vectors (__m128, float32x4_t): one whole XMM or V register each,
+// the ninth on the stack, and results in XMM0 or V0.
+typedef float v4f __attribute__((vector_size(16)));
+typedef double v2d __attribute__((vector_size(16)));
+typedef int32_t v4i __attribute__((vector_size(16)));
+
+v4f v4f_madd(v4f a, v4f b, float s) {
+    v4f c = { s, s, s, s };
+    return a * b + c;
+}
+
+double v2d_dot(double pad, v2d a, v2d b) {
+    v2d p = a * b;
+    return pad + p[0] + p[1];
+}
+
+v4i v4i_sum9(v4i a, v4i b, v4i c, v4i d, v4i e, v4i f, v4i g, v4i h, v4i i) {
+    return a + b + c + d + e + f + g + h + i * 100;
+}
+
+// Callbacks returning by value: *out receives what the caller reads from
+// RAX:RDX and XMM0:XMM1, or from X0:X1 and D0-D3.
+struct f64_i64 { double d; int64_t n; };
+struct rect_f { float x; float y; float w; float h; };
+struct quad_d { double a; double b; double c; double d; };
+
+#define CB_RET(name, type) \
+    void name(type (*cb)(int64_t), int64_t seed, type *out) { *out = cb(seed); }
+
+CB_RET(cb_ret_double, double)
+CB_RET(cb_ret_float, float)
+CB_RET(cb_ret_pair_i32_u32, struct pair_i32_u32)
+CB_RET(cb_ret_pair_f32, struct pair_f32)
+CB_RET(cb_ret_pair_i64, struct pair_i64)
+CB_RET(cb_ret_f64_i64, struct f64_i64)
+CB_RET(cb_ret_rect_f, struct rect_f)
+CB_RET(cb_ret_quad_d, struct quad_d)
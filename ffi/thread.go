@@ -0,0 +1,79 @@
+//go:build ((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm)
+
+package ffi
+
+import (
+	"sync"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// threadEntry holds the lazily created C entry point returned by
+// AttachCurrentThread.
+var threadEntry struct {
+	once sync.Once
+	ptr  uintptr
+	err  error
+}
+
+// initThreadEntry makes the runtime keep the M it lends a foreign thread and
+// registers the attach entry point, which occupies one callback slot for
+// the lifetime of the program.
+func initThreadEntry() {
+	if threadEntry.err = bindThreads(); threadEntry.err != nil {
+		return
+	}
+	threadEntry.ptr = NewCallback(func() int32 { return 0 })
+}
+
+// AttachCurrentThread returns a C function pointer with the signature
+// `int (*)(void)` that binds the calling foreign thread to the Go runtime
+// until the thread exits. The entry point returns 0.
+//
+// A thread the Go runtime did not create has no M (the runtime's handle for
+// an OS thread); a callback from it borrows one for the call and gives it
+// back on return, which costs time in every callback. Libraries that create
+// their own threads (render workers, audio callbacks, job systems) can
+// invoke the entry point once from each thread before the thread first
+// calls into Go, so the borrowing happens up front rather than inside a
+// latency-sensitive callback:
+//
+//	typedef int (*goffi_attach_fn)(void);
+//	void *worker(void *arg) {
+//	    ((goffi_attach_fn)attach)();
+//	    ... call Go callbacks ...
+//	    return NULL;
+//	}
+//
+// The first call of AttachCurrentThread creates the pthread key whose
+// destructor gives a thread's M back when the thread exits, as runtime/cgo
+// does for exported functions. From then on the runtime keeps the M of every
+// foreign thread that calls into Go, through the entry point or any
+// callback, until that thread exits. The entry point consumes one callback
+// slot.
+//
+// There is no detach entry point: a thread cannot give its M back before it
+// exits. With CGO_ENABLED=1 the binding is the value of a pthread key that
+// is private to runtime/cgo, and the runtime drops the M only from that
+// key's destructor. Dropping it any other way would leave the key pointing
+// at an M the runtime may already have lent to another thread, which the
+// destructor would then drop a second time. internal/fakecgo keeps the same
+// binding so that both build modes behave alike.
+//
+// Built with -tags nofakecgo and CGO_ENABLED=0, where the runtime's cgo
+// support comes from another package, threads cannot be bound and
+// AttachCurrentThread returns an *UnsupportedPlatformError.
+func AttachCurrentThread() (uintptr, error) {
+	threadEntry.once.Do(initThreadEntry)
+	return threadEntry.ptr, threadEntry.err
+}
+
+// callThreadInit calls the C function fn, a void (*)(void), such as
+// _cgo_wait_runtime_init_done.
+func callThreadInit(fn uintptr) error {
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.VoidTypeDescriptor, nil); err != nil {
+		return err
+	}
+	return CallFunction(&cif, foreignPointer(fn), nil, nil)
+}
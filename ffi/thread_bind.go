@@ -0,0 +1,15 @@
+//go:build (((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm)) && (cgo || !nofakecgo)
+
+package ffi
+
+// cgoWaitRuntimeInitDoneABI0 is the address of _cgo_wait_runtime_init_done,
+// which runtime/cgo provides with CGO_ENABLED=1 and internal/fakecgo
+// without (see thread_unix.s).
+var cgoWaitRuntimeInitDoneABI0 uintptr
+
+// bindThreads creates the pthread key that makes the runtime bind the M it
+// lends a foreign thread to that thread until it exits. runtime/cgo only
+// creates it on the first call of a cgo-exported function.
+func bindThreads() error {
+	return callThreadInit(cgoWaitRuntimeInitDoneABI0)
+}
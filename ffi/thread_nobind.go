@@ -0,0 +1,11 @@
+//go:build (((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm)) && !cgo && nofakecgo
+
+package ffi
+
+import "runtime"
+
+// bindThreads reports that the package providing the runtime's cgo support
+// in place of internal/fakecgo cannot bind threads.
+func bindThreads() error {
+	return &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
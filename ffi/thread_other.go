@@ -0,0 +1,11 @@
+//go:build !(((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm))
+
+package ffi
+
+import "runtime"
+
+// AttachCurrentThread returns an *UnsupportedPlatformError: foreign threads
+// cannot be bound to the Go runtime on this platform.
+func AttachCurrentThread() (uintptr, error) {
+	return 0, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
@@ -0,0 +1,110 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// attachTestSource runs, on a fresh C thread, the attach entry point and
+// a callback reporting the goroutine it ran on; then a callback on a second
+// C thread, and another on the first.
+const attachTestSource = `#include <pthread.h>
+#include <stdint.h>
+
+static int (*attach)(void);
+static uintptr_t (*goid)(void);
+static uintptr_t ids[3];
+
+static void *second(void *arg) {
+	ids[1] = goid();
+	return 0;
+}
+
+static void *first(void *arg) {
+	pthread_t t;
+	if (attach() != 0)
+		return 0;
+	ids[0] = goid();
+	pthread_create(&t, 0, second, 0);
+	pthread_join(t, 0);
+	ids[2] = goid();
+	return 0;
+}
+
+void attach_test(int (*a)(void), uintptr_t (*g)(void), uintptr_t *out) {
+	pthread_t t;
+	attach = a;
+	goid = g;
+	pthread_create(&t, 0, first, 0);
+	pthread_join(t, 0);
+	out[0] = ids[0];
+	out[1] = ids[1];
+	out[2] = ids[2];
+}
+`
+
+func TestAttachCurrentThread(t *testing.T) {
+	attach, err := AttachCurrentThread()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := AttachCurrentThread(); again != attach {
+		t.Errorf("AttachCurrentThread is not stable: %#x != %#x", again, attach)
+	}
+
+	cc := os.Getenv("CC")
+	if cc == "" {
+		cc = "gcc"
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "attach.c")
+	so := filepath.Join(dir, "libattach.so")
+	if err := os.WriteFile(src, []byte(attachTestSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"-shared", "-fPIC", "-o", so, src}
+	if runtime.GOOS != "darwin" {
+		args = append(args, "-lpthread")
+	}
+	if out, err := exec.Command(cc, args...).CombinedOutput(); err != nil {
+		t.Skipf("cannot build test library: %v\n%s", err, out)
+	}
+	lib, err := LoadLibrary(so)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer FreeLibrary(lib)
+	fn, err := GetSymbol(lib, "attach_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ptr := types.PointerTypeDescriptor
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.VoidTypeDescriptor, []*types.TypeDescriptor{ptr, ptr, ptr}); err != nil {
+		t.Fatal(err)
+	}
+
+	goid := NewCallback(func() uintptr { return uintptr(goroutineID()) })
+	defer releaseCallback(goid)
+	var ids [3]uintptr
+	out := unsafe.Pointer(&ids)
+	if err := CallFunction(cif, fn, nil, []unsafe.Pointer{
+		unsafe.Pointer(&attach), unsafe.Pointer(&goid), unsafe.Pointer(&out),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first thread keeps its M, and with it the goroutine callbacks
+	// run on, while the second thread has to take another one.
+	if ids[0] == 0 || ids[0] != ids[2] || ids[1] == ids[0] {
+		t.Errorf("goroutines of the callbacks = %v, want the first and last equal and the second different", ids)
+	}
+}
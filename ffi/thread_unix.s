@@ -0,0 +1,11 @@
+//go:build (((linux || darwin || freebsd) && (amd64 || arm64)) || (linux && arm)) && (cgo || !nofakecgo)
+
+#include "textflag.h"
+
+#ifdef GOARCH_arm
+GLOBL ·cgoWaitRuntimeInitDoneABI0(SB), NOPTR|RODATA, $4
+DATA ·cgoWaitRuntimeInitDoneABI0(SB)/4, $_cgo_wait_runtime_init_done(SB)
+#else
+GLOBL ·cgoWaitRuntimeInitDoneABI0(SB), NOPTR|RODATA, $8
+DATA ·cgoWaitRuntimeInitDoneABI0(SB)/8, $_cgo_wait_runtime_init_done(SB)
+#endif
@@ -0,0 +1,67 @@
+package ffi
+
+// ThreadClass is the scheduling class requested for a dedicated FFI thread.
+//
+// Classes are modelled after the macOS quality-of-service classes and mapped
+// to the closest native mechanism on each platform:
+//
+//	Class            macOS QoS                   Windows priority        Linux nice
+//	Background       QOS_CLASS_BACKGROUND        THREAD_PRIORITY_LOWEST  10
+//	Utility          QOS_CLASS_UTILITY           BELOW_NORMAL            5
+//	Default          QOS_CLASS_DEFAULT           NORMAL                  0
+//	UserInitiated    QOS_CLASS_USER_INITIATED    ABOVE_NORMAL            -5
+//	UserInteractive  QOS_CLASS_USER_INTERACTIVE  HIGHEST                 -10
+//
+// Raising the priority above the default may require privileges on Linux
+// (CAP_SYS_NICE or RLIMIT_NICE); without them applying the class fails.
+type ThreadClass int
+
+const (
+	ThreadClassUnchanged       ThreadClass = iota // keep the thread's current class
+	ThreadClassBackground                         // maintenance work nobody is waiting for
+	ThreadClassUtility                            // long-running work with visible progress
+	ThreadClassDefault                            // the platform default
+	ThreadClassUserInitiated                      // work the user is actively waiting for
+	ThreadClassUserInteractive                    // audio, rendering, input handling
+)
+
+// ThreadConfig describes scheduling settings for a dedicated FFI thread.
+// The zero value leaves the thread unchanged.
+type ThreadConfig struct {
+	// Class is the scheduling class of the thread.
+	Class ThreadClass
+
+	// Affinity restricts the thread to the listed CPUs (0-based).
+	// Supported on Linux and Windows (CPUs 0-63); nil leaves it unchanged.
+	Affinity []int
+}
+
+// ApplyThreadConfig applies cfg to the calling OS thread and returns a
+// function that restores the previous settings. A cfg with an unknown class
+// or an invalid CPU number fails with a *ThreadConfigError, affinity on a
+// platform without it with an *UnsupportedPlatformError.
+//
+// The caller must be locked to its thread with runtime.LockOSThread, and must
+// call restore before unlocking: otherwise the runtime reuses the thread for
+// unrelated goroutines with the changed priority or affinity. If restore
+// returns an error the thread may still carry cfg's settings; the caller
+// should then let the goroutine exit without unlocking, so the runtime
+// terminates the thread instead of reusing it. This happens on Linux when an
+// unprivileged process moved a thread to a lower-priority class, because it
+// cannot lower the nice value again.
+//
+// BlockingPool threads can be configured with NewBlockingPoolWithThreadConfig.
+func ApplyThreadConfig(cfg ThreadConfig) (restore func() error, err error) {
+	if cfg.Class < ThreadClassUnchanged || cfg.Class > ThreadClassUserInteractive {
+		return nil, &ThreadConfigError{Field: "Class", Reason: "unknown thread class", Index: -1}
+	}
+	for i, cpu := range cfg.Affinity {
+		if cpu < 0 {
+			return nil, &ThreadConfigError{Field: "Affinity", Reason: "negative CPU number", Index: i}
+		}
+	}
+	if cfg.Class == ThreadClassUnchanged && cfg.Affinity == nil {
+		return func() error { return nil }, nil
+	}
+	return applyThreadConfig(cfg)
+}
@@ -0,0 +1,113 @@
+//go:build darwin
+
+package ffi
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// QoS classes from <sys/qos.h>.
+var darwinQoS = [...]uint32{
+	ThreadClassBackground:      0x09, // QOS_CLASS_BACKGROUND
+	ThreadClassUtility:         0x11, // QOS_CLASS_UTILITY
+	ThreadClassDefault:         0x15, // QOS_CLASS_DEFAULT
+	ThreadClassUserInitiated:   0x19, // QOS_CLASS_USER_INITIATED
+	ThreadClassUserInteractive: 0x21, // QOS_CLASS_USER_INTERACTIVE
+}
+
+// qosAPI holds the libSystem QoS entry points, resolved on first use.
+var qosAPI struct {
+	once    sync.Once
+	err     error
+	self    unsafe.Pointer // pthread_t pthread_self(void)
+	get     unsafe.Pointer // int pthread_get_qos_class_np(pthread_t, qos_class_t *, int *)
+	setSelf unsafe.Pointer // int pthread_set_qos_class_self_np(qos_class_t, int)
+	cifSelf types.CallInterface
+	cifGet  types.CallInterface
+	cifSet  types.CallInterface
+}
+
+func loadQoSAPI() {
+	lib, err := LoadLibrary("/usr/lib/libSystem.B.dylib")
+	if err != nil {
+		qosAPI.err = err
+		return
+	}
+	for _, sym := range []struct {
+		name string
+		dst  *unsafe.Pointer
+	}{
+		{"pthread_self", &qosAPI.self},
+		{"pthread_get_qos_class_np", &qosAPI.get},
+		{"pthread_set_qos_class_self_np", &qosAPI.setSelf},
+	} {
+		if *sym.dst, err = GetSymbol(lib, sym.name); err != nil {
+			qosAPI.err = err
+			return
+		}
+	}
+	ptr, s32 := types.PointerTypeDescriptor, types.SInt32TypeDescriptor
+	if err := PrepareCallInterface(&qosAPI.cifSelf, types.DefaultCall, ptr, nil); err != nil {
+		qosAPI.err = err
+		return
+	}
+	if err := PrepareCallInterface(&qosAPI.cifGet, types.DefaultCall, s32,
+		[]*types.TypeDescriptor{ptr, ptr, ptr}); err != nil {
+		qosAPI.err = err
+		return
+	}
+	qosAPI.err = PrepareCallInterface(&qosAPI.cifSet, types.DefaultCall, s32,
+		[]*types.TypeDescriptor{types.UInt32TypeDescriptor, s32})
+}
+
+func setQoSSelf(class uint32, relpri int32) error {
+	var rc int32
+	if err := CallFunction(&qosAPI.cifSet, qosAPI.setSelf, unsafe.Pointer(&rc),
+		[]unsafe.Pointer{unsafe.Pointer(&class), unsafe.Pointer(&relpri)}); err != nil {
+		return err
+	}
+	if rc != 0 {
+		return fmt.Errorf("goffi: pthread_set_qos_class_self_np failed: error %d", rc)
+	}
+	return nil
+}
+
+// applyThreadConfig sets the QoS class of the calling thread. macOS has no
+// API to pin threads to CPUs, so Affinity is rejected.
+func applyThreadConfig(cfg ThreadConfig) (func() error, error) {
+	if cfg.Affinity != nil {
+		return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+	}
+	qosAPI.once.Do(loadQoSAPI)
+	if qosAPI.err != nil {
+		return nil, qosAPI.err
+	}
+
+	var self uintptr
+	if err := CallFunction(&qosAPI.cifSelf, qosAPI.self, unsafe.Pointer(&self), nil); err != nil {
+		return nil, err
+	}
+	var oldClass uint32
+	var oldRelpri int32
+	classPtr, relpriPtr := unsafe.Pointer(&oldClass), unsafe.Pointer(&oldRelpri)
+	var rc int32
+	if err := CallFunction(&qosAPI.cifGet, qosAPI.get, unsafe.Pointer(&rc),
+		[]unsafe.Pointer{unsafe.Pointer(&self), unsafe.Pointer(&classPtr), unsafe.Pointer(&relpriPtr)}); err != nil {
+		return nil, err
+	}
+
+	if err := setQoSSelf(darwinQoS[cfg.Class], 0); err != nil {
+		return nil, err
+	}
+	return func() error {
+		if oldClass == 0 { // QOS_CLASS_UNSPECIFIED cannot be set back
+			return nil
+		}
+		return setQoSSelf(oldClass, oldRelpri)
+	}, nil
+}
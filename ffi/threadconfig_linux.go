@@ -0,0 +1,83 @@
+//go:build linux
+
+package ffi
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// linuxNice maps a ThreadClass to a nice value.
+var linuxNice = [...]int{
+	ThreadClassBackground:      10,
+	ThreadClassUtility:         5,
+	ThreadClassDefault:         0,
+	ThreadClassUserInitiated:   -5,
+	ThreadClassUserInteractive: -10,
+}
+
+// cpuMask is a cpu_set_t for up to 1024 CPUs.
+type cpuMask [1024 / 64]uint64
+
+// applyThreadConfig sets the nice value and CPU affinity of the calling
+// thread. On Linux both are per-thread attributes addressed by TID.
+func applyThreadConfig(cfg ThreadConfig) (func() error, error) {
+	tid := syscall.Gettid()
+	var restores []func() error
+	restore := func() error {
+		var errs []error
+		for i := len(restores) - 1; i >= 0; i-- {
+			errs = append(errs, restores[i]())
+		}
+		return errors.Join(errs...)
+	}
+
+	if cfg.Class != ThreadClassUnchanged {
+		// The raw getpriority syscall returns 20-nice to avoid negative results.
+		prio, err := syscall.Getpriority(syscall.PRIO_PROCESS, tid)
+		if err != nil {
+			return nil, fmt.Errorf("goffi: getpriority: %w", err)
+		}
+		oldNice := 20 - prio
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, tid, linuxNice[cfg.Class]); err != nil {
+			return nil, fmt.Errorf("goffi: setpriority: %w", err)
+		}
+		restores = append(restores, func() error {
+			if err := syscall.Setpriority(syscall.PRIO_PROCESS, tid, oldNice); err != nil {
+				return fmt.Errorf("goffi: setpriority: %w", err)
+			}
+			return nil
+		})
+	}
+
+	if cfg.Affinity != nil {
+		var oldMask, mask cpuMask
+		if _, _, errno := syscall.RawSyscall(syscall.SYS_SCHED_GETAFFINITY, 0,
+			unsafe.Sizeof(oldMask), uintptr(unsafe.Pointer(&oldMask))); errno != 0 {
+			_ = restore()
+			return nil, fmt.Errorf("goffi: sched_getaffinity: %w", errno)
+		}
+		for i, cpu := range cfg.Affinity {
+			if cpu >= len(mask)*64 {
+				_ = restore()
+				return nil, &ThreadConfigError{Field: "Affinity", Reason: "CPU number out of range", Index: i}
+			}
+			mask[cpu/64] |= 1 << (cpu % 64)
+		}
+		if _, _, errno := syscall.RawSyscall(syscall.SYS_SCHED_SETAFFINITY, 0,
+			unsafe.Sizeof(mask), uintptr(unsafe.Pointer(&mask))); errno != 0 {
+			_ = restore()
+			return nil, fmt.Errorf("goffi: sched_setaffinity: %w", errno)
+		}
+		restores = append(restores, func() error {
+			if _, _, errno := syscall.RawSyscall(syscall.SYS_SCHED_SETAFFINITY, 0,
+				unsafe.Sizeof(oldMask), uintptr(unsafe.Pointer(&oldMask))); errno != 0 {
+				return fmt.Errorf("goffi: sched_setaffinity: %w", errno)
+			}
+			return nil
+		})
+	}
+	return restore, nil
+}
@@ -0,0 +1,88 @@
+package ffi
+
+import (
+	"context"
+	"errors"
+	"os"
+	"runtime"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func currentNice(t *testing.T) int {
+	t.Helper()
+	prio, err := syscall.Getpriority(syscall.PRIO_PROCESS, syscall.Gettid())
+	if err != nil {
+		t.Fatalf("getpriority: %v", err)
+	}
+	return 20 - prio
+}
+
+func TestApplyThreadConfig(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	before := currentNice(t)
+	if before > linuxNice[ThreadClassBackground] {
+		t.Skipf("thread already runs at nice %d", before)
+	}
+	restore, err := ApplyThreadConfig(ThreadConfig{Class: ThreadClassBackground, Affinity: []int{0}})
+	if err != nil {
+		t.Fatalf("ApplyThreadConfig: %v", err)
+	}
+	if got := currentNice(t); got != linuxNice[ThreadClassBackground] {
+		t.Errorf("nice = %d, want %d", got, linuxNice[ThreadClassBackground])
+	}
+	// Going back to a lower nice value needs CAP_SYS_NICE or RLIMIT_NICE.
+	if err := restore(); err != nil && os.Geteuid() == 0 {
+		t.Errorf("restore: %v", err)
+	}
+	if got := currentNice(t); got != before && os.Geteuid() == 0 {
+		t.Errorf("nice after restore = %d, want %d", got, before)
+	}
+
+	var tcErr *ThreadConfigError
+	if _, err := ApplyThreadConfig(ThreadConfig{Affinity: []int{-1}}); !errors.As(err, &tcErr) || tcErr.Index != 0 {
+		t.Errorf("negative CPU: got %v, want *ThreadConfigError", err)
+	}
+	if _, err := ApplyThreadConfig(ThreadConfig{Affinity: []int{0, 1 << 20}}); !errors.As(err, &tcErr) || tcErr.Index != 1 {
+		t.Errorf("CPU out of range: got %v, want *ThreadConfigError", err)
+	}
+}
+
+func TestBlockingPoolWithThreadConfig(t *testing.T) {
+	handle, err := LoadLibrary("libc.so.6")
+	if err != nil {
+		t.Skipf("cannot load libc: %v", err)
+	}
+	getpriority, err := GetSymbol(handle, "getpriority")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.SInt32TypeDescriptor, types.UInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	pool, err := NewBlockingPoolWithThreadConfig(2, 0, ThreadConfig{Class: ThreadClassBackground})
+	if err != nil {
+		t.Fatalf("NewBlockingPoolWithThreadConfig: %v", err)
+	}
+	defer pool.Close()
+
+	// glibc's getpriority returns the nice value; who == 0 is the calling thread.
+	var which int32 = syscall.PRIO_PROCESS
+	var who uint32
+	var nice int32
+	if err := pool.Call(context.Background(), cif, getpriority, unsafe.Pointer(&nice),
+		[]unsafe.Pointer{unsafe.Pointer(&which), unsafe.Pointer(&who)}); err != nil {
+		t.Fatal(err)
+	}
+	if nice != int32(linuxNice[ThreadClassBackground]) {
+		t.Errorf("pool thread nice = %d, want %d", nice, linuxNice[ThreadClassBackground])
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin && !windows
+
+package ffi
+
+import "runtime"
+
+// applyThreadConfig reports that thread scheduling cannot be configured here.
+func applyThreadConfig(ThreadConfig) (func() error, error) {
+	return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
@@ -0,0 +1,80 @@
+//go:build windows
+
+package ffi
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	procGetCurrentThread      = modkernel32.NewProc("GetCurrentThread")
+	procGetThreadPriority     = modkernel32.NewProc("GetThreadPriority")
+	procSetThreadPriority     = modkernel32.NewProc("SetThreadPriority")
+	procSetThreadAffinityMask = modkernel32.NewProc("SetThreadAffinityMask")
+)
+
+// windowsPriority maps a ThreadClass to a THREAD_PRIORITY_* value.
+var windowsPriority = [...]int32{
+	ThreadClassBackground:      -2, // THREAD_PRIORITY_LOWEST
+	ThreadClassUtility:         -1, // THREAD_PRIORITY_BELOW_NORMAL
+	ThreadClassDefault:         0,  // THREAD_PRIORITY_NORMAL
+	ThreadClassUserInitiated:   1,  // THREAD_PRIORITY_ABOVE_NORMAL
+	ThreadClassUserInteractive: 2,  // THREAD_PRIORITY_HIGHEST
+}
+
+// threadPriorityErrorReturn is THREAD_PRIORITY_ERROR_RETURN (MAXLONG).
+const threadPriorityErrorReturn = 0x7fffffff
+
+// applyThreadConfig sets the priority and affinity mask of the calling thread.
+func applyThreadConfig(cfg ThreadConfig) (func() error, error) {
+	thread, _, _ := procGetCurrentThread.Call() // pseudo-handle, needs no CloseHandle
+	var restores []func() error
+	restore := func() error {
+		var errs []error
+		for i := len(restores) - 1; i >= 0; i-- {
+			errs = append(errs, restores[i]())
+		}
+		return errors.Join(errs...)
+	}
+
+	if cfg.Class != ThreadClassUnchanged {
+		old, _, err := procGetThreadPriority.Call(thread)
+		if uint32(old) == threadPriorityErrorReturn {
+			return nil, fmt.Errorf("goffi: GetThreadPriority: %w", err)
+		}
+		prio := windowsPriority[cfg.Class]
+		if ok, _, err := procSetThreadPriority.Call(thread, uintptr(prio)); ok == 0 {
+			return nil, fmt.Errorf("goffi: SetThreadPriority: %w", err)
+		}
+		restores = append(restores, func() error {
+			if ok, _, err := procSetThreadPriority.Call(thread, old); ok == 0 {
+				return fmt.Errorf("goffi: SetThreadPriority: %w", err)
+			}
+			return nil
+		})
+	}
+
+	if cfg.Affinity != nil {
+		var mask uintptr
+		for i, cpu := range cfg.Affinity {
+			if cpu >= 64 {
+				_ = restore()
+				return nil, &ThreadConfigError{Field: "Affinity", Reason: "CPU number out of range", Index: i}
+			}
+			mask |= 1 << cpu
+		}
+		old, _, err := procSetThreadAffinityMask.Call(thread, mask)
+		if old == 0 {
+			_ = restore()
+			return nil, fmt.Errorf("goffi: SetThreadAffinityMask: %w", err)
+		}
+		restores = append(restores, func() error {
+			if prev, _, err := procSetThreadAffinityMask.Call(thread, old); prev == 0 {
+				return fmt.Errorf("goffi: SetThreadAffinityMask: %w", err)
+			}
+			return nil
+		})
+	}
+	return restore, nil
+}
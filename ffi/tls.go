@@ -0,0 +1,91 @@
+package ffi
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// TLSVar is a thread-local variable (__thread, _Thread_local,
+// thread_local) exported by a native library, such as errno-style status
+// variables or a library's per-thread context. Every OS thread has its own
+// instance, at its own address.
+//
+// Go code sees the instance of whichever thread the goroutine happens to run
+// on. To observe the same instance as the native calls made by a goroutine,
+// lock it to its thread with runtime.LockOSThread before those calls and keep
+// it locked while accessing the variable.
+type TLSVar struct {
+	name string
+	tlsSym
+}
+
+// LookupTLSVar resolves the thread-local variable name in the library handle.
+// Only symbols defined as thread-local in C may be looked up: on Linux and
+// FreeBSD a plain global is indistinguishable and would be accessed as one,
+// on macOS it would be misinterpreted as a TLV descriptor.
+//
+// Thread-local variables are supported on Linux, FreeBSD and macOS; Windows
+// cannot export them and returns *UnsupportedPlatformError.
+//
+// Example:
+//
+//	// __thread int lib_last_status;
+//	status, err := ffi.LookupTLSVar(lib, "lib_last_status")
+//	...
+//	runtime.LockOSThread()
+//	defer runtime.UnlockOSThread()
+//	ffi.CallFunction(&cif, doWork, nil, nil)
+//	var buf [4]byte
+//	err = status.Load(buf[:])
+func LookupTLSVar(handle unsafe.Pointer, name string) (*TLSVar, error) {
+	sym, err := lookupTLS(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	return &TLSVar{name: name, tlsSym: sym}, nil
+}
+
+// Name returns the symbol name of the variable.
+func (v *TLSVar) Name() string {
+	return v.name
+}
+
+// Addr returns the address of the calling thread's instance of the variable.
+// It is only meaningful while the goroutine stays locked to its thread, and
+// only until the thread exits or the library is unloaded.
+func (v *TLSVar) Addr() (unsafe.Pointer, error) {
+	p, err := v.addr()
+	if err != nil {
+		return nil, &LibraryError{Operation: "tls", Name: v.name, Err: err}
+	}
+	if p == nil {
+		return nil, &LibraryError{Operation: "tls", Name: v.name, Err: fmt.Errorf("no address for the current thread")}
+	}
+	return p, nil
+}
+
+// Load copies len(dst) bytes of the current thread's instance into dst.
+func (v *TLSVar) Load(dst []byte) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	p, err := v.Addr()
+	if err != nil {
+		return err
+	}
+	copy(dst, unsafe.Slice((*byte)(p), len(dst)))
+	return nil
+}
+
+// Store copies src over the first len(src) bytes of the current thread's
+// instance.
+func (v *TLSVar) Store(src []byte) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	p, err := v.Addr()
+	if err != nil {
+		return err
+	}
+	copy(unsafe.Slice((*byte)(p), len(src)), src)
+	return nil
+}
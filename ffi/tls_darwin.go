@@ -0,0 +1,38 @@
+//go:build darwin && (amd64 || arm64)
+
+package ffi
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// tlsSym holds the TLV descriptor dyld exports for a thread-local variable:
+//
+//	struct { void *(*thunk)(void *desc); unsigned long key, offset; }
+//
+// Calling the thunk with the descriptor returns the calling thread's
+// instance, allocating it on first access.
+type tlsSym struct {
+	desc unsafe.Pointer
+	cif  types.CallInterface
+}
+
+func lookupTLS(handle unsafe.Pointer, name string) (tlsSym, error) {
+	desc, err := GetSymbol(handle, name)
+	if err != nil {
+		return tlsSym{}, err
+	}
+	s := tlsSym{desc: desc}
+	err = PrepareCallInterface(&s.cif, types.DefaultCall, types.PointerTypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor})
+	return s, err
+}
+
+func (s *tlsSym) addr() (unsafe.Pointer, error) {
+	thunk := *(*unsafe.Pointer)(s.desc)
+	var p unsafe.Pointer
+	err := CallFunction(&s.cif, thunk, unsafe.Pointer(&p), []unsafe.Pointer{unsafe.Pointer(&s.desc)})
+	return p, err
+}
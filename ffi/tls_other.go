@@ -0,0 +1,19 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm))
+
+package ffi
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// tlsSym is empty: thread-local variables cannot be looked up here.
+type tlsSym struct{}
+
+func lookupTLS(handle unsafe.Pointer, name string) (tlsSym, error) {
+	return tlsSym{}, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+func (s *tlsSym) addr() (unsafe.Pointer, error) {
+	return nil, &UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
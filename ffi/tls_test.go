@@ -0,0 +1,85 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestTLSVar(t *testing.T) {
+	requireStructLib(t)
+
+	v, err := LookupTLSVar(structTestLib, "tls_counter")
+	if runtime.GOOS == "windows" {
+		var upErr *UnsupportedPlatformError
+		if !errors.As(err, &upErr) {
+			t.Fatalf("err = %v, want *UnsupportedPlatformError", err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	bumpFn, err := GetSymbol(structTestLib, "tls_bump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt64TypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	bump := func() int64 {
+		var n int64
+		if err := CallFunction(&cif, bumpFn, unsafe.Pointer(&n), nil); err != nil {
+			t.Error(err)
+		}
+		return n
+	}
+	load := func() int64 {
+		var buf [8]byte
+		if err := v.Load(buf[:]); err != nil {
+			t.Error(err)
+		}
+		return int64(binary.LittleEndian.Uint64(buf[:]))
+	}
+	store := func(n int64) {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], uint64(n))
+		if err := v.Store(buf[:]); err != nil {
+			t.Error(err)
+		}
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	store(40)
+	if got := bump(); got != 41 {
+		t.Errorf("tls_bump after Store(40) = %d, want 41", got)
+	}
+	if got := load(); got != 41 {
+		t.Errorf("Load = %d, want 41", got)
+	}
+
+	// A second locked thread has its own instance.
+	done := make(chan int64)
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		store(100)
+		bump()
+		done <- load()
+	}()
+	if got := <-done; got != 101 {
+		t.Errorf("other thread: Load = %d, want 101", got)
+	}
+	if got := load(); got != 41 {
+		t.Errorf("after other thread: Load = %d, want 41", got)
+	}
+}
@@ -0,0 +1,35 @@
+//go:build ((linux || freebsd) && (amd64 || arm64)) || (linux && arm)
+
+package ffi
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+)
+
+// tlsSym resolves thread-local symbols with dlsym, which on glibc, musl and
+// FreeBSD returns the address of the calling thread's instance, allocating
+// it first for libraries loaded with dlopen.
+type tlsSym struct {
+	handle unsafe.Pointer
+	name   string
+}
+
+func lookupTLS(handle unsafe.Pointer, name string) (tlsSym, error) {
+	// Resolve once up front so a missing symbol fails the lookup.
+	if _, err := GetSymbol(handle, name); err != nil {
+		return tlsSym{}, err
+	}
+	return tlsSym{handle: handle, name: name}, nil
+}
+
+// addr must run on the thread whose instance is wanted: dl.Dlsym executes on
+// the calling goroutine's OS thread.
+func (s *tlsSym) addr() (unsafe.Pointer, error) {
+	p, err := dl.Dlsym(uintptr(s.handle), s.name)
+	if err != nil {
+		return nil, err
+	}
+	return foreignPointer(p), nil
+}
@@ -0,0 +1,118 @@
+package ffi
+
+import (
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// TraceKind identifies the operation a TraceEvent reports.
+type TraceKind int
+
+const (
+	TraceCall             TraceKind = iota + 1 // A native call returned
+	TraceLoad                                  // LoadLibrary finished
+	TraceUnload                                // FreeLibrary finished
+	TraceSymbol                                // GetSymbol finished
+	TraceCallbackRegister                      // NewCallback handed out a function pointer
+	TraceCallbackRelease                       // A callback's slot was released (FreeCallback or scoped)
+)
+
+// String returns the lower-case name of the kind, e.g. "call".
+func (k TraceKind) String() string {
+	switch k {
+	case TraceCall:
+		return "call"
+	case TraceLoad:
+		return "load"
+	case TraceUnload:
+		return "unload"
+	case TraceSymbol:
+		return "symbol"
+	case TraceCallbackRegister:
+		return "callback_register"
+	case TraceCallbackRelease:
+		return "callback_release"
+	default:
+		return "unknown"
+	}
+}
+
+// TraceEvent describes one traced FFI operation. Fields that do not apply to
+// the kind are zero.
+type TraceEvent struct {
+	Kind     TraceKind
+	Name     string        // Library name (TraceLoad) or symbol name (TraceSymbol)
+	Addr     uintptr       // Function, symbol, library handle or callback pointer
+	Library  uintptr       // Handle of the library searched (TraceSymbol)
+	Args     int           // Number of arguments (TraceCall)
+	Duration time.Duration // Time spent in the operation (TraceCall, TraceLoad)
+	Err      error         // Failure reported by the operation, if any
+}
+
+// TraceHook receives trace events. It runs synchronously on the goroutine
+// that performed the operation, after the operation completed and outside
+// any goffi lock, so it may call back into goffi. Calls made from the hook
+// are traced too. It should return quickly: a slow hook slows down every
+// traced call.
+type TraceHook func(TraceEvent)
+
+var traceHook atomic.Pointer[TraceHook]
+
+// SetTraceHook installs hook for all subsequent FFI activity and returns the
+// previously installed one. A nil hook disables tracing, which then costs a
+// single atomic load per operation. ffi/ffislog adapts a hook to log/slog.
+func SetTraceHook(hook TraceHook) TraceHook {
+	var p *TraceHook
+	if hook != nil {
+		p = &hook
+	}
+	if old := traceHook.Swap(p); old != nil {
+		return *old
+	}
+	return nil
+}
+
+// trace delivers ev to the audit recorder and the installed hook, if any.
+func trace(ev TraceEvent) {
+	if a := auditor.Load(); a != nil {
+		a.record(ev)
+	}
+	if h := traceHook.Load(); h != nil {
+		(*h)(ev)
+	}
+}
+
+// tracing reports whether a hook is installed or an audit is running, for
+// callers that want to skip timing when nobody is listening.
+func tracing() bool {
+	return traceHook.Load() != nil || auditor.Load() != nil
+}
+
+// traceLoad reports a finished LoadLibrary call that started at start. It
+// is deferred with pointers to the call's named results.
+func traceLoad(name string, start time.Time, handle *unsafe.Pointer, err *error) {
+	if tracing() {
+		trace(TraceEvent{Kind: TraceLoad, Name: name, Addr: uintptr(*handle), Duration: time.Since(start), Err: *err})
+	}
+}
+
+// traceSymbol reports a finished GetSymbol call, like traceLoad.
+func traceSymbol(handle unsafe.Pointer, name string, sym *unsafe.Pointer, err *error) {
+	if tracing() {
+		trace(TraceEvent{Kind: TraceSymbol, Name: name, Addr: uintptr(*sym), Library: uintptr(handle), Err: *err})
+	}
+}
+
+// traceUnload reports a finished FreeLibrary call, like traceLoad.
+func traceUnload(handle unsafe.Pointer, err *error) {
+	if tracing() {
+		trace(TraceEvent{Kind: TraceUnload, Addr: uintptr(handle), Err: *err})
+	}
+}
+
+// releaseScopedCallback releases a scoped callback and reports it.
+func releaseScopedCallback(ptr uintptr) {
+	releaseCallback(ptr)
+	trace(TraceEvent{Kind: TraceCallbackRelease, Addr: ptr})
+}
@@ -0,0 +1,76 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestTraceHook(t *testing.T) {
+	var events []TraceEvent
+	prev := SetTraceHook(func(ev TraceEvent) { events = append(events, ev) })
+	defer SetTraceHook(prev)
+
+	lib, sym := testLibAndSymbol()
+	handle, err := LoadLibrary(lib)
+	if err != nil {
+		t.Skipf("cannot load %s: %v", lib, err)
+	}
+	fn, err := GetSymbol(handle, sym)
+	if err != nil {
+		t.Fatalf("GetSymbol(%s): %v", sym, err)
+	}
+
+	cif := &types.CallInterface{}
+	if err := PrepareCallInterface(cif, types.DefaultCall, types.UInt64TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	s := append([]byte("trace"), 0)
+	p := unsafe.Pointer(&s[0])
+	var n uint64
+	if err := CallFunction(cif, fn, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []TraceKind{TraceLoad, TraceSymbol, TraceCall}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %v", len(events), events, want)
+	}
+	for i, k := range want {
+		if events[i].Kind != k {
+			t.Errorf("event %d kind = %v, want %v", i, events[i].Kind, k)
+		}
+	}
+	if events[0].Name != lib || events[0].Addr != uintptr(handle) {
+		t.Errorf("load event = %+v, want name %s, addr %p", events[0], lib, handle)
+	}
+	if events[1].Name != sym || events[1].Addr != uintptr(fn) {
+		t.Errorf("symbol event = %+v, want name %s, addr %p", events[1], sym, fn)
+	}
+	if events[2].Addr != uintptr(fn) || events[2].Args != 1 || events[2].Err != nil {
+		t.Errorf("call event = %+v, want addr %p, 1 arg", events[2], fn)
+	}
+
+	if SetTraceHook(nil) == nil {
+		t.Error("SetTraceHook did not return the installed hook")
+	}
+	if _, err := GetSymbol(handle, sym); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != len(want) {
+		t.Errorf("event delivered after the hook was removed")
+	}
+}
+
+func TestTraceKindString(t *testing.T) {
+	if got := TraceCallbackRegister.String(); got != "callback_register" {
+		t.Errorf("String() = %q, want callback_register", got)
+	}
+	if got := TraceKind(0).String(); got != "unknown" {
+		t.Errorf("String() = %q, want unknown", got)
+	}
+}
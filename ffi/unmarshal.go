@@ -0,0 +1,253 @@
+package ffi
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Unmarshal reads the C object at src, laid out as desc, into the Go value v
+// points to. It is the inverse of Marshal and turns callback payloads and
+// query results into ordinary Go values:
+//
+//	// struct { const char *name; size_t count; const struct limits *items; };
+//	var info struct {
+//	    Name  string
+//	    Items []limits
+//	}
+//	err := ffi.Unmarshal(payload, infoDesc, &info)
+//
+// Fields match members as for Marshal. Everything is copied, so v does not
+// refer to C memory afterwards:
+//
+//   - integer and floating-point members go to Go bool, integer and float
+//     fields; a value the field cannot hold is an error
+//   - pointer members go to strings as copies of the NUL-terminated text
+//     (wchar_t for WString members, up to MaxLen units if set), "" for NULL
+//   - a count member followed by a pointer member goes to a slice of count
+//     elements laid out as the pointer's Elem; a zero count or NULL gives nil
+//   - a pointer *T receives a copy of the pointee, described by Elem; NULL
+//     gives nil
+//   - uintptr and unsafe.Pointer fields receive the pointer itself
+//
+// Function pointers cannot become Go funcs: func fields accept only NULL,
+// which gives nil; use a uintptr field to receive one.
+func Unmarshal(src unsafe.Pointer, desc *types.TypeDescriptor, v any) error {
+	if src == nil {
+		return &InvalidCallInterfaceError{Field: "src", Reason: "must not be nil", Index: -1}
+	}
+	if err := prepareMarshalType("desc", desc); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return &InvalidCallInterfaceError{Field: "v", Reason: "must be a non-nil pointer", Index: -1}
+	}
+	return unmarshalValue(src, desc, rv.Elem())
+}
+
+// unmarshalValue reads p as d into the settable v.
+func unmarshalValue(p unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value) error {
+	switch d.Kind {
+	case types.StructType:
+		if v.Kind() != reflect.Struct {
+			return marshalMismatch(v, d, "struct member needs a Go struct")
+		}
+		bindings, err := bindFields(v.Type(), d)
+		if err != nil {
+			return err
+		}
+		for i := range bindings {
+			b := &bindings[i]
+			fv := b.field(v)
+			if b.countDesc != nil {
+				n := loadInt(unsafe.Add(p, b.countOffset), b.countDesc)
+				err = unmarshalSlice(*(*unsafe.Pointer)(unsafe.Add(p, b.offset)), b.desc, n, fv)
+			} else {
+				err = unmarshalValue(unsafe.Add(p, b.offset), b.desc, fv)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case types.PointerType:
+		return unmarshalPointer(*(*unsafe.Pointer)(p), d, v)
+	default:
+		return unmarshalScalar(p, d, v)
+	}
+}
+
+// unmarshalPointer stores the pointer ptr, a member of type d, into v.
+func unmarshalPointer(ptr unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Uintptr:
+		v.SetUint(uint64(uintptr(ptr)))
+	case reflect.UnsafePointer:
+		v.SetPointer(ptr)
+	case reflect.String:
+		if d.WString {
+			v.SetString(goWideStringN(ptr, d.MaxLen))
+		} else {
+			v.SetString(goStringN(ptr, d.MaxLen))
+		}
+	case reflect.Pointer:
+		if ptr == nil {
+			v.SetZero()
+			return nil
+		}
+		elem, err := elemType(d, v.Type().Elem())
+		if err != nil {
+			return err
+		}
+		pv := reflect.New(v.Type().Elem())
+		if err := unmarshalValue(ptr, elem, pv.Elem()); err != nil {
+			return err
+		}
+		v.Set(pv)
+	case reflect.Slice:
+		return marshalMismatch(v, d, "slice needs a count member before the pointer member")
+	case reflect.Func:
+		if ptr != nil {
+			return marshalMismatch(v, d, "function pointers cannot become Go funcs; use uintptr")
+		}
+		v.SetZero()
+	default:
+		return marshalMismatch(v, d, "cannot hold a pointer member")
+	}
+	return nil
+}
+
+// unmarshalSlice sets the slice v to copies of the n elements of the array
+// at ptr, described by the pointer type d.
+func unmarshalSlice(ptr unsafe.Pointer, d *types.TypeDescriptor, n int64, v reflect.Value) error {
+	if d.Kind != types.PointerType {
+		return marshalMismatch(v, d, "slice needs a pointer member")
+	}
+	if ptr == nil || n <= 0 {
+		v.SetZero()
+		return nil
+	}
+	elem, err := elemType(d, v.Type().Elem())
+	if err != nil {
+		return err
+	}
+	if n > int64(^uint(0)>>1)/int64(elem.Size) {
+		return marshalMismatch(v, d, fmt.Sprintf("element count %d is too large", n))
+	}
+	s := reflect.MakeSlice(v.Type(), int(n), int(n))
+	for i := range int(n) {
+		if err := unmarshalValue(unsafe.Add(ptr, uintptr(i)*elem.Size), elem, s.Index(i)); err != nil {
+			return err
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+// unmarshalScalar reads the integer or floating-point member p of type d
+// into the Go bool, integer or float v.
+func unmarshalScalar(p unsafe.Pointer, d *types.TypeDescriptor, v reflect.Value) error {
+	var f float64
+	isFloat := true
+	switch d.Kind {
+	case types.FloatType:
+		f = float64(*(*float32)(p))
+	case types.DoubleType:
+		f = *(*float64)(p)
+	case types.Float16Type:
+		if v.Kind() == reflect.Uint16 { // types.Float16
+			v.SetUint(uint64(*(*uint16)(p)))
+			return nil
+		}
+		f = float64((*(*types.Float16)(p)).Float32())
+	case types.LongDoubleType:
+		if v.Type() == reflect.TypeFor[types.LongDouble]() {
+			v.Set(reflect.ValueOf(*(*types.LongDouble)(p)))
+			return nil
+		}
+		f = (*(*types.LongDouble)(p)).Float64()
+	case types.SInt128Type, types.UInt128Type:
+		if !isInt128Type(v.Type()) {
+			return marshalMismatch(v, d, "128-bit member needs a types.Int128 or types.UInt128")
+		}
+		x := *(*[2]uint64)(p)
+		v.Field(0).SetUint(x[0])
+		v.Field(1).SetUint(x[1])
+		return nil
+	default:
+		if !isIntegerKind(d.Kind) && d.Kind != types.BoolType {
+			return marshalMismatch(v, d, "unsupported member kind")
+		}
+		isFloat = false
+	}
+
+	if isFloat {
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			v.SetFloat(f)
+			return nil
+		}
+		return marshalMismatch(v, d, "floating-point member needs a Go float")
+	}
+
+	n := loadInt(p, d)
+	if d.Kind == types.BoolType && n != 0 {
+		n = 1
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(n != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !isSignedKind(d.Kind) && n < 0 || v.OverflowInt(n) {
+			return marshalMismatch(v, d, fmt.Sprintf("value %d overflows the Go field", n))
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := uint64(n)
+		if isSignedKind(d.Kind) && n < 0 || v.OverflowUint(u) {
+			return marshalMismatch(v, d, fmt.Sprintf("value %d overflows the Go field", n))
+		}
+		v.SetUint(u)
+	default:
+		return marshalMismatch(v, d, "integer member needs a Go bool or integer")
+	}
+	return nil
+}
+
+// loadInt reads the integer member p of type d, sign-extending signed
+// kinds. Unsigned 64-bit values above math.MaxInt64 come back negative;
+// convert with uint64.
+func loadInt(p unsafe.Pointer, d *types.TypeDescriptor) int64 {
+	signed := isSignedKind(d.Kind)
+	switch d.Size {
+	case 1:
+		if signed {
+			return int64(*(*int8)(p))
+		}
+		return int64(*(*uint8)(p))
+	case 2:
+		if signed {
+			return int64(*(*int16)(p))
+		}
+		return int64(*(*uint16)(p))
+	case 4:
+		if signed {
+			return int64(*(*int32)(p))
+		}
+		return int64(*(*uint32)(p))
+	default:
+		return *(*int64)(p)
+	}
+}
+
+// isSignedKind reports whether k is a signed integer kind.
+func isSignedKind(k types.TypeKind) bool {
+	switch k {
+	case types.SInt8Type, types.SInt16Type, types.SInt32Type, types.SInt64Type, types.IntType:
+		return true
+	}
+	return false
+}
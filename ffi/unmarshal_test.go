@@ -0,0 +1,120 @@
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestUnmarshalRoundTrip(t *testing.T) {
+	// struct {
+	//     const char *name;
+	//     uint32_t count; const struct limits *items;
+	//     const struct limits *best;
+	//     int16_t offsets[2];
+	//     const char *missing;
+	// };
+	desc := &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.PointerTypeDescriptor,
+			types.UInt32TypeDescriptor, types.PointerTo(limitsDesc()),
+			types.PointerTo(limitsDesc()),
+			types.SInt16TypeDescriptor, types.SInt16TypeDescriptor,
+			types.PointerTypeDescriptor,
+		},
+	}
+	type info struct {
+		Name    string
+		Items   []limits
+		Best    *limits
+		Offsets [2]int
+		Missing string
+	}
+	in := info{
+		Name:    "adapter",
+		Items:   []limits{{1, 0.25}, {2, 0.5}, {3, 0.75}},
+		Best:    &limits{8, 4},
+		Offsets: [2]int{-3, 5},
+	}
+
+	arena := NewArena()
+	defer arena.Free()
+	var buf [8]uint64
+	if err := Marshal(unsafe.Pointer(&buf), desc, in, arena); err != nil {
+		t.Fatal(err)
+	}
+	var out info
+	if err := Unmarshal(unsafe.Pointer(&buf), desc, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.Name != in.Name || out.Missing != "" || out.Offsets != in.Offsets {
+		t.Errorf("out = %+v", out)
+	}
+	if len(out.Items) != 3 || out.Items[2] != in.Items[2] {
+		t.Errorf("Items = %+v", out.Items)
+	}
+	if out.Best == nil || *out.Best != *in.Best || out.Best == in.Best {
+		t.Errorf("Best = %v, want a copy of %+v", out.Best, *in.Best)
+	}
+}
+
+func TestUnmarshalNullAndEmpty(t *testing.T) {
+	desc := &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.UInt64TypeDescriptor, types.PointerTo(types.UInt32TypeDescriptor),
+			types.PointerTo(limitsDesc()),
+		},
+	}
+	var buf [3]uint64
+	buf[0] = 5 // a count with a NULL array
+	out := struct {
+		List []uint32
+		One  *limits
+	}{List: []uint32{1}, One: &limits{}}
+	if err := Unmarshal(unsafe.Pointer(&buf), desc, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.List != nil || out.One != nil {
+		t.Errorf("out = %+v, want nil slice and pointer", out)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var tve *TypeValidationError
+	word := [2]uint64{^uint64(0), 0}
+	src := unsafe.Pointer(&word)
+
+	one := func(member *types.TypeDescriptor) *types.TypeDescriptor {
+		return &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{member}}
+	}
+
+	var small struct{ V int8 }
+	if err := Unmarshal(src, one(types.UInt32TypeDescriptor), &small); !errors.As(err, &tve) {
+		t.Errorf("overflow: err = %v", err)
+	}
+	var signed struct{ V int64 }
+	if err := Unmarshal(src, one(types.UInt64TypeDescriptor), &signed); !errors.As(err, &tve) {
+		t.Errorf("uint64 into int64: err = %v", err)
+	}
+	var negative struct{ V uint32 }
+	if err := Unmarshal(src, one(types.SInt32TypeDescriptor), &negative); !errors.As(err, &tve) {
+		t.Errorf("negative into unsigned: err = %v", err)
+	}
+	var fn struct{ F func() }
+	if err := Unmarshal(src, one(types.PointerTypeDescriptor), &fn); !errors.As(err, &tve) {
+		t.Errorf("func field: err = %v", err)
+	}
+	if err := Unmarshal(src, one(types.UInt32TypeDescriptor), small); err == nil {
+		t.Error("non-pointer v: expected an error")
+	}
+
+	var ok struct{ V uint32 }
+	if err := Unmarshal(src, one(types.UInt32TypeDescriptor), &ok); err != nil || ok.V != ^uint32(0) {
+		t.Errorf("V = %d, %v", ok.V, err)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !(((linux || freebsd || darwin) && (amd64 || arm64)) || (linux && arm) || windows || js || wasip1)
+
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnsupportedPlatformStubs(t *testing.T) {
+	_, err := LoadLibrary("libc.so")
+	var perr *UnsupportedPlatformError
+	if !errors.As(err, &perr) {
+		t.Fatalf("LoadLibrary error = %v, want *UnsupportedPlatformError", err)
+	}
+	if _, err := LoadLibraryFS(nil, "lib.so"); !errors.As(err, &perr) {
+		t.Errorf("LoadLibraryFS error = %v, want *UnsupportedPlatformError", err)
+	}
+	if err := FreeLibrary(nil); err != nil {
+		t.Errorf("FreeLibrary(nil) = %v", err)
+	}
+	if _, err := AttachCurrentThread(); !errors.As(err, &perr) {
+		t.Errorf("AttachCurrentThread error = %v, want *UnsupportedPlatformError", err)
+	}
+	if CallbackCount() != 0 {
+		t.Error("callback stubs must report nothing registered")
+	}
+	if !strings.Contains(err.Error(), "libc.so") {
+		t.Errorf("error %q does not name the library", err)
+	}
+}
@@ -0,0 +1,146 @@
+package ffi
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Var is a global variable exported by a native library, typed by a
+// TypeDescriptor: version structs, feature tables, function-pointer dispatch
+// tables and the like. It is only valid while the library stays loaded.
+//
+// Accesses are plain memory reads and writes with no synchronization; if
+// native code modifies the variable concurrently, the library's own locking
+// rules apply.
+type Var struct {
+	name string
+	addr unsafe.Pointer
+	typ  *types.TypeDescriptor
+}
+
+// GetVar resolves the data symbol name in the library handle and describes
+// it with t. Struct descriptors are laid out as for PrepareCallInterface.
+//
+// Example:
+//
+//	// extern const struct { uint32_t major, minor, patch; } lib_version;
+//	version, err := ffi.GetVar(lib, "lib_version", &types.TypeDescriptor{
+//	    Kind:    types.StructType,
+//	    Members: []*types.TypeDescriptor{types.UInt32TypeDescriptor, types.UInt32TypeDescriptor, types.UInt32TypeDescriptor},
+//	})
+//	v, err := ffi.LoadVar[[3]uint32](version)
+func GetVar(handle unsafe.Pointer, name string, t *types.TypeDescriptor) (*Var, error) {
+	if t == nil {
+		return nil, &TypeValidationError{TypeName: "var", Kind: 0, Reason: "type descriptor is nil", Index: -1}
+	}
+	if t.Size == 0 && t.Kind == types.StructType {
+		if err := initializeCompositeType(t); err != nil {
+			return nil, err
+		}
+	}
+	if !isValidType(t) || t.Kind == types.VoidType {
+		return nil, newInvalidTypeError("var", int(t.Kind), "unsupported type kind")
+	}
+
+	addr, err := GetSymbol(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	return &Var{name: name, addr: addr, typ: t}, nil
+}
+
+// Name returns the symbol name of the variable.
+func (v *Var) Name() string {
+	return v.name
+}
+
+// Type returns the descriptor the variable was resolved with.
+func (v *Var) Type() *types.TypeDescriptor {
+	return v.typ
+}
+
+// Addr returns the address of the variable.
+func (v *Var) Addr() unsafe.Pointer {
+	return v.addr
+}
+
+// Load copies the variable into dst, which must hold Type().Size bytes.
+func (v *Var) Load(dst unsafe.Pointer) {
+	copy(unsafe.Slice((*byte)(dst), v.typ.Size), unsafe.Slice((*byte)(v.addr), v.typ.Size))
+}
+
+// Store copies Type().Size bytes from src into the variable. Writing to a
+// variable in a read-only segment (a C const) crashes the process.
+func (v *Var) Store(src unsafe.Pointer) {
+	copy(unsafe.Slice((*byte)(v.addr), v.typ.Size), unsafe.Slice((*byte)(src), v.typ.Size))
+}
+
+// Member returns the address of member i of a struct variable, for example
+// one entry of a function-pointer table.
+func (v *Var) Member(i int) (unsafe.Pointer, error) {
+	offset, err := memberOffset(v.name, v.typ, i)
+	if err != nil {
+		return nil, err
+	}
+	return unsafe.Add(v.addr, offset), nil
+}
+
+// memberOffset returns the offset of member i of the struct t, named name in
+// errors.
+func memberOffset(name string, t *types.TypeDescriptor, i int) (uintptr, error) {
+	if t.Kind != types.StructType {
+		return 0, &TypeValidationError{TypeName: name, Kind: int(t.Kind), Reason: "not a struct", Index: -1}
+	}
+	if i < 0 || i >= len(t.Members) {
+		return 0, &TypeValidationError{
+			TypeName: name,
+			Kind:     int(t.Kind),
+			Reason:   fmt.Sprintf("member index out of range [0, %d)", len(t.Members)),
+			Index:    i,
+		}
+	}
+	var offset uintptr
+	for k, m := range t.Members {
+		offset = align(offset, t.MemberAlignment(m))
+		if k == i {
+			break
+		}
+		offset += m.Size
+	}
+	return offset, nil
+}
+
+// LoadVar returns the value of v as a T, which must have the size of the
+// variable's descriptor.
+func LoadVar[T any](v *Var) (T, error) {
+	var x T
+	if err := checkVarSize(v, unsafe.Sizeof(x), x); err != nil {
+		return x, err
+	}
+	v.Load(unsafe.Pointer(&x))
+	return x, nil
+}
+
+// StoreVar sets v to x, which must have the size of the variable's
+// descriptor.
+func StoreVar[T any](v *Var, x T) error {
+	if err := checkVarSize(v, unsafe.Sizeof(x), x); err != nil {
+		return err
+	}
+	v.Store(unsafe.Pointer(&x))
+	return nil
+}
+
+func checkVarSize(v *Var, size uintptr, x any) error {
+	if size != v.typ.Size {
+		return &TypeValidationError{
+			TypeName: fmt.Sprintf("%T", x),
+			Kind:     int(v.typ.Kind),
+			Reason:   fmt.Sprintf("size %d does not match variable %q of size %d", size, v.name, v.typ.Size),
+			Index:    -1,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestGetVar(t *testing.T) {
+	requireStructLib(t)
+
+	version, err := GetVar(structTestLib, "structtest_version", &types.TypeDescriptor{
+		Kind: types.StructType,
+		Members: []*types.TypeDescriptor{
+			types.UInt16TypeDescriptor, types.UInt32TypeDescriptor, types.UInt8TypeDescriptor,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type libVersion struct {
+		Major uint16
+		Minor uint32
+		Patch uint8
+	}
+	got, err := LoadVar[libVersion](version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (libVersion{1, 22, 3}); got != want {
+		t.Errorf("structtest_version = %+v, want %+v", got, want)
+	}
+	if _, err := LoadVar[uint32](version); err == nil {
+		t.Error("LoadVar with a mismatched size succeeded")
+	}
+
+	counter, err := GetVar(structTestLib, "var_counter", types.SInt64TypeDescriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := StoreVar(counter, int64(77)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Call var_counter_get through the dispatch table to see the store.
+	ptr := types.PointerTypeDescriptor
+	table, err := GetVar(structTestLib, "structtest_dispatch", &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{ptr, ptr},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := table.Member(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.SInt64TypeDescriptor, nil); err != nil {
+		t.Fatal(err)
+	}
+	var n int64
+	if err := CallFunction(&cif, *(*unsafe.Pointer)(entry), unsafe.Pointer(&n), nil); err != nil {
+		t.Fatal(err)
+	}
+	if n != 77 {
+		t.Errorf("dispatch.get() = %d, want 77", n)
+	}
+
+	var tvErr *TypeValidationError
+	if _, err := table.Member(2); !errors.As(err, &tvErr) {
+		t.Errorf("Member(2): err = %v, want *TypeValidationError", err)
+	}
+}
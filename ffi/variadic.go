@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+package ffi
+
+import (
+	"slices"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// promoteVariadic applies C's default argument promotions to the variadic
+// arguments of cif: float and _Float16 are passed as double, and bools and
+// integers narrower than int as int. The callee reads them with va_arg in the
+// promoted types, so passing them as declared hands it the wrong bits.
+//
+// cif and avalue are left as they are; when an argument needs promoting,
+// promoteVariadic returns a copy of each holding the promoted type and value.
+func promoteVariadic(cif *types.CallInterface, avalue []unsafe.Pointer) (*types.CallInterface, []unsafe.Pointer) {
+	if cif.FixedArgCount == 0 {
+		return cif, avalue
+	}
+	promoted := cif
+	for i := cif.FixedArgCount; i < len(cif.ArgTypes) && i < len(avalue); i++ {
+		var (
+			typ *types.TypeDescriptor
+			arg unsafe.Pointer
+		)
+		switch cif.ArgTypes[i].Kind {
+		case types.FloatType:
+			v := float64(*(*float32)(avalue[i]))
+			typ, arg = types.DoubleTypeDescriptor, unsafe.Pointer(&v)
+		case types.Float16Type:
+			v := float64((*(*types.Float16)(avalue[i])).Float32())
+			typ, arg = types.DoubleTypeDescriptor, unsafe.Pointer(&v)
+		case types.BoolType:
+			var v int32
+			if *(*bool)(avalue[i]) {
+				v = 1
+			}
+			typ, arg = types.SInt32TypeDescriptor, unsafe.Pointer(&v)
+		case types.SInt8Type:
+			v := int32(*(*int8)(avalue[i]))
+			typ, arg = types.SInt32TypeDescriptor, unsafe.Pointer(&v)
+		case types.UInt8Type:
+			v := int32(*(*uint8)(avalue[i]))
+			typ, arg = types.SInt32TypeDescriptor, unsafe.Pointer(&v)
+		case types.SInt16Type:
+			v := int32(*(*int16)(avalue[i]))
+			typ, arg = types.SInt32TypeDescriptor, unsafe.Pointer(&v)
+		case types.UInt16Type:
+			v := int32(*(*uint16)(avalue[i]))
+			typ, arg = types.SInt32TypeDescriptor, unsafe.Pointer(&v)
+		default:
+			continue
+		}
+		if promoted == cif {
+			promoted = cif.Clone()
+			avalue = slices.Clone(avalue)
+		}
+		promoted.ArgTypes[i] = typ
+		avalue[i] = arg
+	}
+	return promoted, avalue
+}
@@ -6,6 +6,7 @@
 package ffi
 
 import (
+	"runtime"
 	"testing"
 	"unsafe"
 
@@ -187,3 +188,74 @@ func TestVariadic_ErrorValidation(t *testing.T) {
 		}
 	})
 }
+
+// TestVariadic_Promotions calls snprintf with float32, int8 and uint16
+// variadic arguments, which must reach it promoted to double and int.
+func TestVariadic_Promotions(t *testing.T) {
+	var libName string
+	switch runtime.GOOS {
+	case "linux":
+		libName = "libc.so.6"
+	case "darwin":
+		libName = "libSystem.B.dylib"
+	case "windows":
+		libName = "msvcrt.dll"
+	default:
+		t.Skip("no C library to call snprintf from")
+	}
+	handle, err := LoadLibrary(libName)
+	if err != nil {
+		t.Skipf("LoadLibrary(%s): %v", libName, err)
+	}
+	defer FreeLibrary(handle)
+	name := "snprintf"
+	if runtime.GOOS == "windows" {
+		name = "_snprintf"
+	}
+	sym, err := GetSymbol(handle, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cif types.CallInterface
+	if err := PrepareVariadicCallInterface(&cif, types.DefaultCall, 3,
+		types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{
+			types.PointerTypeDescriptor, // buf
+			types.UInt64TypeDescriptor,  // n
+			types.PointerTypeDescriptor, // format
+			types.FloatTypeDescriptor,
+			types.SInt8TypeDescriptor,
+			types.UInt16TypeDescriptor,
+			types.FloatTypeDescriptor,
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 64)
+	bufPtr := unsafe.Pointer(&buf[0])
+	size := uint64(len(buf))
+	format := unsafe.Pointer(unsafe.StringData("%.2f %d %d %.1f\x00"))
+	f1, i8, u16, f2 := float32(2.5), int8(-7), uint16(65535), float32(-0.5)
+	var written int32
+	if err := CallFunction(&cif, sym, unsafe.Pointer(&written), []unsafe.Pointer{
+		unsafe.Pointer(&bufPtr),
+		unsafe.Pointer(&size),
+		unsafe.Pointer(&format),
+		unsafe.Pointer(&f1),
+		unsafe.Pointer(&i8),
+		unsafe.Pointer(&u16),
+		unsafe.Pointer(&f2),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	const want = "2.50 -7 65535 -0.5"
+	if got := string(buf[:max(written, 0)]); got != want {
+		t.Errorf("snprintf = %q, want %q", got, want)
+	}
+	if cif.ArgTypes[3] != types.FloatTypeDescriptor {
+		t.Error("call changed the declared argument types of the call interface")
+	}
+}
@@ -0,0 +1,124 @@
+// Package vdso resolves and calls functions exported by the Linux vDSO, the
+// small shared object the kernel maps into every process. Calls such as
+// clock_gettime run entirely in user space through it, without a system call
+// and without loading the C runtime.
+//
+// Lookup returns the address of any vDSO function, for use with
+// ffi.CallFunction like a symbol from GetSymbol. ClockGettime and Getcpu are
+// bound on first use:
+//
+//	var ts vdso.Timespec
+//	if err := vdso.ClockGettime(vdso.ClockMonotonic, &ts); err != nil {
+//	    return err
+//	}
+//
+// The vDSO is located through the auxiliary vector passed by the kernel, so
+// no file system access is needed. The package supports linux/amd64 and
+// linux/arm64; elsewhere every function returns an error wrapping
+// *ffi.UnsupportedPlatformError.
+package vdso
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Clock IDs for ClockGettime, from <linux/time.h>.
+const (
+	ClockRealtime        int32 = 0
+	ClockMonotonic       int32 = 1
+	ClockMonotonicRaw    int32 = 4
+	ClockRealtimeCoarse  int32 = 5
+	ClockMonotonicCoarse int32 = 6
+	ClockBoottime        int32 = 7
+)
+
+// Timespec is the C struct timespec of the 64-bit Linux ABIs.
+type Timespec struct {
+	Sec  int64
+	Nsec int64
+}
+
+// Lookup returns the address of the vDSO function name. The plain C name
+// ("clock_gettime") is enough: the architecture's internal prefixes
+// (__vdso_ on amd64, __kernel_ on arm64) are tried as well. A missing
+// function is reported as an *ffi.LibraryError.
+func Lookup(name string) (unsafe.Pointer, error) {
+	for _, candidate := range []string{name, "__vdso_" + name, "__kernel_" + name} {
+		addr, err := lookup(candidate)
+		if err != nil {
+			return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: err}
+		}
+		if addr != 0 {
+			return *(*unsafe.Pointer)(unsafe.Pointer(&addr)), nil
+		}
+	}
+	return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: errNotExported}
+}
+
+// binding is one vDSO function bound on first use.
+type binding struct {
+	once sync.Once
+	fn   unsafe.Pointer
+	cif  types.CallInterface
+	err  error
+}
+
+func (b *binding) bind(name string, ret *types.TypeDescriptor, args ...*types.TypeDescriptor) error {
+	b.once.Do(func() {
+		if b.fn, b.err = Lookup(name); b.err != nil {
+			return
+		}
+		b.err = ffi.PrepareCallInterface(&b.cif, types.DefaultCall, ret, args)
+	})
+	return b.err
+}
+
+var clockGettime, getcpu binding
+
+// ClockGettime stores the time of clock clockID in ts, like clock_gettime(2).
+// A failure reported by the kernel is returned as an ffi.Errno.
+func ClockGettime(clockID int32, ts *Timespec) error {
+	ptr := types.PointerTypeDescriptor
+	if err := clockGettime.bind("clock_gettime", types.SInt32TypeDescriptor, types.SInt32TypeDescriptor, ptr); err != nil {
+		return err
+	}
+	var ret int32
+	err := ffi.CallFunction(&clockGettime.cif, clockGettime.fn, unsafe.Pointer(&ret),
+		[]unsafe.Pointer{unsafe.Pointer(&clockID), unsafe.Pointer(&ts)})
+	if err != nil {
+		return err
+	}
+	return errnoResult(ret)
+}
+
+// Getcpu returns the CPU and NUMA node the calling thread is running on,
+// like getcpu(2). The result may be stale as soon as it is returned unless
+// the thread is pinned. Not every architecture's vDSO exports getcpu; arm64
+// does not, and then Getcpu returns the lookup error.
+func Getcpu() (cpu, node uint32, err error) {
+	ptr := types.PointerTypeDescriptor
+	if err := getcpu.bind("getcpu", types.SInt32TypeDescriptor, ptr, ptr, ptr); err != nil {
+		return 0, 0, err
+	}
+	var ret int32
+	cpuPtr, nodePtr, cache := unsafe.Pointer(&cpu), unsafe.Pointer(&node), unsafe.Pointer(nil)
+	err = ffi.CallFunction(&getcpu.cif, getcpu.fn, unsafe.Pointer(&ret),
+		[]unsafe.Pointer{unsafe.Pointer(&cpuPtr), unsafe.Pointer(&nodePtr), unsafe.Pointer(&cache)})
+	if err != nil {
+		return 0, 0, err
+	}
+	return cpu, node, errnoResult(ret)
+}
+
+// errnoResult converts the return value of a vDSO function, which falls back
+// to the raw system call and so reports failure as -errno.
+func errnoResult(ret int32) error {
+	if ret < 0 {
+		return ffi.Errno(-ret)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+//go:build linux && (amd64 || arm64)
+
+package vdso
+
+import (
+	"debug/elf"
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+//go:linkname runtime_getAuxv runtime.getAuxv
+func runtime_getAuxv() []uintptr
+
+// atSysinfoEhdr is the auxiliary vector entry holding the vDSO base address.
+const atSysinfoEhdr = 33
+
+var (
+	errNoVDSO      = errors.New("goffi: no vDSO mapped in this process")
+	errBadImage    = errors.New("goffi: unrecognized vDSO image")
+	errNotExported = errors.New("goffi: not exported by the vDSO")
+)
+
+var (
+	tableOnce sync.Once
+	table     map[string]uintptr
+	tableErr  error
+)
+
+// lookup returns the address of the exported function name, or zero.
+func lookup(name string) (uintptr, error) {
+	tableOnce.Do(func() {
+		table, tableErr = loadTable()
+	})
+	return table[name], tableErr
+}
+
+// loadTable reads the dynamic symbol table of the mapped vDSO.
+func loadTable() (map[string]uintptr, error) {
+	auxv := runtime_getAuxv()
+	for i := 0; i+1 < len(auxv); i += 2 {
+		if auxv[i] == atSysinfoEhdr && auxv[i+1] != 0 {
+			return parseImage(auxv[i+1])
+		}
+	}
+	return nil, errNoVDSO
+}
+
+// at returns a pointer to the T at address addr of the vDSO mapping.
+func at[T any](addr uintptr) *T {
+	return *(**T)(unsafe.Pointer(&addr))
+}
+
+// parseImage collects the global and weak functions of the ELF64 image
+// mapped at base. Like the runtime's own vDSO parser it relies only on the
+// dynamic segment, since section headers need not be mapped.
+func parseImage(base uintptr) (map[string]uintptr, error) {
+	hdr := at[elf.Header64](base)
+	if string(hdr.Ident[:4]) != elf.ELFMAG || elf.Class(hdr.Ident[elf.EI_CLASS]) != elf.ELFCLASS64 {
+		return nil, errBadImage
+	}
+
+	var bias, dynamic uintptr
+	loaded := false
+	for i := range uintptr(hdr.Phnum) {
+		p := at[elf.Prog64](base + uintptr(hdr.Phoff) + i*uintptr(hdr.Phentsize))
+		switch elf.ProgType(p.Type) {
+		case elf.PT_LOAD:
+			if !loaded {
+				bias = base + uintptr(p.Off) - uintptr(p.Vaddr)
+				loaded = true
+			}
+		case elf.PT_DYNAMIC:
+			dynamic = base + uintptr(p.Off)
+		}
+	}
+	if !loaded || dynamic == 0 {
+		return nil, errBadImage
+	}
+
+	var strtab, symtab, hash, gnuHash uintptr
+	for d := dynamic; ; d += unsafe.Sizeof(elf.Dyn64{}) {
+		dyn := at[elf.Dyn64](d)
+		if elf.DynTag(dyn.Tag) == elf.DT_NULL {
+			break
+		}
+		addr := bias + uintptr(dyn.Val)
+		switch elf.DynTag(dyn.Tag) {
+		case elf.DT_STRTAB:
+			strtab = addr
+		case elf.DT_SYMTAB:
+			symtab = addr
+		case elf.DT_HASH:
+			hash = addr
+		case elf.DT_GNU_HASH:
+			gnuHash = addr
+		}
+	}
+	if strtab == 0 || symtab == 0 || (hash == 0 && gnuHash == 0) {
+		return nil, errBadImage
+	}
+
+	var count uintptr
+	if hash != 0 {
+		count = uintptr(*at[uint32](hash + 4)) // nchain
+	} else {
+		count = gnuSymbolCount(gnuHash)
+	}
+
+	syms := make(map[string]uintptr)
+	for i := range count {
+		sym := at[elf.Sym64](symtab + i*unsafe.Sizeof(elf.Sym64{}))
+		// STT_GNU_IFUNC entries are skipped along with everything else that
+		// is not STT_FUNC: their value is a resolver, not the function.
+		bind, typ := elf.ST_BIND(sym.Info), elf.ST_TYPE(sym.Info)
+		if typ != elf.STT_FUNC || (bind != elf.STB_GLOBAL && bind != elf.STB_WEAK) ||
+			elf.SectionIndex(sym.Shndx) == elf.SHN_UNDEF {
+			continue
+		}
+		syms[cString(strtab+uintptr(sym.Name))] = bias + uintptr(sym.Value)
+	}
+	return syms, nil
+}
+
+// gnuSymbolCount derives the number of symbols from a DT_GNU_HASH table,
+// which, unlike DT_HASH, does not record it: it is one past the last
+// symbol of the highest bucket's chain.
+func gnuSymbolCount(table uintptr) uintptr {
+	nbuckets := uintptr(*at[uint32](table))
+	symoffset := uintptr(*at[uint32](table + 4))
+	bloomSize := uintptr(*at[uint32](table + 8))
+	buckets := table + 16 + bloomSize*8
+	chain := buckets + nbuckets*4
+
+	var last uintptr
+	for i := range nbuckets {
+		last = max(last, uintptr(*at[uint32](buckets + i*4)))
+	}
+	if last < symoffset {
+		return symoffset
+	}
+	for *at[uint32](chain + (last-symoffset)*4)&1 == 0 {
+		last++
+	}
+	return last + 1
+}
+
+// cString returns the NUL-terminated string at addr.
+func cString(addr uintptr) string {
+	n := uintptr(0)
+	for *at[byte](addr + n) != 0 {
+		n++
+	}
+	return string(unsafe.Slice(at[byte](addr), n))
+}
@@ -0,0 +1,16 @@
+//go:build !(linux && (amd64 || arm64))
+
+package vdso
+
+import (
+	"errors"
+	"runtime"
+
+	"github.com/go-webgpu/goffi/ffi"
+)
+
+var errNotExported = errors.New("goffi: not exported by the vDSO")
+
+func lookup(string) (uintptr, error) {
+	return 0, &ffi.UnsupportedPlatformError{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
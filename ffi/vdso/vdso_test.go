@@ -0,0 +1,72 @@
+//go:build linux && (amd64 || arm64)
+
+package vdso_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/vdso"
+)
+
+func TestLookup(t *testing.T) {
+	fn, err := vdso.Lookup("clock_gettime")
+	if err != nil || fn == nil {
+		t.Fatalf("Lookup(clock_gettime) = %p, %v", fn, err)
+	}
+
+	_, err = vdso.Lookup("no_such_function")
+	var le *ffi.LibraryError
+	if !errors.As(err, &le) || le.Operation != "symbol" || le.Name != "no_such_function" {
+		t.Errorf("err = %v, want *ffi.LibraryError for the missing symbol", err)
+	}
+}
+
+func TestClockGettime(t *testing.T) {
+	var ts vdso.Timespec
+	before := time.Now()
+	if err := vdso.ClockGettime(vdso.ClockRealtime, &ts); err != nil {
+		t.Fatal(err)
+	}
+	got := time.Unix(ts.Sec, ts.Nsec)
+	if d := got.Sub(before); d < -time.Second || d > time.Second {
+		t.Errorf("realtime clock = %v, time.Now = %v", got, before)
+	}
+
+	var a, b vdso.Timespec
+	if err := vdso.ClockGettime(vdso.ClockMonotonic, &a); err != nil {
+		t.Fatal(err)
+	}
+	if err := vdso.ClockGettime(vdso.ClockMonotonic, &b); err != nil {
+		t.Fatal(err)
+	}
+	if b.Sec < a.Sec || (b.Sec == a.Sec && b.Nsec < a.Nsec) {
+		t.Errorf("monotonic clock went backwards: %v then %v", a, b)
+	}
+}
+
+func TestClockGettimeInvalidClock(t *testing.T) {
+	var ts vdso.Timespec
+	if err := vdso.ClockGettime(-100, &ts); !errors.Is(err, ffi.Errno(22)) { // EINVAL
+		t.Errorf("err = %v, want EINVAL", err)
+	}
+}
+
+func TestGetcpu(t *testing.T) {
+	cpu, _, err := vdso.Getcpu()
+	if runtime.GOARCH == "arm64" {
+		if err == nil {
+			t.Log("vDSO exports getcpu on this arm64 kernel")
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cpu > 1<<16 {
+		t.Errorf("cpu = %d, implausibly large", cpu)
+	}
+}
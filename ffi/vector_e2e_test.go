@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestVector(t *testing.T) {
+	requireStructLib(t)
+
+	t.Run("float32x4", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "v4f_madd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.Float32x4TypeDescriptor, []*types.TypeDescriptor{
+			types.Float32x4TypeDescriptor, types.Float32x4TypeDescriptor, types.FloatTypeDescriptor,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		a, b, s := [4]float32{1, 2, 3, 4}, [4]float32{10, 20, 30, 40}, float32(0.5)
+		var got [4]float32
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+			[]unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&s)}); err != nil {
+			t.Fatal(err)
+		}
+		if want := [4]float32{10.5, 40.5, 90.5, 160.5}; got != want {
+			t.Errorf("v4f_madd = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("float64x2 after a double", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "v2d_dot")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.DoubleTypeDescriptor, []*types.TypeDescriptor{
+			types.DoubleTypeDescriptor, types.Float64x2TypeDescriptor, types.Float64x2TypeDescriptor,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		pad, a, b := 0.25, [2]float64{3, 5}, [2]float64{7, 11}
+		var got float64
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got),
+			[]unsafe.Pointer{unsafe.Pointer(&pad), unsafe.Pointer(&a), unsafe.Pointer(&b)}); err != nil {
+			t.Fatal(err)
+		}
+		if got != 76.25 {
+			t.Errorf("v2d_dot = %v, want 76.25", got)
+		}
+	})
+
+	t.Run("stack slot", func(t *testing.T) {
+		sym, err := GetSymbol(structTestLib, "v4i_sum9")
+		if err != nil {
+			t.Fatal(err)
+		}
+		argTypes := slices.Repeat([]*types.TypeDescriptor{types.Int32x4TypeDescriptor}, 9)
+		vecs := make([][4]int32, 9)
+		avalue := make([]unsafe.Pointer, 9)
+		for i := range vecs {
+			vecs[i] = [4]int32{int32(i), 1, -int32(i), 1 << i}
+			avalue[i] = unsafe.Pointer(&vecs[i])
+		}
+		var cif types.CallInterface
+		if err := PrepareCallInterface(&cif, types.DefaultCall, types.Int32x4TypeDescriptor, argTypes); err != nil {
+			t.Fatal(err)
+		}
+		var got [4]int32
+		if err := CallFunction(&cif, sym, unsafe.Pointer(&got), avalue); err != nil {
+			t.Fatal(err)
+		}
+		if want := [4]int32{828, 108, -828, 25855}; got != want {
+			t.Errorf("v4i_sum9 = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestVectorRejected(t *testing.T) {
+	tests := []struct {
+		name string
+		ret  *types.TypeDescriptor
+	}{
+		{"struct member", &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.Float32x4TypeDescriptor}}},
+		{"8 bytes", types.VectorOf(types.FloatTypeDescriptor, 2)},
+		{"no lane type", &types.TypeDescriptor{Size: 16, Alignment: 16, Kind: types.VectorType}},
+	}
+	if runtime.GOARCH == "amd64" {
+		tests = append(tests, struct {
+			name string
+			ret  *types.TypeDescriptor
+		}{"Win64", types.Float32x4TypeDescriptor})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := types.DefaultCall
+			if tt.name == "Win64" {
+				conv = types.WindowsCallingConvention
+			}
+			var cif types.CallInterface
+			err := PrepareCallInterface(&cif, conv, tt.ret, nil)
+			var tve *TypeValidationError
+			if !errors.As(err, &tve) {
+				t.Fatalf("PrepareCallInterface = %v, want a *TypeValidationError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package ffi
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// VTable is a C table of function pointers, such as a driver interface or a
+// plugin API table, implemented by Go functions. NewVTable registers one
+// callback per function and fills the table; Release unregisters them all
+// together, so the trampolines live exactly as long as the table:
+//
+//	// struct allocator { uint32_t version;
+//	//     void *(*alloc)(void *ctx, size_t n); void (*free)(void *ctx, void *p); };
+//	type allocator struct {
+//	    Version uint32
+//	    Alloc   func(ctx unsafe.Pointer, n uintptr) unsafe.Pointer
+//	    Free    func(ctx, p unsafe.Pointer)
+//	}
+//	vt, err := ffi.NewVTable(nil, &allocator{Version: 2, Alloc: myAlloc, Free: myFree})
+//	...
+//	defer vt.Release()
+//	// pass vt.Ptr() to the library
+type VTable struct {
+	ptr   unsafe.Pointer
+	desc  *types.TypeDescriptor
+	arena *Arena
+}
+
+// NewVTable builds the C table laid out as desc from impl, a Go struct (or
+// pointer to one) whose func fields become callbacks. Fields match members
+// as for Marshal, so tables may also hold data members such as a version or
+// struct size, and a nil func field becomes NULL. A nil desc is derived from
+// impl's type with DescribeStruct.
+//
+// If building the table fails, the callbacks registered so far are released.
+func NewVTable(desc *types.TypeDescriptor, impl any) (*VTable, error) {
+	rv := reflect.ValueOf(impl)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, &InvalidCallInterfaceError{Field: "impl", Reason: "must be a struct or a pointer to one", Index: -1}
+	}
+	if desc == nil {
+		var err error
+		if desc, err = DescribeStruct(rv.Type()); err != nil {
+			return nil, err
+		}
+	}
+	if err := prepareMarshalType("desc", desc); err != nil {
+		return nil, err
+	}
+
+	arena := NewArena()
+	ptr := arena.Alloc(desc.Size, desc.Alignment)
+	if err := Marshal(ptr, desc, rv.Interface(), arena); err != nil {
+		arena.Free()
+		return nil, err
+	}
+	return &VTable{ptr: ptr, desc: desc, arena: arena}, nil
+}
+
+// Ptr returns the address of the table, to hand to native code. It is nil
+// after Release.
+func (v *VTable) Ptr() unsafe.Pointer {
+	return v.ptr
+}
+
+// Desc returns the layout of the table.
+func (v *VTable) Desc() *types.TypeDescriptor {
+	return v.desc
+}
+
+// Release unregisters the table's callbacks and frees it. Native code must
+// no longer use the table or any function pointer read from it. Further
+// calls do nothing.
+func (v *VTable) Release() {
+	if v.ptr == nil {
+		return
+	}
+	v.ptr = nil
+	v.arena.Free()
+}
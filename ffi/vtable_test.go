@@ -0,0 +1,85 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestVTable(t *testing.T) {
+	type plugin struct {
+		Version uint32
+		Add     func(a, b int32) int32
+		Neg     func(a int32) int32
+		Unused  func()
+	}
+	before := CallbackCount()
+	vt, err := NewVTable(nil, &plugin{
+		Version: 3,
+		Add:     func(a, b int32) int32 { return a + b },
+		Neg:     func(a int32) int32 { return -a },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := CallbackCount() - before; got != 2 {
+		t.Errorf("registered %d callbacks, want 2", got)
+	}
+
+	// C: struct { uint32_t version; int32_t (*add)(int32_t, int32_t); int32_t (*neg)(int32_t); void (*unused)(void); }
+	table := (*struct {
+		version          uint32
+		add, neg, unused uintptr
+	})(vt.Ptr())
+	if table.version != 3 || table.unused != 0 {
+		t.Errorf("version = %d, unused = %#x; want 3, NULL", table.version, table.unused)
+	}
+	invoke := func(fn uintptr, args ...uintptr) int32 {
+		var frame [128]uintptr
+		for i, a := range args {
+			frame[callbackIntRegIndex(i)] = a
+		}
+		a := &callbackArgs{index: callbackIndex(fn), args: unsafe.Pointer(&frame)}
+		callbackWrap(a)
+		return int32(a.result)
+	}
+	if r := invoke(table.add, 2, 3); r != 5 {
+		t.Errorf("add(2, 3) = %d", r)
+	}
+	if r := invoke(table.neg, 7); r != -7 {
+		t.Errorf("neg(7) = %d", r)
+	}
+
+	vt.Release()
+	vt.Release()
+	if vt.Ptr() != nil {
+		t.Error("Ptr not nil after Release")
+	}
+	if got := CallbackCount(); got != before {
+		t.Errorf("CallbackCount = %d after Release, want %d", got, before)
+	}
+}
+
+func TestVTableErrors(t *testing.T) {
+	var icie *InvalidCallInterfaceError
+	if _, err := NewVTable(nil, func() {}); !errors.As(err, &icie) {
+		t.Errorf("non-struct impl: err = %v", err)
+	}
+	before := CallbackCount()
+	// F is registered before the chan field fails to marshal.
+	desc := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		types.PointerTypeDescriptor, types.PointerTypeDescriptor}}
+	if _, err := NewVTable(desc, struct {
+		F func()
+		C chan int
+	}{F: func() {}}); err == nil {
+		t.Error("chan field accepted")
+	}
+	if CallbackCount() != before {
+		t.Error("failed NewVTable leaked callbacks")
+	}
+}
@@ -0,0 +1,333 @@
+package wasm
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// call is the state of one CallFunction: linear memory allocated for struct
+// arguments and results, freed by release.
+type call struct {
+	mod    *Module
+	ctx    context.Context
+	malloc Function
+	allocs []uint32
+}
+
+func (c *call) run(sym *symbol, cif *types.CallInterface, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	rt := cif.ReturnType
+	var params []uint64
+
+	// Structs that are not a single scalar come back through memory: the
+	// callee takes a pointer to the result as its first parameter.
+	var sret uint32
+	if rt.Kind == types.StructType && scalarOf(rt) == nil && rt.Size > 0 {
+		size, align := layout(rt)
+		var err error
+		if sret, err = c.alloc(size, align); err != nil {
+			return err
+		}
+		params = append(params, uint64(sret))
+	}
+
+	for i, t := range cif.ArgTypes {
+		if avalue[i] == nil {
+			return &ffi.InvalidCallInterfaceError{Field: "avalue", Reason: "argument pointer must not be nil", Index: i}
+		}
+		v, ok, err := c.arg(t, avalue[i])
+		if err != nil {
+			return fmt.Errorf("goffi: wasm: argument %d: %w", i, err)
+		}
+		if ok {
+			params = append(params, v)
+		}
+	}
+
+	results, err := sym.fn(c.ctx, params...)
+	if err != nil {
+		return fmt.Errorf("goffi: wasm: %s: %w", sym.name, err)
+	}
+	if rvalue == nil || rt.Kind == types.VoidType {
+		return nil
+	}
+
+	if sret != 0 {
+		size, _ := layout(rt)
+		b, err := c.read(sret, size)
+		if err != nil {
+			return err
+		}
+		fromWasm(unsafe.Slice((*byte)(rvalue), rt.Size), b, rt)
+		return nil
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("goffi: wasm: %s returned no value", sym.name)
+	}
+	if rt.CString || rt.WString {
+		s, err := c.readString(uint32(results[0]), rt)
+		if err != nil {
+			return err
+		}
+		*(*string)(rvalue) = s
+		return nil
+	}
+	t := rt
+	if s := scalarOf(rt); s != nil {
+		t = s
+	}
+	return storeScalar(rvalue, t, results[0])
+}
+
+// arg converts one argument. ok is false for empty structs, which the wasm
+// ABI drops.
+func (c *call) arg(t *types.TypeDescriptor, p unsafe.Pointer) (v uint64, ok bool, err error) {
+	if t.Kind != types.StructType {
+		v, err = loadScalar(p, t)
+		return v, err == nil, err
+	}
+	if s := scalarOf(t); s != nil {
+		v, err = loadScalar(p, s) // a lone scalar sits at offset 0
+		return v, err == nil, err
+	}
+	if t.Size == 0 {
+		return 0, false, nil
+	}
+	size, align := layout(t)
+	addr, err := c.alloc(size, align)
+	if err != nil {
+		return 0, false, err
+	}
+	b := make([]byte, size)
+	toWasm(b, unsafe.Slice((*byte)(p), t.Size), t)
+	if !c.mod.Memory.Write(addr, b) {
+		return 0, false, fmt.Errorf("struct copy at %#x out of memory bounds", addr)
+	}
+	return uint64(addr), true, nil
+}
+
+// alloc reserves size bytes of linear memory with the module's exported
+// malloc.
+func (c *call) alloc(size, align uint32) (uint32, error) {
+	if c.mod.Memory == nil {
+		return 0, fmt.Errorf("goffi: wasm: struct passing needs the module's memory")
+	}
+	if c.malloc == nil {
+		if c.malloc = c.mod.Func("malloc"); c.malloc == nil {
+			return 0, fmt.Errorf("goffi: wasm: struct passing needs an exported malloc")
+		}
+	}
+	res, err := c.malloc(c.ctx, uint64(size+align))
+	if err != nil {
+		return 0, fmt.Errorf("goffi: wasm: malloc: %w", err)
+	}
+	if len(res) == 0 || uint32(res[0]) == 0 {
+		return 0, fmt.Errorf("goffi: wasm: malloc(%d) failed", size+align)
+	}
+	addr := uint32(res[0])
+	c.allocs = append(c.allocs, addr)
+	return (addr + align - 1) &^ (align - 1), nil
+}
+
+// release frees the memory reserved by alloc, if the module exports free.
+func (c *call) release() {
+	if len(c.allocs) == 0 {
+		return
+	}
+	free := c.mod.Func("free")
+	if free == nil {
+		return
+	}
+	for _, addr := range c.allocs {
+		_, _ = free(c.ctx, uint64(addr))
+	}
+}
+
+func (c *call) read(addr, n uint32) ([]byte, error) {
+	b, ok := c.mod.Memory.Read(addr, n)
+	if !ok {
+		return nil, fmt.Errorf("goffi: wasm: read of %d bytes at %#x out of memory bounds", n, addr)
+	}
+	return b, nil
+}
+
+// readString decodes a NUL-terminated char or 32-bit wchar_t string.
+func (c *call) readString(addr uint32, t *types.TypeDescriptor) (string, error) {
+	if addr == 0 {
+		return "", nil
+	}
+	if c.mod.Memory == nil {
+		return "", fmt.Errorf("goffi: wasm: string return needs the module's memory")
+	}
+	unit := uint32(1)
+	if t.WString {
+		unit = 4
+	}
+	var out []byte
+	for n := 0; t.MaxLen <= 0 || n < t.MaxLen; n++ {
+		b, err := c.read(addr+uint32(n)*unit, unit)
+		if err != nil {
+			return "", err
+		}
+		if t.WString {
+			r := rune(binary.LittleEndian.Uint32(b))
+			if r == 0 {
+				break
+			}
+			out = utf8.AppendRune(out, r)
+		} else {
+			if b[0] == 0 {
+				break
+			}
+			out = append(out, b[0])
+		}
+	}
+	return string(out), nil
+}
+
+// scalarOf returns the only scalar inside a struct made of exactly one
+// (possibly nested) member, which the wasm ABI passes like the scalar itself.
+func scalarOf(t *types.TypeDescriptor) *types.TypeDescriptor {
+	for t.Kind == types.StructType {
+		if len(t.Members) != 1 {
+			return nil
+		}
+		t = t.Members[0]
+	}
+	return t
+}
+
+// loadScalar encodes the host value at p as a wasm value.
+func loadScalar(p unsafe.Pointer, t *types.TypeDescriptor) (uint64, error) {
+	switch t.Kind {
+	case types.SInt8Type:
+		return uint64(uint32(int32(*(*int8)(p)))), nil
+	case types.UInt8Type, types.BoolType:
+		return uint64(*(*uint8)(p)), nil
+	case types.SInt16Type:
+		return uint64(uint32(int32(*(*int16)(p)))), nil
+	case types.UInt16Type:
+		return uint64(*(*uint16)(p)), nil
+	case types.IntType, types.SInt32Type, types.UInt32Type, types.FloatType:
+		return uint64(*(*uint32)(p)), nil
+	case types.SInt64Type, types.UInt64Type, types.DoubleType:
+		return *(*uint64)(p), nil
+	case types.PointerType:
+		addr := *(*uintptr)(p)
+		if addr > math.MaxUint32 {
+			return 0, fmt.Errorf("pointer %#x is not a wasm32 address", addr)
+		}
+		return uint64(addr), nil
+	}
+	return 0, &ffi.TypeValidationError{TypeName: "wasm argument", Kind: int(t.Kind), Reason: "unsupported by the wasm backend", Index: -1}
+}
+
+// storeScalar decodes the wasm value v into the host value at p.
+func storeScalar(p unsafe.Pointer, t *types.TypeDescriptor, v uint64) error {
+	switch t.Kind {
+	case types.SInt8Type, types.UInt8Type:
+		*(*uint8)(p) = uint8(v)
+	case types.BoolType:
+		*(*bool)(p) = uint8(v) != 0
+	case types.SInt16Type, types.UInt16Type:
+		*(*uint16)(p) = uint16(v)
+	case types.IntType, types.SInt32Type, types.UInt32Type, types.FloatType:
+		*(*uint32)(p) = uint32(v)
+	case types.SInt64Type, types.UInt64Type, types.DoubleType:
+		*(*uint64)(p) = v
+	case types.PointerType:
+		*(*uintptr)(p) = uintptr(uint32(v))
+	default:
+		return &ffi.TypeValidationError{TypeName: "wasm return", Kind: int(t.Kind), Reason: "unsupported by the wasm backend", Index: -1}
+	}
+	return nil
+}
+
+// layout returns the wasm32 size and alignment of t.
+func layout(t *types.TypeDescriptor) (size, align uint32) {
+	switch t.Kind {
+	case types.PointerType:
+		return 4, 4
+	case types.StructType:
+		align = 1
+		for _, m := range t.Members {
+			ms, ma := memberLayout(t, m)
+			size = alignUp(size, ma) + ms
+			align = max(align, ma)
+		}
+		return alignUp(size, align), align
+	}
+	return uint32(t.Size), uint32(t.Alignment)
+}
+
+// memberLayout returns the wasm32 size and alignment of the member m of the
+// struct t, whose PackedAlignment caps the alignment.
+func memberLayout(t, m *types.TypeDescriptor) (size, align uint32) {
+	size, align = layout(m)
+	if t.PackedAlignment != 0 {
+		align = min(align, uint32(t.PackedAlignment))
+	}
+	return size, align
+}
+
+// toWasm converts the host struct in src to its wasm32 layout in dst.
+func toWasm(dst, src []byte, t *types.TypeDescriptor) {
+	var hoff uintptr
+	var woff uint32
+	for _, m := range t.Members {
+		hoff = alignUpHost(hoff, t.MemberAlignment(m))
+		ws, wa := memberLayout(t, m)
+		woff = alignUp(woff, wa)
+		switch m.Kind {
+		case types.StructType:
+			toWasm(dst[woff:woff+ws], src[hoff:hoff+m.Size], m)
+		case types.PointerType:
+			binary.LittleEndian.PutUint32(dst[woff:], uint32(*(*uintptr)(unsafe.Pointer(&src[hoff]))))
+		default:
+			copy(dst[woff:woff+ws], src[hoff:hoff+m.Size])
+		}
+		hoff += m.Size
+		woff += ws
+	}
+}
+
+// fromWasm converts the wasm32 struct in src to its host layout in dst.
+func fromWasm(dst, src []byte, t *types.TypeDescriptor) {
+	var hoff uintptr
+	var woff uint32
+	for _, m := range t.Members {
+		hoff = alignUpHost(hoff, t.MemberAlignment(m))
+		ws, wa := memberLayout(t, m)
+		woff = alignUp(woff, wa)
+		switch m.Kind {
+		case types.StructType:
+			fromWasm(dst[hoff:hoff+m.Size], src[woff:woff+ws], m)
+		case types.PointerType:
+			*(*uintptr)(unsafe.Pointer(&dst[hoff])) = uintptr(binary.LittleEndian.Uint32(src[woff:]))
+		default:
+			copy(dst[hoff:hoff+m.Size], src[woff:woff+ws])
+		}
+		hoff += m.Size
+		woff += ws
+	}
+}
+
+func alignUp(n, a uint32) uint32 {
+	if a <= 1 {
+		return n
+	}
+	return (n + a - 1) &^ (a - 1)
+}
+
+func alignUpHost(n, a uintptr) uintptr {
+	if a <= 1 {
+		return n
+	}
+	return (n + a - 1) &^ (a - 1)
+}
@@ -0,0 +1,238 @@
+// Package wasm implements ffi.Loader and ffi.Caller for functions exported by
+// WebAssembly modules, so that binding code written against those interfaces
+// can drive a .wasm build of a library as well as the native one.
+//
+// Calls follow the wasm32 C ABI used by clang, wasi-libc and Emscripten:
+// integers up to 32 bits become i32, 64-bit integers i64, float f32, double
+// f64 and pointers i32 offsets into the module's linear memory. Structs are
+// passed and returned through linear memory, except structs wrapping a single
+// scalar, which are passed as that scalar. Struct layouts are converted
+// between the host's 64-bit pointers and wasm32's 32-bit ones.
+//
+// Pointer arguments and results are linear-memory offsets, not host
+// addresses; read and write the memory through Module.Memory. String return
+// types (types.CStringType, types.WStringType) are decoded from linear memory.
+//
+// The package does not depend on a wasm runtime. A Module is a thin view of an
+// instantiated module, which for wazero is built like this:
+//
+//	r := wazero.NewRuntime(ctx)
+//	rt := wasm.New(func(name string) (*wasm.Module, error) {
+//	    code, err := os.ReadFile(name)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    mod, err := r.Instantiate(ctx, code)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return &wasm.Module{
+//	        Memory: mod.Memory(),
+//	        Func: func(name string) wasm.Function {
+//	            if f := mod.ExportedFunction(name); f != nil {
+//	                return f.Call
+//	            }
+//	            return nil
+//	        },
+//	        Close: func() error { return mod.Close(ctx) },
+//	    }, nil
+//	})
+//
+//	lib, err := rt.LoadLibrary("libfoo.wasm")
+//	fn, err := rt.GetSymbol(lib, "foo_add")
+//	err = rt.CallFunction(&cif, fn, unsafe.Pointer(&sum), avalue)
+//
+// Variadic functions, long double, Float16 and callbacks are not supported.
+package wasm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Function calls an exported wasm function. Parameters and results are wasm
+// values in their raw uint64 encoding, as in wazero's api.Function.Call.
+type Function func(ctx context.Context, params ...uint64) ([]uint64, error)
+
+// Memory is the linear memory of a module. wazero's api.Memory implements it.
+type Memory interface {
+	Read(offset, byteCount uint32) ([]byte, bool)
+	Write(offset uint32, v []byte) bool
+}
+
+// Module is an instantiated wasm module.
+type Module struct {
+	Memory Memory                     // Linear memory; needed for pointers, strings and structs
+	Func   func(name string) Function // Exported function name, or nil if there is none
+	Close  func() error               // Optional; called when the last handle is freed
+}
+
+// OpenFunc instantiates the module for a library name passed to LoadLibrary.
+type OpenFunc func(name string) (*Module, error)
+
+// Runtime is a wasm ffi.Loader and ffi.Caller. It is safe for concurrent use
+// to the extent the underlying modules are.
+type Runtime struct {
+	open OpenFunc
+	ctx  context.Context
+
+	mu      sync.Mutex
+	libs    map[string]*library
+	handles map[unsafe.Pointer]*library
+	funcs   map[unsafe.Pointer]*symbol
+}
+
+// library is a loaded module; its address serves as the library handle.
+type library struct {
+	name string
+	mod  *Module
+	refs int
+}
+
+// symbol is a resolved export; its address serves as the function pointer.
+type symbol struct {
+	lib  *library
+	name string
+	fn   Function
+}
+
+var (
+	_ ffi.Loader = (*Runtime)(nil)
+	_ ffi.Caller = (*Runtime)(nil)
+)
+
+// ErrUnknownFunction is returned by CallFunction for a function pointer that
+// was not obtained from this Runtime's GetSymbol.
+var ErrUnknownFunction = errors.New("goffi: wasm: unknown function pointer")
+
+// New returns a Runtime that instantiates modules with open.
+func New(open OpenFunc) *Runtime {
+	return NewWithContext(context.Background(), open)
+}
+
+// NewWithContext is New with the context passed to every wasm call.
+func NewWithContext(ctx context.Context, open OpenFunc) *Runtime {
+	return &Runtime{
+		open:    open,
+		ctx:     ctx,
+		libs:    make(map[string]*library),
+		handles: make(map[unsafe.Pointer]*library),
+		funcs:   make(map[unsafe.Pointer]*symbol),
+	}
+}
+
+// LoadLibrary instantiates the module name, or returns another handle to it
+// if it is already loaded.
+func (r *Runtime) LoadLibrary(name string) (unsafe.Pointer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lib, ok := r.libs[name]
+	if !ok {
+		mod, err := r.open(name)
+		if err != nil {
+			return nil, &ffi.LibraryError{Operation: "load", Name: name, Err: err}
+		}
+		if mod == nil || mod.Func == nil {
+			return nil, &ffi.LibraryError{Operation: "load", Name: name, Err: errors.New("module has no exports")}
+		}
+		lib = &library{name: name, mod: mod}
+		r.libs[name] = lib
+		r.handles[unsafe.Pointer(lib)] = lib
+	}
+	lib.refs++
+	return unsafe.Pointer(lib), nil
+}
+
+// GetSymbol resolves an exported function of a loaded module.
+func (r *Runtime) GetSymbol(handle unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lib, ok := r.handles[handle]
+	if !ok {
+		return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: errors.New("invalid library handle")}
+	}
+	fn := lib.mod.Func(name)
+	if fn == nil {
+		return nil, &ffi.LibraryError{Operation: "symbol", Name: name, Err: fmt.Errorf("not exported by %s", lib.name)}
+	}
+	sym := &symbol{lib: lib, name: name, fn: fn}
+	r.funcs[unsafe.Pointer(sym)] = sym
+	return unsafe.Pointer(sym), nil
+}
+
+// FreeLibrary releases a handle from LoadLibrary and closes the module when
+// the last one is gone. Like the native FreeLibrary it accepts nil.
+func (r *Runtime) FreeLibrary(handle unsafe.Pointer) error {
+	if handle == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lib, ok := r.handles[handle]
+	if !ok {
+		return &ffi.LibraryError{Operation: "free", Name: "<library handle>", Err: errors.New("invalid library handle")}
+	}
+	lib.refs--
+	if lib.refs > 0 {
+		return nil
+	}
+	delete(r.handles, handle)
+	delete(r.libs, lib.name)
+	for p, sym := range r.funcs {
+		if sym.lib == lib {
+			delete(r.funcs, p)
+		}
+	}
+	if lib.mod.Close != nil {
+		if err := lib.mod.Close(); err != nil {
+			return &ffi.LibraryError{Operation: "free", Name: lib.name, Err: err}
+		}
+	}
+	return nil
+}
+
+// Module returns the module behind a library handle, for access to its
+// linear memory.
+func (r *Runtime) Module(handle unsafe.Pointer) (*Module, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lib, ok := r.handles[handle]
+	if !ok {
+		return nil, false
+	}
+	return lib.mod, true
+}
+
+// CallFunction calls the wasm function fn, converting arguments and the
+// return value as described in the package documentation.
+func (r *Runtime) CallFunction(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error {
+	if cif == nil || cif.ReturnType == nil {
+		return &ffi.InvalidCallInterfaceError{Field: "cif", Reason: "must be prepared", Index: -1}
+	}
+	if cif.FixedArgCount > 0 {
+		return &ffi.InvalidCallInterfaceError{Field: "cif", Reason: "variadic calls are not supported by the wasm backend", Index: -1}
+	}
+	if len(avalue) != len(cif.ArgTypes) {
+		return &ffi.InvalidCallInterfaceError{
+			Field:  "avalue",
+			Reason: fmt.Sprintf("got %d argument pointers, call interface expects %d", len(avalue), len(cif.ArgTypes)),
+			Index:  -1,
+		}
+	}
+	r.mu.Lock()
+	sym, ok := r.funcs[fn]
+	r.mu.Unlock()
+	if !ok {
+		return ErrUnknownFunction
+	}
+
+	c := &call{mod: sym.lib.mod, ctx: r.ctx}
+	defer c.release()
+	return c.run(sym, cif, rvalue, avalue)
+}
@@ -0,0 +1,252 @@
+package wasm_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/ffi"
+	"github.com/go-webgpu/goffi/ffi/wasm"
+	"github.com/go-webgpu/goffi/types"
+)
+
+// memory is a fixed-size linear memory.
+type memory []byte
+
+func (m memory) Read(offset, n uint32) ([]byte, bool) {
+	if uint64(offset)+uint64(n) > uint64(len(m)) {
+		return nil, false
+	}
+	return m[offset : offset+n], true
+}
+
+func (m memory) Write(offset uint32, v []byte) bool {
+	if uint64(offset)+uint64(len(v)) > uint64(len(m)) {
+		return false
+	}
+	copy(m[offset:], v)
+	return true
+}
+
+// fakeModule emulates a compiled C library: exports are Go functions over raw
+// wasm values, with a bump allocator standing in for malloc.
+func fakeModule(t *testing.T, closed *int) *wasm.Module {
+	mem := make(memory, 1<<16)
+	copy(mem[0x100:], "hello, wasm\x00")
+	next := uint32(0x1000)
+	frees := 0
+	exports := map[string]wasm.Function{
+		"malloc": func(_ context.Context, p ...uint64) ([]uint64, error) {
+			addr := next
+			next += uint32(p[0])
+			return []uint64{uint64(addr)}, nil
+		},
+		"free": func(context.Context, ...uint64) ([]uint64, error) {
+			frees++
+			return nil, nil
+		},
+		// int32_t widen(int8_t a, int16_t b) { return a + b; }
+		"widen": func(_ context.Context, p ...uint64) ([]uint64, error) {
+			return []uint64{uint64(uint32(int32(p[0]) + int32(p[1])))}, nil
+		},
+		// double scale(double x, float f) { return x * f; }
+		"scale": func(_ context.Context, p ...uint64) ([]uint64, error) {
+			x := math.Float64frombits(p[0])
+			f := math.Float32frombits(uint32(p[1]))
+			return []uint64{math.Float64bits(x * float64(f))}, nil
+		},
+		// const char *greeting(void)
+		"greeting": func(context.Context, ...uint64) ([]uint64, error) {
+			return []uint64{0x100}, nil
+		},
+		// int32_t sum(struct { int32_t a; const char *s; double d; } *v)
+		// passed indirectly: a at 0, s at 4, d at 8.
+		"sum": func(_ context.Context, p ...uint64) ([]uint64, error) {
+			b := mem[p[0]:]
+			a := int32(binary.LittleEndian.Uint32(b[0:]))
+			s := binary.LittleEndian.Uint32(b[4:])
+			d := math.Float64frombits(binary.LittleEndian.Uint64(b[8:]))
+			return []uint64{uint64(uint32(a + int32(s) + int32(d)))}, nil
+		},
+		// struct { int32_t a; const char *s; double d; } make(int32_t a)
+		// returned through the sret pointer in the first parameter.
+		"make": func(_ context.Context, p ...uint64) ([]uint64, error) {
+			b := mem[p[0]:]
+			binary.LittleEndian.PutUint32(b[0:], uint32(p[1]))
+			binary.LittleEndian.PutUint32(b[4:], 0x100)
+			binary.LittleEndian.PutUint64(b[8:], math.Float64bits(2.5))
+			return nil, nil
+		},
+		// struct { double v; } negate(struct { double v; } x)
+		"negate": func(_ context.Context, p ...uint64) ([]uint64, error) {
+			return []uint64{math.Float64bits(-math.Float64frombits(p[0]))}, nil
+		},
+	}
+	t.Cleanup(func() {
+		if next != 0x1000 && frees == 0 {
+			t.Error("struct memory was never freed")
+		}
+	})
+	return &wasm.Module{
+		Memory: mem,
+		Func:   func(name string) wasm.Function { return exports[name] },
+		Close: func() error {
+			*closed++
+			return nil
+		},
+	}
+}
+
+func prepare(t *testing.T, ret *types.TypeDescriptor, args ...*types.TypeDescriptor) *types.CallInterface {
+	t.Helper()
+	cif := new(types.CallInterface)
+	if err := ffi.PrepareCallInterface(cif, types.DefaultCall, ret, args); err != nil {
+		t.Fatal(err)
+	}
+	return cif
+}
+
+func TestRuntime(t *testing.T) {
+	closed, opened := 0, 0
+	rt := wasm.New(func(name string) (*wasm.Module, error) {
+		if name != "libdemo.wasm" {
+			return nil, errors.New("no such module")
+		}
+		opened++
+		return fakeModule(t, &closed), nil
+	})
+	var loader ffi.Loader = rt
+	var caller ffi.Caller = rt
+
+	lib, err := loader.LoadLibrary("libdemo.wasm")
+	if err != nil {
+		t.Fatalf("LoadLibrary: %v", err)
+	}
+	sym := func(name string) unsafe.Pointer {
+		t.Helper()
+		fn, err := loader.GetSymbol(lib, name)
+		if err != nil {
+			t.Fatalf("GetSymbol(%s): %v", name, err)
+		}
+		return fn
+	}
+
+	t.Run("integers", func(t *testing.T) {
+		cif := prepare(t, types.SInt32TypeDescriptor, types.SInt8TypeDescriptor, types.SInt16TypeDescriptor)
+		a, b := int8(-100), int16(-1000)
+		var r int32
+		if err := caller.CallFunction(cif, sym("widen"), unsafe.Pointer(&r), []unsafe.Pointer{unsafe.Pointer(&a), unsafe.Pointer(&b)}); err != nil {
+			t.Fatal(err)
+		}
+		if r != -1100 {
+			t.Errorf("widen = %d, want -1100", r)
+		}
+	})
+
+	t.Run("floats", func(t *testing.T) {
+		cif := prepare(t, types.DoubleTypeDescriptor, types.DoubleTypeDescriptor, types.FloatTypeDescriptor)
+		x, f := 1.5, float32(4)
+		var r float64
+		if err := caller.CallFunction(cif, sym("scale"), unsafe.Pointer(&r), []unsafe.Pointer{unsafe.Pointer(&x), unsafe.Pointer(&f)}); err != nil {
+			t.Fatal(err)
+		}
+		if r != 6 {
+			t.Errorf("scale = %v, want 6", r)
+		}
+	})
+
+	t.Run("string return", func(t *testing.T) {
+		var s string
+		if err := caller.CallFunction(prepare(t, types.CStringTypeDescriptor), sym("greeting"), unsafe.Pointer(&s), nil); err != nil {
+			t.Fatal(err)
+		}
+		if s != "hello, wasm" {
+			t.Errorf("greeting = %q", s)
+		}
+	})
+
+	mixed := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{
+		types.SInt32TypeDescriptor, types.PointerTypeDescriptor, types.DoubleTypeDescriptor,
+	}}
+	type hostMixed struct {
+		a int32
+		s uintptr
+		d float64
+	}
+
+	t.Run("struct argument", func(t *testing.T) {
+		cif := prepare(t, types.SInt32TypeDescriptor, mixed)
+		v := hostMixed{a: 1, s: 20, d: 300}
+		var r int32
+		if err := caller.CallFunction(cif, sym("sum"), unsafe.Pointer(&r), []unsafe.Pointer{unsafe.Pointer(&v)}); err != nil {
+			t.Fatal(err)
+		}
+		if r != 321 {
+			t.Errorf("sum = %d, want 321", r)
+		}
+	})
+
+	t.Run("struct return", func(t *testing.T) {
+		cif := prepare(t, mixed, types.SInt32TypeDescriptor)
+		a := int32(7)
+		var r hostMixed
+		if err := caller.CallFunction(cif, sym("make"), unsafe.Pointer(&r), []unsafe.Pointer{unsafe.Pointer(&a)}); err != nil {
+			t.Fatal(err)
+		}
+		if r != (hostMixed{a: 7, s: 0x100, d: 2.5}) {
+			t.Errorf("make = %+v", r)
+		}
+	})
+
+	t.Run("single scalar struct", func(t *testing.T) {
+		wrapped := &types.TypeDescriptor{Kind: types.StructType, Members: []*types.TypeDescriptor{types.DoubleTypeDescriptor}}
+		cif := prepare(t, wrapped, wrapped)
+		x := 3.0
+		var r float64
+		if err := caller.CallFunction(cif, sym("negate"), unsafe.Pointer(&r), []unsafe.Pointer{unsafe.Pointer(&x)}); err != nil {
+			t.Fatal(err)
+		}
+		if r != -3 {
+			t.Errorf("negate = %v, want -3", r)
+		}
+	})
+
+	var libErr *ffi.LibraryError
+	if _, err := loader.GetSymbol(lib, "missing"); !errors.As(err, &libErr) || libErr.Operation != "symbol" {
+		t.Errorf("GetSymbol(missing) = %v, want a symbol LibraryError", err)
+	}
+	if _, err := loader.LoadLibrary("libother.wasm"); !errors.As(err, &libErr) || libErr.Operation != "load" {
+		t.Errorf("LoadLibrary(libother.wasm) = %v, want a load LibraryError", err)
+	}
+	if err := caller.CallFunction(prepare(t, types.VoidTypeDescriptor), unsafe.Pointer(&opened), nil, nil); !errors.Is(err, wasm.ErrUnknownFunction) {
+		t.Errorf("CallFunction(unknown) = %v, want ErrUnknownFunction", err)
+	}
+
+	// Handles are reference counted; the module closes with the last one.
+	lib2, err := loader.LoadLibrary("libdemo.wasm")
+	if err != nil || lib2 != lib || opened != 1 {
+		t.Fatalf("second LoadLibrary = %p, %v (opened %d), want the same module", lib2, err, opened)
+	}
+	if err := loader.FreeLibrary(lib2); err != nil || closed != 0 {
+		t.Fatalf("FreeLibrary = %v, closed %d", err, closed)
+	}
+	if err := loader.FreeLibrary(lib); err != nil || closed != 1 {
+		t.Fatalf("FreeLibrary = %v, closed %d", err, closed)
+	}
+}
+
+func TestRuntimeVariadic(t *testing.T) {
+	rt := wasm.New(func(string) (*wasm.Module, error) { return nil, errors.New("unused") })
+	var cif types.CallInterface
+	if err := ffi.PrepareVariadicCallInterface(&cif, types.DefaultCall, 1, types.SInt32TypeDescriptor,
+		[]*types.TypeDescriptor{types.PointerTypeDescriptor, types.SInt32TypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+	var icErr *ffi.InvalidCallInterfaceError
+	if err := rt.CallFunction(&cif, nil, nil, make([]unsafe.Pointer, 2)); !errors.As(err, &icErr) {
+		t.Errorf("variadic CallFunction = %v, want *InvalidCallInterfaceError", err)
+	}
+}
@@ -0,0 +1,64 @@
+package ffi
+
+import (
+	"errors"
+	"strings"
+	"unsafe"
+)
+
+// ErrNULInString is returned when a Go string holding a NUL byte is
+// converted to a C string, which would silently truncate it.
+var ErrNULInString = errors.New("goffi: string contains a NUL byte")
+
+// WCharSize is the size of the platform's wchar_t in bytes: 2 on Windows
+// (UTF-16), 4 elsewhere (UTF-32).
+const WCharSize = unsafe.Sizeof(WChar(0))
+
+// WideCString returns s encoded in the platform's wchar_t encoding with a
+// terminating NUL, ready to pass to C as &ws[0]. Characters outside the
+// Basic Multilingual Plane become surrogate pairs on Windows. It fails with
+// ErrNULInString if s contains a NUL byte.
+//
+// Example:
+//
+//	ws, err := ffi.WideCString("Grüße")
+//	if err != nil {
+//	    return err
+//	}
+//	p := unsafe.Pointer(&ws[0])
+//	err = ffi.CallFunction(&cif, wcslenPtr, unsafe.Pointer(&n), []unsafe.Pointer{unsafe.Pointer(&p)})
+//	runtime.KeepAlive(ws)
+func WideCString(s string) ([]WChar, error) {
+	if strings.IndexByte(s, 0) >= 0 {
+		return nil, ErrNULInString
+	}
+	return append(encodeWide(s), 0), nil
+}
+
+// GoWideString copies the NUL-terminated wchar_t string at p into a Go
+// string. Invalid code units decode to U+FFFD. A nil p yields "".
+func GoWideString(p unsafe.Pointer) string {
+	return goWideStringN(p, 0)
+}
+
+// GoWideStringN is GoWideString reading at most maxLen wchar_t units, for
+// buffers that may lack a terminating NUL.
+func GoWideStringN(p unsafe.Pointer, maxLen int) string {
+	if maxLen <= 0 {
+		return ""
+	}
+	return goWideStringN(p, maxLen)
+}
+
+// goWideStringN copies the wide string at p, stopping after maxLen units if
+// maxLen > 0.
+func goWideStringN(p unsafe.Pointer, maxLen int) string {
+	if p == nil {
+		return ""
+	}
+	n := 0
+	for (maxLen <= 0 || n < maxLen) && *(*WChar)(unsafe.Add(p, uintptr(n)*WCharSize)) != 0 {
+		n++
+	}
+	return decodeWide(unsafe.Slice((*WChar)(p), n))
+}
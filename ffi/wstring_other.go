@@ -0,0 +1,22 @@
+//go:build !windows
+
+package ffi
+
+// WChar is one wchar_t code unit: a UTF-32 code point outside Windows.
+type WChar = uint32
+
+func encodeWide(s string) []WChar {
+	w := make([]WChar, 0, len(s))
+	for _, r := range s {
+		w = append(w, WChar(r))
+	}
+	return w
+}
+
+func decodeWide(w []WChar) string {
+	r := make([]rune, len(w))
+	for i, c := range w {
+		r[i] = rune(c) // out-of-range values become U+FFFD in the conversion
+	}
+	return string(r)
+}
@@ -0,0 +1,72 @@
+//go:build (linux || darwin || freebsd || windows) && (amd64 || arm64)
+
+package ffi
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+func TestWideCStringRoundTrip(t *testing.T) {
+	for _, s := range []string{"", "plain", "Grüße", "日本語", "emoji 🎉 outside the BMP"} {
+		ws, err := WideCString(s)
+		if err != nil {
+			t.Fatalf("WideCString(%q): %v", s, err)
+		}
+		if ws[len(ws)-1] != 0 {
+			t.Fatalf("WideCString(%q) is not NUL-terminated", s)
+		}
+		if got := GoWideString(unsafe.Pointer(&ws[0])); got != s {
+			t.Errorf("GoWideString(WideCString(%q)) = %q", s, got)
+		}
+	}
+
+	ws, _ := WideCString("truncated")
+	if got := GoWideStringN(unsafe.Pointer(&ws[0]), 5); got != "trunc" {
+		t.Errorf("GoWideStringN(5) = %q, want %q", got, "trunc")
+	}
+	if got := GoWideString(nil); got != "" {
+		t.Errorf("GoWideString(nil) = %q, want empty", got)
+	}
+	if _, err := WideCString("a\x00b"); !errors.Is(err, ErrNULInString) {
+		t.Errorf("WideCString with NUL: err = %v, want ErrNULInString", err)
+	}
+}
+
+func TestWStringReturn(t *testing.T) {
+	requireStructLib(t)
+	sym, err := GetSymbol(structTestLib, "wstring_echo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cif types.CallInterface
+	if err := PrepareCallInterface(&cif, types.DefaultCall, types.WStringTypeDescriptor,
+		[]*types.TypeDescriptor{types.WStringTypeDescriptor, types.PointerTypeDescriptor}); err != nil {
+		t.Fatal(err)
+	}
+
+	const s = "wide 🎉"
+	ws, err := WideCString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := unsafe.Pointer(&ws[0])
+	var n int64
+	np := unsafe.Pointer(&n)
+	var got string
+	if err := CallFunction(&cif, sym, unsafe.Pointer(&got), []unsafe.Pointer{unsafe.Pointer(&p), unsafe.Pointer(&np)}); err != nil {
+		t.Fatal(err)
+	}
+	runtime.KeepAlive(ws)
+	if got != s {
+		t.Errorf("wstring_echo returned %q, want %q", got, s)
+	}
+	if want := int64(len(ws) - 1); n != want {
+		t.Errorf("wcslen = %d, want %d", n, want)
+	}
+}
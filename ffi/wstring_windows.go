@@ -0,0 +1,14 @@
+package ffi
+
+import "unicode/utf16"
+
+// WChar is one wchar_t code unit: a UTF-16 code unit on Windows.
+type WChar = uint16
+
+func encodeWide(s string) []WChar {
+	return utf16.Encode([]rune(s))
+}
+
+func decodeWide(w []WChar) string {
+	return string(utf16.Decode(w))
+}
@@ -6,11 +6,13 @@
 package amd64
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math"
 	"runtime"
 	"unsafe"
 
+	"github.com/go-webgpu/goffi/internal/arch"
 	gosyscall "github.com/go-webgpu/goffi/internal/syscall"
 	"github.com/go-webgpu/goffi/types"
 )
@@ -25,6 +27,27 @@ func (i *Implementation) Execute(
 	rvalue unsafe.Pointer,
 	avalue []unsafe.Pointer,
 ) error {
+	_, err := i.execute(cif, fn, rvalue, avalue)
+	return err
+}
+
+// ExecuteRegisters is Execute that also reports the raw contents of the two
+// integer return registers (RAX, RDX).
+func (i *Implementation) ExecuteRegisters(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) ([2]uint64, error) {
+	return i.execute(cif, fn, rvalue, avalue)
+}
+
+func (i *Implementation) execute(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) ([2]uint64, error) {
 	// System V AMD64 ABI:
 	// - GP registers: RDI, RSI, RDX, RCX, R8, R9 (6 registers, indices 0-5)
 	// - SSE registers: XMM0-XMM7 (8 registers)
@@ -35,6 +58,8 @@ func (i *Implementation) Execute(
 	//   sysargs[6..14] -> stack slots (pushed before CALL)
 	var sysargs [maxTotalArgs]uintptr
 	var floats [8]uintptr
+	var floatsHi [8]uint64 // high halves of XMM0-XMM7, for vector arguments
+	vector := cif.ReturnType.Kind == types.VectorType
 
 	numInts := 0   // GP register index (0-5 = registers, 6+ = stack)
 	numFloats := 0 // SSE register index (0-7)
@@ -58,6 +83,15 @@ func (i *Implementation) Execute(
 		numStack++
 	}
 
+	// alignStack starts the next stack argument on a 16-byte boundary, as
+	// long double and other 16-byte aligned arguments require. The first
+	// stack slot is 16-byte aligned at the CALL.
+	alignStack := func(alignment uintptr) {
+		if alignment > 8 && numStack%2 != 0 {
+			addStack(0)
+		}
+	}
+
 	addFloat := func(x uintptr) {
 		if numFloats < 8 {
 			floats[numFloats] = x
@@ -70,15 +104,17 @@ func (i *Implementation) Execute(
 		}
 	}
 
-	// Detect sret: struct > 16 bytes requires hidden first argument in RDI.
+	// Detect sret: MEMORY class structs (> 16 bytes, or with unaligned
+	// fields) require a hidden first argument in RDI.
 	// The caller's rvalue buffer is passed as the first integer argument and
-	// callee writes the return value directly into it.
+	// callee writes the return value directly into it. A discarded result
+	// still needs a buffer of the full struct size.
 	sretBuf := unsafe.Pointer(nil)
-	if cif.ReturnType.Kind == types.StructType && cif.ReturnType.Size > 16 {
+	if cif.ReturnType.Kind == types.StructType && cif.Flags&types.ReturnViaPointer != 0 {
 		if rvalue != nil {
 			sretBuf = rvalue
 		} else {
-			sretBuf = unsafe.Pointer(&[128]byte{})
+			sretBuf = unsafe.Pointer(&make([]byte, cif.ReturnType.Size)[0])
 		}
 		addInt(uintptr(sretBuf))
 	}
@@ -96,9 +132,42 @@ func (i *Implementation) Execute(
 			addFloat(uintptr(math.Float32bits(*(*float32)(avalue[idx]))))
 		case types.DoubleType:
 			addFloat(*(*uintptr)(avalue[idx]))
+		case types.Float16Type:
+			// _Float16 travels in the low 16 bits of an XMM register.
+			addFloat(uintptr(*(*uint16)(avalue[idx])))
+		case types.VectorType:
+			// SSE, SSEUP: one whole XMM register, else a 16-byte aligned
+			// stack slot.
+			lo, hi := *(*uintptr)(avalue[idx]), *(*uintptr)(unsafe.Add(avalue[idx], 8))
+			if numFloats < 8 {
+				floatsHi[numFloats] = uint64(hi)
+				addFloat(lo)
+			} else {
+				alignStack(16)
+				addStack(lo)
+				addStack(hi)
+			}
+			vector = true
+		case types.LongDoubleType:
+			// X87 class: the 10 value bytes in a 16-byte aligned stack slot.
+			alignStack(16)
+			addStack(*(*uintptr)(avalue[idx]))
+			addStack(uintptr(*(*uint16)(unsafe.Add(avalue[idx], 8))))
+		case types.SInt128Type, types.UInt128Type:
+			// Two INTEGER eightbytes: a register pair if both registers
+			// are free, else a 16-byte aligned stack slot (§3.2.3).
+			lo, hi := *(*uintptr)(avalue[idx]), *(*uintptr)(unsafe.Add(avalue[idx], 8))
+			if numInts+2 <= 6 {
+				addInt(lo)
+				addInt(hi)
+			} else {
+				alignStack(16)
+				addStack(lo)
+				addStack(hi)
+			}
 		case types.PointerType:
 			addInt(*(*uintptr)(avalue[idx]))
-		case types.SInt8Type, types.UInt8Type:
+		case types.SInt8Type, types.UInt8Type, types.BoolType:
 			addInt(uintptr(*(*uint8)(avalue[idx])))
 		case types.SInt16Type, types.UInt16Type:
 			addInt(uintptr(*(*uint16)(avalue[idx])))
@@ -109,10 +178,11 @@ func (i *Implementation) Execute(
 		case types.StructType:
 			argPtr := avalue[idx]
 			sz := argType.Size
+			memory := isMemoryClass(argType)
 			switch {
 			case sz == 0:
 				// Zero-size struct: pass nothing.
-			case sz <= 8:
+			case sz <= 8 && !memory:
 				// Single eightbyte: INTEGER if any member is not float/double, else SSE.
 				if isStructAllFloats(argType) {
 					addFloat(*(*uintptr)(argPtr))
@@ -131,7 +201,7 @@ func (i *Implementation) Execute(
 					}
 					addInt(v)
 				}
-			case sz <= 16:
+			case sz <= 16 && !memory:
 				// Two eightbytes: classify each independently.
 				// System V ABI §3.2.3: INTEGER wins over SSE within an eightbyte.
 				if classifyEightbyte(argType, 0, 8) {
@@ -169,8 +239,10 @@ func (i *Implementation) Execute(
 					addInt(v)
 				}
 			default:
-				// MEMORY class (> 16 bytes): copy onto stack in 8-byte chunks.
+				// MEMORY class (> 16 bytes, unaligned fields or long double
+				// members): copy onto stack in 8-byte chunks.
 				// Per SysV ABI §3.2.3: MEMORY class structs bypass registers entirely.
+				alignStack(argType.Alignment)
 				nChunks := (sz + 7) / 8
 				for k := uintptr(0); k < nChunks; k++ {
 					chunkPtr := unsafe.Add(argPtr, k*8)
@@ -201,7 +273,7 @@ func (i *Implementation) Execute(
 
 	// Validate we haven't exceeded platform maximum
 	if numStack > maxTotalArgs-6 {
-		return fmt.Errorf("goffi: %d stack arguments exceed platform limit of %d", numStack, maxTotalArgs-6)
+		return [2]uint64{}, fmt.Errorf("goffi: %d stack arguments exceed platform limit of %d", numStack, maxTotalArgs-6)
 	}
 
 	// Build GP register array (first 6 slots)
@@ -218,24 +290,108 @@ func (i *Implementation) Execute(
 	var stackArgs [9]uintptr
 	copy(stackArgs[:], sysargs[6:])
 
+	// long double returns come back in ST0, which only CallNX87 reads.
+	if cif.Flags == types.ReturnInST0 {
+		st0, clobbered := gosyscall.CallNX87(uintptr(fn), gpr, sse, stackArgs, numStack, cif.Blocking, cif.CheckRegisters)
+		runtime.KeepAlive(avalue)
+		if rvalue != nil {
+			var x types.LongDouble
+			binary.LittleEndian.PutUint64(x[0:], st0[0])
+			binary.LittleEndian.PutUint16(x[8:], uint16(st0[1]))
+			*(*types.LongDouble)(rvalue) = x
+		}
+		return [2]uint64{}, clobberError(clobbered)
+	}
+
 	// Call via syscall
-	ret, r2, fret, fret2 := gosyscall.CallNFloat(uintptr(fn), gpr, sse, stackArgs, numStack)
+	var ret, r2 uintptr
+	var fret, fret2 float64
+	var xmm0 [2]uint64
+	var clobberErr error
+	switch {
+	case vector:
+		// Vectors need whole XMM registers in both directions.
+		var xmm [8][2]uint64
+		for k := range xmm {
+			xmm[k] = [2]uint64{uint64(floats[k]), floatsHi[k]}
+		}
+		var xmm1 [2]uint64
+		var clobbered uint32
+		ret, r2, xmm0, xmm1, clobbered = gosyscall.CallNVector(uintptr(fn), gpr, xmm, stackArgs, numStack, cif.Blocking, cif.CheckRegisters)
+		clobberErr = clobberError(clobbered)
+		fret, fret2 = math.Float64frombits(xmm0[0]), math.Float64frombits(xmm1[0])
+	case cif.CheckRegisters:
+		var clobbered uint32
+		ret, r2, fret, fret2, clobbered = gosyscall.CallNFloatChecked(uintptr(fn), gpr, sse, stackArgs, numStack, cif.Blocking)
+		clobberErr = clobberError(clobbered)
+	case cif.Blocking:
+		ret, r2, fret, fret2 = gosyscall.CallNFloatBlocking(uintptr(fn), gpr, sse, stackArgs, numStack)
+	default:
+		ret, r2, fret, fret2 = gosyscall.CallNFloat(uintptr(fn), gpr, sse, stackArgs, numStack)
+	}
 
 	runtime.KeepAlive(avalue)
 	runtime.KeepAlive(sretBuf)
+	regs := [2]uint64{uint64(ret), uint64(r2)}
 
 	// If sret, the callee wrote directly into rvalue — no further copy needed.
 	if sretBuf != nil {
-		return nil
+		return regs, clobberErr
+	}
+
+	if cif.Flags == types.ReturnInXMM128 {
+		if rvalue != nil {
+			*(*[2]uint64)(rvalue) = xmm0
+		}
+		return regs, clobberErr
 	}
 
 	// Handle return value based on type
 	retVal := uint64(ret)
 
 	// For float returns, use the float value from XMM0
-	if cif.ReturnType.Kind == types.FloatType || cif.ReturnType.Kind == types.DoubleType {
+	if isSSEKind(cif.ReturnType.Kind) {
 		retVal = *(*uint64)(unsafe.Pointer(&fret))
 	}
 
-	return i.handleReturn(cif, rvalue, retVal, uint64(r2), fret, fret2)
+	if err := i.handleReturn(cif, rvalue, retVal, uint64(r2), fret, fret2); err != nil {
+		return regs, err
+	}
+	return regs, clobberErr
+}
+
+// ExecuteRaw calls fn with the GP registers RDI..R9 (GPR[0:6]), XMM0-XMM7
+// and up to 9 stack words taken verbatim from regs. Sret is not used: System V
+// passes the result pointer in RDI.
+func (i *Implementation) ExecuteRaw(fn unsafe.Pointer, regs *arch.RawRegisters) {
+	var gpr [6]uintptr
+	for k := range gpr {
+		gpr[k] = uintptr(regs.GPR[k])
+	}
+	var sse [8]float64
+	for k := range sse {
+		sse[k] = math.Float64frombits(regs.FPR[k])
+	}
+	var stackArgs [9]uintptr
+	for k, w := range regs.Stack {
+		stackArgs[k] = uintptr(w)
+	}
+
+	r1, r2, f1, f2 := gosyscall.CallNFloat(uintptr(fn), gpr, sse, stackArgs, len(regs.Stack))
+	regs.R1, regs.R2 = uint64(r1), uint64(r2)
+	regs.FRet = [4]uint64{math.Float64bits(f1), math.Float64bits(f2)}
+}
+
+// clobberError converts a CallNFloatChecked mask into an error.
+func clobberError(clobbered uint32) error {
+	if clobbered == 0 {
+		return nil
+	}
+	var names []string
+	for bit, name := range gosyscall.CalleeSavedRegisters {
+		if clobbered&(1<<bit) != 0 {
+			names = append(names, name)
+		}
+	}
+	return &types.RegisterClobberError{Registers: names}
 }
@@ -3,11 +3,13 @@
 package amd64
 
 import (
+	"fmt"
 	"math"
 	"runtime"
 	"syscall"
 	"unsafe"
 
+	gosyscall "github.com/go-webgpu/goffi/internal/syscall"
 	"github.com/go-webgpu/goffi/types"
 )
 
@@ -17,10 +19,52 @@ func (i *Implementation) Execute(
 	rvalue unsafe.Pointer,
 	avalue []unsafe.Pointer,
 ) error {
+	_, _, err := i.execute(cif, fn, rvalue, avalue)
+	return err
+}
+
+// ExecuteRegisters is Execute that also reports the raw contents of the two
+// return registers. The Win64 ABI only returns integers in RAX; the second
+// value is XMM0, where float results are returned.
+func (i *Implementation) ExecuteRegisters(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) ([2]uint64, error) {
+	regs, _, err := i.execute(cif, fn, rvalue, avalue)
+	return regs, err
+}
+
+// ExecuteErrno is Execute that also reports the thread's last-error value
+// (GetLastError) as captured right after the call.
+func (i *Implementation) ExecuteErrno(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) (uintptr, error) {
+	_, lastErr, err := i.execute(cif, fn, rvalue, avalue)
+	return uintptr(lastErr), err
+}
+
+func (i *Implementation) execute(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) ([2]uint64, syscall.Errno, error) {
+	if cif.CheckRegisters {
+		return [2]uint64{}, 0, fmt.Errorf("goffi: register clobber check: %w on windows/amd64", types.ErrUnsupportedArchitecture)
+	}
+
 	// Win64 ABI: arguments are passed in numbered slots.
 	// First 4 args: RCX, RDX, R8, R9 (integer) or XMM0-XMM3 (float).
 	// Args 5+: on the stack.
-	// syscall.SyscallN handles the full Win64 stack layout including shadow space.
+	// Slot idx holds argument idx whatever its type, integer or float bits:
+	// gosyscall.CallWin64 loads each of the first four into both its integer
+	// and XMM register, so which one the callee reads never depends on the
+	// value, and handles the full Win64 stack layout including shadow space.
 	args := make([]uintptr, len(cif.ArgTypes))
 
 	for idx := range cif.ArgTypes {
@@ -29,7 +73,7 @@ func (i *Implementation) Execute(
 		switch argType.Kind {
 		case types.PointerType:
 			args[idx] = *(*uintptr)(avalue[idx])
-		case types.SInt8Type, types.UInt8Type:
+		case types.SInt8Type, types.UInt8Type, types.BoolType:
 			args[idx] = uintptr(*(*uint8)(avalue[idx]))
 		case types.SInt16Type, types.UInt16Type:
 			args[idx] = uintptr(*(*uint16)(avalue[idx]))
@@ -45,6 +89,9 @@ func (i *Implementation) Execute(
 		case types.DoubleType:
 			// Pass float64 as raw bit pattern
 			args[idx] = *(*uintptr)(avalue[idx])
+		case types.Float16Type:
+			// Raw binary16 bits in the low 16 bits of the XMM slot.
+			args[idx] = uintptr(*(*uint16)(avalue[idx]))
 		case types.StructType:
 			// Windows x64 ABI: structs of exactly 1, 2, 4, or 8 bytes are passed by
 			// value (integer register / stack slot). All other sizes are passed by
@@ -67,19 +114,38 @@ func (i *Implementation) Execute(
 		}
 	}
 
-	// Call via syscall.SyscallN — handles all args including stack args (5+).
-	ret, _, _ := syscall.SyscallN(uintptr(fn), args...)
+	// Win64 ABI: structs not exactly 1, 2, 4 or 8 bytes are returned through a
+	// hidden pointer to caller-provided memory passed in RCX, which shifts every
+	// declared argument one slot to the right. The callee returns the same
+	// pointer in RAX.
+	sretBuf := unsafe.Pointer(nil)
+	if cif.ReturnType.Kind == types.StructType && cif.Flags&types.ReturnViaPointer != 0 {
+		if rvalue != nil {
+			sretBuf = rvalue
+		} else {
+			sretBuf = unsafe.Pointer(&make([]byte, cif.ReturnType.Size)[0])
+		}
+		args = append([]uintptr{uintptr(sretBuf)}, args...)
+	}
+	if len(args) > gosyscall.MaxWin64Args {
+		return [2]uint64{}, 0, fmt.Errorf("goffi: %d argument slots: %w", len(args), types.ErrUnsupportedArchitecture)
+	}
+
+	ret, fret, lastErr := gosyscall.CallWin64(uintptr(fn), args)
 
 	runtime.KeepAlive(avalue)
+	runtime.KeepAlive(sretBuf)
+	regs := [2]uint64{uint64(ret), fret}
 
-	// Handle return value.
-	// Note: float return values in XMM0 are not captured by syscall.SyscallN on Windows.
-	// This is a known limitation: Go's syscall package on Windows only exposes RAX (ret).
-	// Float-returning C functions on Windows require a custom assembly wrapper to capture
-	// XMM0. Since this requires significant additional infrastructure and matches purego's
-	// documented limitation, it is recorded as a known limitation for v0.4.1.
-	// See: TASK-019, GAP-7. Workaround: use integer return type and reinterpret bits.
-	// fret and fret2 are zero: Windows syscall.SyscallN does not capture XMM returns.
-	// Float-returning functions on Windows require a custom assembly wrapper (known limitation).
-	return i.handleReturn(cif, rvalue, uint64(ret), 0, 0, 0)
+	// If sret, the callee wrote directly into rvalue — no further copy needed.
+	if sretBuf != nil {
+		return regs, syscall.Errno(lastErr), nil
+	}
+
+	// Float results come back in XMM0, everything else in RAX.
+	retVal := uint64(ret)
+	if isSSEKind(cif.ReturnType.Kind) {
+		retVal = fret
+	}
+	return regs, syscall.Errno(lastErr), i.handleReturn(cif, rvalue, retVal, 0, math.Float64frombits(fret), 0)
 }
@@ -20,11 +20,26 @@ func classifyReturnAMD64(t *types.TypeDescriptor, abi types.CallingConvention) i
 	switch t.Kind {
 	case types.VoidType:
 		return types.ReturnVoid
-	case types.FloatType:
+	case types.FloatType, types.Float16Type:
 		return types.ReturnInXMM32
 	case types.DoubleType:
 		return types.ReturnInXMM64
+	case types.LongDoubleType:
+		return types.ReturnInST0
+	case types.SInt128Type, types.UInt128Type:
+		// INTEGER, INTEGER: the low half in RAX, the high half in RDX.
+		return types.ReturnStRaxRdx
+	case types.VectorType:
+		// SSE, SSEUP: all of XMM0.
+		return types.ReturnInXMM128
 	case types.StructType:
+		if runtime.GOOS != "windows" && isX87Struct(t) {
+			// X87 class: a struct holding just a long double comes back in ST0.
+			return types.ReturnInST0
+		}
+		if runtime.GOOS != "windows" && isMemoryClass(t) {
+			return types.ReturnViaPointer | types.ReturnVoid
+		}
 		switch t.Size {
 		case 1:
 			return types.ReturnSInt8
@@ -35,7 +50,7 @@ func classifyReturnAMD64(t *types.TypeDescriptor, abi types.CallingConvention) i
 		case 8:
 			return types.ReturnInt64
 		default:
-			if t.Size > 16 || runtime.GOOS == "windows" {
+			if isMemoryClass(t) || runtime.GOOS == "windows" {
 				// MEMORY class (>16B) or Windows (all structs >8B): sret pointer.
 				// Win64 ABI: structs not exactly 1/2/4/8 bytes are returned by reference.
 				return types.ReturnViaPointer | types.ReturnVoid
@@ -71,13 +86,69 @@ func isStructAllFloats(t *types.TypeDescriptor) bool {
 		return false
 	}
 	for _, m := range t.Members {
-		if m.Kind != types.FloatType && m.Kind != types.DoubleType {
+		if !isSSEKind(m.Kind) {
 			return false
 		}
 	}
 	return true
 }
 
+// isSSEKind reports whether a scalar of kind k is SSE class.
+func isSSEKind(k types.TypeKind) bool {
+	return k == types.FloatType || k == types.DoubleType || k == types.Float16Type
+}
+
+// isMemoryClass reports whether SysV passes the struct t in memory: when it
+// is larger than 16 bytes, when packing leaves one of its fields unaligned,
+// or when it holds a long double, which is X87 class (§3.2.3). The same
+// structs are returned in memory, except those isX87Struct accepts.
+func isMemoryClass(t *types.TypeDescriptor) bool {
+	return t.Size > 16 || hasUnalignedFields(t, 0) || hasLongDouble(t)
+}
+
+// hasLongDouble reports whether the struct t, or a struct nested in it, has
+// a long double member.
+func hasLongDouble(t *types.TypeDescriptor) bool {
+	for _, m := range t.Members {
+		if m != nil && (m.Kind == types.LongDoubleType || m.Kind == types.StructType && hasLongDouble(m)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isX87Struct reports whether the struct t is just a long double, possibly
+// wrapped in further structs, which SysV returns in ST0 like a long double.
+func isX87Struct(t *types.TypeDescriptor) bool {
+	for t.Kind == types.StructType && len(t.Members) == 1 && t.Members[0] != nil {
+		t = t.Members[0]
+	}
+	return t.Kind == types.LongDoubleType
+}
+
+// hasUnalignedFields reports whether a scalar field of the struct t, placed
+// at base, sits off its natural alignment, as PackedAlignment allows.
+func hasUnalignedFields(t *types.TypeDescriptor, base uintptr) bool {
+	var offset uintptr
+	for _, m := range t.Members {
+		if m == nil {
+			continue
+		}
+		if a := t.MemberAlignment(m); a > 0 {
+			offset = (offset + a - 1) &^ (a - 1)
+		}
+		if m.Kind == types.StructType {
+			if hasUnalignedFields(m, base+offset) {
+				return true
+			}
+		} else if m.Alignment > 0 && (base+offset)%m.Alignment != 0 {
+			return true
+		}
+		offset += m.Size
+	}
+	return false
+}
+
 // classifyEightbyte returns true if all struct fields whose offset falls within
 // [startOff, endOff) are SSE types (float or double).
 // Returns false if any field in the range is INTEGER class, or if no fields lie in the range.
@@ -89,12 +160,12 @@ func classifyEightbyte(t *types.TypeDescriptor, startOff, endOff uintptr) bool {
 		if m == nil {
 			continue
 		}
-		if m.Alignment > 0 {
-			offset = (offset + m.Alignment - 1) &^ (m.Alignment - 1)
+		if a := t.MemberAlignment(m); a > 0 {
+			offset = (offset + a - 1) &^ (a - 1)
 		}
 		if offset >= startOff && offset < endOff {
 			hasField = true
-			if m.Kind != types.FloatType && m.Kind != types.DoubleType {
+			if !isSSEKind(m.Kind) {
 				allFloat = false
 				break
 			}
@@ -108,10 +179,12 @@ func classifyEightbyte(t *types.TypeDescriptor, startOff, endOff uintptr) bool {
 func classifyArgumentAMD64(t *types.TypeDescriptor, abi types.CallingConvention) classification {
 	res := classification{}
 	switch t.Kind {
-	case types.FloatType, types.DoubleType:
+	case types.FloatType, types.DoubleType, types.Float16Type, types.VectorType:
 		res.SSECount = 1
+	case types.LongDoubleType:
+		// X87 class: passed on the stack, like MEMORY class structs.
 	case types.StructType:
-		if t.Size > 16 {
+		if t.Size > 16 || abi == types.UnixCallingConvention && isMemoryClass(t) {
 			// MEMORY class: passed on the stack. No GP or SSE registers consumed.
 			// The caller copies the struct bytes; the callee receives a copy on its stack frame.
 		} else {
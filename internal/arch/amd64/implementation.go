@@ -71,8 +71,13 @@ func (i *Implementation) handleReturn(
 		*(*float32)(rvalue) = *(*float32)(unsafe.Pointer(&retVal))
 	case types.DoubleType:
 		*(*float64)(rvalue) = *(*float64)(unsafe.Pointer(&retVal))
+	case types.Float16Type:
+		*(*uint16)(rvalue) = uint16(retVal)
 	case types.UInt8Type:
 		*(*uint8)(rvalue) = uint8(retVal)
+	case types.BoolType:
+		// Only AL is defined; the rest of RAX may hold anything.
+		*(*bool)(rvalue) = uint8(retVal) != 0
 	case types.SInt8Type:
 		*(*int8)(rvalue) = int8(retVal)
 	case types.UInt16Type:
@@ -85,6 +90,8 @@ func (i *Implementation) handleReturn(
 		*(*int32)(rvalue) = int32(retVal)
 	case types.UInt64Type, types.SInt64Type, types.PointerType:
 		*(*uint64)(rvalue) = retVal
+	case types.SInt128Type, types.UInt128Type:
+		*(*[2]uint64)(rvalue) = [2]uint64{retVal, retVal2}
 	case types.StructType:
 		// System V AMD64 ABI struct return rules:
 		//   <= 8 bytes : returned in RAX (any eightbyte class, since there is only one)
@@ -97,7 +104,8 @@ func (i *Implementation) handleReturn(
 		//   > 16 bytes : returned via hidden sret pointer (handled above before the switch)
 		size := cif.ReturnType.Size
 		if size <= 8 {
-			*(*uint64)(rvalue) = retVal
+			// Copy only size bytes: rvalue may be exactly as large as the struct.
+			copy(unsafe.Slice((*byte)(rvalue), size), (*[8]byte)(unsafe.Pointer(&retVal))[:size])
 			break
 		}
 		// 9-16B: reconstruct from the correct register pair.
@@ -0,0 +1,61 @@
+//go:build linux && arm
+
+// ARM EABI hard-float implementation (Linux on 32-bit ARM).
+// Non-variadic calls use the VFP variant of the AAPCS; variadic calls use
+// the base standard, which passes and returns floating-point values in core
+// registers. CheckRegisters has no effect on this platform.
+
+package arm
+
+import (
+	"runtime"
+	"unsafe"
+
+	gosyscall "github.com/go-webgpu/goffi/internal/syscall"
+	"github.com/go-webgpu/goffi/types"
+)
+
+func (i *Implementation) Execute(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	vfp := cif.FixedArgCount == 0
+
+	// Composites returned through memory need a buffer even when the
+	// caller discards the result.
+	var sret uintptr
+	if returnsViaPointer(cif.ReturnType, vfp) {
+		if rvalue == nil {
+			rvalue = unsafe.Pointer(&make([]byte, cif.ReturnType.Size)[0])
+		}
+		sret = uintptr(rvalue)
+	}
+
+	f := newFrame(sret)
+	for idx, argType := range cif.ArgTypes {
+		if idx >= len(avalue) {
+			break
+		}
+		if err := f.place(argType, avalue[idx], vfp); err != nil {
+			return err
+		}
+	}
+
+	var core [4]uintptr
+	for k, w := range f.core {
+		core[k] = uintptr(w)
+	}
+	var stack [gosyscall.MaxStackWords]uintptr
+	for k, w := range f.stack {
+		stack[k] = uintptr(w)
+	}
+
+	r0, r1, fret := gosyscall.CallVFP(uintptr(fn), core, f.vfp, stack)
+
+	runtime.KeepAlive(avalue)
+	runtime.KeepAlive(rvalue)
+
+	return storeReturn(cif.ReturnType, rvalue, uint32(r0), uint32(r1), fret, vfp)
+}
@@ -0,0 +1,195 @@
+// Package arm implements calls under the 32-bit ARM hard-float EABI
+// (AAPCS with the VFP variant, as used by linux/arm with GOARM=6 or 7).
+//
+// Classification and frame layout carry no build constraints so that the
+// AAPCS rules are tested on any host; only the call itself needs an ARM CPU.
+package arm
+
+import (
+	"github.com/go-webgpu/goffi/types"
+)
+
+func alignOffset(value, alignment uintptr) uintptr {
+	if alignment == 0 {
+		return value
+	}
+	return (value + alignment - 1) &^ (alignment - 1)
+}
+
+func ensureStructLayout(desc *types.TypeDescriptor) (size, align uintptr) {
+	if desc == nil {
+		return 0, 1
+	}
+	if desc.Kind != types.StructType {
+		if desc.Alignment == 0 {
+			if desc.Size != 0 {
+				desc.Alignment = desc.Size
+			} else {
+				desc.Alignment = 1
+			}
+		}
+		return desc.Size, desc.Alignment
+	}
+
+	if desc.Size != 0 && desc.Alignment != 0 {
+		return desc.Size, desc.Alignment
+	}
+
+	var (
+		offset   uintptr
+		maxAlign uintptr = 1
+	)
+	for _, member := range desc.Members {
+		if member == nil {
+			continue
+		}
+		mSize, mAlign := ensureStructLayout(member)
+		if desc.PackedAlignment != 0 {
+			mAlign = min(mAlign, desc.PackedAlignment)
+		}
+		if mAlign == 0 {
+			mAlign = 1
+		}
+		offset = alignOffset(offset, mAlign)
+		offset += mSize
+		if mAlign > maxAlign {
+			maxAlign = mAlign
+		}
+	}
+
+	size = alignOffset(offset, maxAlign)
+	if desc.Size == 0 {
+		desc.Size = size
+	}
+	if desc.Alignment == 0 {
+		desc.Alignment = maxAlign
+	}
+	return desc.Size, desc.Alignment
+}
+
+// isHomogeneousAggregate reports whether t is a homogeneous floating-point
+// aggregate (AAPCS §4.3.5): 1-4 members, possibly nested, all of one
+// floating-point kind. Only these composites are VFP candidates.
+func isHomogeneousAggregate(t *types.TypeDescriptor) (bool, int, types.TypeKind) {
+	if t.Kind != types.StructType {
+		return false, 0, types.VoidType
+	}
+
+	const invalidKind types.TypeKind = -1
+
+	var (
+		elementKind types.TypeKind = invalidKind
+		totalCount  int
+	)
+
+	var walk func(desc *types.TypeDescriptor) bool
+	walk = func(desc *types.TypeDescriptor) bool {
+		switch desc.Kind {
+		case types.FloatType, types.DoubleType, types.Float16Type:
+			if elementKind == invalidKind {
+				elementKind = desc.Kind
+			} else if desc.Kind != elementKind {
+				return false
+			}
+			totalCount++
+			return totalCount <= 4
+		case types.StructType:
+			if len(desc.Members) == 0 {
+				return false
+			}
+			for _, member := range desc.Members {
+				if !walk(member) {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	}
+
+	if !walk(t) || elementKind == invalidKind || totalCount == 0 {
+		return false, 0, types.VoidType
+	}
+
+	return true, totalCount, elementKind
+}
+
+// returnsViaPointer reports whether a value of type t is returned through a
+// caller-allocated buffer whose address is passed in R0. Under the VFP
+// variant homogeneous aggregates come back in S0-S3/D0-D3; every other
+// composite larger than a word goes through memory. Variadic functions use
+// the base standard, where that holds for homogeneous aggregates as well.
+func returnsViaPointer(t *types.TypeDescriptor, vfp bool) bool {
+	if t.Kind != types.StructType {
+		return false
+	}
+	ensureStructLayout(t)
+	if vfp {
+		if isHA, _, _ := isHomogeneousAggregate(t); isHA {
+			return false
+		}
+	}
+	return t.Size > 4
+}
+
+// classifyReturnARM determines how a return value comes back under the VFP
+// variant of the AAPCS:
+//   - float/_Float16 in S0, double in D0
+//   - homogeneous aggregates in S0-S3 or D0-D3
+//   - integers and pointers in R0, 64-bit integers in R0:R1
+//   - other composites of up to 4 bytes in R0, larger ones via memory
+func classifyReturnARM(t *types.TypeDescriptor) int {
+	switch t.Kind {
+	case types.VoidType:
+		return types.ReturnVoid
+	case types.FloatType, types.Float16Type:
+		return types.ReturnInXMM32
+	case types.DoubleType:
+		return types.ReturnInXMM64
+	case types.UInt64Type, types.SInt64Type:
+		return types.ReturnInt64
+	case types.StructType:
+		if returnsViaPointer(t, true) {
+			return types.ReturnViaPointer | types.ReturnVoid
+		}
+		isHA, count, elemKind := isHomogeneousAggregate(t)
+		if !isHA {
+			return types.ReturnUInt32
+		}
+		elemType := types.ReturnInXMM64
+		if elemKind != types.DoubleType {
+			elemType = types.ReturnInXMM32
+		}
+		switch count {
+		case 2:
+			return types.ReturnHFA2 | elemType
+		case 3:
+			return types.ReturnHFA3 | elemType
+		case 4:
+			return types.ReturnHFA4 | elemType
+		}
+		return elemType
+	default:
+		return types.ReturnUInt32
+	}
+}
+
+// classifyArgumentARM counts the core words (GPRCount) and VFP registers
+// (SSECount, in D-register units) an argument uses when nothing has been
+// allocated yet. The exact placement, including back-filling and the
+// register/stack split, is done per call by frame.
+func classifyArgumentARM(t *types.TypeDescriptor) (gpr, vfp int) {
+	switch t.Kind {
+	case types.FloatType, types.DoubleType, types.Float16Type:
+		return 0, 1
+	case types.StructType:
+		ensureStructLayout(t)
+		if isHA, count, _ := isHomogeneousAggregate(t); isHA {
+			return 0, count
+		}
+		return int((t.Size + 3) / 4), 0
+	default:
+		return int((t.Size + 3) / 4), 0
+	}
+}
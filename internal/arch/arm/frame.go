@@ -0,0 +1,244 @@
+package arm
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// maxStackWords is the number of outgoing stack words the call stub copies.
+// It matches syscall.MaxStackWords on linux/arm.
+const maxStackWords = 16
+
+// frame is the register and stack image of one AAPCS call. It follows the
+// allocation rules of AAPCS §6.5 stage C: NCRN and NSAA track the next core
+// register and stack word, and the VFP registers are allocated with
+// back-filling until a VFP candidate first lands on the stack.
+type frame struct {
+	core  [4]uint32             // R0-R3
+	vfp   [16]uint32            // S0-S15; D<n> is S<2n>:S<2n+1>
+	stack [maxStackWords]uint32 // Outgoing stack words, stack[0] at SP
+
+	ncrn  int    // Next core register number
+	nsaa  int    // Next stacked argument word
+	sused uint16 // Allocated (or unavailable) S registers
+}
+
+// newFrame returns an empty frame. With sret set, R0 carries the address
+// of the result buffer and allocation starts at R1.
+func newFrame(sret uintptr) *frame {
+	f := &frame{}
+	if sret != 0 {
+		f.core[0] = uint32(sret)
+		f.ncrn = 1
+	}
+	return f
+}
+
+// stackError reports an argument list that needs more stack than the call
+// stub copies.
+func stackError(words int) error {
+	return fmt.Errorf("goffi: %d stack words exceed platform limit of %d", words, maxStackWords)
+}
+
+// placeVFP allocates a VFP candidate (rules C.1 and C.2): words are the
+// S-register images of its elements, two per element for doubles. It takes
+// the lowest run of free registers, aligned to D registers for doubles;
+// without one, every remaining VFP register becomes unavailable and the
+// value goes to the stack.
+func (f *frame) placeVFP(words []uint32, double bool) error {
+	step := 1
+	if double {
+		step = 2
+	}
+	n := len(words)
+	for start := 0; start+n <= len(f.vfp); start += step {
+		mask := uint16((1<<n)-1) << start
+		if f.sused&mask != 0 {
+			continue
+		}
+		copy(f.vfp[start:], words)
+		f.sused |= mask
+		return nil
+	}
+
+	f.sused = 0xffff
+	if double {
+		f.nsaa = (f.nsaa + 1) &^ 1
+	}
+	return f.push(words)
+}
+
+// placeCore allocates an argument passed in core registers and on the
+// stack (rules C.3-C.8). Values with 8-byte alignment start at an even
+// register and an 8-byte aligned stack word. A value may be split between
+// R3 and the stack as long as nothing has been stacked yet.
+func (f *frame) placeCore(words []uint32, align8 bool) error {
+	if align8 {
+		f.ncrn = (f.ncrn + 1) &^ 1
+	}
+	n := len(words)
+	if f.ncrn+n <= len(f.core) {
+		copy(f.core[f.ncrn:], words)
+		f.ncrn += n
+		return nil
+	}
+	if f.ncrn < len(f.core) && f.nsaa == 0 {
+		k := copy(f.core[f.ncrn:], words)
+		f.ncrn = len(f.core)
+		return f.push(words[k:])
+	}
+
+	f.ncrn = len(f.core)
+	if align8 {
+		f.nsaa = (f.nsaa + 1) &^ 1
+	}
+	return f.push(words)
+}
+
+// push copies words to the stack at NSAA.
+func (f *frame) push(words []uint32) error {
+	if f.nsaa+len(words) > maxStackWords {
+		return stackError(f.nsaa + len(words))
+	}
+	copy(f.stack[f.nsaa:], words)
+	f.nsaa += len(words)
+	return nil
+}
+
+// place allocates the argument of type t stored at p. vfp selects the VFP
+// variant; variadic calls use the base standard, which passes floating-point
+// values in core registers.
+func (f *frame) place(t *types.TypeDescriptor, p unsafe.Pointer, vfp bool) error {
+	switch t.Kind {
+	case types.FloatType:
+		w := *(*uint32)(p)
+		if vfp {
+			return f.placeVFP([]uint32{w}, false)
+		}
+		return f.placeCore([]uint32{w}, false)
+	case types.Float16Type:
+		w := uint32(*(*uint16)(p))
+		if vfp {
+			return f.placeVFP([]uint32{w}, false)
+		}
+		return f.placeCore([]uint32{w}, false)
+	case types.DoubleType:
+		bits := *(*uint64)(p)
+		words := []uint32{uint32(bits), uint32(bits >> 32)}
+		if vfp {
+			return f.placeVFP(words, true)
+		}
+		return f.placeCore(words, true)
+	case types.UInt8Type, types.BoolType:
+		return f.placeCore([]uint32{uint32(*(*uint8)(p))}, false)
+	case types.SInt8Type:
+		return f.placeCore([]uint32{uint32(int32(*(*int8)(p)))}, false)
+	case types.UInt16Type:
+		return f.placeCore([]uint32{uint32(*(*uint16)(p))}, false)
+	case types.SInt16Type:
+		return f.placeCore([]uint32{uint32(int32(*(*int16)(p)))}, false)
+	case types.UInt32Type, types.SInt32Type, types.IntType:
+		return f.placeCore([]uint32{*(*uint32)(p)}, false)
+	case types.UInt64Type, types.SInt64Type:
+		bits := *(*uint64)(p)
+		return f.placeCore([]uint32{uint32(bits), uint32(bits >> 32)}, true)
+	case types.PointerType:
+		return f.placeCore([]uint32{uint32(*(*uintptr)(p))}, false)
+	case types.StructType:
+		ensureStructLayout(t)
+		if vfp {
+			if isHA, _, elemKind := isHomogeneousAggregate(t); isHA {
+				return f.placeVFP(aggregateWords(t, p, elemKind), elemKind == types.DoubleType)
+			}
+		}
+		return f.placeCore(memoryWords(p, t.Size), t.Alignment >= 8)
+	default:
+		return types.ErrInvalidTypeDefinition
+	}
+}
+
+// memoryWords returns the size bytes at p as little-endian words, the last
+// one zero-padded (rule B.5 rounds composites up to a multiple of 4).
+func memoryWords(p unsafe.Pointer, size uintptr) []uint32 {
+	if size == 0 {
+		return nil
+	}
+	words := make([]uint32, (size+3)/4)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&words[0])), len(words)*4), unsafe.Slice((*byte)(p), size))
+	return words
+}
+
+// aggregateWords returns the S-register images of the elements of the
+// homogeneous aggregate t stored at p. Elements of one floating-point kind
+// are laid out without padding; each half-precision element takes the low
+// 16 bits of its own S register.
+func aggregateWords(t *types.TypeDescriptor, p unsafe.Pointer, elemKind types.TypeKind) []uint32 {
+	if elemKind != types.Float16Type {
+		return memoryWords(p, t.Size)
+	}
+	halves := unsafe.Slice((*uint16)(p), t.Size/2)
+	words := make([]uint32, len(halves))
+	for i, h := range halves {
+		words[i] = uint32(h)
+	}
+	return words
+}
+
+// storeReturn writes the result of a call to rvalue. r0 and r1 are the core
+// result registers and fret holds S0-S7 (D0-D3). Values returned through
+// memory are already in place.
+func storeReturn(t *types.TypeDescriptor, rvalue unsafe.Pointer, r0, r1 uint32, fret [8]uint32, vfp bool) error {
+	if rvalue == nil || t.Kind == types.VoidType || returnsViaPointer(t, vfp) {
+		return nil
+	}
+
+	// The base standard returns floating-point values in R0 (and R1).
+	if !vfp {
+		fret[0], fret[1] = r0, r1
+	}
+
+	switch t.Kind {
+	case types.FloatType:
+		*(*uint32)(rvalue) = fret[0]
+	case types.Float16Type:
+		*(*uint16)(rvalue) = uint16(fret[0])
+	case types.DoubleType:
+		*(*uint64)(rvalue) = uint64(fret[0]) | uint64(fret[1])<<32
+	case types.UInt8Type:
+		*(*uint8)(rvalue) = uint8(r0)
+	case types.BoolType:
+		*(*bool)(rvalue) = uint8(r0) != 0
+	case types.SInt8Type:
+		*(*int8)(rvalue) = int8(r0)
+	case types.UInt16Type:
+		*(*uint16)(rvalue) = uint16(r0)
+	case types.SInt16Type:
+		*(*int16)(rvalue) = int16(r0)
+	case types.UInt32Type, types.SInt32Type, types.IntType:
+		*(*uint32)(rvalue) = r0
+	case types.UInt64Type, types.SInt64Type:
+		*(*uint64)(rvalue) = uint64(r0) | uint64(r1)<<32
+	case types.PointerType:
+		*(*uintptr)(rvalue) = uintptr(r0)
+	case types.StructType:
+		if isHA, _, elemKind := isHomogeneousAggregate(t); isHA && vfp {
+			if elemKind == types.Float16Type {
+				dest := unsafe.Slice((*uint16)(rvalue), t.Size/2)
+				for i := range dest {
+					dest[i] = uint16(fret[i])
+				}
+				return nil
+			}
+			copy(unsafe.Slice((*byte)(rvalue), t.Size), unsafe.Slice((*byte)(unsafe.Pointer(&fret[0])), len(fret)*4))
+			return nil
+		}
+		// Composites of up to 4 bytes: copy only Size bytes, rvalue may be
+		// exactly as large as the struct.
+		copy(unsafe.Slice((*byte)(rvalue), t.Size), unsafe.Slice((*byte)(unsafe.Pointer(&r0)), 4))
+	default:
+		return types.ErrUnsupportedReturnType
+	}
+	return nil
+}
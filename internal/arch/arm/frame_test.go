@@ -0,0 +1,264 @@
+package arm
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// build places args into a fresh frame and fails the test on error.
+func build(t *testing.T, vfp bool, args ...any) *frame {
+	t.Helper()
+	f := newFrame(0)
+	for i, a := range args {
+		var err error
+		switch v := a.(type) {
+		case int32:
+			err = f.place(types.SInt32TypeDescriptor, unsafe.Pointer(&v), vfp)
+		case int8:
+			err = f.place(types.SInt8TypeDescriptor, unsafe.Pointer(&v), vfp)
+		case int64:
+			err = f.place(types.SInt64TypeDescriptor, unsafe.Pointer(&v), vfp)
+		case float32:
+			err = f.place(types.FloatTypeDescriptor, unsafe.Pointer(&v), vfp)
+		case float64:
+			err = f.place(types.DoubleTypeDescriptor, unsafe.Pointer(&v), vfp)
+		default:
+			t.Fatalf("arg %d: unsupported test type %T", i, a)
+		}
+		if err != nil {
+			t.Fatalf("arg %d: place: %v", i, err)
+		}
+	}
+	return f
+}
+
+func lo(d float64) uint32 { return uint32(math.Float64bits(d)) }
+func hi(d float64) uint32 { return uint32(math.Float64bits(d) >> 32) }
+
+func TestFrameCoreRegisters(t *testing.T) {
+	f := build(t, true, int32(1), int8(-2), int32(3))
+	want := [4]uint32{1, 0xfffffffe, 3, 0}
+	if f.core != want {
+		t.Errorf("core = %#x, want %#x", f.core, want)
+	}
+	if f.ncrn != 3 {
+		t.Errorf("ncrn = %d, want 3", f.ncrn)
+	}
+}
+
+func TestFrameInt64UsesEvenPair(t *testing.T) {
+	f := build(t, true, int32(1), int64(0x1122334455667788))
+	want := [4]uint32{1, 0, 0x55667788, 0x11223344}
+	if f.core != want {
+		t.Errorf("core = %#x, want %#x", f.core, want)
+	}
+}
+
+func TestFrameInt64NotSplit(t *testing.T) {
+	// R3 is free, but a doubleword-aligned value skips to the stack
+	// (rule C.3 rounds NCRN to 4) and starts on an 8-byte boundary.
+	f := build(t, true, int32(1), int32(2), int32(3), int64(0x0102030405060708))
+	if f.stack[0] != 0x05060708 || f.stack[1] != 0x01020304 || f.nsaa != 2 {
+		t.Errorf("stack = %#x (nsaa %d), want int64 in words 0-1", f.stack[:2], f.nsaa)
+	}
+	if f.ncrn != 4 {
+		t.Errorf("ncrn = %d, want 4", f.ncrn)
+	}
+}
+
+func TestFrameCompositeSplit(t *testing.T) {
+	st := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.SInt32TypeDescriptor, types.SInt32TypeDescriptor, types.SInt32TypeDescriptor},
+	}
+	v := [3]int32{10, 20, 30}
+	f := build(t, true, int32(1), int32(2))
+	if err := f.place(st, unsafe.Pointer(&v), true); err != nil {
+		t.Fatal(err)
+	}
+	if f.core[2] != 10 || f.core[3] != 20 || f.stack[0] != 30 || f.nsaa != 1 {
+		t.Errorf("core = %v stack[0] = %d nsaa = %d, want split 10,20 | 30", f.core, f.stack[0], f.nsaa)
+	}
+
+	// Once something is on the stack, a composite is no longer split.
+	if err := f.place(st, unsafe.Pointer(&v), true); err != nil {
+		t.Fatal(err)
+	}
+	if f.stack[1] != 10 || f.stack[3] != 30 || f.nsaa != 4 {
+		t.Errorf("stack = %v nsaa = %d, want second struct in words 1-3", f.stack[:4], f.nsaa)
+	}
+}
+
+func TestFrameVFPBackfill(t *testing.T) {
+	f := build(t, true, float32(1), float64(2), float32(3))
+	if f.vfp[0] != math.Float32bits(1) {
+		t.Errorf("s0 = %#x, want 1.0", f.vfp[0])
+	}
+	if f.vfp[1] != math.Float32bits(3) {
+		t.Errorf("s1 = %#x, want back-filled 3.0", f.vfp[1])
+	}
+	if f.vfp[2] != lo(2) || f.vfp[3] != hi(2) {
+		t.Errorf("d1 = %#x:%#x, want 2.0", f.vfp[2], f.vfp[3])
+	}
+	if f.ncrn != 0 || f.nsaa != 0 {
+		t.Errorf("ncrn = %d nsaa = %d, want no core or stack use", f.ncrn, f.nsaa)
+	}
+}
+
+func TestFrameVFPExhaustion(t *testing.T) {
+	args := []any{}
+	for k := 0; k < 7; k++ {
+		args = append(args, float64(k))
+	}
+	// S14 takes the float; D7 is then unavailable, so the next double goes
+	// to the stack and every later VFP candidate follows it (rule C.2),
+	// even though S15 is still free.
+	args = append(args, float32(7), float64(8), float32(9))
+	f := build(t, true, args...)
+
+	if f.vfp[14] != math.Float32bits(7) {
+		t.Errorf("s14 = %#x, want 7.0", f.vfp[14])
+	}
+	if f.vfp[15] != 0 {
+		t.Errorf("s15 = %#x, want unused", f.vfp[15])
+	}
+	if f.stack[0] != lo(8) || f.stack[1] != hi(8) || f.stack[2] != math.Float32bits(9) {
+		t.Errorf("stack = %#x, want 8.0 then 9.0", f.stack[:3])
+	}
+}
+
+func TestFrameVariadicUsesCoreRegisters(t *testing.T) {
+	f := build(t, false, int32(1), float64(2), float32(3))
+	want := [4]uint32{1, 0, lo(2), hi(2)}
+	if f.core != want {
+		t.Errorf("core = %#x, want %#x", f.core, want)
+	}
+	if f.stack[0] != math.Float32bits(3) {
+		t.Errorf("stack[0] = %#x, want 3.0", f.stack[0])
+	}
+	if f.sused != 0 {
+		t.Errorf("sused = %#x, want no VFP registers", f.sused)
+	}
+}
+
+func TestFrameHomogeneousAggregate(t *testing.T) {
+	dd := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.DoubleTypeDescriptor, types.DoubleTypeDescriptor, types.DoubleTypeDescriptor},
+	}
+	v := [3]float64{1, 2, 3}
+	f := build(t, true, float32(0.5))
+	if err := f.place(dd, unsafe.Pointer(&v), true); err != nil {
+		t.Fatal(err)
+	}
+	// S0 holds the float, so the aggregate starts at D1.
+	for k, d := range v {
+		if f.vfp[2+2*k] != lo(d) || f.vfp[3+2*k] != hi(d) {
+			t.Errorf("d%d = %#x:%#x, want %v", k+1, f.vfp[2+2*k], f.vfp[3+2*k], d)
+		}
+	}
+
+	// The same aggregate in a variadic call is an ordinary composite.
+	g := newFrame(0)
+	if err := g.place(dd, unsafe.Pointer(&v), false); err != nil {
+		t.Fatal(err)
+	}
+	if g.core[0] != lo(1) || g.core[3] != hi(2) || g.stack[0] != lo(3) {
+		t.Errorf("core = %#x stack = %#x, want aggregate split across R0-R3 and stack", g.core, g.stack[:2])
+	}
+}
+
+func TestFrameSret(t *testing.T) {
+	f := newFrame(0x1000)
+	v := int32(5)
+	if err := f.place(types.SInt32TypeDescriptor, unsafe.Pointer(&v), true); err != nil {
+		t.Fatal(err)
+	}
+	if f.core[0] != 0x1000 || f.core[1] != 5 {
+		t.Errorf("core = %#x, want result buffer in R0 and first argument in R1", f.core)
+	}
+}
+
+func TestFrameStackLimit(t *testing.T) {
+	f := newFrame(0)
+	v := int32(1)
+	var err error
+	for k := 0; k < 4+maxStackWords+1 && err == nil; k++ {
+		err = f.place(types.SInt32TypeDescriptor, unsafe.Pointer(&v), true)
+	}
+	if err == nil {
+		t.Fatal("expected an error once the stack words are exhausted")
+	}
+}
+
+func TestStoreReturn(t *testing.T) {
+	var fret [8]uint32
+	fret[0], fret[1] = lo(2.5), hi(2.5)
+
+	var d float64
+	if err := storeReturn(types.DoubleTypeDescriptor, unsafe.Pointer(&d), 0, 0, fret, true); err != nil || d != 2.5 {
+		t.Errorf("VFP double = %v (%v), want 2.5", d, err)
+	}
+	d = 0
+	if err := storeReturn(types.DoubleTypeDescriptor, unsafe.Pointer(&d), lo(4.5), hi(4.5), fret, false); err != nil || d != 4.5 {
+		t.Errorf("variadic double = %v (%v), want 4.5 from R0:R1", d, err)
+	}
+
+	var i64 int64
+	if err := storeReturn(types.SInt64TypeDescriptor, unsafe.Pointer(&i64), 0xfffffffe, 0xffffffff, fret, true); err != nil || i64 != -2 {
+		t.Errorf("int64 = %d (%v), want -2", i64, err)
+	}
+
+	var s8 int8
+	if err := storeReturn(types.SInt8TypeDescriptor, unsafe.Pointer(&s8), 0xff, 0, fret, true); err != nil || s8 != -1 {
+		t.Errorf("int8 = %d (%v), want -1", s8, err)
+	}
+
+	ff := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.FloatTypeDescriptor, types.FloatTypeDescriptor},
+	}
+	fret[0], fret[1] = math.Float32bits(1), math.Float32bits(2)
+	var pair [2]float32
+	if err := storeReturn(ff, unsafe.Pointer(&pair), 0, 0, fret, true); err != nil || pair != [2]float32{1, 2} {
+		t.Errorf("float pair = %v (%v), want [1 2]", pair, err)
+	}
+
+	small := &types.TypeDescriptor{
+		Kind:    types.StructType,
+		Members: []*types.TypeDescriptor{types.UInt8TypeDescriptor, types.UInt8TypeDescriptor, types.UInt8TypeDescriptor},
+	}
+	out := [4]byte{0, 0, 0, 0xaa}
+	if err := storeReturn(small, unsafe.Pointer(&out), 0x00332211, 0, fret, true); err != nil || out != [4]byte{0x11, 0x22, 0x33, 0xaa} {
+		t.Errorf("3-byte struct = %#x (%v), want only Size bytes written", out, err)
+	}
+}
+
+func TestClassifyReturnARM(t *testing.T) {
+	mk := func(members ...*types.TypeDescriptor) *types.TypeDescriptor {
+		return &types.TypeDescriptor{Kind: types.StructType, Members: members}
+	}
+	tests := []struct {
+		name string
+		t    *types.TypeDescriptor
+		want int
+	}{
+		{"void", types.VoidTypeDescriptor, types.ReturnVoid},
+		{"float", types.FloatTypeDescriptor, types.ReturnInXMM32},
+		{"double", types.DoubleTypeDescriptor, types.ReturnInXMM64},
+		{"int64", types.SInt64TypeDescriptor, types.ReturnInt64},
+		{"pointer", types.PointerTypeDescriptor, types.ReturnUInt32},
+		{"4 doubles", mk(types.DoubleTypeDescriptor, types.DoubleTypeDescriptor, types.DoubleTypeDescriptor, types.DoubleTypeDescriptor), types.ReturnHFA4 | types.ReturnInXMM64},
+		{"2 floats", mk(types.FloatTypeDescriptor, types.FloatTypeDescriptor), types.ReturnHFA2 | types.ReturnInXMM32},
+		{"2 shorts", mk(types.SInt16TypeDescriptor, types.SInt16TypeDescriptor), types.ReturnUInt32},
+		{"2 ints", mk(types.SInt32TypeDescriptor, types.SInt32TypeDescriptor), types.ReturnViaPointer | types.ReturnVoid},
+	}
+	for _, tt := range tests {
+		if got := classifyReturnARM(tt.t); got != tt.want {
+			t.Errorf("%s: classifyReturnARM = %#x, want %#x", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+//go:build arm
+
+package arm
+
+import (
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/types"
+)
+
+type Implementation struct{}
+
+func init() {
+	arch.Register(&Implementation{}, &Implementation{})
+}
+
+func (i *Implementation) ClassifyReturn(
+	t *types.TypeDescriptor,
+	abi types.CallingConvention,
+) int {
+	return classifyReturnARM(t)
+}
+
+func (i *Implementation) ClassifyArgument(
+	t *types.TypeDescriptor,
+	abi types.CallingConvention,
+) arch.ArgumentClassification {
+	gpr, vfp := classifyArgumentARM(t)
+	return arch.ArgumentClassification{
+		GPRCount: gpr,
+		SSECount: vfp, // VFP D registers, mapped to SSECount for interface compatibility
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"runtime"
 	"unsafe"
 
+	"github.com/go-webgpu/goffi/internal/arch"
 	gosyscall "github.com/go-webgpu/goffi/internal/syscall"
 	"github.com/go-webgpu/goffi/types"
 )
@@ -28,6 +29,18 @@ func placeStructRegisters(
 	if base == nil || desc == nil || desc.Kind != types.StructType {
 		return false
 	}
+	if isHFA, _, _ := isHomogeneousFloatAggregate(desc); !isHFA && isPacked(desc) {
+		// Packed fields may straddle the eightbytes: copy the bytes as they are.
+		ok := true
+		for off := uintptr(0); off < desc.Size && ok; off += 8 {
+			var v uint64
+			for k := off; k < min(off+8, desc.Size); k++ {
+				v |= uint64(*(*uint8)(unsafe.Add(base, k))) << ((k - off) * 8)
+			}
+			ok = addInt(v)
+		}
+		return ok
+	}
 
 	var (
 		val   uint64
@@ -64,7 +77,7 @@ func placeStructRegisters(
 				if member == nil {
 					continue
 				}
-				offset = alignOffset(offset, member.Alignment)
+				offset = alignOffset(offset, cur.MemberAlignment(member))
 				place(member, unsafe.Add(ptr, offset))
 				offset += member.Size
 			}
@@ -87,12 +100,19 @@ func placeStructRegisters(
 			val |= uint64(bits) << shift
 			shift += 32
 			class |= classFloat
+		case types.Float16Type:
+			if class == classFloat {
+				flush()
+			}
+			val |= uint64(*(*uint16)(ptr)) << shift
+			shift += 16
+			class |= classFloat
 		case types.DoubleType:
 			ok = addFloat(math.Float64bits(*(*float64)(ptr))) && ok
 			shift = 0
 			class = classNone
 			val = 0
-		case types.UInt8Type:
+		case types.UInt8Type, types.BoolType:
 			val |= uint64(*(*uint8)(ptr)) << shift
 			shift += 8
 			class |= classInt
@@ -126,6 +146,11 @@ func placeStructRegisters(
 			shift = 0
 			class = classNone
 			val = 0
+		case types.SInt128Type, types.UInt128Type:
+			ok = addInt(*(*uint64)(ptr)) && addInt(*(*uint64)(unsafe.Add(ptr, 8))) && ok
+			shift = 0
+			class = classNone
+			val = 0
 		case types.PointerType:
 			ok = addInt(uint64(*(*uintptr)(ptr))) && ok
 			shift = 0
@@ -153,6 +178,27 @@ func (i *Implementation) Execute(
 	rvalue unsafe.Pointer,
 	avalue []unsafe.Pointer,
 ) error {
+	_, err := i.execute(cif, fn, rvalue, avalue)
+	return err
+}
+
+// ExecuteRegisters is Execute that also reports the raw contents of the two
+// integer return registers (X0, X1).
+func (i *Implementation) ExecuteRegisters(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) ([2]uint64, error) {
+	return i.execute(cif, fn, rvalue, avalue)
+}
+
+func (i *Implementation) execute(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) ([2]uint64, error) {
 	// AAPCS64 ABI:
 	// - X0-X7: 8 integer/pointer GP registers
 	// - D0-D7: 8 floating-point registers
@@ -160,7 +206,9 @@ func (i *Implementation) Execute(
 
 	var gpr [8]uintptr
 	var fpr [8]uint64
+	var fprHi [8]uint64 // high halves of V0-V7, for vector arguments
 	var stackArgs [maxStackArgs]uintptr
+	vector := cif.ReturnType.Kind == types.VectorType
 
 	gprIdx := 0
 	fprIdx := 0
@@ -196,10 +244,15 @@ func (i *Implementation) Execute(
 	}
 
 	// Determine if we need to pass X8 for large struct return (sret)
+	// A discarded result still needs a buffer of the full struct size.
 	var r8 uintptr
-	if cif.Flags&types.ReturnViaPointer != 0 && rvalue != nil {
+	sretBuf := rvalue
+	if cif.Flags&types.ReturnViaPointer != 0 {
+		if sretBuf == nil {
+			sretBuf = unsafe.Pointer(&make([]byte, cif.ReturnType.Size)[0])
+		}
 		// For sret, pass rvalue pointer in X8 - callee writes directly to it
-		r8 = uintptr(rvalue)
+		r8 = uintptr(sretBuf)
 	}
 
 	// Map arguments to registers or stack
@@ -225,11 +278,41 @@ func (i *Implementation) Execute(
 			addFloat(uint64(math.Float32bits(*(*float32)(avalue[idx]))))
 		case types.DoubleType:
 			addFloat(math.Float64bits(*(*float64)(avalue[idx])))
+		case types.Float16Type:
+			// Raw binary16 bits, read by the callee from H<n>.
+			addFloat(uint64(*(*uint16)(avalue[idx])))
+		case types.VectorType:
+			// A short vector: one whole V register, else a 16-byte
+			// aligned stack slot.
+			lo, hi := *(*uint64)(avalue[idx]), *(*uint64)(unsafe.Add(avalue[idx], 8))
+			if fprIdx < 8 {
+				fprHi[fprIdx] = hi
+				addFloat(lo)
+			} else {
+				stackIdx += stackIdx % 2
+				for _, w := range [2]uint64{lo, hi} {
+					if stackIdx < maxStackArgs {
+						stackArgs[stackIdx] = uintptr(w)
+					}
+					stackIdx++ // past maxStackArgs: rejected below
+				}
+			}
+			vector = true
+		case types.SInt128Type, types.UInt128Type:
+			// AAPCS64 C.8/C.9: an even-numbered register pair, or a
+			// 16-byte aligned stack slot once fewer than two remain.
+			lo, hi := *(*uintptr)(avalue[idx]), *(*uintptr)(unsafe.Add(avalue[idx], 8))
+			gprIdx += gprIdx % 2
+			if gprIdx >= 8 {
+				stackIdx += stackIdx % 2
+			}
+			addInt(lo)
+			addInt(hi)
 		case types.PointerType:
 			addInt(*(*uintptr)(avalue[idx]))
 		case types.SInt8Type:
 			addInt(uintptr(int64(*(*int8)(avalue[idx]))))
-		case types.UInt8Type:
+		case types.UInt8Type, types.BoolType:
 			addInt(uintptr(*(*uint8)(avalue[idx])))
 		case types.SInt16Type:
 			addInt(uintptr(int64(*(*int16)(avalue[idx]))))
@@ -284,6 +367,11 @@ func (i *Implementation) Execute(
 
 			if argType.Size <= 16 {
 				intCount, floatCount := countStructRegUsage(argType)
+				if argType.Alignment == 16 && intCount > 0 {
+					// AAPCS64 C.8: 16-byte aligned composites start at an
+					// even-numbered register.
+					gprIdx += gprIdx % 2
+				}
 				if gprIdx+intCount <= 8 && fprIdx+floatCount <= 8 {
 					ok := placeStructRegisters(
 						avalue[idx],
@@ -307,14 +395,77 @@ func (i *Implementation) Execute(
 
 	// Validate we haven't exceeded platform maximum
 	if stackIdx > maxStackArgs {
-		return fmt.Errorf("goffi: %d stack arguments exceed platform limit of %d", stackIdx, maxStackArgs)
+		return [2]uint64{}, fmt.Errorf("goffi: %d stack arguments exceed platform limit of %d", stackIdx, maxStackArgs)
 	}
 
 	// Call via our ARM64 syscall wrapper
-	ret1, ret2, fret := gosyscall.CallNFloat(uintptr(fn), gpr, fpr, stackArgs, stackIdx, r8)
+	var ret1, ret2 uintptr
+	var fret [4]uint64
+	var v0 [2]uint64
+	var clobberErr error
+	switch {
+	case vector:
+		// Vectors need whole V registers in both directions.
+		var vregs [8][2]uint64
+		for k := range vregs {
+			vregs[k] = [2]uint64{fpr[k], fprHi[k]}
+		}
+		var v1 [2]uint64
+		var clobbered uint32
+		ret1, ret2, v0, v1, clobbered = gosyscall.CallNVector(uintptr(fn), gpr, vregs, stackArgs, stackIdx, r8, cif.CheckRegisters)
+		clobberErr = clobberError(clobbered)
+		fret[0], fret[1] = v0[0], v1[0]
+	case cif.CheckRegisters:
+		var clobbered uint32
+		ret1, ret2, fret, clobbered = gosyscall.CallNFloatChecked(uintptr(fn), gpr, fpr, stackArgs, stackIdx, r8)
+		clobberErr = clobberError(clobbered)
+	default:
+		ret1, ret2, fret = gosyscall.CallNFloat(uintptr(fn), gpr, fpr, stackArgs, stackIdx, r8)
+	}
 
 	runtime.KeepAlive(avalue)
+	runtime.KeepAlive(sretBuf)
 
 	// Handle return value based on type
-	return i.handleReturn(cif, rvalue, uint64(ret1), uint64(ret2), fret)
+	regs := [2]uint64{uint64(ret1), uint64(ret2)}
+	if cif.Flags == types.ReturnInXMM128 {
+		if rvalue != nil {
+			*(*[2]uint64)(rvalue) = v0
+		}
+		return regs, clobberErr
+	}
+	if err := i.handleReturn(cif, rvalue, uint64(ret1), uint64(ret2), fret); err != nil {
+		return regs, err
+	}
+	return regs, clobberErr
+}
+
+// ExecuteRaw calls fn with X0-X7, D0-D7, up to 7 stack words and X8 taken
+// verbatim from regs.
+func (i *Implementation) ExecuteRaw(fn unsafe.Pointer, regs *arch.RawRegisters) {
+	var gpr [8]uintptr
+	for k := range gpr {
+		gpr[k] = uintptr(regs.GPR[k])
+	}
+	var stackArgs [maxStackArgs]uintptr
+	for k, w := range regs.Stack {
+		stackArgs[k] = uintptr(w)
+	}
+
+	r1, r2, fret := gosyscall.CallNFloat(uintptr(fn), gpr, regs.FPR, stackArgs, len(regs.Stack), regs.Sret)
+	regs.R1, regs.R2, regs.FRet = uint64(r1), uint64(r2), fret
+}
+
+// clobberError converts a CallNFloatChecked mask into an error.
+func clobberError(clobbered uint32) error {
+	if clobbered == 0 {
+		return nil
+	}
+	var names []string
+	for bit, name := range gosyscall.CalleeSavedRegisters {
+		if clobbered&(1<<bit) != 0 {
+			names = append(names, name)
+		}
+	}
+	return &types.RegisterClobberError{Registers: names}
 }
@@ -0,0 +1,20 @@
+//go:build arm64 && !(linux || darwin || windows || freebsd)
+
+package arm64
+
+import (
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/types"
+)
+
+// Execute reports that no AAPCS64 calling sequence is available on
+// this operating system. The package still builds so that callers compile.
+func (i *Implementation) Execute(
+	cif *types.CallInterface,
+	fn unsafe.Pointer,
+	rvalue unsafe.Pointer,
+	avalue []unsafe.Pointer,
+) error {
+	return types.ErrUnsupportedArchitecture
+}
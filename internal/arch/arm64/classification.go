@@ -56,6 +56,9 @@ func ensureStructLayout(desc *types.TypeDescriptor) (size, align uintptr) {
 			continue
 		}
 		mSize, mAlign := ensureStructLayout(member)
+		if desc.PackedAlignment != 0 {
+			mAlign = min(mAlign, desc.PackedAlignment)
+		}
 		if mAlign == 0 {
 			mAlign = 1
 		}
@@ -87,8 +90,14 @@ func classifyReturnARM64(t *types.TypeDescriptor, abi types.CallingConvention) i
 		return types.ReturnVoid
 	case types.FloatType:
 		return types.ReturnInXMM32 // Uses D0 on ARM64
+	case types.Float16Type:
+		return types.ReturnInXMM32 // Uses H0 (low 16 bits of D0) on ARM64
 	case types.DoubleType:
 		return types.ReturnInXMM64 // Uses D0 on ARM64
+	case types.SInt128Type, types.UInt128Type:
+		return types.ReturnInt64 // Returned in X0-X1
+	case types.VectorType:
+		return types.ReturnInXMM128 // All of V0
 	case types.StructType:
 		ensureStructLayout(t)
 		// AAPCS64: Check HFA first - HFAs are returned in D0-D3 regardless of size.
@@ -97,7 +106,7 @@ func classifyReturnARM64(t *types.TypeDescriptor, abi types.CallingConvention) i
 		if isHFA && hfaCount <= 4 {
 			// Determine element type (float32 or float64)
 			elemType := types.ReturnInXMM64 // default to double
-			if elemKind == types.FloatType {
+			if elemKind == types.FloatType || elemKind == types.Float16Type {
 				elemType = types.ReturnInXMM32
 			}
 
@@ -139,8 +148,8 @@ func classifyArgumentARM64(t *types.TypeDescriptor, abi types.CallingConvention)
 	res := classification{}
 
 	switch t.Kind {
-	case types.FloatType, types.DoubleType:
-		// Floating-point arguments use FP registers (D0-D7)
+	case types.FloatType, types.DoubleType, types.Float16Type, types.VectorType:
+		// Floating-point and vector arguments use FP registers (V0-V7)
 		res.FPRCount = 1
 	case types.StructType:
 		ensureStructLayout(t)
@@ -174,6 +183,9 @@ func countStructRegUsage(desc *types.TypeDescriptor) (intCount, floatCount int)
 		return 0, 0
 	}
 	ensureStructLayout(desc)
+	if isPacked(desc) {
+		return int((desc.Size + 7) / 8), 0
+	}
 
 	var (
 		shift uint
@@ -205,7 +217,7 @@ func countStructRegUsage(desc *types.TypeDescriptor) (intCount, floatCount int)
 				if member == nil {
 					continue
 				}
-				offset = alignOffset(offset, member.Alignment)
+				offset = alignOffset(offset, cur.MemberAlignment(member))
 				walk(member)
 				offset += member.Size
 			}
@@ -226,12 +238,18 @@ func countStructRegUsage(desc *types.TypeDescriptor) (intCount, floatCount int)
 			}
 			shift += 32
 			class |= classFloat
+		case types.Float16Type:
+			if class == classFloat {
+				flush()
+			}
+			shift += 16
+			class |= classFloat
 		case types.DoubleType:
 			flush()
 			floatCount++
 			shift = 0
 			class = classNone
-		case types.UInt8Type, types.SInt8Type:
+		case types.UInt8Type, types.SInt8Type, types.BoolType:
 			shift += 8
 			class |= classInt
 		case types.UInt16Type, types.SInt16Type:
@@ -240,6 +258,11 @@ func countStructRegUsage(desc *types.TypeDescriptor) (intCount, floatCount int)
 		case types.UInt32Type, types.SInt32Type:
 			shift += 32
 			class |= classInt
+		case types.SInt128Type, types.UInt128Type:
+			flush()
+			intCount += 2
+			shift = 0
+			class = classNone
 		case types.UInt64Type, types.SInt64Type, types.PointerType:
 			flush()
 			intCount++
@@ -261,8 +284,23 @@ func countStructRegUsage(desc *types.TypeDescriptor) (intCount, floatCount int)
 	return intCount, floatCount
 }
 
+// isPacked reports whether the struct desc, or a struct nested in it,
+// overrides natural alignment with PackedAlignment. Such composites are
+// passed as their bytes in memory order, in X registers unless they are HFAs.
+func isPacked(desc *types.TypeDescriptor) bool {
+	if desc.PackedAlignment != 0 {
+		return true
+	}
+	for _, member := range desc.Members {
+		if member != nil && member.Kind == types.StructType && isPacked(member) {
+			return true
+		}
+	}
+	return false
+}
+
 // isHomogeneousFloatAggregate checks if a struct is an HFA (Homogeneous Floating-point Aggregate).
-// An HFA contains 1-4 total floating-point members (float32 or float64) of the same type, possibly nested.
+// An HFA contains 1-4 total floating-point members (float16, float32 or float64) of the same type, possibly nested.
 func isHomogeneousFloatAggregate(t *types.TypeDescriptor) (bool, int, types.TypeKind) {
 	if t.Kind != types.StructType {
 		return false, 0, types.VoidType
@@ -278,7 +316,7 @@ func isHomogeneousFloatAggregate(t *types.TypeDescriptor) (bool, int, types.Type
 	var walk func(desc *types.TypeDescriptor) bool
 	walk = func(desc *types.TypeDescriptor) bool {
 		switch desc.Kind {
-		case types.FloatType, types.DoubleType:
+		case types.FloatType, types.DoubleType, types.Float16Type:
 			if elementKind == invalidKind {
 				elementKind = desc.Kind
 			} else if desc.Kind != elementKind {
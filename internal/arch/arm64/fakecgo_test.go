@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2026 The Goffi Authors
 
-//go:build arm64 && !cgo
+//go:build arm64 && (linux || darwin || freebsd) && !cgo
 
 package arm64
 
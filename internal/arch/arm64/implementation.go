@@ -70,8 +70,14 @@ func (i *Implementation) handleReturn(
 	case types.DoubleType:
 		// Single double in D0
 		*(*float64)(rvalue) = math.Float64frombits(fret[0])
+	case types.Float16Type:
+		// Single half in H0 (low 16 bits of D0)
+		*(*uint16)(rvalue) = uint16(fret[0])
 	case types.UInt8Type:
 		*(*uint8)(rvalue) = uint8(retLo)
+	case types.BoolType:
+		// Only W0's low byte is defined.
+		*(*bool)(rvalue) = uint8(retLo) != 0
 	case types.SInt8Type:
 		*(*int8)(rvalue) = int8(retLo)
 	case types.UInt16Type:
@@ -84,14 +90,17 @@ func (i *Implementation) handleReturn(
 		*(*int32)(rvalue) = int32(retLo)
 	case types.UInt64Type, types.SInt64Type, types.PointerType:
 		*(*uint64)(rvalue) = retLo
+	case types.SInt128Type, types.UInt128Type:
+		*(*[2]uint64)(rvalue) = [2]uint64{retLo, retHi}
 	case types.StructType:
-		if cif.ReturnType.Size <= 8 {
-			*(*uint64)(rvalue) = retLo
-		} else if cif.ReturnType.Size <= 16 {
+		// Copy only Size bytes: rvalue may be exactly as large as the struct.
+		size := cif.ReturnType.Size
+		if size <= 8 {
+			copy(unsafe.Slice((*byte)(rvalue), size), (*[8]byte)(unsafe.Pointer(&retLo))[:size])
+		} else if size <= 16 {
 			// 9-16 byte struct returned in X0-X1
-			dest := (*[2]uint64)(rvalue)
-			dest[0] = retLo
-			dest[1] = retHi
+			*(*uint64)(rvalue) = retLo
+			copy(unsafe.Slice((*byte)(unsafe.Add(rvalue, 8)), size-8), (*[8]byte)(unsafe.Pointer(&retHi))[:size-8])
 		} else {
 			return types.ErrUnsupportedReturnType
 		}
@@ -103,7 +112,7 @@ func (i *Implementation) handleReturn(
 }
 
 // handleHFAReturn handles HFA (Homogeneous Floating-point Aggregate) returns.
-// HFA structs with 2-4 floats/doubles are returned in D0-D3.
+// HFA structs with 2-4 halves/floats/doubles are returned in H0-H3/S0-S3/D0-D3.
 func (i *Implementation) handleHFAReturn(
 	cif *types.CallInterface,
 	rvalue unsafe.Pointer,
@@ -132,6 +141,14 @@ func (i *Implementation) handleHFAReturn(
 	}
 	isFloat32 := elemKind == types.FloatType
 
+	if elemKind == types.Float16Type {
+		dest := (*[4]uint16)(rvalue)
+		for idx := 0; idx < hfaCount; idx++ {
+			dest[idx] = uint16(fret[idx])
+		}
+		return nil
+	}
+
 	if isFloat32 {
 		dest := (*[4]float32)(rvalue)
 		for idx := 0; idx < hfaCount; idx++ {
@@ -1,6 +1,8 @@
 package arch
 
 import (
+	"fmt"
+	"sync"
 	"unsafe"
 
 	"github.com/go-webgpu/goffi/types"
@@ -11,6 +13,38 @@ type FunctionCaller interface {
 	Execute(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) error
 }
 
+// RegisterCaller is implemented by callers that can report the raw contents
+// of both integer return registers of a call (RAX/RDX on amd64, X0/X1 on arm64).
+type RegisterCaller interface {
+	ExecuteRegisters(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) ([2]uint64, error)
+}
+
+// ErrnoCaller is implemented by callers that capture the thread's last-error
+// value (GetLastError on Windows) as part of the call itself, before anything
+// else running on the thread can overwrite it.
+type ErrnoCaller interface {
+	ExecuteErrno(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) (uintptr, error)
+}
+
+// RawCaller is implemented by callers that can execute a call from explicit
+// register and stack contents, bypassing classification entirely.
+type RawCaller interface {
+	ExecuteRaw(fn unsafe.Pointer, regs *RawRegisters)
+}
+
+// RawRegisters is the machine state of a call made through RawCaller. The
+// argument fields are read before the call, the return fields written after.
+// Bounds are checked by the caller of ExecuteRaw.
+type RawRegisters struct {
+	GPR   [8]uint64 // Integer argument registers in ABI order
+	FPR   [8]uint64 // Vector argument registers, raw bit patterns
+	Stack []uint64  // Stack argument words, Stack[0] at the lowest address
+	Sret  uintptr   // Indirect result register (X8 on arm64), if the ABI has one
+
+	R1, R2 uint64    // Integer return registers
+	FRet   [4]uint64 // Vector return registers, raw bit patterns
+}
+
 // ArgumentClassifier defines the contract for argument classification
 type ArgumentClassifier interface {
 	ClassifyReturn(t *types.TypeDescriptor, abi types.CallingConvention) int
@@ -34,3 +68,58 @@ func Register(caller FunctionCaller, classifier ArgumentClassifier) {
 	Registry.Caller = caller
 	Registry.Classifier = classifier
 }
+
+// BackendKey identifies an alternative implementation registered with
+// RegisterBackend.
+type BackendKey struct {
+	Name       string                  // Backend name, never empty
+	Convention types.CallingConvention // Calling convention it implements
+	Arch       string                  // GOARCH it runs on
+}
+
+// Backend is a caller/classifier pair. CIFs classified by a backend's
+// Classifier must be executed by the same backend's Caller.
+type Backend struct {
+	Caller     FunctionCaller
+	Classifier ArgumentClassifier
+}
+
+// backends holds the implementations registered next to the default one.
+var backends struct {
+	mu sync.RWMutex
+	m  map[BackendKey]Backend
+}
+
+// RegisterBackend adds an alternative implementation. Registering the same
+// key twice is an error.
+func RegisterBackend(key BackendKey, b Backend) error {
+	if key.Name == "" {
+		return fmt.Errorf("backend name must not be empty")
+	}
+	if b.Caller == nil || b.Classifier == nil {
+		return fmt.Errorf("backend %q: caller and classifier must not be nil", key.Name)
+	}
+	backends.mu.Lock()
+	defer backends.mu.Unlock()
+	if _, ok := backends.m[key]; ok {
+		return fmt.Errorf("backend %q already registered for convention %d on %s", key.Name, key.Convention, key.Arch)
+	}
+	if backends.m == nil {
+		backends.m = make(map[BackendKey]Backend)
+	}
+	backends.m[key] = b
+	return nil
+}
+
+// LookupBackend returns the implementation registered under key. The empty
+// name denotes the default implementation in Registry, whatever the
+// convention and architecture.
+func LookupBackend(key BackendKey) (Backend, bool) {
+	if key.Name == "" {
+		return Backend{Caller: Registry.Caller, Classifier: Registry.Classifier}, Registry.Caller != nil
+	}
+	backends.mu.RLock()
+	defer backends.mu.RUnlock()
+	b, ok := backends.m[key]
+	return b, ok
+}
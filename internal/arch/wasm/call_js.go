@@ -0,0 +1,228 @@
+//go:build js
+
+package wasm
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"syscall/js"
+	"unsafe"
+
+	"github.com/go-webgpu/goffi/internal/arch"
+	"github.com/go-webgpu/goffi/types"
+)
+
+type Implementation struct{}
+
+func init() {
+	arch.Register(&Implementation{}, &Implementation{})
+}
+
+// ClassifyReturn reports no return flags: JavaScript has no registers.
+func (i *Implementation) ClassifyReturn(t *types.TypeDescriptor, abi types.CallingConvention) int {
+	return types.ReturnVoid
+}
+
+// ClassifyArgument reports no register usage, so no argument count is ever
+// rejected as overflowing the stack.
+func (i *Implementation) ClassifyArgument(t *types.TypeDescriptor, abi types.CallingConvention) arch.ArgumentClassification {
+	return arch.ArgumentClassification{}
+}
+
+// Execute calls the JavaScript function behind handle fn. Arguments and the
+// return value are converted as described by cif; a thrown exception is
+// returned as an error.
+func (i *Implementation) Execute(cif *types.CallInterface, fn unsafe.Pointer, rvalue unsafe.Pointer, avalue []unsafe.Pointer) (err error) {
+	f, this, ok := Lookup(uintptr(fn))
+	if !ok || f.Type() != js.TypeFunction {
+		return fmt.Errorf("goffi: %#x is not a JavaScript function handle", uintptr(fn))
+	}
+
+	args := make([]any, 1, len(avalue)+1)
+	args[0] = this
+	for k, t := range cif.ArgTypes {
+		v, err := ToJS(t, avalue[k])
+		if err != nil {
+			return fmt.Errorf("goffi: argument %d: %w", k, err)
+		}
+		args = append(args, v)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if jsErr, ok := r.(js.Error); ok {
+				err = fmt.Errorf("goffi: JavaScript exception: %w", jsErr)
+				return
+			}
+			panic(r)
+		}
+	}()
+	res := f.Call("call", args...)
+
+	if rvalue == nil || cif.ReturnType.Kind == types.VoidType {
+		return nil
+	}
+	return FromJS(cif.ReturnType, res, rvalue)
+}
+
+// ToJS converts the Go value of type t at p to a JavaScript value. Integers up
+// to 32 bits and floating-point values become Numbers, 64-bit integers
+// BigInts, bools Booleans, structs Arrays of their members, and pointers the
+// value behind a handle, null for 0, or a Number otherwise.
+func ToJS(t *types.TypeDescriptor, p unsafe.Pointer) (js.Value, error) {
+	switch t.Kind {
+	case types.SInt8Type:
+		return js.ValueOf(int(*(*int8)(p))), nil
+	case types.UInt8Type:
+		return js.ValueOf(int(*(*uint8)(p))), nil
+	case types.BoolType:
+		return js.ValueOf(*(*bool)(p)), nil
+	case types.SInt16Type:
+		return js.ValueOf(int(*(*int16)(p))), nil
+	case types.UInt16Type:
+		return js.ValueOf(int(*(*uint16)(p))), nil
+	case types.IntType, types.SInt32Type:
+		return js.ValueOf(int(*(*int32)(p))), nil
+	case types.UInt32Type:
+		return js.ValueOf(float64(*(*uint32)(p))), nil
+	case types.SInt64Type:
+		return bigInt(strconv.FormatInt(*(*int64)(p), 10)), nil
+	case types.UInt64Type:
+		return bigInt(strconv.FormatUint(*(*uint64)(p), 10)), nil
+	case types.FloatType:
+		return js.ValueOf(float64(*(*float32)(p))), nil
+	case types.DoubleType:
+		return js.ValueOf(*(*float64)(p)), nil
+	case types.Float16Type:
+		return js.ValueOf(float64(types.Float16(*(*uint16)(p)).Float32())), nil
+	case types.PointerType:
+		h := *(*uintptr)(p)
+		if h == 0 {
+			return js.Null(), nil
+		}
+		if v, _, ok := Lookup(h); ok {
+			return v, nil
+		}
+		return js.ValueOf(float64(h)), nil
+	case types.StructType:
+		arr := js.Global().Get("Array").New(len(t.Members))
+		var off uintptr
+		for k, m := range t.Members {
+			off = align(off, t.MemberAlignment(m))
+			v, err := ToJS(m, unsafe.Add(p, off))
+			if err != nil {
+				return js.Undefined(), err
+			}
+			arr.SetIndex(k, v)
+			off += m.Size
+		}
+		return arr, nil
+	}
+	return js.Undefined(), fmt.Errorf("unsupported type kind %d", t.Kind)
+}
+
+// FromJS stores the JavaScript value v as a Go value of type t at p, the
+// inverse of ToJS. A pointer result that is an object, function or string
+// gets a new handle, which the caller must release.
+//
+// String results for types.CStringTypeDescriptor and WStringTypeDescriptor
+// (any value but null and undefined, converted with String()) are stored as a
+// pointer to a NUL-terminated Go copy, which the ffi layer decodes like a C
+// string.
+func FromJS(t *types.TypeDescriptor, v js.Value, p unsafe.Pointer) error {
+	switch t.Kind {
+	case types.SInt8Type, types.UInt8Type:
+		*(*uint8)(p) = uint8(int64(number(v)))
+	case types.BoolType:
+		*(*bool)(p) = v.Truthy()
+	case types.SInt16Type, types.UInt16Type:
+		*(*uint16)(p) = uint16(int64(number(v)))
+	case types.IntType, types.SInt32Type, types.UInt32Type:
+		*(*uint32)(p) = uint32(int64(number(v)))
+	case types.SInt64Type, types.UInt64Type:
+		s := js.Global().Get("String").Invoke(v).String()
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			*(*int64)(p) = n
+		} else if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			*(*uint64)(p) = u
+		} else {
+			*(*int64)(p) = int64(number(v))
+		}
+	case types.FloatType:
+		*(*float32)(p) = float32(number(v))
+	case types.DoubleType:
+		*(*float64)(p) = number(v)
+	case types.Float16Type:
+		*(*uint16)(p) = uint16(types.Float16FromFloat32(float32(number(v))))
+	case types.PointerType:
+		if !t.CString && !t.WString {
+			*(*uintptr)(p) = pointer(v)
+			break
+		}
+		*(*unsafe.Pointer)(p) = nil
+		if v.IsNull() || v.IsUndefined() {
+			break
+		}
+		str := js.Global().Get("String").Invoke(v).String()
+		if t.WString {
+			var w []uint32
+			for _, r := range str {
+				w = append(w, uint32(r))
+			}
+			w = append(w, 0)
+			*(*unsafe.Pointer)(p) = unsafe.Pointer(&w[0])
+		} else {
+			b := append([]byte(str), 0)
+			*(*unsafe.Pointer)(p) = unsafe.Pointer(&b[0])
+		}
+	case types.StructType:
+		var off uintptr
+		for k, m := range t.Members {
+			off = align(off, t.MemberAlignment(m))
+			if err := FromJS(m, v.Index(k), unsafe.Add(p, off)); err != nil {
+				return err
+			}
+			off += m.Size
+		}
+	default:
+		return fmt.Errorf("goffi: unsupported return type kind %d", t.Kind)
+	}
+	return nil
+}
+
+// pointer converts a JavaScript value to a pointer-sized Go value.
+func pointer(v js.Value) uintptr {
+	switch v.Type() {
+	case js.TypeUndefined, js.TypeNull:
+		return 0
+	case js.TypeNumber:
+		return uintptr(v.Float())
+	case js.TypeBoolean:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	}
+	return NewHandle(v, js.Undefined())
+}
+
+// number converts Numbers, BigInts and Booleans to float64. NaN becomes 0.
+func number(v js.Value) float64 {
+	f := js.Global().Get("Number").Invoke(v).Float()
+	if math.IsNaN(f) {
+		return 0
+	}
+	return f
+}
+
+func bigInt(s string) js.Value {
+	return js.Global().Get("BigInt").Invoke(s)
+}
+
+func align(value, alignment uintptr) uintptr {
+	if alignment <= 1 {
+		return value
+	}
+	return (value + alignment - 1) &^ (alignment - 1)
+}
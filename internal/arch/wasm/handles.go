@@ -0,0 +1,54 @@
+//go:build js
+
+// Package wasm executes goffi calls under GOOS=js. "Native" functions are
+// JavaScript functions reached through syscall/js, and pointer-typed values
+// are handles of JavaScript values (objects, functions, strings) or plain
+// numbers such as Emscripten heap offsets.
+package wasm
+
+import (
+	"sync"
+	"syscall/js"
+	"unsafe"
+)
+
+// ref is a JavaScript value kept reachable by a handle. The address of the
+// ref is the handle, so handles never collide with each other or with NULL.
+type ref struct {
+	v    js.Value
+	this js.Value // receiver for function values resolved from an object
+}
+
+var refs = struct {
+	sync.Mutex
+	m map[uintptr]*ref
+}{m: make(map[uintptr]*ref)}
+
+// NewHandle returns a new handle for v. this is the receiver used when v is
+// called as a function; pass js.Undefined() for none.
+func NewHandle(v, this js.Value) uintptr {
+	r := &ref{v: v, this: this}
+	h := uintptr(unsafe.Pointer(r))
+	refs.Lock()
+	refs.m[h] = r
+	refs.Unlock()
+	return h
+}
+
+// Lookup returns the value and receiver behind handle h.
+func Lookup(h uintptr) (v, this js.Value, ok bool) {
+	refs.Lock()
+	r, ok := refs.m[h]
+	refs.Unlock()
+	if !ok {
+		return js.Undefined(), js.Undefined(), false
+	}
+	return r.v, r.this, true
+}
+
+// Release forgets handle h. Releasing an unknown handle does nothing.
+func Release(h uintptr) {
+	refs.Lock()
+	delete(refs.m, h)
+	refs.Unlock()
+}
@@ -1,4 +1,4 @@
-//go:build darwin
+//go:build darwin && (amd64 || arm64)
 
 // macOS-specific constants for dynamic library loading.
 //
@@ -38,6 +38,10 @@ const (
 	// RTLD_GLOBAL makes all symbols available for relocation processing of other modules.
 	// NOTE: Different from Linux (0x00100) - macOS uses 0x8
 	RTLD_GLOBAL = 0x8
+
+	// RTLD_NODELETE keeps the library loaded after its last dlclose.
+	// NOTE: Different from Linux (0x01000) - macOS uses 0x80
+	RTLD_NODELETE = 0x80
 )
 
 // RTLD_DEFAULT is a pseudo-handle for dlsym to search for any loaded symbol.
@@ -1,4 +1,4 @@
-//go:build freebsd
+//go:build freebsd && (amd64 || arm64)
 
 // FreeBSD-specific constants for dynamic library loading.
 //
@@ -38,6 +38,13 @@ const (
 
 	// RTLD_LOCAL makes symbols not available for relocation processing by other modules.
 	RTLD_LOCAL = 0x00000
+
+	// RTLD_NODELETE keeps the library loaded after its last dlclose.
+	RTLD_NODELETE = 0x01000
+
+	// RTLD_DEEPBIND makes the library prefer its own symbols over global ones.
+	// NOTE: Different from Linux (0x00008) - FreeBSD uses 0x40000
+	RTLD_DEEPBIND = 0x40000
 )
 
 // RTLD_DEFAULT is a pseudo-handle for dlsym to search for any loaded symbol.
@@ -1,4 +1,4 @@
-//go:build linux
+//go:build linux && (amd64 || arm64 || arm)
 
 // Linux-specific constants for dynamic library loading.
 //
@@ -44,6 +44,16 @@ const (
 
 	// RTLD_LOCAL makes symbols not available for relocation processing by other modules.
 	RTLD_LOCAL = 0x00000
+
+	// RTLD_NODELETE keeps the library loaded after its last dlclose.
+	RTLD_NODELETE = 0x01000
+
+	// RTLD_NOLOAD makes dlopen return a handle only for a library that is
+	// already loaded, without loading it.
+	RTLD_NOLOAD = 0x00004
+
+	// RTLD_DEEPBIND makes the library prefer its own symbols over global ones.
+	RTLD_DEEPBIND = 0x00008
 )
 
 // RTLD_DEFAULT is a pseudo-handle for dlsym to search for any loaded symbol.
@@ -0,0 +1,18 @@
+//go:build linux && arm
+
+#include "textflag.h"
+
+// JMP stubs to dynamically linked symbols (ARM)
+// These symbols are linked via //go:cgo_import_dynamic in dl_linux.go.
+
+// dlopen_stub: B to dlopen
+TEXT dlopen_stub(SB), NOSPLIT|NOFRAME, $0-0
+	B goffi_dlopen(SB)
+
+// dlsym_stub: B to dlsym
+TEXT dlsym_stub(SB), NOSPLIT|NOFRAME, $0-0
+	B goffi_dlsym(SB)
+
+// dlerror_stub: B to dlerror
+TEXT dlerror_stub(SB), NOSPLIT|NOFRAME, $0-0
+	B goffi_dlerror(SB)
@@ -1,6 +1,7 @@
 //go:build (linux || darwin || freebsd) && amd64
 
 #include "textflag.h"
+#include "cet_amd64.h"
 
 // JMP stubs to dynamically linked symbols
 // These symbols are linked via //go:cgo_import_dynamic in:
@@ -9,12 +10,15 @@
 
 // dlopen_stub: JMP to dlopen
 TEXT dlopen_stub(SB), NOSPLIT|NOFRAME, $0-0
+	ENDBR64
 	JMP goffi_dlopen(SB)
 
 // dlsym_stub: JMP to dlsym
 TEXT dlsym_stub(SB), NOSPLIT|NOFRAME, $0-0
+	ENDBR64
 	JMP goffi_dlsym(SB)
 
 // dlerror_stub: JMP to dlerror
 TEXT dlerror_stub(SB), NOSPLIT|NOFRAME, $0-0
+	ENDBR64
 	JMP goffi_dlerror(SB)
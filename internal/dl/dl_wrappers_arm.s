@@ -0,0 +1,89 @@
+//go:build linux && arm
+
+#include "textflag.h"
+
+// Assembly wrappers for dlopen/dlsym/dlerror using the ARM EABI (AAPCS).
+//
+// runtime.cgocall enters each wrapper on the system stack with the args
+// pointer in R0 and the stack 8-byte aligned. The wrappers keep the args
+// pointer in R4, which the callee preserves, and push R4 and LR together
+// so the alignment survives.
+//
+// Reference: Procedure Call Standard for the Arm Architecture (AAPCS32)
+// https://github.com/ARM-software/abi-aa/blob/main/aapcs32/aapcs32.rst
+
+// dlopen_wrapper calls dlopen(path, mode)
+//
+// Args struct layout:
+//   fn     uintptr  // offset 0
+//   path   *byte    // offset 4
+//   mode   int      // offset 8
+//   _pad   int      // offset 12
+//   result uintptr  // offset 16
+//
+GLOBL ·dlopen_wrapperABI0(SB), NOPTR|RODATA, $4
+DATA ·dlopen_wrapperABI0(SB)/4, $dlopen_wrapper(SB)
+
+TEXT dlopen_wrapper(SB), NOSPLIT|NOFRAME, $0
+	MOVM.WP [R4, R14], (R13)
+	MOVW    R0, R4
+
+	MOVW 4(R4), R0            // path (offset 4) -> R0
+	MOVW 8(R4), R1            // mode (offset 8) -> R1
+	MOVW 0(R4), R12           // fn pointer (offset 0)
+	BL   (R12)
+
+	MOVW R0, 16(R4)           // result (offset 16)
+
+	MOVM.IAW (R13), [R4, R14]
+	MOVW     $0, R0
+	RET
+
+// dlsym_wrapper calls dlsym(handle, symbol)
+//
+// Args struct layout:
+//   fn     uintptr  // offset 0
+//   handle uintptr  // offset 4
+//   symbol *byte    // offset 8
+//   _pad   int      // offset 12
+//   result uintptr  // offset 16
+//
+GLOBL ·dlsym_wrapperABI0(SB), NOPTR|RODATA, $4
+DATA ·dlsym_wrapperABI0(SB)/4, $dlsym_wrapper(SB)
+
+TEXT dlsym_wrapper(SB), NOSPLIT|NOFRAME, $0
+	MOVM.WP [R4, R14], (R13)
+	MOVW    R0, R4
+
+	MOVW 4(R4), R0            // handle (offset 4) -> R0
+	MOVW 8(R4), R1            // symbol (offset 8) -> R1
+	MOVW 0(R4), R12           // fn pointer (offset 0)
+	BL   (R12)
+
+	MOVW R0, 16(R4)           // result (offset 16)
+
+	MOVM.IAW (R13), [R4, R14]
+	MOVW     $0, R0
+	RET
+
+// dlerror_wrapper calls dlerror()
+//
+// Args struct layout:
+//   fn     uintptr  // offset 0
+//   result *byte    // offset 4
+//
+GLOBL ·dlerror_wrapperABI0(SB), NOPTR|RODATA, $4
+DATA ·dlerror_wrapperABI0(SB)/4, $dlerror_wrapper(SB)
+
+TEXT dlerror_wrapper(SB), NOSPLIT|NOFRAME, $0
+	MOVM.WP [R4, R14], (R13)
+	MOVW    R0, R4
+
+	MOVW 0(R4), R12           // fn pointer (offset 0)
+	BL   (R12)
+
+	MOVW R0, 4(R4)            // result (offset 4)
+
+	MOVM.IAW (R13), [R4, R14]
+	MOVW     $0, R0
+	RET
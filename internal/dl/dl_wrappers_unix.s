@@ -1,6 +1,7 @@
 //go:build (linux || darwin || freebsd) && amd64
 
 #include "textflag.h"
+#include "cet_amd64.h"
 
 // Assembly wrappers for dlopen/dlsym/dlerror using System V AMD64 ABI
 // This calling convention is IDENTICAL on Linux and macOS, so we share
@@ -22,6 +23,7 @@ GLOBL ·dlopen_wrapperABI0(SB), NOPTR|RODATA, $8
 DATA ·dlopen_wrapperABI0(SB)/8, $dlopen_wrapper(SB)
 
 TEXT dlopen_wrapper(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
 	PUSHQ BP
 	MOVQ  SP, BP
 	SUBQ  $16, SP
@@ -59,6 +61,7 @@ GLOBL ·dlsym_wrapperABI0(SB), NOPTR|RODATA, $8
 DATA ·dlsym_wrapperABI0(SB)/8, $dlsym_wrapper(SB)
 
 TEXT dlsym_wrapper(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
 	PUSHQ BP
 	MOVQ  SP, BP
 	SUBQ  $16, SP
@@ -93,6 +96,7 @@ GLOBL ·dlerror_wrapperABI0(SB), NOPTR|RODATA, $8
 DATA ·dlerror_wrapperABI0(SB)/8, $dlerror_wrapper(SB)
 
 TEXT dlerror_wrapper(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
 	PUSHQ BP
 	MOVQ  SP, BP
 	SUBQ  $16, SP
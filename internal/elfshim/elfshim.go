@@ -0,0 +1,305 @@
+// Package elfshim builds minimal ELF shared objects whose exported functions
+// jump through a writable table of code pointers.
+//
+// goffi loads such an object with RTLD_GLOBAL and fills the table with Go
+// callback addresses, which makes the callbacks resolvable by name through
+// dlsym for native code that discovers its hooks that way.
+//
+// The image needs no relocations: the stubs address the table PC-relatively,
+// and the dynamic loader only reads the program headers, DT_HASH, the dynamic
+// symbol table and the string table. Section headers are emitted for the
+// benefit of readelf, debuggers and debug/elf.
+package elfshim
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// Machine selects the instruction set of the generated stubs.
+type Machine uint16
+
+// Supported machines, as e_machine values.
+const (
+	MachineX86_64  Machine = 62
+	MachineAArch64 Machine = 183
+)
+
+// SlotsSymbol is the name of the exported object holding the jump table:
+// one code pointer per exported function, in the order given to Build.
+const SlotsSymbol = "goffi_export_slots"
+
+const (
+	pageAlign = 0x10000 // segment alignment valid for 4K, 16K and 64K pages
+	stubSize  = 16
+
+	ehdrSize = 64
+	phdrSize = 56
+	shdrSize = 64
+	symSize  = 24
+	dynSize  = 16
+	numPhdr  = 4
+
+	ptLoad     = 1
+	ptDynamic  = 2
+	ptGNUStack = 0x6474e551
+	pfX        = 1
+	pfW        = 2
+	pfR        = 4
+	dtNull     = 0
+	dtHash     = 4
+	dtStrtab   = 5
+	dtSymtab   = 6
+	dtStrsz    = 10
+	dtSyment   = 11
+	dtSoname   = 14
+	sttObject  = 1
+	sttFunc    = 2
+	stbGlobal  = 1
+
+	shtProgbits = 1
+	shtStrtab   = 3
+	shtHash     = 5
+	shtDynamic  = 6
+	shtDynsym   = 11
+	shfWrite    = 1
+	shfAlloc    = 2
+	shfExec     = 4
+)
+
+// Section indices, in the order of the section header table.
+const (
+	secHash = 1 + iota
+	secDynsym
+	secDynstr
+	secText
+	secDynamic
+	secData
+	secShstrtab
+	numSections
+)
+
+// shstrtab holds the section names; the offsets below index into it.
+const shstrtab = "\x00.hash\x00.dynsym\x00.dynstr\x00.text\x00.dynamic\x00.data\x00.shstrtab\x00"
+
+var sectionNameOff = [numSections]uint32{0, 1, 7, 15, 23, 29, 38, 44}
+
+// Build returns a little-endian ELF64 ET_DYN image with the given DT_SONAME
+// that exports one function per entry of names plus the object SlotsSymbol.
+// Calling names[i] jumps to the address stored in slot i.
+func Build(m Machine, soname string, names []string) ([]byte, error) {
+	if m != MachineX86_64 && m != MachineAArch64 {
+		return nil, fmt.Errorf("elfshim: unsupported machine %d", m)
+	}
+	if err := checkName(soname); err != nil {
+		return nil, fmt.Errorf("elfshim: soname: %w", err)
+	}
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if err := checkName(name); err != nil {
+			return nil, fmt.Errorf("elfshim: symbol %q: %w", name, err)
+		}
+		if name == SlotsSymbol || seen[name] {
+			return nil, fmt.Errorf("elfshim: duplicate symbol %q", name)
+		}
+		seen[name] = true
+	}
+
+	// Symbol 0 is the null symbol, then the functions, then the table.
+	symNames := append(append([]string{""}, names...), SlotsSymbol)
+	nsym := len(symNames)
+
+	var strtab strings.Builder
+	strtab.WriteByte(0)
+	nameOff := make([]uint32, nsym)
+	for i, name := range symNames[1:] {
+		nameOff[i+1] = uint32(strtab.Len())
+		strtab.WriteString(name)
+		strtab.WriteByte(0)
+	}
+	sonameOff := uint32(strtab.Len())
+	strtab.WriteString(soname)
+	strtab.WriteByte(0)
+
+	// Read-only, executable segment: headers, hash, symbols, strings, stubs.
+	hashOff := uint64(ehdrSize + numPhdr*phdrSize)
+	hashSize := uint64(4 * (2 + nsym + nsym))
+	symOff := alignUp(hashOff+hashSize, 8)
+	strOff := symOff + uint64(nsym*symSize)
+	textOff := alignUp(strOff+uint64(strtab.Len()), stubSize)
+	textEnd := textOff + uint64(len(names)*stubSize)
+
+	// Read-write segment: dynamic section and the jump table. Its file
+	// offset follows the text; its address starts on a fresh page with the
+	// same offset within the page, as the loader requires.
+	const numDyn = 7
+	dataOff := alignUp(textEnd, 16)
+	dataAddr := alignUp(textEnd, pageAlign) + dataOff%pageAlign
+	slotsAddr := dataAddr + numDyn*dynSize
+	dataSize := uint64(numDyn*dynSize + 8*len(names))
+
+	// Non-loaded tail: section names and the section header table.
+	shstrOff := dataOff + dataSize
+	shOff := alignUp(shstrOff+uint64(len(shstrtab)), 8)
+
+	img := make([]byte, shOff+numSections*shdrSize)
+	le := binary.LittleEndian
+
+	// ELF header.
+	copy(img, "\x7fELF")
+	img[4] = 2                // ELFCLASS64
+	img[5] = 1                // ELFDATA2LSB
+	img[6] = 1                // EV_CURRENT
+	le.PutUint16(img[16:], 3) // ET_DYN
+	le.PutUint16(img[18:], uint16(m))
+	le.PutUint32(img[20:], 1)
+	le.PutUint64(img[32:], ehdrSize) // e_phoff
+	le.PutUint16(img[52:], ehdrSize)
+	le.PutUint16(img[54:], phdrSize)
+	le.PutUint16(img[56:], numPhdr)
+	le.PutUint64(img[40:], shOff)
+	le.PutUint16(img[58:], shdrSize)
+	le.PutUint16(img[60:], numSections)
+	le.PutUint16(img[62:], secShstrtab)
+
+	phdr := func(i int, typ, flags uint32, off, addr, size, align uint64) {
+		p := img[ehdrSize+i*phdrSize:]
+		le.PutUint32(p[0:], typ)
+		le.PutUint32(p[4:], flags)
+		le.PutUint64(p[8:], off)
+		le.PutUint64(p[16:], addr)
+		le.PutUint64(p[24:], addr)
+		le.PutUint64(p[32:], size)
+		le.PutUint64(p[40:], size)
+		le.PutUint64(p[48:], align)
+	}
+	phdr(0, ptLoad, pfR|pfX, 0, 0, textEnd, pageAlign)
+	phdr(1, ptLoad, pfR|pfW, dataOff, dataAddr, dataSize, pageAlign)
+	phdr(2, ptDynamic, pfR|pfW, dataOff, dataAddr, numDyn*dynSize, 8)
+	phdr(3, ptGNUStack, pfR|pfW, 0, 0, 0, 16)
+
+	// SysV hash table with one bucket per symbol.
+	hash := img[hashOff:]
+	le.PutUint32(hash[0:], uint32(nsym))
+	le.PutUint32(hash[4:], uint32(nsym))
+	buckets := hash[8:]
+	chains := hash[8+4*nsym:]
+	for i := nsym - 1; i > 0; i-- {
+		b := elfHash(symNames[i]) % uint32(nsym)
+		le.PutUint32(chains[4*i:], le.Uint32(buckets[4*b:]))
+		le.PutUint32(buckets[4*b:], uint32(i))
+	}
+
+	// Dynamic symbols.
+	for i := 1; i < nsym; i++ {
+		s := img[symOff+uint64(i*symSize):]
+		le.PutUint32(s[0:], nameOff[i])
+		if i < nsym-1 {
+			s[4] = stbGlobal<<4 | sttFunc
+			le.PutUint16(s[6:], secText)
+			le.PutUint64(s[8:], textOff+uint64((i-1)*stubSize))
+			le.PutUint64(s[16:], stubSize)
+		} else {
+			s[4] = stbGlobal<<4 | sttObject
+			le.PutUint16(s[6:], secData)
+			le.PutUint64(s[8:], slotsAddr)
+			le.PutUint64(s[16:], uint64(8*len(names)))
+		}
+	}
+	copy(img[strOff:], strtab.String())
+
+	// Stubs.
+	for i := range names {
+		addr := textOff + uint64(i*stubSize)
+		putStub(m, img[addr:addr+stubSize], addr, slotsAddr+uint64(8*i))
+	}
+
+	// Dynamic section; the jump table after it starts out zeroed.
+	dyn := img[dataOff:]
+	for i, e := range [numDyn][2]uint64{
+		{dtHash, hashOff},
+		{dtStrtab, strOff},
+		{dtSymtab, symOff},
+		{dtStrsz, uint64(strtab.Len())},
+		{dtSyment, symSize},
+		{dtSoname, uint64(sonameOff)},
+		{dtNull, 0},
+	} {
+		le.PutUint64(dyn[i*dynSize:], e[0])
+		le.PutUint64(dyn[i*dynSize+8:], e[1])
+	}
+
+	// Section headers.
+	copy(img[shstrOff:], shstrtab)
+	shdr := func(i int, typ uint32, flags, addr, off, size uint64, link, info uint32, align, entsize uint64) {
+		p := img[shOff+uint64(i*shdrSize):]
+		le.PutUint32(p[0:], sectionNameOff[i])
+		le.PutUint32(p[4:], typ)
+		le.PutUint64(p[8:], flags)
+		le.PutUint64(p[16:], addr)
+		le.PutUint64(p[24:], off)
+		le.PutUint64(p[32:], size)
+		le.PutUint32(p[40:], link)
+		le.PutUint32(p[44:], info)
+		le.PutUint64(p[48:], align)
+		le.PutUint64(p[56:], entsize)
+	}
+	shdr(secHash, shtHash, shfAlloc, hashOff, hashOff, hashSize, secDynsym, 0, 4, 4)
+	shdr(secDynsym, shtDynsym, shfAlloc, symOff, symOff, uint64(nsym*symSize), secDynstr, 1, 8, symSize)
+	shdr(secDynstr, shtStrtab, shfAlloc, strOff, strOff, uint64(strtab.Len()), 0, 0, 1, 0)
+	shdr(secText, shtProgbits, shfAlloc|shfExec, textOff, textOff, textEnd-textOff, 0, 0, stubSize, 0)
+	shdr(secDynamic, shtDynamic, shfAlloc|shfWrite, dataAddr, dataOff, numDyn*dynSize, secDynstr, 0, 8, dynSize)
+	shdr(secData, shtProgbits, shfAlloc|shfWrite, slotsAddr, dataOff+numDyn*dynSize, uint64(8*len(names)), 0, 0, 8, 0)
+	shdr(secShstrtab, shtStrtab, 0, 0, shstrOff, uint64(len(shstrtab)), 0, 0, 1, 0)
+	return img, nil
+}
+
+// putStub writes a stub at addr that jumps to the pointer stored at slot.
+func putStub(m Machine, b []byte, addr, slot uint64) {
+	le := binary.LittleEndian
+	switch m {
+	case MachineX86_64:
+		// endbr64; jmp *slot(%rip); int3 padding
+		copy(b, []byte{0xf3, 0x0f, 0x1e, 0xfa, 0xff, 0x25})
+		le.PutUint32(b[6:], uint32(int32(int64(slot)-int64(addr+10))))
+		for k := 10; k < len(b); k++ {
+			b[k] = 0xcc
+		}
+	case MachineAArch64:
+		// bti c; ldr x16, slot; br x16; brk #0
+		imm19 := uint32((int64(slot)-int64(addr+4))/4) & 0x7ffff
+		le.PutUint32(b[0:], 0xd503245f)
+		le.PutUint32(b[4:], 0x58000010|imm19<<5)
+		le.PutUint32(b[8:], 0xd61f0200)
+		le.PutUint32(b[12:], 0xd4200000)
+	}
+}
+
+// checkName rejects names that cannot be stored in the string table.
+func checkName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if strings.IndexByte(name, 0) >= 0 {
+		return fmt.Errorf("name must not contain NUL")
+	}
+	return nil
+}
+
+// elfHash is the System V ABI symbol hash function.
+func elfHash(name string) uint32 {
+	var h uint32
+	for i := 0; i < len(name); i++ {
+		h = h<<4 + uint32(name[i])
+		if g := h & 0xf0000000; g != 0 {
+			h ^= g >> 24
+			h &^= g
+		}
+	}
+	return h
+}
+
+func alignUp(v, a uint64) uint64 {
+	return (v + a - 1) &^ (a - 1)
+}
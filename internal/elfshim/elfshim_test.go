@@ -0,0 +1,71 @@
+package elfshim
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"testing"
+)
+
+func TestBuild(t *testing.T) {
+	names := []string{"host_log", "host_time", "host_alloc"}
+	for _, m := range []Machine{MachineX86_64, MachineAArch64} {
+		img, err := Build(m, "libhost.so", names)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := elf.NewFile(bytes.NewReader(img))
+		if err != nil {
+			t.Fatalf("machine %d: %v", m, err)
+		}
+		if f.Type != elf.ET_DYN || f.Machine != elf.Machine(m) {
+			t.Errorf("machine %d: type %v machine %v", m, f.Type, f.Machine)
+		}
+		sonames, err := f.DynString(elf.DT_SONAME)
+		if err != nil || len(sonames) != 1 || sonames[0] != "libhost.so" {
+			t.Errorf("machine %d: DT_SONAME = %v, %v", m, sonames, err)
+		}
+
+		syms, err := f.DynamicSymbols()
+		if err != nil {
+			t.Fatalf("machine %d: %v", m, err)
+		}
+		if len(syms) != len(names)+1 {
+			t.Fatalf("machine %d: %d symbols, want %d", m, len(syms), len(names)+1)
+		}
+		slots := syms[len(names)]
+		if slots.Name != SlotsSymbol || slots.Size != uint64(8*len(names)) {
+			t.Errorf("machine %d: slots symbol %+v", m, slots)
+		}
+		for i, s := range syms[:len(names)] {
+			if s.Name != names[i] || elf.ST_TYPE(s.Info) != elf.STT_FUNC {
+				t.Errorf("machine %d: symbol %d = %+v", m, i, s)
+			}
+			if got, want := stubTarget(m, img, s.Value), slots.Value+uint64(8*i); got != want {
+				t.Errorf("machine %d: %s jumps through %#x, want %#x", m, s.Name, got, want)
+			}
+		}
+	}
+}
+
+// stubTarget decodes the slot address a stub at addr loads its target from.
+// The text segment is mapped at address 0, so addresses equal file offsets.
+func stubTarget(m Machine, img []byte, addr uint64) uint64 {
+	le := binary.LittleEndian
+	if m == MachineX86_64 {
+		return addr + 10 + uint64(int64(int32(le.Uint32(img[addr+6:]))))
+	}
+	imm19 := int64(le.Uint32(img[addr+4:])>>5&0x7ffff) << 45 >> 45
+	return addr + 4 + uint64(imm19*4)
+}
+
+func TestBuildInvalid(t *testing.T) {
+	for _, names := range [][]string{{""}, {"a", "a"}, {SlotsSymbol}, {"bad\x00name"}} {
+		if _, err := Build(MachineX86_64, "libx.so", names); err == nil {
+			t.Errorf("Build(%q) succeeded", names)
+		}
+	}
+	if _, err := Build(Machine(3), "libx.so", nil); err == nil {
+		t.Error("Build succeeded for unsupported machine")
+	}
+}
@@ -0,0 +1,116 @@
+// Package execmem allocates executable memory for code generated at run time.
+//
+// Hardened systems refuse memory that is writable and executable at the same
+// time, and some refuse to make anonymous memory executable at all:
+//
+//   - Linux: regions are mapped read-write and flipped to read-execute with
+//     mprotect (W^X). When SELinux denies execmem, the region is instead
+//     backed by a memfd mapped twice, once writable and once executable.
+//   - macOS: regions are mapped with MAP_JIT, which the hardened runtime
+//     requires (com.apple.security.cs.allow-jit). On Apple Silicon writes are
+//     bracketed with pthread_jit_write_protect_np on a locked OS thread.
+//   - Windows: VirtualAlloc/VirtualProtect, flipping between PAGE_READWRITE
+//     and PAGE_EXECUTE_READ.
+//
+// Callers never see which strategy is in use: they write code with
+// Region.Write and call it at Region.Addr. The instruction cache is
+// synchronized after every write.
+package execmem
+
+import (
+	"errors"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// ErrFreed is returned when a Region is used after Free.
+var ErrFreed = errors.New("execmem: region already freed")
+
+// ErrOutOfRange is returned when a write does not fit inside the Region.
+var ErrOutOfRange = errors.New("execmem: write out of range")
+
+// regionKind selects how a Region is made writable and executable.
+type regionKind int
+
+const (
+	kindProtect regionKind = iota // single mapping, mprotect/VirtualProtect between RW and RX
+	kindDual                      // two views of one memfd: exec is RX, data is RW
+	kindJIT                       // single RWX MAP_JIT mapping (macOS)
+)
+
+// Region is a block of executable memory.
+//
+// A Region must not be executed while a Write to it is in progress; with
+// single-mapping strategies the memory is not executable during the write.
+type Region struct {
+	mu    sync.Mutex
+	kind  regionKind
+	exec  []byte // executable view; Addr points here
+	data  []byte // writable view for kindDual, nil otherwise
+	freed bool
+}
+
+// Alloc returns a new Region of at least size bytes, rounded up to whole pages.
+// The memory is executable and initially zero.
+func Alloc(size int) (*Region, error) {
+	if size <= 0 {
+		return nil, errors.New("execmem: size must be positive")
+	}
+	return mapRegion(roundUp(size, pageSize()))
+}
+
+// Addr returns the address at which the Region's code executes.
+func (r *Region) Addr() uintptr {
+	return addrOf(r.exec)
+}
+
+// Size returns the size of the Region in bytes.
+func (r *Region) Size() int {
+	return len(r.exec)
+}
+
+// Write copies code to offset off in the Region and makes it executable.
+func (r *Region) Write(off int, code []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.freed {
+		return ErrFreed
+	}
+	if off < 0 || off > len(r.exec) || len(code) > len(r.exec)-off {
+		return ErrOutOfRange
+	}
+
+	if err := r.beginWrite(); err != nil {
+		return err
+	}
+	dst := r.exec
+	if r.kind == kindDual {
+		dst = r.data
+	}
+	copy(dst[off:], code)
+	return r.endWrite(off, len(code))
+}
+
+// Free releases the Region. Code in it must no longer be running or referenced.
+func (r *Region) Free() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.freed {
+		return ErrFreed
+	}
+	r.freed = true
+	return r.unmap()
+}
+
+func roundUp(n, to int) int {
+	return (n + to - 1) &^ (to - 1)
+}
+
+func pageSize() int {
+	return syscall.Getpagesize()
+}
+
+func addrOf(b []byte) uintptr {
+	return uintptr(unsafe.Pointer(&b[0]))
+}
@@ -0,0 +1,21 @@
+//go:build darwin && (amd64 || arm64)
+
+package execmem
+
+import "syscall"
+
+// mapJIT is MAP_JIT from <sys/mman.h>.
+const mapJIT = 0x800
+
+// mapRegion prefers a MAP_JIT mapping, the only kind of writable executable
+// memory the hardened runtime allows (with the allow-jit entitlement). Older
+// systems and unsigned binaries may reject MAP_JIT; they get plain W^X.
+func mapRegion(size int) (*Region, error) {
+	b, err := syscall.Mmap(-1, 0, size,
+		syscall.PROT_READ|syscall.PROT_WRITE|syscall.PROT_EXEC,
+		syscall.MAP_PRIVATE|syscall.MAP_ANON|mapJIT)
+	if err == nil {
+		return &Region{kind: kindJIT, exec: b}, nil
+	}
+	return mapProtect(size)
+}
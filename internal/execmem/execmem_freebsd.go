@@ -0,0 +1,7 @@
+//go:build freebsd && (amd64 || arm64)
+
+package execmem
+
+func mapRegion(size int) (*Region, error) {
+	return mapProtect(size)
+}
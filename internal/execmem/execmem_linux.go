@@ -0,0 +1,67 @@
+//go:build linux && (amd64 || arm64)
+
+package execmem
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+)
+
+// mfdCloexec is MFD_CLOEXEC from <linux/memfd.h>.
+const mfdCloexec = 0x1
+
+// execmemDenied is set once making anonymous memory executable has failed
+// with a permission error (SELinux deny_execmem, PaX MPROTECT), so later
+// allocations go straight to the dual mapping.
+var execmemDenied atomic.Bool
+
+func mapRegion(size int) (*Region, error) {
+	if !execmemDenied.Load() {
+		r, err := mapProtect(size)
+		if err == nil || !(errors.Is(err, syscall.EACCES) || errors.Is(err, syscall.EPERM)) {
+			return r, err
+		}
+		execmemDenied.Store(true)
+	}
+	return mapDual(size)
+}
+
+// mapDual backs the region with a memfd mapped twice: a read-write view for
+// writing and a read-execute view for running. Neither view is ever both
+// writable and executable, and the executable view is a file mapping, which
+// SELinux governs by the tmpfs file label rather than the execmem permission.
+func mapDual(size int) (*Region, error) {
+	fd, err := MemfdCreate("goffi-execmem")
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.Close(fd)
+
+	if err := syscall.Ftruncate(fd, int64(size)); err != nil {
+		return nil, fmt.Errorf("execmem: ftruncate: %w", err)
+	}
+	data, err := syscall.Mmap(fd, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, fmt.Errorf("execmem: mmap: %w", err)
+	}
+	exec, err := syscall.Mmap(fd, 0, size, syscall.PROT_READ|syscall.PROT_EXEC, syscall.MAP_SHARED)
+	if err != nil {
+		_ = syscall.Munmap(data)
+		return nil, fmt.Errorf("execmem: mmap: %w", err)
+	}
+	return &Region{kind: kindDual, exec: exec, data: data}, nil
+}
+
+// MemfdCreate creates an anonymous, close-on-exec tmpfs file. name only
+// shows up in /proc/self/fd and /proc/self/maps.
+func MemfdCreate(name string) (int, error) {
+	cname := append([]byte(name), 0)
+	fd, _, errno := syscall.Syscall(sysMemfdCreate, uintptr(unsafe.Pointer(&cname[0])), mfdCloexec, 0)
+	if errno != 0 {
+		return -1, fmt.Errorf("execmem: memfd_create: %w", errno)
+	}
+	return int(fd), nil
+}
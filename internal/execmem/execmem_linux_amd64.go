@@ -0,0 +1,3 @@
+package execmem
+
+const sysMemfdCreate = 319
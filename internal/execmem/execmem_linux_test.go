@@ -0,0 +1,16 @@
+//go:build linux && (amd64 || arm64)
+
+package execmem
+
+import "testing"
+
+func TestMapDual(t *testing.T) {
+	r, err := mapDual(pageSize())
+	if err != nil {
+		t.Skipf("memfd dual mapping not available: %v", err)
+	}
+	if r.Addr() == addrOf(r.data) {
+		t.Fatal("executable and writable views must be distinct mappings")
+	}
+	testRegion(t, r)
+}
@@ -0,0 +1,18 @@
+//go:build !(((linux || darwin || freebsd) && (amd64 || arm64)) || windows)
+
+package execmem
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// mapRegion fails: executable memory is only supported on the platforms
+// listed in the package documentation.
+func mapRegion(int) (*Region, error) {
+	return nil, fmt.Errorf("execmem: unsupported platform %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+func (r *Region) beginWrite() error       { return nil }
+func (r *Region) endWrite(int, int) error { return nil }
+func (r *Region) unmap() error            { return nil }
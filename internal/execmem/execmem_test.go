@@ -0,0 +1,84 @@
+//go:build ((linux || darwin || freebsd) && (amd64 || arm64)) || windows
+
+package execmem
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+// returnConst returns machine code for a leaf function returning v in the
+// first integer result register, which is where Go's register ABI expects
+// an int result as well.
+func returnConst(t *testing.T, v uint16) []byte {
+	t.Helper()
+	switch runtime.GOARCH {
+	case "amd64":
+		// mov eax, imm32; ret
+		return []byte{0xB8, byte(v), byte(v >> 8), 0, 0, 0xC3}
+	case "arm64":
+		// movz w0, #v; ret
+		movz := uint32(0x52800000) | uint32(v)<<5
+		return []byte{
+			byte(movz), byte(movz >> 8), byte(movz >> 16), byte(movz >> 24),
+			0xC0, 0x03, 0x5F, 0xD6,
+		}
+	}
+	t.Skipf("no test code for %s", runtime.GOARCH)
+	return nil
+}
+
+// callRegion calls the code at addr as a Go func() int.
+func callRegion(addr uintptr) int {
+	code := &addr
+	fn := *(*func() int)(unsafe.Pointer(&code))
+	return fn()
+}
+
+func testRegion(t *testing.T, r *Region) {
+	t.Helper()
+	if err := r.Write(0, returnConst(t, 42)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if got := callRegion(r.Addr()); got != 42 {
+		t.Fatalf("generated code returned %d, want 42", got)
+	}
+
+	// Rewriting must be visible to the next execution.
+	if err := r.Write(0, returnConst(t, 7)); err != nil {
+		t.Fatalf("rewrite: %v", err)
+	}
+	if got := callRegion(r.Addr()); got != 7 {
+		t.Fatalf("rewritten code returned %d, want 7", got)
+	}
+
+	if err := r.Write(r.Size()-1, []byte{0, 0}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Write past end: got %v, want ErrOutOfRange", err)
+	}
+	if err := r.Free(); err != nil {
+		t.Fatalf("Free: %v", err)
+	}
+	if err := r.Write(0, []byte{0}); !errors.Is(err, ErrFreed) {
+		t.Errorf("Write after Free: got %v, want ErrFreed", err)
+	}
+	if err := r.Free(); !errors.Is(err, ErrFreed) {
+		t.Errorf("second Free: got %v, want ErrFreed", err)
+	}
+}
+
+func TestAlloc(t *testing.T) {
+	r, err := Alloc(100)
+	if err != nil {
+		t.Fatalf("Alloc: %v", err)
+	}
+	if r.Size() < 100 || r.Size()%pageSize() != 0 {
+		t.Errorf("Size = %d, want a page multiple >= 100", r.Size())
+	}
+	testRegion(t, r)
+
+	if _, err := Alloc(0); err == nil {
+		t.Error("Alloc(0) should fail")
+	}
+}
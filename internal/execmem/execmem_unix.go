@@ -0,0 +1,59 @@
+//go:build (linux || darwin || freebsd) && (amd64 || arm64)
+
+package execmem
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// mapProtect maps a kindProtect region: read-write while it is being
+// written, read-execute otherwise.
+func mapProtect(size int) (*Region, error) {
+	b, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, fmt.Errorf("execmem: mmap: %w", err)
+	}
+	if err := mprotect(b, syscall.PROT_READ|syscall.PROT_EXEC); err != nil {
+		_ = syscall.Munmap(b)
+		return nil, fmt.Errorf("execmem: mprotect: %w", err)
+	}
+	return &Region{kind: kindProtect, exec: b}, nil
+}
+
+func (r *Region) beginWrite() error {
+	switch r.kind {
+	case kindProtect:
+		if err := mprotect(r.exec, syscall.PROT_READ|syscall.PROT_WRITE); err != nil {
+			return fmt.Errorf("execmem: mprotect: %w", err)
+		}
+	case kindJIT:
+		jitWriteEnable()
+	}
+	return nil
+}
+
+func (r *Region) endWrite(off, n int) error {
+	switch r.kind {
+	case kindProtect:
+		if err := mprotect(r.exec, syscall.PROT_READ|syscall.PROT_EXEC); err != nil {
+			return fmt.Errorf("execmem: mprotect: %w", err)
+		}
+	case kindJIT:
+		jitWriteDisable()
+	}
+	flushICache(r.exec[off : off+n])
+	return nil
+}
+
+func (r *Region) unmap() error {
+	if r.data != nil {
+		if err := syscall.Munmap(r.data); err != nil {
+			return fmt.Errorf("execmem: munmap: %w", err)
+		}
+	}
+	if err := syscall.Munmap(r.exec); err != nil {
+		return fmt.Errorf("execmem: munmap: %w", err)
+	}
+	return nil
+}
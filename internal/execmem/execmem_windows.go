@@ -0,0 +1,71 @@
+//go:build windows
+
+package execmem
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	memCommit       = 0x1000
+	memReserve      = 0x2000
+	memRelease      = 0x8000
+	pageReadWrite   = 0x04
+	pageExecuteRead = 0x20
+)
+
+var (
+	modkernel32               = syscall.NewLazyDLL("kernel32.dll")
+	procVirtualAlloc          = modkernel32.NewProc("VirtualAlloc")
+	procVirtualProtect        = modkernel32.NewProc("VirtualProtect")
+	procVirtualFree           = modkernel32.NewProc("VirtualFree")
+	procFlushInstructionCache = modkernel32.NewProc("FlushInstructionCache")
+	procGetCurrentProcess     = modkernel32.NewProc("GetCurrentProcess")
+)
+
+func mapRegion(size int) (*Region, error) {
+	addr, _, err := procVirtualAlloc.Call(0, uintptr(size), memCommit|memReserve, pageReadWrite)
+	if addr == 0 {
+		return nil, fmt.Errorf("execmem: VirtualAlloc: %w", err)
+	}
+	// Double indirection keeps checkptr from treating addr as a Go pointer.
+	b := unsafe.Slice(*(**byte)(unsafe.Pointer(&addr)), size)
+	r := &Region{kind: kindProtect, exec: b}
+	if err := r.protect(pageExecuteRead); err != nil {
+		_, _, _ = procVirtualFree.Call(addr, 0, memRelease)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Region) protect(prot uintptr) error {
+	var old uint32
+	ok, _, err := procVirtualProtect.Call(addrOf(r.exec), uintptr(len(r.exec)), prot, uintptr(unsafe.Pointer(&old)))
+	if ok == 0 {
+		return fmt.Errorf("execmem: VirtualProtect: %w", err)
+	}
+	return nil
+}
+
+func (r *Region) beginWrite() error {
+	return r.protect(pageReadWrite)
+}
+
+func (r *Region) endWrite(off, n int) error {
+	if err := r.protect(pageExecuteRead); err != nil {
+		return err
+	}
+	process, _, _ := procGetCurrentProcess.Call()
+	_, _, _ = procFlushInstructionCache.Call(process, addrOf(r.exec)+uintptr(off), uintptr(n))
+	return nil
+}
+
+func (r *Region) unmap() error {
+	ok, _, err := procVirtualFree.Call(addrOf(r.exec), 0, memRelease)
+	if ok == 0 {
+		return fmt.Errorf("execmem: VirtualFree: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build linux || freebsd
+
+package execmem
+
+// clearCache cleans the data cache and invalidates the instruction cache
+// for [start, end), like the compiler builtin __clear_cache.
+// Implemented in flush_arm64.s.
+//
+//go:noescape
+func clearCache(start, end uintptr)
+
+func flushICache(b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	start := addrOf(b)
+	clearCache(start, start+uintptr(len(b)))
+}
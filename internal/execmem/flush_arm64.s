@@ -0,0 +1,43 @@
+//go:build linux || freebsd
+
+#include "textflag.h"
+
+// func clearCache(start, end uintptr)
+//
+// Line sizes come from CTR_EL0 (DminLine bits 19:16, IminLine bits 3:0, both
+// log2 of the size in words). The Go assembler has no mnemonics for the
+// cache maintenance ops, so they are encoded by hand with X3 as operand:
+//   0xD50B7B23 = DC CVAU, X3
+//   0xD50B7523 = IC IVAU, X3
+TEXT ·clearCache(SB), NOSPLIT, $0-16
+	MOVD start+0(FP), R0
+	MOVD end+8(FP), R1
+	MRS  CTR_EL0, R2
+
+	// Clean D-cache to the point of unification.
+	UBFX $16, R2, $4, R4
+	MOVD $4, R5
+	LSL  R4, R5, R5           // R5 = D-cache line size
+	SUB  $1, R5, R6
+	BIC  R6, R0, R3           // align start down
+dloop:
+	WORD $0xD50B7B23
+	ADD  R5, R3, R3
+	CMP  R1, R3
+	BLO  dloop
+	DSB  $11                  // ISH
+
+	// Invalidate I-cache to the point of unification.
+	AND  $15, R2, R4
+	MOVD $4, R5
+	LSL  R4, R5, R5           // R5 = I-cache line size
+	SUB  $1, R5, R6
+	BIC  R6, R0, R3
+iloop:
+	WORD $0xD50B7523
+	ADD  R5, R3, R3
+	CMP  R1, R3
+	BLO  iloop
+	DSB  $11
+	ISB  $15
+	RET
@@ -0,0 +1,59 @@
+package execmem
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/go-webgpu/goffi/internal/dl"
+	gosyscall "github.com/go-webgpu/goffi/internal/syscall"
+)
+
+// libSystem entry points used on Apple Silicon, resolved on first use.
+var libSystem struct {
+	once                sync.Once
+	jitWriteProtect     uintptr // pthread_jit_write_protect_np
+	sysICacheInvalidate uintptr // sys_icache_invalidate
+}
+
+func loadLibSystem() {
+	h, err := dl.Dlopen("/usr/lib/libSystem.B.dylib", dl.RTLD_LAZY)
+	if err != nil {
+		return
+	}
+	libSystem.jitWriteProtect, _ = dl.Dlsym(h, "pthread_jit_write_protect_np")
+	libSystem.sysICacheInvalidate, _ = dl.Dlsym(h, "sys_icache_invalidate")
+}
+
+func call2(fn, a1, a2 uintptr) {
+	var fpr [8]uint64
+	var stack [7]uintptr
+	gosyscall.CallNFloat(fn, [8]uintptr{a1, a2}, fpr, stack, 0, 0)
+}
+
+// jitWriteEnable makes MAP_JIT memory writable (and not executable) for the
+// calling thread. The toggle is per thread, so the goroutine stays locked to
+// its thread until jitWriteDisable.
+func jitWriteEnable() {
+	libSystem.once.Do(loadLibSystem)
+	runtime.LockOSThread()
+	if libSystem.jitWriteProtect != 0 {
+		call2(libSystem.jitWriteProtect, 0, 0)
+	}
+}
+
+func jitWriteDisable() {
+	if libSystem.jitWriteProtect != 0 {
+		call2(libSystem.jitWriteProtect, 1, 0)
+	}
+	runtime.UnlockOSThread()
+}
+
+func flushICache(b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	libSystem.once.Do(loadLibSystem)
+	if libSystem.sysICacheInvalidate != 0 {
+		call2(libSystem.sysICacheInvalidate, addrOf(b), uintptr(len(b)))
+	}
+}
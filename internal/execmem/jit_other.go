@@ -0,0 +1,8 @@
+//go:build ((linux || freebsd) && (amd64 || arm64)) || (darwin && amd64)
+
+package execmem
+
+// MAP_JIT memory is plain RWX here (Intel macOS) or never used (Linux,
+// FreeBSD), so there is nothing to toggle.
+func jitWriteEnable()  {}
+func jitWriteDisable() {}
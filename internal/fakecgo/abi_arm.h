@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+// Macros for transitioning from the host ABI (AAPCS) to Go ABI0 on 32-bit ARM.
+//
+// AAPCS treats R4-R11 as callee-save, while Go ABI0 treats every register
+// except g (R10) and the stack pointer as caller-save, and uses R11 as the
+// assembler temporary. Code entered from C saves R4-R11 and LR before
+// calling into Go and restores them on the way out.
+//
+// REGS_HOST_TO_ABI0_STACK is the stack bytes used by PUSH_REGS_HOST_TO_ABI0;
+// it is a multiple of 4 but not of 8, so callers reserve an odd number of
+// words to keep the stack 8-byte aligned for any C code called later.
+#define REGS_HOST_TO_ABI0_STACK (9*4)
+
+#define PUSH_REGS_HOST_TO_ABI0() \
+	MOVM.WP [R4, R5, R6, R7, R8, R9, g, R11, R14], (R13)
+
+#define POP_REGS_HOST_TO_ABI0() \
+	MOVM.IAW (R13), [R4, R5, R6, R7, R8, R9, g, R11, R14]
@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 package fakecgo
 
@@ -90,4 +90,7 @@ var (
 	x_cgo_setenv_call       = x_cgo_setenv
 	x_cgo_unsetenv_call     = x_cgo_unsetenv
 	x_cgo_thread_start_call = x_cgo_thread_start
+	x_cgo_bindm_call        = x_cgo_bindm
+
+	x_cgo_wait_runtime_init_done_call = x_cgo_wait_runtime_init_done
 )
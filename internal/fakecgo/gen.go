@@ -20,7 +20,7 @@ const templateSymbols = `// Code generated by 'go generate' with gen.go. DO NOT
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 package fakecgo
 
@@ -87,7 +87,7 @@ const templateTrampolinesStubs = `// Code generated by 'go generate' with gen.go
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 #include "textflag.h"
 
@@ -104,11 +104,11 @@ const templateSymbolsGoos = `// Code generated by 'go generate' with gen.go. DO
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo
+//go:build !cgo && {{ .Arches }}
 
 package fakecgo
 
-{{- range $location := . }}
+{{- range $location := .Locations }}
 {{- range .Symbols }}
 //go:cgo_import_dynamic purego_{{ .Name }} {{ .Name }} "{{ $location.SharedObject }}"
 {{- end }}
@@ -131,6 +131,11 @@ type LocatedSymbols struct {
 	Symbols      []Symbol
 }
 
+type GoosSymbols struct {
+	Arches    string
+	Locations []LocatedSymbols
+}
+
 var (
 	libcSymbols = []Symbol{
 		{"malloc", [5]Arg{{"size", "uintptr"}}, "unsafe.Pointer"},
@@ -155,6 +160,7 @@ var (
 		{"pthread_mutex_lock", [5]Arg{{"mutex", "*pthread_mutex_t"}}, "int32"},
 		{"pthread_mutex_unlock", [5]Arg{{"mutex", "*pthread_mutex_t"}}, "int32"},
 		{"pthread_cond_broadcast", [5]Arg{{"cond", "*pthread_cond_t"}}, "int32"},
+		{"pthread_key_create", [5]Arg{{"key", "*pthread_key_t"}, {"destructor", "unsafe.Pointer"}}, "int32"},
 		{"pthread_setspecific", [5]Arg{{"key", "pthread_key_t"}, {"value", "unsafe.Pointer"}}, "int32"},
 	}
 )
@@ -209,6 +215,7 @@ func run() error {
 		}
 		b := &bytes.Buffer{}
 		var libcSO, pthreadSO string
+		arches := "(amd64 || arm64)"
 		switch goos {
 		case "darwin":
 			libcSO = "/usr/lib/libSystem.B.dylib"
@@ -219,6 +226,7 @@ func run() error {
 		case "linux":
 			libcSO = "libc.so.6"
 			pthreadSO = "libpthread.so.0"
+			arches = "(amd64 || arm64 || arm)"
 		case "netbsd":
 			libcSO = "libc.so"
 			pthreadSO = "libpthread.so"
@@ -229,7 +237,7 @@ func run() error {
 			{SharedObject: libcSO, Symbols: libcSymbols},
 			{SharedObject: pthreadSO, Symbols: pthreadSymbols},
 		}
-		if err = t.Execute(b, located); err != nil {
+		if err = t.Execute(b, GoosSymbols{Arches: arches, Locations: located}); err != nil {
 			return err
 		}
 		var src []byte
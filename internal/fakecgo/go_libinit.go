@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 package fakecgo
 
@@ -27,6 +27,31 @@ func x_cgo_notify_runtime_init_done() {
 	pthread_mutex_unlock(&runtime_init_mu)
 }
 
+// x_cgo_wait_runtime_init_done is runtime/cgo's _cgo_wait_runtime_init_done,
+// reduced to what a fakecgo program needs: the runtime is initialized by the
+// time Go code can call it, so it only creates the pthread key pthread_g.
+// Once the key exists, the runtime binds the M it lends a C thread calling
+// into Go to that thread, until pthread_key_destructor drops it when the
+// thread exits.
+//
+//go:nosplit
+//go:norace
+func x_cgo_wait_runtime_init_done() {
+	pthread_mutex_lock(&runtime_init_mu)
+	if x_cgo_pthread_key_created == 0 && pthread_key_create(&pthread_g, unsafe.Pointer(pthread_key_destructor_trampolineABI0)) == 0 {
+		x_cgo_pthread_key_created = 1
+	}
+	pthread_mutex_unlock(&runtime_init_mu)
+}
+
+// pthread_key_destructor_trampolineABI0 is the C destructor of pthread_g. It
+// passes the g stored by x_cgo_bindm to crosscall2 with a nil function,
+// which makes runtime.cgocallback drop the M.
+//
+//go:linkname x_pthread_key_destructor_trampoline pthread_key_destructor_trampoline
+var x_pthread_key_destructor_trampoline byte
+var pthread_key_destructor_trampolineABI0 = &x_pthread_key_destructor_trampoline
+
 // Store the g into a thread-specific value associated with the pthread key pthread_g.
 // And pthread_key_destructor will dropm when the thread is exiting.
 //
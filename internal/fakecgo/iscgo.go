@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 // The runtime package contains an uninitialized definition
 // for runtime·iscgo. Override it to tell the runtime we're here.
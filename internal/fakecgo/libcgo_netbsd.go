@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2025 The Ebitengine Authors
 
-//go:build !cgo
+//go:build !cgo && (amd64 || arm64)
 
 package fakecgo
 
@@ -3,7 +3,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 package fakecgo
 
@@ -140,6 +140,12 @@ func pthread_cond_broadcast(cond *pthread_cond_t) int32 {
 	return int32(call5(pthread_cond_broadcastABI0, uintptr(unsafe.Pointer(cond)), 0, 0, 0, 0))
 }
 
+//go:nosplit
+//go:norace
+func pthread_key_create(key *pthread_key_t, destructor unsafe.Pointer) int32 {
+	return int32(call5(pthread_key_createABI0, uintptr(unsafe.Pointer(key)), uintptr(destructor), 0, 0, 0))
+}
+
 //go:nosplit
 //go:norace
 func pthread_setspecific(key pthread_key_t, value unsafe.Pointer) int32 {
@@ -226,6 +232,10 @@ var pthread_mutex_unlockABI0 = uintptr(unsafe.Pointer(&_pthread_mutex_unlock))
 var _pthread_cond_broadcast uint8
 var pthread_cond_broadcastABI0 = uintptr(unsafe.Pointer(&_pthread_cond_broadcast))
 
+//go:linkname _pthread_key_create _pthread_key_create
+var _pthread_key_create uint8
+var pthread_key_createABI0 = uintptr(unsafe.Pointer(&_pthread_key_create))
+
 //go:linkname _pthread_setspecific _pthread_setspecific
 var _pthread_setspecific uint8
 var pthread_setspecificABI0 = uintptr(unsafe.Pointer(&_pthread_setspecific))
@@ -3,7 +3,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo
+//go:build !cgo && (amd64 || arm64)
 
 package fakecgo
 
@@ -27,4 +27,5 @@ package fakecgo
 //go:cgo_import_dynamic purego_pthread_mutex_lock pthread_mutex_lock "libpthread.so"
 //go:cgo_import_dynamic purego_pthread_mutex_unlock pthread_mutex_unlock "libpthread.so"
 //go:cgo_import_dynamic purego_pthread_cond_broadcast pthread_cond_broadcast "libpthread.so"
+//go:cgo_import_dynamic purego_pthread_key_create pthread_key_create "libpthread.so"
 //go:cgo_import_dynamic purego_pthread_setspecific pthread_setspecific "libpthread.so"
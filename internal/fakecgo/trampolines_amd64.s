@@ -21,6 +21,7 @@ temporary register is R11
 #include "textflag.h"
 #include "go_asm.h"
 #include "abi_amd64.h"
+#include "cet_amd64.h"
 
 // these trampolines map the gcc ABI to Go ABI and then calls into the Go equivalent functions.
 
@@ -56,8 +57,43 @@ TEXT x_cgo_unsetenv_trampoline(SB), NOSPLIT, $8
 TEXT x_cgo_notify_runtime_init_done_trampoline(SB), NOSPLIT, $0
 	JMP ·x_cgo_notify_runtime_init_done(SB)
 
-TEXT x_cgo_bindm_trampoline(SB), NOSPLIT, $0
-	JMP ·x_cgo_bindm(SB)
+TEXT x_cgo_bindm_trampoline(SB), NOSPLIT, $8
+	MOVQ DI, AX
+	MOVQ ·x_cgo_bindm_call(SB), R11
+	MOVQ (R11), R11
+	CALL R11
+	RET
+
+// _cgo_wait_runtime_init_done has the name and C ABI of the runtime/cgo
+// function, so goffi can call it in either build mode.
+TEXT _cgo_wait_runtime_init_done(SB), NOSPLIT, $0
+	ENDBR64
+	PUSH_REGS_HOST_TO_ABI0()
+
+	// X15 is designated by Go as a fixed zero register.
+	// Calling directly into ABIInternal, ensure it is zero.
+	PXOR X15, X15
+
+	MOVQ ·x_cgo_wait_runtime_init_done_call(SB), R11
+	MOVQ (R11), R11
+	CALL R11
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+// pthread_key_destructor_trampoline(g) calls crosscall2(nil, g, 0, 0) unless
+// g is nil, as runtime/cgo's pthread_key_destructor does.
+TEXT pthread_key_destructor_trampoline(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
+	TESTQ DI, DI
+	JZ    nog
+	MOVQ  DI, SI
+	XORL  DI, DI
+	XORL  DX, DX
+	XORL  CX, CX
+	JMP   crosscall2(SB)
+
+nog:
+	RET
 
 // func setg_trampoline(setg uintptr, g uintptr)
 TEXT ·setg_trampoline(SB), NOSPLIT, $0-16
@@ -68,6 +104,7 @@ TEXT ·setg_trampoline(SB), NOSPLIT, $0-16
 	RET
 
 TEXT threadentry_trampoline(SB), NOSPLIT, $0
+	ENDBR64
 	// See crosscall2.
 	PUSH_REGS_HOST_TO_ABI0()
 
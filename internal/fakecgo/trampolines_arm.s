@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Goffi Authors
+
+//go:build !cgo && linux
+
+#include "textflag.h"
+#include "go_asm.h"
+#include "abi_arm.h"
+
+// These trampolines map the gcc ABI to Go ABI0 and then call into the Go equivalent functions.
+// C arguments arrive in R0-R3; Go ABI0 takes them on the stack starting at 4(R13),
+// with 0(R13) left for the callee's saved link register.
+// Go functions are reached through closures (see callbacks.go), which load the
+// context register R7 and branch to the code pointer in R12.
+
+TEXT x_cgo_init_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	MOVW R0, 4(R13)
+	MOVW R1, 8(R13)
+	MOVW ·x_cgo_init_call(SB), R7
+	MOVW (R7), R12
+	BL   (R12)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+TEXT x_cgo_thread_start_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	MOVW R0, 4(R13)
+	MOVW ·x_cgo_thread_start_call(SB), R7
+	MOVW (R7), R12
+	BL   (R12)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+TEXT x_cgo_setenv_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	MOVW R0, 4(R13)
+	MOVW ·x_cgo_setenv_call(SB), R7
+	MOVW (R7), R12
+	BL   (R12)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+TEXT x_cgo_unsetenv_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	MOVW R0, 4(R13)
+	MOVW ·x_cgo_unsetenv_call(SB), R7
+	MOVW (R7), R12
+	BL   (R12)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+TEXT x_cgo_notify_runtime_init_done_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	BL   ·x_cgo_notify_runtime_init_done(SB)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+TEXT x_cgo_bindm_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	MOVW R0, 4(R13)
+	BL   ·x_cgo_bindm(SB)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+// _cgo_wait_runtime_init_done has the name and C ABI of the runtime/cgo
+// function, so goffi can call it in either build mode.
+TEXT _cgo_wait_runtime_init_done(SB), NOSPLIT|NOFRAME, $0-0
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	BL   ·x_cgo_wait_runtime_init_done(SB)
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+// pthread_key_destructor_trampoline(g) calls crosscall2(nil, g, 0, 0) unless
+// g is nil, as runtime/cgo's pthread_key_destructor does.
+TEXT pthread_key_destructor_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	CMP  $0, R0
+	BEQ  nog
+	MOVW R0, R1
+	MOVW $0, R0
+	MOVW $0, R2
+	MOVW $0, R3
+	B    crosscall2(SB)
+
+nog:
+	RET
+
+// func setg_trampoline(setg uintptr, g uintptr)
+TEXT ·setg_trampoline(SB), NOSPLIT, $0-8
+	MOVW G+4(FP), R0
+	MOVW setg+0(FP), R12
+	BL   (R12)
+	RET
+
+TEXT threadentry_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	// See crosscall2.
+	PUSH_REGS_HOST_TO_ABI0()
+	SUB  $12, R13
+	MOVW R0, 4(R13)
+	MOVW ·threadentry_call(SB), R7
+	MOVW (R7), R12
+	BL   (R12)
+	MOVW 8(R13), R0
+	ADD  $12, R13
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+// func call5(fn, a1, a2, a3, a4, a5 uintptr) uintptr
+//
+// The fifth argument goes on the stack. Go only keeps R13 4-byte aligned,
+// so the C call runs on an 8-byte aligned copy; the old stack pointer is kept
+// in R4, which the callee preserves.
+TEXT ·call5(SB), NOSPLIT, $0-28
+	MOVW fn+0(FP), R12
+	MOVW a1+4(FP), R0
+	MOVW a2+8(FP), R1
+	MOVW a3+12(FP), R2
+	MOVW a4+16(FP), R3
+	MOVW a5+20(FP), R5
+
+	MOVW R13, R4
+	SUB  $8, R13
+	BIC  $7, R13
+	MOVW R5, 0(R13)
+
+	BL (R12)
+
+	MOVW R4, R13
+	MOVW R0, ret+24(FP)
+	RET
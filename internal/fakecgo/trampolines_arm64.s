@@ -40,7 +40,41 @@ TEXT x_cgo_notify_runtime_init_done_trampoline(SB), NOSPLIT, $0-0
 	RET
 
 TEXT x_cgo_bindm_trampoline(SB), NOSPLIT, $0
-	CALL ·x_cgo_bindm(SB)
+	MOVD ·x_cgo_bindm_call(SB), R9
+	MOVD (R9), R9
+	CALL R9
+	RET
+
+// _cgo_wait_runtime_init_done has the name and C ABI of the runtime/cgo
+// function, so goffi can call it in either build mode.
+TEXT _cgo_wait_runtime_init_done(SB), NOSPLIT|NOFRAME, $0-0
+	// See crosscall2.
+	SUB $(8*24), RSP
+	SAVE_R19_TO_R28(8*4)
+	SAVE_F8_TO_F15(8*14)
+	STP (R29, R30), (8*22)(RSP)
+
+	MOVD ·x_cgo_wait_runtime_init_done_call(SB), R9
+	MOVD (R9), R9
+	CALL R9
+
+	RESTORE_R19_TO_R28(8*4)
+	RESTORE_F8_TO_F15(8*14)
+	LDP (8*22)(RSP), (R29, R30)
+	ADD $(8*24), RSP
+	RET
+
+// pthread_key_destructor_trampoline(g) calls crosscall2(nil, g, 0, 0) unless
+// g is nil, as runtime/cgo's pthread_key_destructor does.
+TEXT pthread_key_destructor_trampoline(SB), NOSPLIT|NOFRAME, $0-0
+	CBZ  R0, nog
+	MOVD R0, R1
+	MOVD $0, R0
+	MOVD $0, R2
+	MOVD $0, R3
+	B    crosscall2(SB)
+
+nog:
 	RET
 
 // func setg_trampoline(setg uintptr, g uintptr)
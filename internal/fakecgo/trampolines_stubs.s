@@ -3,7 +3,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build !cgo && (darwin || freebsd || linux || netbsd)
+//go:build !cgo && (((darwin || freebsd || linux || netbsd) && (amd64 || arm64)) || (linux && arm))
 
 #include "textflag.h"
 
@@ -89,6 +89,10 @@ TEXT _pthread_cond_broadcast(SB), NOSPLIT|NOFRAME, $0-0
 	JMP purego_pthread_cond_broadcast(SB)
 	RET
 
+TEXT _pthread_key_create(SB), NOSPLIT|NOFRAME, $0-0
+	JMP purego_pthread_key_create(SB)
+	RET
+
 TEXT _pthread_setspecific(SB), NOSPLIT|NOFRAME, $0-0
 	JMP purego_pthread_setspecific(SB)
 	RET
@@ -0,0 +1,122 @@
+//go:build linux || darwin || freebsd
+
+package syscall
+
+import (
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+//go:linkname runtime_getm runtime.getm
+func runtime_getm() unsafe.Pointer
+
+// blockingCall hands off the P with entersyscallblock, calls the C function
+// fn with arg as its first argument on the stack [stackLo, stackTop), and
+// leaves the syscall state with exitsyscall. Implemented in
+// blocking_unix_amd64.s.
+//
+//go:noescape
+func blockingCall(fn uintptr, arg unsafe.Pointer, g0, stackLo, stackTop uintptr)
+
+// blockingNop is an empty C function, see cgocallBlocking.
+func blockingNop()
+
+// blockingNopABI0 is the ABI0 entry point for blockingNop.
+var blockingNopABI0 uintptr
+
+// blockingStackSize is the size of each C stack used for blocking calls.
+// It matches the default pthread stack size on Linux; pages are only
+// committed when the callee actually touches them.
+const blockingStackSize = 8 << 20
+
+// maxIdleBlockingStacks caps the number of stacks kept for reuse.
+const maxIdleBlockingStacks = 16
+
+// blockingStacks is a free list of C stacks for cgocallBlocking.
+var blockingStacks struct {
+	mu   sync.Mutex
+	free [][]byte
+}
+
+// getBlockingStack returns a stack from the free list or maps a new one.
+// The lowest page is made inaccessible so an overflow faults instead of
+// corrupting neighbouring memory.
+func getBlockingStack() ([]byte, error) {
+	blockingStacks.mu.Lock()
+	if n := len(blockingStacks.free); n > 0 {
+		stk := blockingStacks.free[n-1]
+		blockingStacks.free = blockingStacks.free[:n-1]
+		blockingStacks.mu.Unlock()
+		return stk, nil
+	}
+	blockingStacks.mu.Unlock()
+
+	stk, err := syscall.Mmap(-1, 0, blockingStackSize,
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+	if err := mprotect(stk[:syscall.Getpagesize()], syscall.PROT_NONE); err != nil {
+		_ = syscall.Munmap(stk)
+		return nil, err
+	}
+	return stk, nil
+}
+
+// putBlockingStack returns stk to the free list, unmapping it if the list is full.
+func putBlockingStack(stk []byte) {
+	blockingStacks.mu.Lock()
+	if len(blockingStacks.free) < maxIdleBlockingStacks {
+		blockingStacks.free = append(blockingStacks.free, stk)
+		blockingStacks.mu.Unlock()
+		return
+	}
+	blockingStacks.mu.Unlock()
+	_ = syscall.Munmap(stk)
+}
+
+// cgocallBlocking is runtime.cgocall for calls known to block.
+//
+// runtime.cgocall enters the syscall state with entersyscall, which keeps
+// the P attached to the M; sysmon only retakes it after the call has been
+// running for a while (20µs up to 10ms). entersyscallblock hands the P off
+// immediately, so other goroutines keep running on it for the whole call.
+//
+// runtime.asmcgocall, which cgocall uses to reach the g0 stack, is not
+// linkable from outside the runtime, so blockingCall does its job: the
+// callee runs on a C stack of our own with g set to the M's g0, whose stack
+// bounds are pointed at that stack for the duration of the call. A callback
+// then enters Go through cgocallback and exitsyscall as it would from any
+// cgo call. This relies only on field offsets the toolchain itself fixes:
+// g.stack and g.stackguard0/1 at the start of g, and m.g0 at the start of m.
+//
+// args usually lives on the goroutine stack, which a callback may move
+// while fn still holds its address, so fn gets a copy at the top of the C
+// stack and the results are copied back afterwards. cgo's generated code
+// deals with the same problem through _cgo_topofstack.
+//
+// Falls back to runtime.cgocall if no stack can be mapped.
+func cgocallBlocking(fn uintptr, args *syscallArgs) {
+	stk, err := getBlockingStack()
+	if err != nil {
+		runtime_cgocall(fn, unsafe.Pointer(args))
+		return
+	}
+	c := (*syscallArgs)(unsafe.Pointer(&stk[len(stk)-int(unsafe.Sizeof(*args))]))
+	*c = *args
+	// The thread is locked so that g0 is the g0 of the M the call runs on.
+	runtime.LockOSThread()
+	g0 := *(*uintptr)(runtime_getm()) // m.g0
+	lo := uintptr(unsafe.Pointer(&stk[0])) + uintptr(syscall.Getpagesize())
+	blockingCall(fn, unsafe.Pointer(c), g0, lo, uintptr(unsafe.Pointer(c)))
+	runtime.UnlockOSThread()
+	*args = *c
+	putBlockingStack(stk)
+
+	// cgocallbackg leaves m.incgo set when a callback returns to C, for
+	// runtime.cgocall to clear once the C function returns. An empty cgocall
+	// clears it here too; the scheduler refuses to run with it set.
+	runtime_cgocall(blockingNopABI0, nil)
+}
@@ -0,0 +1,109 @@
+//go:build linux || darwin || freebsd
+
+#include "textflag.h"
+
+// Offsets in runtime.g fixed by the toolchain: stack.lo and stack.hi are
+// known to runtime/cgo, stackguard0 and stackguard1 to cmd/internal/obj.
+#define g_stack_lo 0
+#define g_stack_hi 8
+#define g_stackguard0 16
+#define g_stackguard1 24
+
+// blockingStackGuard is the space below the stack guard, as in the
+// runtime's StackGuard but with ample margin.
+#define blockingStackGuard 65536
+
+// Layout of the state saved at the top of the C stack.
+#define saved_g 0
+#define saved_depth 8
+#define saved_lo 16
+#define saved_hi 24
+#define saved_guard0 32
+#define saved_guard1 40
+#define saved_g0 48
+#define saved_ret 56
+
+// func blockingCall(fn uintptr, arg unsafe.Pointer, g0, stackLo, stackTop uintptr)
+//
+// Does what runtime.cgocall does, with entersyscallblock in place of
+// entersyscall. blockingCall itself keeps SP untouched so that tracebacks
+// of the goroutine, taken by a callback that grows its stack, can unwind
+// through it; blockingSwitch does the stack switch.
+TEXT ·blockingCall(SB), NOSPLIT, $0-40
+	CALL runtime·entersyscallblock(SB)
+	MOVQ fn+0(FP), AX
+	MOVQ arg+8(FP), DI
+	MOVQ g0+16(FP), SI
+	MOVQ stackLo+24(FP), R8
+	MOVQ stackTop+32(FP), CX
+	CALL blockingSwitch<>(SB)
+	CALL runtime·exitsyscall(SB)
+	RET
+
+// blockingSwitch calls AX(DI) with the System V ABI on the stack
+// [R8, CX) with g set to g0 in SI, as runtime.asmcgocall does on the g0
+// stack. g0's stack bounds describe the C stack during the call, so Go code
+// run on g0 by a callback checks against them.
+//
+// The goroutine stack may be moved by a callback, so the Go SP is saved as
+// its depth below g.stack.hi, as asmcgocall does. A callback also writes
+// the frame of runtime.cgocallback over the return address of this
+// function, which lies below the SP saved by entersyscallblock, so the
+// return address is kept on the C stack and put back before returning.
+TEXT blockingSwitch<>(SB), NOSPLIT|NOFRAME, $0-0
+	ANDQ $~15, CX
+	SUBQ $64, CX
+
+	MOVQ 0(SP), DX
+	MOVQ DX, saved_ret(CX)
+	MOVQ (TLS), R9
+	MOVQ R9, saved_g(CX)
+	MOVQ g_stack_hi(R9), DX
+	SUBQ SP, DX
+	MOVQ DX, saved_depth(CX)
+
+	MOVQ SI, saved_g0(CX)
+	MOVQ g_stack_lo(SI), DX
+	MOVQ DX, saved_lo(CX)
+	MOVQ g_stack_hi(SI), DX
+	MOVQ DX, saved_hi(CX)
+	MOVQ g_stackguard0(SI), DX
+	MOVQ DX, saved_guard0(CX)
+	MOVQ g_stackguard1(SI), DX
+	MOVQ DX, saved_guard1(CX)
+
+	MOVQ R8, g_stack_lo(SI)
+	MOVQ CX, g_stack_hi(SI)
+	ADDQ $blockingStackGuard, R8
+	MOVQ R8, g_stackguard0(SI)
+	MOVQ R8, g_stackguard1(SI)
+
+	MOVQ SI, (TLS)
+	MOVQ CX, SP
+	CALL AX
+
+	MOVQ saved_g0(SP), SI
+	MOVQ saved_lo(SP), DX
+	MOVQ DX, g_stack_lo(SI)
+	MOVQ saved_hi(SP), DX
+	MOVQ DX, g_stack_hi(SI)
+	MOVQ saved_guard0(SP), DX
+	MOVQ DX, g_stackguard0(SI)
+	MOVQ saved_guard1(SP), DX
+	MOVQ DX, g_stackguard1(SI)
+
+	MOVQ saved_ret(SP), AX
+	MOVQ saved_g(SP), R9
+	MOVQ R9, (TLS)
+	MOVQ g_stack_hi(R9), DX
+	SUBQ saved_depth(SP), DX
+	MOVQ DX, SP
+	MOVQ AX, 0(SP)
+	RET
+
+// blockingNop is an empty C function, see cgocallBlocking.
+TEXT ·blockingNop(SB), NOSPLIT|NOFRAME, $0-0
+	RET
+
+GLOBL ·blockingNopABI0(SB), NOPTR|RODATA, $8
+DATA ·blockingNopABI0(SB)/8, $·blockingNop(SB)
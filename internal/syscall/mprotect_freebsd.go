@@ -0,0 +1,22 @@
+//go:build freebsd && amd64
+
+package syscall
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// mprotect changes the protection of b. The syscall package does not
+// export Mprotect on FreeBSD, so it is issued directly.
+func mprotect(b []byte, prot int) error {
+	if len(b) == 0 {
+		return nil
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_MPROTECT,
+		uintptr(unsafe.Pointer(&b[0])), uintptr(len(b)), uintptr(prot))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
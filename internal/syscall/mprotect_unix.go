@@ -0,0 +1,10 @@
+//go:build (linux || darwin) && amd64
+
+package syscall
+
+import "syscall"
+
+// mprotect changes the protection of b.
+func mprotect(b []byte, prot int) error {
+	return syscall.Mprotect(b, prot)
+}
@@ -0,0 +1,32 @@
+//go:build linux && (amd64 || arm64 || arm)
+
+package syscall
+
+import _ "unsafe" // for go:linkname
+
+//go:linkname runtime_entersyscall runtime.entersyscall
+func runtime_entersyscall()
+
+//go:linkname runtime_exitsyscall runtime.exitsyscall
+func runtime_exitsyscall()
+
+// rawSyscall6 executes the system call trap with the kernel's register
+// convention and returns the two result registers. Implemented in
+// rawsyscall_linux_$GOARCH.s.
+func rawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr)
+
+// Syscall6 issues the Linux system call trap and returns the raw result
+// registers; the kernel reports failure as a value in [-4095, -1] in r1.
+// The runtime is told the thread is in a system call, so a call that blocks
+// does not hold up other goroutines.
+//
+// Like syscall.Syscall it must not grow the stack between entering and
+// leaving the system call state.
+//
+//go:nosplit
+func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr) {
+	runtime_entersyscall()
+	r1, r2 = rawSyscall6(trap, a1, a2, a3, a4, a5, a6)
+	runtime_exitsyscall()
+	return r1, r2
+}
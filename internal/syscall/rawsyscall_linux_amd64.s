@@ -0,0 +1,20 @@
+//go:build linux && amd64
+
+#include "textflag.h"
+
+// func rawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr)
+//
+// The x86-64 system call convention: number in RAX, arguments in RDI, RSI,
+// RDX, R10, R8, R9; SYSCALL clobbers RCX and R11.
+TEXT ·rawSyscall6(SB), NOSPLIT, $0-72
+	MOVQ a1+8(FP), DI
+	MOVQ a2+16(FP), SI
+	MOVQ a3+24(FP), DX
+	MOVQ a4+32(FP), R10
+	MOVQ a5+40(FP), R8
+	MOVQ a6+48(FP), R9
+	MOVQ trap+0(FP), AX
+	SYSCALL
+	MOVQ AX, r1+56(FP)
+	MOVQ DX, r2+64(FP)
+	RET
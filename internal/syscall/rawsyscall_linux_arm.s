@@ -0,0 +1,19 @@
+//go:build linux && arm
+
+#include "textflag.h"
+
+// func rawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr)
+//
+// The EABI system call convention: number in R7, arguments in R0-R5.
+TEXT ·rawSyscall6(SB), NOSPLIT, $0-36
+	MOVW trap+0(FP), R7
+	MOVW a1+4(FP), R0
+	MOVW a2+8(FP), R1
+	MOVW a3+12(FP), R2
+	MOVW a4+16(FP), R3
+	MOVW a5+20(FP), R4
+	MOVW a6+24(FP), R5
+	SWI $0
+	MOVW R0, r1+28(FP)
+	MOVW R1, r2+32(FP)
+	RET
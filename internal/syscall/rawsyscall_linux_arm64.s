@@ -0,0 +1,19 @@
+//go:build linux && arm64
+
+#include "textflag.h"
+
+// func rawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr)
+//
+// The arm64 system call convention: number in X8, arguments in X0-X5.
+TEXT ·rawSyscall6(SB), NOSPLIT, $0-72
+	MOVD a1+8(FP), R0
+	MOVD a2+16(FP), R1
+	MOVD a3+24(FP), R2
+	MOVD a4+32(FP), R3
+	MOVD a5+40(FP), R4
+	MOVD a6+48(FP), R5
+	MOVD trap+0(FP), R8
+	SVC
+	MOVD R0, r1+56(FP)
+	MOVD R1, r2+64(FP)
+	RET
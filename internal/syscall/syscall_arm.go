@@ -0,0 +1,62 @@
+//go:build linux && arm
+
+// ARM EABI hard-float (AAPCS-VFP) syscall implementation (Linux on 32-bit ARM).
+package syscall
+
+import (
+	"structs"
+	"unsafe"
+)
+
+//go:linkname runtime_cgocall runtime.cgocall
+//go:noescape
+func runtime_cgocall(fn uintptr, arg unsafe.Pointer) int32
+
+// MaxStackWords is the number of 4-byte stack argument words CallVFP passes.
+const MaxStackWords = 16
+
+// syscallArgs matches the layout expected by syscallN assembly.
+// AAPCS-VFP uses R0-R3 for core arguments and S0-S15 (D0-D7) for
+// floating-point arguments; everything else goes on the stack.
+//
+// Layout (offsets must match syscall_arm.s exactly):
+//
+//	fn:    0
+//	r:     4-16    (R0-R3)
+//	s:     20-80   (S0-S15 = D0-D7, raw bits)
+//	stack: 84-144  (stack argument words)
+//	r0:    148     (R0 return)
+//	r1:    152     (R1 return, high word of 64-bit results)
+//	fret:  156-184 (S0-S7 = D0-D3 returns, raw bits)
+type syscallArgs struct {
+	_      structs.HostLayout
+	fn     uintptr
+	r      [4]uintptr
+	s      [16]uint32
+	stack  [MaxStackWords]uintptr
+	r0, r1 uintptr
+	fret   [8]uint32
+}
+
+// syscallN is implemented in syscall_arm.s
+//
+//nolint:unused // Called from assembly
+func syscallN(args unsafe.Pointer)
+
+// syscallNABI0 is the ABI0 entry point for syscallN
+var syscallNABI0 uintptr
+
+// CallVFP calls a C function with the four core argument registers R0-R3,
+// the sixteen single-precision VFP registers S0-S15 (which alias D0-D7) and
+// MaxStackWords stack words, all taken verbatim. It returns R0, R1 and the
+// raw contents of S0-S7 (D0-D3).
+func CallVFP(fn uintptr, core [4]uintptr, vfp [16]uint32, stack [MaxStackWords]uintptr) (r0, r1 uintptr, fret [8]uint32) {
+	args := syscallArgs{
+		fn:    fn,
+		r:     core,
+		s:     vfp,
+		stack: stack,
+	}
+	runtime_cgocall(syscallNABI0, unsafe.Pointer(&args))
+	return args.r0, args.r1, args.fret
+}
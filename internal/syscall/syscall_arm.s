@@ -0,0 +1,96 @@
+//go:build linux && arm
+
+#include "textflag.h"
+
+// syscallN calls a C function following the ARM EABI hard-float variant
+// (AAPCS-VFP), as used by armhf Linux distributions.
+//
+// syscallN takes a pointer to syscallArgs (see syscall_arm.go):
+// struct {
+//	fn    uintptr     // offset 0
+//	r     [4]uintptr  // offset 4   (R0-R3)
+//	s     [16]uint32  // offset 20  (S0-S15 = D0-D7)
+//	stack [16]uintptr // offset 84  (stack words)
+//	r0    uintptr     // offset 148 (return R0)
+//	r1    uintptr     // offset 152 (return R1)
+//	fret  [8]uint32   // offset 156 (return S0-S7 = D0-D3)
+// }
+//
+// runtime.cgocall enters on the system stack with the args pointer in R0
+// and the stack 8-byte aligned. R4 (callee-saved) holds the args pointer
+// across the call; R4 and LR are pushed together and the 64-byte outgoing
+// argument area keeps the alignment AAPCS requires.
+//
+// syscallN must be called on the g0 stack with runtime.cgocall.
+GLOBL ·syscallNABI0(SB), NOPTR|RODATA, $4
+DATA ·syscallNABI0(SB)/4, $syscallN(SB)
+
+TEXT syscallN(SB), NOSPLIT|NOFRAME, $0
+	MOVM.WP [R4, R14], (R13)
+	MOVW    R0, R4
+	SUB     $64, R13
+
+	// Copy the stack argument words.
+	MOVW 84(R4), R0
+	MOVW R0, 0(R13)
+	MOVW 88(R4), R0
+	MOVW R0, 4(R13)
+	MOVW 92(R4), R0
+	MOVW R0, 8(R13)
+	MOVW 96(R4), R0
+	MOVW R0, 12(R13)
+	MOVW 100(R4), R0
+	MOVW R0, 16(R13)
+	MOVW 104(R4), R0
+	MOVW R0, 20(R13)
+	MOVW 108(R4), R0
+	MOVW R0, 24(R13)
+	MOVW 112(R4), R0
+	MOVW R0, 28(R13)
+	MOVW 116(R4), R0
+	MOVW R0, 32(R13)
+	MOVW 120(R4), R0
+	MOVW R0, 36(R13)
+	MOVW 124(R4), R0
+	MOVW R0, 40(R13)
+	MOVW 128(R4), R0
+	MOVW R0, 44(R13)
+	MOVW 132(R4), R0
+	MOVW R0, 48(R13)
+	MOVW 136(R4), R0
+	MOVW R0, 52(R13)
+	MOVW 140(R4), R0
+	MOVW R0, 56(R13)
+	MOVW 144(R4), R0
+	MOVW R0, 60(R13)
+
+	// Load D0-D7 (S0-S15).
+	MOVD 20(R4), F0
+	MOVD 28(R4), F1
+	MOVD 36(R4), F2
+	MOVD 44(R4), F3
+	MOVD 52(R4), F4
+	MOVD 60(R4), F5
+	MOVD 68(R4), F6
+	MOVD 76(R4), F7
+
+	// Load R0-R3 last: R0 was used as a scratch register above.
+	MOVW 4(R4), R0
+	MOVW 8(R4), R1
+	MOVW 12(R4), R2
+	MOVW 16(R4), R3
+
+	MOVW 0(R4), R12 // fn
+	BL   (R12)
+
+	MOVW R0, 148(R4) // r0
+	MOVW R1, 152(R4) // r1
+	MOVD F0, 156(R4) // fret S0-S1
+	MOVD F1, 164(R4) // fret S2-S3
+	MOVD F2, 172(R4) // fret S4-S5
+	MOVD F3, 180(R4) // fret S6-S7
+
+	ADD      $64, R13
+	MOVM.IAW (R13), [R4, R14]
+	MOVW     $0, R0 // no error (ignored by runtime.cgocall)
+	RET
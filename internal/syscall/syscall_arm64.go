@@ -27,6 +27,8 @@ func runtime_cgocall(fn uintptr, arg unsafe.Pointer) int32
 //	r2:      200     (X1 integer return, 9-16 byte struct returns)
 //	fr1-fr4: 208-232 (D0-D3 float returns for HFA)
 //	r8:      240     (X8 - large struct return pointer)
+//	clobbered: 248   (syscallNChecked only)
+//	fhi:     256-312 (high halves of V0-V7; V0 and V1 on return)
 //
 // NOTE: f1-f8 and fr1-fr4 are raw bit patterns. For float32 values, the
 // lower 32 bits contain the float32 representation (upper 32 bits are ignored).
@@ -39,6 +41,15 @@ type syscallArgs struct {
 	r1, r2                           uintptr // X0-X1 integer returns (offsets 192-200)
 	fr1, fr2, fr3, fr4               uintptr // D0-D3 float returns for HFA (offsets 208-232)
 	r8                               uintptr // X8 - large struct return pointer (offset 240)
+	clobbered                        uintptr // syscallNChecked only: bitmask over CalleeSavedRegisters (offset 248)
+	fhi                              [8]uint64 // high halves of V0-V7 for vector arguments; V0-V1 on return (offsets 256-312)
+}
+
+// CalleeSavedRegisters names the registers syscallNChecked verifies, in the
+// bit order of its clobbered mask.
+var CalleeSavedRegisters = [...]string{
+	"X19", "X20", "X21", "X22", "X23", "X24", "X25", "X26", "X27", "X28",
+	"D8", "D9", "D10", "D11", "D12", "D13", "D14", "D15",
 }
 
 // syscallN is implemented in syscall_unix_arm64.s
@@ -49,20 +60,61 @@ func syscallN(args unsafe.Pointer)
 // syscallNABI0 is the ABI0 entry point for syscallN
 var syscallNABI0 uintptr
 
+// syscallNChecked is implemented in syscall_arm64.s
+//
+//nolint:unused // Called from assembly
+func syscallNChecked(args unsafe.Pointer)
+
+// syscallNCheckedABI0 is the ABI0 entry point for syscallNChecked
+var syscallNCheckedABI0 uintptr
+
 // Call8Float calls a C function with up to 8 integer arguments and 8 float arguments.
 // This is the backward-compatible entry point; for stack spill use CallNFloat.
 func Call8Float(fn uintptr, gpr [8]uintptr, fpr [8]uint64, r8 uintptr) (r1 uintptr, r2 uintptr, fret [4]uint64) {
 	var sa [7]uintptr
-	return callNFloat(fn, gpr, fpr, sa, 0, r8)
+	r1, r2, fret, _ = callNFloat(syscallNABI0, fn, gpr, fpr, sa, 0, r8, nil)
+	return
 }
 
 // CallNFloat calls a C function with up to 8 GP register arguments, 8 FP register
 // arguments, 7 stack-spill slots, and an X8 sret pointer.
 func CallNFloat(fn uintptr, gpr [8]uintptr, fpr [8]uint64, stackArgs [7]uintptr, numStack int, r8 uintptr) (r1 uintptr, r2 uintptr, fret [4]uint64) {
-	return callNFloat(fn, gpr, fpr, stackArgs, numStack, r8)
+	r1, r2, fret, _ = callNFloat(syscallNABI0, fn, gpr, fpr, stackArgs, numStack, r8, nil)
+	return
 }
 
-func callNFloat(fn uintptr, gpr [8]uintptr, fpr [8]uint64, stackArgs [7]uintptr, numStack int, r8 uintptr) (r1 uintptr, r2 uintptr, fret [4]uint64) {
+// CallNFloatChecked is CallNFloat that also reports
+// which callee-saved registers the callee failed to preserve: bit i of
+// clobbered stands for CalleeSavedRegisters[i]. The registers are restored
+// before returning to Go either way.
+func CallNFloatChecked(fn uintptr, gpr [8]uintptr, fpr [8]uint64, stackArgs [7]uintptr, numStack int, r8 uintptr) (r1 uintptr, r2 uintptr, fret [4]uint64, clobbered uint32) {
+	return callNFloat(syscallNCheckedABI0, fn, gpr, fpr, stackArgs, numStack, r8, nil)
+}
+
+// CallNVector is CallNFloat with full 128-bit V registers, for vector
+// arguments and results: fpr[i] holds the low and high halves of Vi, and
+// v0 and v1 both halves of V0 and V1 after the call. checked selects the
+// register clobber check of CallNFloatChecked.
+func CallNVector(fn uintptr, gpr [8]uintptr, fpr [8][2]uint64, stackArgs [7]uintptr, numStack int, r8 uintptr, checked bool) (r1, r2 uintptr, v0, v1 [2]uint64, clobbered uint32) {
+	stub := syscallNABI0
+	if checked {
+		stub = syscallNCheckedABI0
+	}
+	var lo, fhi [8]uint64
+	for k := range fpr {
+		lo[k], fhi[k] = fpr[k][0], fpr[k][1]
+	}
+	var fret [4]uint64
+	r1, r2, fret, clobbered = callNFloat(stub, fn, gpr, lo, stackArgs, numStack, r8, &fhi)
+	v0 = [2]uint64{fret[0], fhi[0]}
+	v1 = [2]uint64{fret[1], fhi[1]}
+	return
+}
+
+// callNFloat makes the call through stub. A non-nil fhi holds the high
+// halves of V0-V7, which are zero otherwise, and receives those of V0 and
+// V1 after the call.
+func callNFloat(stub, fn uintptr, gpr [8]uintptr, fpr [8]uint64, stackArgs [7]uintptr, numStack int, r8 uintptr, fhi *[8]uint64) (r1 uintptr, r2 uintptr, fret [4]uint64, clobbered uint32) {
 	args := syscallArgs{
 		fn: fn,
 		a1: gpr[0], a2: gpr[1], a3: gpr[2], a4: gpr[3],
@@ -87,12 +139,19 @@ func callNFloat(fn uintptr, gpr [8]uintptr, fpr [8]uint64, stackArgs [7]uintptr,
 		r8: r8, // X8 for large struct returns
 	}
 	_ = numStack // informational; assembly always pushes all 7 stack slots
-	runtime_cgocall(syscallNABI0, unsafe.Pointer(&args))
+	if fhi != nil {
+		args.fhi = *fhi
+	}
+	runtime_cgocall(stub, unsafe.Pointer(&args))
 	r1 = args.r1
 	r2 = args.r2
 	fret[0] = uint64(args.fr1)
 	fret[1] = uint64(args.fr2)
 	fret[2] = uint64(args.fr3)
 	fret[3] = uint64(args.fr4)
+	clobbered = uint32(args.clobbered)
+	if fhi != nil {
+		fhi[0], fhi[1] = args.fhi[0], args.fhi[1]
+	}
 	return
 }
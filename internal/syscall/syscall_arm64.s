@@ -40,6 +40,8 @@
 //	fr3   uintptr  // offset 224 (return D2 for HFA)
 //	fr4   uintptr  // offset 232 (return D3 for HFA)
 //	r8    uintptr  // offset 240 (X8 - large struct return pointer)
+//	clobbered uintptr // offset 248 (syscallNChecked only)
+//	fhi   [8]uintptr // offset 256 (high halves of V0-V7; V0-V1 on return)
 // }
 //
 // Stack frame layout (total STACK_SIZE = 96 bytes, 16-byte aligned):
@@ -77,6 +79,25 @@ TEXT syscallN(SB), NOSPLIT|NOFRAME, $0
 	FMOVD 176(R9), F6  // f7 -> D6
 	FMOVD 184(R9), F7  // f8 -> D7
 
+	// Fill the high halves of V0-V7 (offsets 256-312), used by vector
+	// arguments. FMOVD has cleared them.
+	MOVD 256(R9), R11
+	VMOV R11, V0.D[1]
+	MOVD 264(R9), R11
+	VMOV R11, V1.D[1]
+	MOVD 272(R9), R11
+	VMOV R11, V2.D[1]
+	MOVD 280(R9), R11
+	VMOV R11, V3.D[1]
+	MOVD 288(R9), R11
+	VMOV R11, V4.D[1]
+	MOVD 296(R9), R11
+	VMOV R11, V5.D[1]
+	MOVD 304(R9), R11
+	VMOV R11, V6.D[1]
+	MOVD 312(R9), R11
+	VMOV R11, V7.D[1]
+
 	// Load X8 for large struct return pointer (AAPCS64: X8 holds sret address)
 	MOVD 240(R9), R8  // r8 -> X8
 
@@ -121,6 +142,10 @@ TEXT syscallN(SB), NOSPLIT|NOFRAME, $0
 	FMOVD F1, 216(R9)  // fr2: D1 return for HFA
 	FMOVD F2, 224(R9)  // fr3: D2 return for HFA
 	FMOVD F3, 232(R9)  // fr4: D3 return for HFA
+	VMOV  V0.D[1], R11 // high halves of V0 and V1, for vector returns
+	MOVD  R11, 256(R9)
+	VMOV  V1.D[1], R11
+	MOVD  R11, 264(R9)
 
 	// Restore frame and return
 	MOVD 72(RSP), R30            // Restore LR
@@ -128,3 +153,178 @@ TEXT syscallN(SB), NOSPLIT|NOFRAME, $0
 	ADD  $STACK_SIZE, RSP, RSP   // Restore SP
 	MOVD $0, R0                  // no error (ignored by runtime.cgocall)
 	RET
+
+// syscallNChecked is syscallN for the register clobber diagnostic mode.
+//
+// It loads every AAPCS64 callee-saved register (X19-X28, D8-D15) with a
+// canary, runs syscallN, which touches none of them itself, and stores a
+// bitmask of the registers the callee failed to preserve at offset 248
+// (clobbered). The original values are put back before returning. X29 is not
+// checked: syscallN saves and restores it around the call.
+//
+// Stack frame layout (160 bytes, 16-byte aligned):
+//   RSP+0            : saved LR (R30)
+//   RSP+8            : saved args pointer
+//   RSP+16  .. +95   : saved R19-R28 (R28 is g)
+//   RSP+96  .. +159  : saved F8-F15
+GLOBL ·syscallNCheckedABI0(SB), NOPTR|RODATA, $8
+DATA ·syscallNCheckedABI0(SB)/8, $syscallNChecked(SB)
+
+TEXT syscallNChecked(SB), NOSPLIT|NOFRAME, $0
+	SUB  $160, RSP, RSP
+	MOVD R30, 0(RSP)
+	MOVD R0, 8(RSP)
+	MOVD R19, 16(RSP)
+	MOVD R20, 24(RSP)
+	MOVD R21, 32(RSP)
+	MOVD R22, 40(RSP)
+	MOVD R23, 48(RSP)
+	MOVD R24, 56(RSP)
+	MOVD R25, 64(RSP)
+	MOVD R26, 72(RSP)
+	MOVD R27, 80(RSP)
+	MOVD g, 88(RSP)
+	FMOVD F8, 96(RSP)
+	FMOVD F9, 104(RSP)
+	FMOVD F10, 112(RSP)
+	FMOVD F11, 120(RSP)
+	FMOVD F12, 128(RSP)
+	FMOVD F13, 136(RSP)
+	FMOVD F14, 144(RSP)
+	FMOVD F15, 152(RSP)
+
+	MOVD $0x0bad0c0de0ff1001, R19
+	MOVD $0x0bad0c0de0ff1002, R20
+	MOVD $0x0bad0c0de0ff1003, R21
+	MOVD $0x0bad0c0de0ff1004, R22
+	MOVD $0x0bad0c0de0ff1005, R23
+	MOVD $0x0bad0c0de0ff1006, R24
+	MOVD $0x0bad0c0de0ff1007, R25
+	MOVD $0x0bad0c0de0ff1008, R26
+	MOVD $0x0bad0c0de0ff1009, R27
+	MOVD $0x0bad0c0de0ff100a, g
+	MOVD $0x0bad0c0de0ff100b, R9
+	FMOVD R9, F8
+	MOVD $0x0bad0c0de0ff100c, R9
+	FMOVD R9, F9
+	MOVD $0x0bad0c0de0ff100d, R9
+	FMOVD R9, F10
+	MOVD $0x0bad0c0de0ff100e, R9
+	FMOVD R9, F11
+	MOVD $0x0bad0c0de0ff100f, R9
+	FMOVD R9, F12
+	MOVD $0x0bad0c0de0ff1010, R9
+	FMOVD R9, F13
+	MOVD $0x0bad0c0de0ff1011, R9
+	FMOVD R9, F14
+	MOVD $0x0bad0c0de0ff1012, R9
+	FMOVD R9, F15
+
+	BL syscallN(SB) // R0 still holds the args pointer
+
+	MOVD $0, R1
+	MOVD $0x0bad0c0de0ff1001, R2
+	CMP  R2, R19
+	BEQ  2(PC)
+	ORR  $0x1, R1
+	MOVD $0x0bad0c0de0ff1002, R2
+	CMP  R2, R20
+	BEQ  2(PC)
+	ORR  $0x2, R1
+	MOVD $0x0bad0c0de0ff1003, R2
+	CMP  R2, R21
+	BEQ  2(PC)
+	ORR  $0x4, R1
+	MOVD $0x0bad0c0de0ff1004, R2
+	CMP  R2, R22
+	BEQ  2(PC)
+	ORR  $0x8, R1
+	MOVD $0x0bad0c0de0ff1005, R2
+	CMP  R2, R23
+	BEQ  2(PC)
+	ORR  $0x10, R1
+	MOVD $0x0bad0c0de0ff1006, R2
+	CMP  R2, R24
+	BEQ  2(PC)
+	ORR  $0x20, R1
+	MOVD $0x0bad0c0de0ff1007, R2
+	CMP  R2, R25
+	BEQ  2(PC)
+	ORR  $0x40, R1
+	MOVD $0x0bad0c0de0ff1008, R2
+	CMP  R2, R26
+	BEQ  2(PC)
+	ORR  $0x80, R1
+	MOVD $0x0bad0c0de0ff1009, R2
+	CMP  R2, R27
+	BEQ  2(PC)
+	ORR  $0x100, R1
+	MOVD $0x0bad0c0de0ff100a, R2
+	CMP  R2, g
+	BEQ  2(PC)
+	ORR  $0x200, R1
+	FMOVD F8, R3
+	MOVD  $0x0bad0c0de0ff100b, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x400, R1
+	FMOVD F9, R3
+	MOVD  $0x0bad0c0de0ff100c, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x800, R1
+	FMOVD F10, R3
+	MOVD  $0x0bad0c0de0ff100d, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x1000, R1
+	FMOVD F11, R3
+	MOVD  $0x0bad0c0de0ff100e, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x2000, R1
+	FMOVD F12, R3
+	MOVD  $0x0bad0c0de0ff100f, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x4000, R1
+	FMOVD F13, R3
+	MOVD  $0x0bad0c0de0ff1010, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x8000, R1
+	FMOVD F14, R3
+	MOVD  $0x0bad0c0de0ff1011, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x10000, R1
+	FMOVD F15, R3
+	MOVD  $0x0bad0c0de0ff1012, R2
+	CMP   R2, R3
+	BEQ   2(PC)
+	ORR   $0x20000, R1
+	MOVD 8(RSP), R9
+	MOVD R1, 248(R9) // clobbered
+
+	MOVD 16(RSP), R19
+	MOVD 24(RSP), R20
+	MOVD 32(RSP), R21
+	MOVD 40(RSP), R22
+	MOVD 48(RSP), R23
+	MOVD 56(RSP), R24
+	MOVD 64(RSP), R25
+	MOVD 72(RSP), R26
+	MOVD 80(RSP), R27
+	MOVD 88(RSP), g
+	FMOVD 96(RSP), F8
+	FMOVD 104(RSP), F9
+	FMOVD 112(RSP), F10
+	FMOVD 120(RSP), F11
+	FMOVD 128(RSP), F12
+	FMOVD 136(RSP), F13
+	FMOVD 144(RSP), F14
+	FMOVD 152(RSP), F15
+	MOVD 0(RSP), R30
+	ADD  $160, RSP, RSP
+	MOVD $0, R0
+	RET
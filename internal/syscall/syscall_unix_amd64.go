@@ -5,6 +5,7 @@
 package syscall
 
 import (
+	"math"
 	"structs"
 	"unsafe"
 )
@@ -24,14 +25,26 @@ func runtime_cgocall(fn uintptr, arg unsafe.Pointer) int32
 //	f1-f8: 128-192  (XMM0-XMM7 as bit patterns)
 //	r1:    192      (RAX return)
 //	r2:    200      (RDX return, used for 9-16 byte struct returns)
+//	clobbered: 208  (syscallNChecked only: bitmask over CalleeSavedRegisters)
+//	x87:   216      (nonzero: the callee returns a long double in ST0)
+//	st0:   224      (ST0 in 80-bit extended format, when x87 is set)
+//	xhi:   240-304  (high halves of XMM0-XMM7; XMM0 and XMM1 on return)
 type syscallArgs struct {
 	_                                                                structs.HostLayout
 	fn                                                               uintptr
 	a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15 uintptr
 	f1, f2, f3, f4, f5, f6, f7, f8                                   uintptr
 	r1, r2                                                           uintptr
+	clobbered                                                        uintptr
+	x87                                                              uintptr
+	st0                                                              [2]uint64
+	xhi                                                              [8]uint64
 }
 
+// CalleeSavedRegisters names the registers syscallNChecked verifies, in the
+// bit order of its clobbered mask.
+var CalleeSavedRegisters = [...]string{"RBX", "RBP", "R12", "R13", "R14", "R15"}
+
 // syscallN is implemented in syscall_unix_amd64.s
 //
 //nolint:unused // Called from assembly (syscall_unix_amd64.s)
@@ -40,6 +53,14 @@ func syscallN(args unsafe.Pointer)
 // syscallNABI0 is the ABI0 entry point for syscallN
 var syscallNABI0 uintptr
 
+// syscallNChecked is implemented in syscall_unix_amd64.s
+//
+//nolint:unused // Called from assembly (syscall_unix_amd64.s)
+func syscallNChecked(args unsafe.Pointer)
+
+// syscallNCheckedABI0 is the ABI0 entry point for syscallNChecked
+var syscallNCheckedABI0 uintptr
+
 // CallNFloat calls a C function with up to 6 integer register arguments,
 // 8 SSE arguments, and 9 stack-spill arguments (15 total).
 //
@@ -54,6 +75,64 @@ var syscallNABI0 uintptr
 //   - f1: XMM0 float return value (bit pattern)
 //   - f2: XMM1 second float return value — for {SSE, SSE} 9-16B struct returns (e.g. NSPoint)
 func CallNFloat(fn uintptr, gpr [6]uintptr, sse [8]float64, stackArgs [9]uintptr, numStack int) (r1 uintptr, r2 uintptr, f1 float64, f2 float64) {
+	r1, r2, f1, f2, _ = callNFloat(syscallNABI0, fn, gpr, sse, stackArgs, numStack, false, nil, nil)
+	return
+}
+
+// CallNFloatBlocking is CallNFloat for calls expected to block. The P is
+// handed off before the call starts, see cgocallBlocking.
+func CallNFloatBlocking(fn uintptr, gpr [6]uintptr, sse [8]float64, stackArgs [9]uintptr, numStack int) (r1 uintptr, r2 uintptr, f1 float64, f2 float64) {
+	r1, r2, f1, f2, _ = callNFloat(syscallNABI0, fn, gpr, sse, stackArgs, numStack, true, nil, nil)
+	return
+}
+
+// CallNFloatChecked is CallNFloat (or CallNFloatBlocking) that also reports
+// which callee-saved registers the callee failed to preserve: bit i of
+// clobbered stands for CalleeSavedRegisters[i]. The registers are restored
+// before returning to Go either way.
+func CallNFloatChecked(fn uintptr, gpr [6]uintptr, sse [8]float64, stackArgs [9]uintptr, numStack int, blocking bool) (r1 uintptr, r2 uintptr, f1 float64, f2 float64, clobbered uint32) {
+	return callNFloat(syscallNCheckedABI0, fn, gpr, sse, stackArgs, numStack, blocking, nil, nil)
+}
+
+// CallNX87 calls a C function that returns a long double, which System V
+// returns in the x87 register ST0, and stores it in st0 in 80-bit extended
+// format (the low 10 bytes). checked selects the register clobber check of
+// CallNFloatChecked.
+func CallNX87(fn uintptr, gpr [6]uintptr, sse [8]float64, stackArgs [9]uintptr, numStack int, blocking, checked bool) (st0 [2]uint64, clobbered uint32) {
+	stub := syscallNABI0
+	if checked {
+		stub = syscallNCheckedABI0
+	}
+	_, _, _, _, clobbered = callNFloat(stub, fn, gpr, sse, stackArgs, numStack, blocking, &st0, nil)
+	return
+}
+
+// CallNVector is CallNFloat with full 128-bit XMM registers, for vector
+// arguments and results: xmm[i] holds the low and high halves of XMMi, and
+// x0 and x1 both halves of XMM0 and XMM1 after the call. checked selects
+// the register clobber check of CallNFloatChecked.
+func CallNVector(fn uintptr, gpr [6]uintptr, xmm [8][2]uint64, stackArgs [9]uintptr, numStack int, blocking, checked bool) (r1, r2 uintptr, x0, x1 [2]uint64, clobbered uint32) {
+	stub := syscallNABI0
+	if checked {
+		stub = syscallNCheckedABI0
+	}
+	var sse [8]float64
+	var xhi [8]uint64
+	for k := range xmm {
+		sse[k] = math.Float64frombits(xmm[k][0])
+		xhi[k] = xmm[k][1]
+	}
+	var f1, f2 float64
+	r1, r2, f1, f2, clobbered = callNFloat(stub, fn, gpr, sse, stackArgs, numStack, blocking, nil, &xhi)
+	x0 = [2]uint64{math.Float64bits(f1), xhi[0]}
+	x1 = [2]uint64{math.Float64bits(f2), xhi[1]}
+	return
+}
+
+// callNFloat makes the call through stub. A non-nil st0 receives the ST0
+// return value. A non-nil xhi holds the high halves of XMM0-XMM7, which
+// are zero otherwise, and receives those of XMM0 and XMM1 after the call.
+func callNFloat(stub, fn uintptr, gpr [6]uintptr, sse [8]float64, stackArgs [9]uintptr, numStack int, blocking bool, st0 *[2]uint64, xhi *[8]uint64) (r1 uintptr, r2 uintptr, f1 float64, f2 float64, clobbered uint32) {
 	args := syscallArgs{
 		fn: fn,
 		a1: gpr[0], a2: gpr[1], a3: gpr[2],
@@ -79,10 +158,27 @@ func CallNFloat(fn uintptr, gpr [6]uintptr, sse [8]float64, stackArgs [9]uintptr
 		f8: *(*uintptr)(unsafe.Pointer(&sse[7])),
 	}
 	_ = numStack // numStack is informational; assembly always pushes all 9 slots
-	runtime_cgocall(syscallNABI0, unsafe.Pointer(&args))
+	if st0 != nil {
+		args.x87 = 1
+	}
+	if xhi != nil {
+		args.xhi = *xhi
+	}
+	if blocking {
+		cgocallBlocking(stub, &args)
+	} else {
+		runtime_cgocall(stub, unsafe.Pointer(&args))
+	}
 	r1 = args.r1
 	r2 = args.r2
 	f1 = *(*float64)(unsafe.Pointer(&args.f1))
 	f2 = *(*float64)(unsafe.Pointer(&args.f2))
+	clobbered = uint32(args.clobbered)
+	if st0 != nil {
+		*st0 = args.st0
+	}
+	if xhi != nil {
+		xhi[0], xhi[1] = args.xhi[0], args.xhi[1]
+	}
 	return
 }
@@ -2,6 +2,7 @@
 
 #include "textflag.h"
 #include "abi_amd64.h"
+#include "cet_amd64.h"
 
 // syscallN calls a C function with up to 6 integer, 8 float, and 9 stack arguments.
 // System V AMD64 ABI calling convention (identical on Linux, macOS, FreeBSD).
@@ -35,6 +36,10 @@
 //	f8    uintptr  // offset 184 (XMM7)
 //	r1    uintptr  // offset 192 (RAX return)
 //	r2    uintptr  // offset 200 (RDX return, 9-16 byte struct)
+//	clobbered uintptr // offset 208 (syscallNChecked only)
+//	x87   uintptr  // offset 216 (nonzero: store ST0 at offset 224)
+//	st0   [2]uintptr // offset 224 (80-bit long double return, popped from ST0)
+//	xhi   [8]uintptr // offset 240 (high halves of XMM0-XMM7; XMM0-XMM1 on return)
 // }
 //
 // syscallN must be called on the g0 stack with runtime.cgocall.
@@ -47,21 +52,31 @@ GLOBL ·syscallNABI0(SB), NOPTR|RODATA, $8
 DATA ·syscallNABI0(SB)/8, $syscallN(SB)
 
 TEXT syscallN(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
 	PUSHQ BP
 	MOVQ  SP, BP
 	SUBQ  $STACK_SIZE, SP
-	MOVQ  DI, PTR_ADDRESS(BP) // save the pointer
+	MOVQ  DI, PTR_ADDRESS(SP) // save the pointer
 	MOVQ  DI, R11             // R11 = args pointer
 
-	// Load float arguments into XMM0-XMM7 (offsets 128-184)
-	MOVQ 128(R11), X0 // f1
-	MOVQ 136(R11), X1 // f2
-	MOVQ 144(R11), X2 // f3
-	MOVQ 152(R11), X3 // f4
-	MOVQ 160(R11), X4 // f5
-	MOVQ 168(R11), X5 // f6
-	MOVQ 176(R11), X6 // f7
-	MOVQ 184(R11), X7 // f8
+	// Load float arguments into XMM0-XMM7: the low halves from offsets
+	// 128-184, the high halves, used by vector arguments, from 240-296.
+	MOVQ   128(R11), X0 // f1
+	MOVHPD 240(R11), X0
+	MOVQ   136(R11), X1 // f2
+	MOVHPD 248(R11), X1
+	MOVQ   144(R11), X2 // f3
+	MOVHPD 256(R11), X2
+	MOVQ   152(R11), X3 // f4
+	MOVHPD 264(R11), X3
+	MOVQ   160(R11), X4 // f5
+	MOVHPD 272(R11), X4
+	MOVQ   168(R11), X5 // f6
+	MOVHPD 280(R11), X5
+	MOVQ   176(R11), X6 // f7
+	MOVHPD 288(R11), X6
+	MOVQ   184(R11), X7 // f8
+	MOVHPD 296(R11), X7
 
 	// Push stack-spill arguments a7-a15 onto the stack (offsets 56-120)
 	// System V AMD64 ABI: args 7+ are pushed right-to-left onto the stack,
@@ -94,23 +109,165 @@ TEXT syscallN(SB), NOSPLIT|NOFRAME, $0
 	MOVQ 40(R11), R8  // a5 -> R8
 	MOVQ 48(R11), R9  // a6 -> R9
 
-	// For vararg functions: AL = number of float args in XMM registers (set 0 = safe default)
-	XORL AX, AX
+	// For vararg functions: AL = upper bound on the number of XMM registers
+	// used. 8 is always valid and makes variadic callees save all of them.
+	MOVL $8, AX
 
 	// Load function pointer and call (offset 0)
 	MOVQ 0(R11), R10
 	CALL R10
 
 	// Restore pointer and save return values
-	MOVQ PTR_ADDRESS(BP), DI
+	MOVQ PTR_ADDRESS(SP), DI
 	MOVQ AX, 192(DI) // r1: integer return in RAX
 	MOVQ DX, 200(DI) // r2: second integer return in RDX (9-16 byte structs)
-	MOVQ X0, 128(DI) // f1: float return in XMM0
-	MOVQ X1, 136(DI) // f2: XMM1 — second SSE return for 9-16B all-float struct returns
+	MOVQ   X0, 128(DI) // f1: float return in XMM0
+	MOVQ   X1, 136(DI) // f2: XMM1 — second SSE return for 9-16B all-float struct returns
+	MOVHPD X0, 240(DI) // high half of XMM0, for vector returns
+	MOVHPD X1, 248(DI)
+
+	// long double returns leave ST0 pushed; pop it only when one is expected,
+	// since popping an empty x87 stack corrupts its top-of-stack pointer.
+	CMPQ   216(DI), $0
+	JEQ    nox87
+	FMOVXP F0, 224(DI) // st0: 80-bit extended return
 
+nox87:
 	// Restore stack and return
 	XORL AX, AX          // no error (ignored by runtime.cgocall)
 	ADDQ $STACK_SIZE, SP
 	MOVQ BP, SP
 	POPQ BP
 	RET
+
+// Callee-saved register canaries loaded by syscallNChecked. Any value is
+// fine as long as it is unlikely to be produced by accident.
+#define CANARY_RBX $0x0bad0c0de0ff1001
+#define CANARY_RBP $0x0bad0c0de0ff1002
+#define CANARY_R12 $0x0bad0c0de0ff1003
+#define CANARY_R13 $0x0bad0c0de0ff1004
+#define CANARY_R14 $0x0bad0c0de0ff1005
+#define CANARY_R15 $0x0bad0c0de0ff1006
+
+// CHECK(reg, canary, bit) sets bit in CX unless reg still holds canary.
+#define CHECK(reg, canary, bit) \
+	MOVQ canary, R10; \
+	CMPQ reg, R10;    \
+	JEQ  2(PC);       \
+	ORQ  $bit, CX
+
+// syscallNChecked is syscallN for the register clobber diagnostic mode.
+//
+// Before the call it loads every System V callee-saved register (RBX, RBP,
+// R12-R15) with a canary; afterwards it stores a bitmask of the registers the
+// callee failed to preserve at offset 208 (clobbered) and puts the original
+// values back. Everything is addressed relative to SP, so a clobbered RBP is
+// reported instead of crashing the stub.
+//
+// Stack frame layout (CHECK_STACK_SIZE = 128):
+//   SP+0   .. SP+71  : 9 stack-spill slots for a7-a15
+//   SP+72            : saved args pointer
+//   SP+80  .. SP+119 : saved RBX, R12, R13, R14, R15
+//   SP+120           : padding (16-byte alignment)
+#define CHECK_STACK_SIZE 128
+
+GLOBL ·syscallNCheckedABI0(SB), NOPTR|RODATA, $8
+DATA ·syscallNCheckedABI0(SB)/8, $syscallNChecked(SB)
+
+TEXT syscallNChecked(SB), NOSPLIT|NOFRAME, $0
+	ENDBR64
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $CHECK_STACK_SIZE, SP
+	MOVQ  DI, 72(SP)
+	MOVQ  BX, 80(SP)
+	MOVQ  R12, 88(SP)
+	MOVQ  R13, 96(SP)
+	MOVQ  R14, 104(SP)
+	MOVQ  R15, 112(SP)
+	MOVQ  DI, R11
+
+	MOVQ   128(R11), X0
+	MOVHPD 240(R11), X0
+	MOVQ   136(R11), X1
+	MOVHPD 248(R11), X1
+	MOVQ   144(R11), X2
+	MOVHPD 256(R11), X2
+	MOVQ   152(R11), X3
+	MOVHPD 264(R11), X3
+	MOVQ   160(R11), X4
+	MOVHPD 272(R11), X4
+	MOVQ   168(R11), X5
+	MOVHPD 280(R11), X5
+	MOVQ   176(R11), X6
+	MOVHPD 288(R11), X6
+	MOVQ   184(R11), X7
+	MOVHPD 296(R11), X7
+
+	MOVQ 56(R11), R10
+	MOVQ R10, 0(SP)
+	MOVQ 64(R11), R10
+	MOVQ R10, 8(SP)
+	MOVQ 72(R11), R10
+	MOVQ R10, 16(SP)
+	MOVQ 80(R11), R10
+	MOVQ R10, 24(SP)
+	MOVQ 88(R11), R10
+	MOVQ R10, 32(SP)
+	MOVQ 96(R11), R10
+	MOVQ R10, 40(SP)
+	MOVQ 104(R11), R10
+	MOVQ R10, 48(SP)
+	MOVQ 112(R11), R10
+	MOVQ R10, 56(SP)
+	MOVQ 120(R11), R10
+	MOVQ R10, 64(SP)
+
+	MOVQ 8(R11), DI
+	MOVQ 16(R11), SI
+	MOVQ 24(R11), DX
+	MOVQ 32(R11), CX
+	MOVQ 40(R11), R8
+	MOVQ 48(R11), R9
+
+	MOVQ CANARY_RBX, BX
+	MOVQ CANARY_RBP, BP
+	MOVQ CANARY_R12, R12
+	MOVQ CANARY_R13, R13
+	MOVQ CANARY_R14, R14
+	MOVQ CANARY_R15, R15
+
+	MOVL $8, AX // vararg XMM count upper bound, as in syscallN
+	MOVQ 0(R11), R10
+	CALL R10
+
+	MOVQ 72(SP), DI
+	MOVQ AX, 192(DI)
+	MOVQ DX, 200(DI)
+	MOVQ   X0, 128(DI)
+	MOVQ   X1, 136(DI)
+	MOVHPD X0, 240(DI)
+	MOVHPD X1, 248(DI)
+	CMPQ   216(DI), $0 // x87 return, as in syscallN
+	JEQ    nox87
+	FMOVXP F0, 224(DI)
+
+nox87:
+	XORL CX, CX
+	CHECK(BX, CANARY_RBX, 0x01)
+	CHECK(BP, CANARY_RBP, 0x02)
+	CHECK(R12, CANARY_R12, 0x04)
+	CHECK(R13, CANARY_R13, 0x08)
+	CHECK(R14, CANARY_R14, 0x10)
+	CHECK(R15, CANARY_R15, 0x20)
+	MOVQ CX, 208(DI) // clobbered
+
+	MOVQ 80(SP), BX
+	MOVQ 88(SP), R12
+	MOVQ 96(SP), R13
+	MOVQ 104(SP), R14
+	MOVQ 112(SP), R15
+	XORL AX, AX
+	ADDQ $CHECK_STACK_SIZE, SP
+	POPQ BP
+	RET
@@ -0,0 +1,66 @@
+//go:build windows && amd64
+
+// Win64 (Microsoft x64) calling convention.
+package syscall
+
+import (
+	"structs"
+	"sync"
+	"unsafe"
+)
+
+//go:linkname runtime_cgocall runtime.cgocall
+//go:noescape
+func runtime_cgocall(fn uintptr, arg unsafe.Pointer) int32
+
+// MaxWin64Args is the number of argument slots CallWin64 passes: 4 in
+// registers and the rest on the stack.
+const MaxWin64Args = 15
+
+// win64Args matches the layout expected by callWin64 assembly.
+//
+// Layout (offsets in bytes):
+//
+//	fn:    0
+//	n:     8    (argument slots in use)
+//	r1:    16   (RAX return)
+//	f1:    24   (XMM0 return)
+//	err:   32   (GetLastError after the call)
+//	a:     40   (argument slots)
+type win64Args struct {
+	_   structs.HostLayout
+	fn  uintptr
+	n   uintptr
+	r1  uintptr
+	f1  uintptr
+	err uintptr
+	a   [MaxWin64Args]uintptr
+}
+
+// callWin64ABI0 is the ABI0 entry point of callWin64 in
+// syscall_windows_amd64.s.
+var callWin64ABI0 uintptr
+
+// win64ArgsPool holds the argument blocks. They live on the heap rather
+// than the goroutine stack, which may move while a callback runs Go code
+// during the call.
+var win64ArgsPool = sync.Pool{New: func() any { return new(win64Args) }}
+
+// CallWin64 calls fn with the argument slots args, at most MaxWin64Args.
+// Each of the first four slots is loaded into both its integer register
+// (RCX, RDX, R8, R9) and its XMM register (XMM0-XMM3), so a slot holds
+// either an integer or the bit pattern of a float, whichever the callee
+// reads; the rest go on the stack above the 32-byte shadow space.
+//
+// Returns RAX, the bit pattern in XMM0 for float results, and the thread's
+// last-error value, which is cleared before the call.
+func CallWin64(fn uintptr, args []uintptr) (r1 uintptr, f1 uint64, lastErr uintptr) {
+	a := win64ArgsPool.Get().(*win64Args)
+	a.fn = fn
+	a.n = uintptr(copy(a.a[:], args))
+	runtime_cgocall(callWin64ABI0, unsafe.Pointer(a))
+	r1, f1, lastErr = a.r1, uint64(a.f1), a.err
+	*a = win64Args{}
+	win64ArgsPool.Put(a)
+	return
+}
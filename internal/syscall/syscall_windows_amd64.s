@@ -0,0 +1,82 @@
+//go:build windows && amd64
+
+#include "textflag.h"
+
+// callWin64 calls a C function with the Win64 calling convention.
+//
+// callWin64 takes a pointer to win64Args in CX:
+// struct {
+//	fn    uintptr      // offset 0
+//	n     uintptr      // offset 8  (argument slots in use)
+//	r1    uintptr      // offset 16 (RAX return)
+//	f1    uintptr      // offset 24 (XMM0 return)
+//	err   uintptr      // offset 32 (GetLastError after the call)
+//	a     [15]uintptr  // offset 40 (argument slots)
+// }
+//
+// callWin64 must be called on the g0 stack with runtime.cgocall.
+//
+// Every slot is copied to the outgoing argument area, the first four over
+// the 32-byte shadow space the callee may spill its register arguments to.
+// Those four are also loaded into RCX, RDX, R8 and R9 and into XMM0-XMM3:
+// the callee reads whichever register its parameter type selects, and
+// variadic callees expect floats in both.
+GLOBL ·callWin64ABI0(SB), NOPTR|RODATA, $8
+DATA ·callWin64ABI0(SB)/8, $callWin64(SB)
+
+TEXT callWin64(SB), NOSPLIT|NOFRAME, $0
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $16, SP
+	MOVQ  CX, -8(BP)   // args pointer
+	ANDQ  $~15, SP     // Win64 requires 16-byte alignment at the CALL
+
+	// Outgoing area: max(n, 4) slots, rounded up to keep the alignment.
+	MOVQ  8(CX), R8
+	CMPQ  R8, $4
+	JGE   2(PC)
+	MOVQ  $4, R8
+	ADDQ  $1, R8
+	ANDQ  $~1, R8
+	SHLQ  $3, R8
+	SUBQ  R8, SP
+
+	// Copy the slots in use.
+	LEAQ  40(CX), SI
+	MOVQ  SP, DI
+	MOVQ  8(CX), CX
+	CLD
+	REP; MOVSQ
+
+	// SetLastError(0), so that a callee that does not set it reports 0.
+	MOVQ  0x30(GS), DI
+	MOVL  $0, 0x68(DI)
+
+	MOVQ  -8(BP), R11
+	MOVQ  0(R11), AX   // fn
+
+	MOVQ  0(SP), CX
+	MOVQ  CX, X0
+	MOVQ  8(SP), DX
+	MOVQ  DX, X1
+	MOVQ  16(SP), R8
+	MOVQ  R8, X2
+	MOVQ  24(SP), R9
+	MOVQ  R9, X3
+
+	CALL  AX
+
+	MOVQ  -8(BP), CX
+	MOVQ  AX, 16(CX)   // r1: integer return in RAX
+	MOVQ  X0, 24(CX)   // f1: float return in XMM0
+
+	// GetLastError().
+	MOVQ  0x30(GS), DI
+	MOVL  0x68(DI), AX
+	MOVQ  AX, 32(CX)
+
+	ADDQ  $16, SP
+	MOVQ  BP, SP
+	POPQ  BP
+	XORL  AX, AX       // no error (ignored by runtime.cgocall)
+	RET
@@ -0,0 +1,78 @@
+package types
+
+import "math"
+
+// Float16 is an IEEE 754 half-precision (binary16) value in its raw bit
+// representation, the Go counterpart of C's _Float16 and ARM's __fp16.
+// Use it for Float16Type arguments, return values and struct fields.
+type Float16 uint16
+
+// Float16FromFloat32 converts f to half precision, rounding to nearest even.
+// Values beyond the half-precision range become infinities, values too small
+// for it become signed zeros, and NaNs stay NaNs.
+func Float16FromFloat32(f float32) Float16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int32(b>>23) & 0xff
+	mant := b & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		if mant != 0 {
+			// Keep the top payload bits and force a quiet NaN.
+			return Float16(sign | 0x7e00 | uint16(mant>>13))
+		}
+		return Float16(sign | 0x7c00)
+	case exp-127 > 15:
+		return Float16(sign | 0x7c00)
+	case exp-127 < -25:
+		return Float16(sign)
+	}
+
+	// Rebias the exponent. Results below the normal range become subnormal:
+	// make the implicit bit explicit and shift it into the mantissa.
+	e := exp - 127 + 15
+	mant |= 0x800000
+	shift := uint32(13)
+	if e <= 0 {
+		shift += uint32(1 - e)
+		e = 0
+	}
+
+	half := uint32(1) << (shift - 1)
+	rest := mant & (1<<shift - 1)
+	m := mant >> shift
+	if rest > half || rest == half && m&1 != 0 {
+		m++ // a carry into the exponent (or to infinity) is the correct result
+	}
+	if e > 0 {
+		// m still holds the implicit bit, which adds one to the exponent.
+		return Float16(uint32(sign) + uint32(e-1)<<10 + m)
+	}
+	return Float16(uint32(sign) + m)
+}
+
+// Float32 returns h as a float32. Every half-precision value, including
+// subnormals, infinities and NaNs, is exactly representable.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// Subnormal: normalize into float32's wider exponent range.
+		exp = 127 - 15 + 1
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		return math.Float32frombits(sign | exp<<23 | (mant&0x3ff)<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
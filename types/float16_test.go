@@ -0,0 +1,50 @@
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16RoundTrip(t *testing.T) {
+	// Every binary16 value converts to float32 exactly and back unchanged.
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := Float16(i)
+		f := h.Float32()
+		got := Float16FromFloat32(f)
+		if math.IsNaN(float64(f)) {
+			if got&0x7c00 != 0x7c00 || got&0x3ff == 0 {
+				t.Fatalf("NaN %#04x converted back to %#04x", i, got)
+			}
+			continue
+		}
+		if got != h {
+			t.Fatalf("%#04x -> %v -> %#04x", i, f, got)
+		}
+	}
+}
+
+func TestFloat16FromFloat32(t *testing.T) {
+	tests := []struct {
+		in   float32
+		want Float16
+	}{
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.333333, 0x3555},
+		{65504, 0x7bff},                         // largest finite
+		{65519, 0x7bff},                         // rounds down to largest finite
+		{65520, 0x7c00},                         // rounds up to +Inf
+		{float32(math.Inf(-1)), 0xfc00},         // -Inf
+		{5.9604645e-08, 0x0001},                 // smallest subnormal
+		{2.9802322e-08, 0x0000},                 // half of it: tie to even zero
+		{2.9802326e-08, 0x0001},                 // just above the tie
+		{float32(math.Copysign(0, -1)), 0x8000}, // -0
+		{1 + 1.0/2048, 0x3c00},                  // tie to even, down
+		{1 + 3.0/2048, 0x3c02},                  // tie to even, up
+	}
+	for _, tt := range tests {
+		if got := Float16FromFloat32(tt.in); got != tt.want {
+			t.Errorf("Float16FromFloat32(%v) = %#04x, want %#04x", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import "math/big"
+
+// Int128 is a signed 128-bit integer as C stores an __int128: the low 64
+// bits, then the high 64 bits in two's complement. Use it for SInt128Type
+// arguments, return values and struct fields.
+type Int128 struct {
+	Lo, Hi uint64
+}
+
+// UInt128 is an unsigned 128-bit integer as C stores an unsigned __int128.
+// Use it for UInt128Type arguments, return values and struct fields.
+type UInt128 struct {
+	Lo, Hi uint64
+}
+
+// Int128FromInt64 sign-extends v to 128 bits.
+func Int128FromInt64(v int64) Int128 {
+	return Int128{Lo: uint64(v), Hi: uint64(v >> 63)}
+}
+
+// UInt128FromUint64 zero-extends v to 128 bits.
+func UInt128FromUint64(v uint64) UInt128 {
+	return UInt128{Lo: v}
+}
+
+// Int128FromBig converts v, reporting false if it is outside the range of
+// a signed 128-bit integer.
+func Int128FromBig(v *big.Int) (Int128, bool) {
+	if v.BitLen() > 127 && !(v.Sign() < 0 && v.BitLen() == 128 && v.TrailingZeroBits() == 127) {
+		return Int128{}, false
+	}
+	u, _ := UInt128FromBig(new(big.Int).And(v, maxUInt128))
+	return Int128(u), true
+}
+
+// UInt128FromBig converts v, reporting false if it is negative or does not
+// fit in 128 bits.
+func UInt128FromBig(v *big.Int) (UInt128, bool) {
+	if v.Sign() < 0 || v.BitLen() > 128 {
+		return UInt128{}, false
+	}
+	lo := new(big.Int).And(v, maxUInt64).Uint64()
+	hi := new(big.Int).Rsh(v, 64).Uint64()
+	return UInt128{Lo: lo, Hi: hi}, true
+}
+
+// Big returns x as a big.Int.
+func (x Int128) Big() *big.Int {
+	v := UInt128(x).Big()
+	if int64(x.Hi) < 0 {
+		v.Sub(v, twoTo128)
+	}
+	return v
+}
+
+// Big returns x as a big.Int.
+func (x UInt128) Big() *big.Int {
+	v := new(big.Int).SetUint64(x.Hi)
+	return v.Lsh(v, 64).Or(v, new(big.Int).SetUint64(x.Lo))
+}
+
+// String returns x in decimal.
+func (x Int128) String() string { return x.Big().String() }
+
+// String returns x in decimal.
+func (x UInt128) String() string { return x.Big().String() }
+
+var (
+	maxUInt64  = new(big.Int).SetUint64(^uint64(0))
+	twoTo128   = new(big.Int).Lsh(big.NewInt(1), 128)
+	maxUInt128 = new(big.Int).Sub(twoTo128, big.NewInt(1))
+)
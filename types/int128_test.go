@@ -0,0 +1,60 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInt128Big(t *testing.T) {
+	minInt128 := new(big.Int).Lsh(big.NewInt(-1), 127)
+	maxInt128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	tests := []struct {
+		in   *big.Int
+		want Int128
+	}{
+		{big.NewInt(0), Int128{}},
+		{big.NewInt(-1), Int128{Lo: ^uint64(0), Hi: ^uint64(0)}},
+		{new(big.Int).Lsh(big.NewInt(3), 64), Int128{Hi: 3}},
+		{minInt128, Int128{Hi: 1 << 63}},
+		{maxInt128, Int128{Lo: ^uint64(0), Hi: 1<<63 - 1}},
+	}
+	for _, tt := range tests {
+		got, ok := Int128FromBig(tt.in)
+		if !ok || got != tt.want {
+			t.Errorf("Int128FromBig(%v) = %+v, %v, want %+v", tt.in, got, ok, tt.want)
+		}
+		if back := got.Big(); back.Cmp(tt.in) != 0 {
+			t.Errorf("Int128%+v.Big() = %v, want %v", got, back, tt.in)
+		}
+	}
+	for _, in := range []*big.Int{
+		new(big.Int).Add(maxInt128, big.NewInt(1)),
+		new(big.Int).Sub(minInt128, big.NewInt(1)),
+	} {
+		if _, ok := Int128FromBig(in); ok {
+			t.Errorf("Int128FromBig(%v) succeeded, want out of range", in)
+		}
+	}
+	if got := Int128FromInt64(-5); got.String() != "-5" {
+		t.Errorf("Int128FromInt64(-5) = %v, want -5", got)
+	}
+}
+
+func TestUInt128Big(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	got, ok := UInt128FromBig(max)
+	if want := (UInt128{Lo: ^uint64(0), Hi: ^uint64(0)}); !ok || got != want {
+		t.Errorf("UInt128FromBig(2^128-1) = %+v, %v, want %+v", got, ok, want)
+	}
+	if got.String() != max.String() {
+		t.Errorf("String() = %s, want %s", got, max)
+	}
+	for _, in := range []*big.Int{big.NewInt(-1), new(big.Int).Add(max, big.NewInt(1))} {
+		if _, ok := UInt128FromBig(in); ok {
+			t.Errorf("UInt128FromBig(%v) succeeded, want out of range", in)
+		}
+	}
+	if got := UInt128FromUint64(7); got != (UInt128{Lo: 7}) {
+		t.Errorf("UInt128FromUint64(7) = %+v", got)
+	}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// LongDouble is an x87 80-bit extended precision value as C stores a long
+// double on x86-64 System V: a 64-bit significand with an explicit integer
+// bit, then the sign and 15-bit exponent, padded to 16 bytes. Use it for
+// LongDoubleType arguments, return values and struct fields.
+type LongDouble [16]byte
+
+// LongDoubleFromFloat64 converts f to extended precision. Every float64,
+// including subnormals, infinities and NaNs, is exactly representable.
+func LongDoubleFromFloat64(f float64) LongDouble {
+	b := math.Float64bits(f)
+	sign := uint16(b>>48) & 0x8000
+	exp := int(b>>52) & 0x7ff
+	mant := b & (1<<52 - 1)
+
+	var e uint16
+	var sig uint64
+	switch {
+	case exp == 0x7ff:
+		e, sig = 0x7fff, 1<<63|mant<<11
+	case exp == 0 && mant == 0:
+		// Signed zero: exponent and significand stay zero.
+	case exp == 0:
+		// Subnormal: the wider exponent range makes it normal.
+		lz := bits.LeadingZeros64(mant)
+		e, sig = uint16(16383+63-1074-lz), mant<<lz
+	default:
+		e, sig = uint16(exp-1023+16383), 1<<63|mant<<11
+	}
+
+	var x LongDouble
+	binary.LittleEndian.PutUint64(x[0:], sig)
+	binary.LittleEndian.PutUint16(x[8:], sign|e)
+	return x
+}
+
+// Float64 returns x as a float64, rounding to nearest even. Values beyond
+// the float64 range become infinities, values too small for it become
+// signed zeros, and NaNs stay NaNs.
+func (x LongDouble) Float64() float64 {
+	sig := binary.LittleEndian.Uint64(x[0:])
+	se := binary.LittleEndian.Uint16(x[8:])
+	sign := uint64(se&0x8000) << 48
+	exp := int(se & 0x7fff)
+
+	switch {
+	case exp == 0x7fff:
+		if sig<<1 == 0 {
+			return math.Float64frombits(sign | 0x7ff<<52)
+		}
+		// Keep the top payload bits and force a quiet NaN.
+		return math.Float64frombits(sign | 0x7ff<<52 | 1<<51 | sig<<1>>12)
+	case sig == 0:
+		return math.Float64frombits(sign)
+	}
+
+	// Normalize denormals (exponent 0, scaled as exponent 1) and unnormals.
+	if exp == 0 {
+		exp = 1
+	}
+	lz := bits.LeadingZeros64(sig)
+	sig <<= lz
+	e := exp - 16383 - lz + 1023 // biased float64 exponent
+	if e >= 0x7ff {
+		return math.Float64frombits(sign | 0x7ff<<52)
+	}
+
+	// Keep 53 bits, fewer for results below the normal range.
+	shift := 11
+	if e <= 0 {
+		shift += 1 - e
+		e = 0
+		if shift > 64 {
+			return math.Float64frombits(sign)
+		}
+	}
+	half := uint64(1) << (shift - 1)
+	rest := sig & (1<<shift - 1)
+	m := sig >> shift
+	if rest > half || rest == half && m&1 != 0 {
+		m++ // a carry into the exponent (or to infinity) is the correct result
+	}
+	if e > 0 {
+		// m still holds the integer bit, which adds one to the exponent.
+		return math.Float64frombits(sign + uint64(e-1)<<52 + m)
+	}
+	return math.Float64frombits(sign + m)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// longDouble builds an extended precision value from its fields.
+func longDouble(signExp uint16, sig uint64) LongDouble {
+	var x LongDouble
+	binary.LittleEndian.PutUint64(x[0:], sig)
+	binary.LittleEndian.PutUint16(x[8:], signExp)
+	return x
+}
+
+func TestLongDoubleFromFloat64(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want LongDouble
+	}{
+		{1, longDouble(0x3fff, 1<<63)},
+		{-2, longDouble(0xc000, 1<<63)},
+		{0.1, longDouble(0x3ffb, 0xccccccccccccd000)},
+		{math.Copysign(0, -1), longDouble(0x8000, 0)},
+		{math.Inf(1), longDouble(0x7fff, 1<<63)},
+		{math.SmallestNonzeroFloat64, longDouble(16383-1074, 1<<63)},
+		{math.MaxFloat64, longDouble(16383+1023, 0xfffffffffffff800)},
+	}
+	for _, tt := range tests {
+		if got := LongDoubleFromFloat64(tt.in); got != tt.want {
+			t.Errorf("LongDoubleFromFloat64(%v) = % x, want % x", tt.in, got[:10], tt.want[:10])
+		}
+	}
+}
+
+func TestLongDoubleFloat64(t *testing.T) {
+	for _, f := range []float64{0, 1, -1.5, math.Pi, 1e300, -1e-300, math.SmallestNonzeroFloat64, 5e-320, math.Inf(-1)} {
+		if got := LongDoubleFromFloat64(f).Float64(); got != f {
+			t.Errorf("round trip of %v = %v", f, got)
+		}
+	}
+	if got := LongDoubleFromFloat64(math.NaN()).Float64(); !math.IsNaN(got) {
+		t.Errorf("round trip of NaN = %v", got)
+	}
+
+	tests := []struct {
+		in   LongDouble
+		want float64
+	}{
+		{longDouble(0x3fff, 1<<63|1<<10), 1},                            // tie to even, down
+		{longDouble(0x3fff, 1<<63|3<<10), 1 + 2*math.Pow(2, -52)},       // tie to even, up
+		{longDouble(0x3fff, 1<<63|1<<10|1), 1 + math.Pow(2, -52)},       // just above the tie
+		{longDouble(0x3fff+1024, 1<<63), math.Inf(1)},                   // beyond float64
+		{longDouble(0x3fff-1075, 1<<63), 0},                             // half the smallest subnormal
+		{longDouble(0x3fff-1075, 1<<63|1), math.SmallestNonzeroFloat64}, // just above it
+		{longDouble(0, 1<<62), 0},                                       // extended denormal
+		{longDouble(0x3fff, 1<<62), 0.5},                                // unnormal
+	}
+	for _, tt := range tests {
+		if got := tt.in.Float64(); got != tt.want {
+			t.Errorf("% x.Float64() = %v, want %v", tt.in[:10], got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DescriptorOf returns a fully initialized descriptor for the Go type t,
+// whose Size, Alignment and member offsets match t's memory layout, so a
+// pointer to a Go value of type t can be passed where C expects the struct:
+//
+//	type extent struct {
+//	    Width, Height uint32
+//	    Depth         uint32
+//	    _             [4]byte // explicit tail padding, if C has any
+//	}
+//	desc, err := types.DescriptorOf(reflect.TypeFor[extent]())
+//
+// bool maps to C bool, sized integers and floats to the same C types, int,
+// uint and uintptr to the pointer-sized integers, Float16 to Float16Type,
+// LongDouble to LongDoubleType, Int128 and UInt128 to the 128-bit integers,
+// and unsafe.Pointer and *T to pointers (typed with PointerTo where T has a
+// descriptor). Struct fields become members in order, nested structs become
+// struct members and arrays that many consecutive members; blank fields are
+// kept, as padding. Strings, slices, maps, funcs, channels and interfaces
+// have no C equivalent and are rejected. If C's natural alignment would
+// place a member at a different offset than Go does, as for int64 fields on
+// 32-bit ARM, DescriptorOf fails instead of returning a descriptor that
+// disagrees with the Go value. For Go structs that should be converted
+// rather than mirrored, use ffi.DescribeStruct.
+func DescriptorOf(t reflect.Type) (*TypeDescriptor, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%w: nil type", ErrInvalidTypeDefinition)
+	}
+	d := &reflectDescriber{structs: make(map[reflect.Type]*TypeDescriptor)}
+	return d.describe(t)
+}
+
+// DescriptorFor is DescriptorOf for the type parameter T.
+func DescriptorFor[T any]() (*TypeDescriptor, error) {
+	return DescriptorOf(reflect.TypeFor[T]())
+}
+
+// reflectDescriber builds descriptors, sharing one per struct type so that
+// self-referential types terminate.
+type reflectDescriber struct {
+	structs map[reflect.Type]*TypeDescriptor
+}
+
+func (d *reflectDescriber) describe(t reflect.Type) (*TypeDescriptor, error) {
+	switch t {
+	case reflect.TypeFor[Float16]():
+		return Float16TypeDescriptor, nil
+	case reflect.TypeFor[LongDouble]():
+		return LongDoubleTypeDescriptor, nil
+	case reflect.TypeFor[Int128]():
+		return SInt128TypeDescriptor, nil
+	case reflect.TypeFor[UInt128]():
+		return UInt128TypeDescriptor, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return BoolTypeDescriptor, nil
+	case reflect.Uint8:
+		return UInt8TypeDescriptor, nil
+	case reflect.Int8:
+		return SInt8TypeDescriptor, nil
+	case reflect.Int16:
+		return SInt16TypeDescriptor, nil
+	case reflect.Uint16:
+		return UInt16TypeDescriptor, nil
+	case reflect.Int32:
+		return SInt32TypeDescriptor, nil
+	case reflect.Uint32:
+		return UInt32TypeDescriptor, nil
+	case reflect.Int64:
+		return SInt64TypeDescriptor, nil
+	case reflect.Uint64:
+		return UInt64TypeDescriptor, nil
+	case reflect.Int:
+		return IntPtrTypeDescriptor, nil
+	case reflect.Uint, reflect.Uintptr:
+		return UIntPtrTypeDescriptor, nil
+	case reflect.Float32:
+		return FloatTypeDescriptor, nil
+	case reflect.Float64:
+		return DoubleTypeDescriptor, nil
+	case reflect.UnsafePointer:
+		return PointerTypeDescriptor, nil
+	case reflect.Pointer:
+		// The pointee only refines the pointer; one without a descriptor
+		// leaves it untyped.
+		elem, err := d.describe(t.Elem())
+		if err != nil {
+			elem = nil
+		}
+		return PointerTo(elem), nil
+	case reflect.Struct:
+		return d.structType(t)
+	}
+	return nil, fmt.Errorf("%w: Go type %s has no C equivalent", ErrInvalidTypeDefinition, t)
+}
+
+func (d *reflectDescriber) structType(t reflect.Type) (*TypeDescriptor, error) {
+	if desc, ok := d.structs[t]; ok {
+		return desc, nil
+	}
+	desc := &TypeDescriptor{Kind: StructType}
+	d.structs[t] = desc
+
+	var offsets []uintptr // Go offset of each member
+	for i := range t.NumField() {
+		f := t.Field(i)
+		ft, count := f.Type, 1
+		for ft.Kind() == reflect.Array {
+			count *= ft.Len()
+			ft = ft.Elem()
+		}
+		if count == 0 {
+			continue
+		}
+		m, err := d.describe(ft)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t, f.Name, err)
+		}
+		for j := range count {
+			desc.Members = append(desc.Members, m)
+			offsets = append(offsets, f.Offset+uintptr(j)*ft.Size())
+		}
+	}
+	if len(desc.Members) == 0 {
+		return nil, fmt.Errorf("%w: struct %s has no members", ErrInvalidTypeDefinition, t)
+	}
+
+	// Lay out as C does and check that it agrees with Go.
+	for i, m := range desc.Members {
+		if m.Kind == StructType && m.Size == 0 {
+			return nil, fmt.Errorf("%w: struct %s contains itself", ErrInvalidTypeDefinition, t)
+		}
+		desc.Size = alignUp(desc.Size, m.Alignment)
+		if desc.Size != offsets[i] {
+			return nil, fmt.Errorf("%w: %s member %d is at offset %d in Go but %d in C", ErrInvalidTypeDefinition, t, i, offsets[i], desc.Size)
+		}
+		desc.Size += m.Size
+		desc.Alignment = max(desc.Alignment, m.Alignment)
+	}
+	desc.Size = alignUp(desc.Size, desc.Alignment)
+	if desc.Size != t.Size() {
+		return nil, fmt.Errorf("%w: %s is %d bytes in Go but %d in C", ErrInvalidTypeDefinition, t, t.Size(), desc.Size)
+	}
+	return desc, nil
+}
+
+// alignUp rounds n up to a multiple of a, a power of two.
+func alignUp(n, a uintptr) uintptr {
+	return (n + a - 1) &^ (a - 1)
+}
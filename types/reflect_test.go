@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestDescriptorOf(t *testing.T) {
+	type point struct{ X, Y float32 }
+	type node struct {
+		Next  *node
+		Value int32
+		Flags [2]bool
+		_     [2]byte
+	}
+	type rect struct {
+		Origin point
+		Size   point
+		Half   Float16
+		Data   unsafe.Pointer
+		Head   *node
+		List   *[]int // pointee has no descriptor
+	}
+
+	desc, err := DescriptorFor[rect]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Kind != StructType || desc.Size != unsafe.Sizeof(rect{}) || desc.Alignment != unsafe.Alignof(rect{}) {
+		t.Errorf("rect: size %d align %d, want %d, %d", desc.Size, desc.Alignment, unsafe.Sizeof(rect{}), unsafe.Alignof(rect{}))
+	}
+	origin := desc.Members[0]
+	if origin.Kind != StructType || len(origin.Members) != 2 || origin.Size != 8 || origin.Members[0] != FloatTypeDescriptor {
+		t.Errorf("rect.Origin = %+v", origin)
+	}
+	if desc.Members[2] != Float16TypeDescriptor || desc.Members[3] != PointerTypeDescriptor {
+		t.Errorf("rect.Half, rect.Data = %+v, %+v", desc.Members[2], desc.Members[3])
+	}
+
+	head := desc.Members[4]
+	nd := head.Elem
+	if head.Kind != PointerType || nd == nil || nd.Size != unsafe.Sizeof(node{}) {
+		t.Fatalf("rect.Head = %+v", head)
+	}
+	// Next, Value, Flags[0], Flags[1], _[0], _[1]
+	if len(nd.Members) != 6 || nd.Members[0].Elem != nd || nd.Members[2] != BoolTypeDescriptor {
+		t.Errorf("node members = %+v", nd.Members)
+	}
+	if list := desc.Members[5]; list.Kind != PointerType || list.Elem != nil {
+		t.Errorf("rect.List = %+v, want an untyped pointer", list)
+	}
+
+	for _, typ := range []reflect.Type{
+		nil,
+		reflect.TypeFor[string](),
+		reflect.TypeFor[struct{ S []int32 }](),
+		reflect.TypeFor[struct{}](),
+		reflect.TypeFor[struct{ F func() }](),
+	} {
+		if _, err := DescriptorOf(typ); !errors.Is(err, ErrInvalidTypeDefinition) {
+			t.Errorf("DescriptorOf(%v) err = %v, want ErrInvalidTypeDefinition", typ, err)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package types
 import (
 	"errors"
 	"runtime"
+	"slices"
+	"strings"
+	"unsafe"
 )
 
 // RuntimeEnvironment returns current runtime OS and architecture
@@ -69,6 +72,12 @@ const (
 	SInt64Type
 	StructType
 	PointerType
+	Float16Type    // IEEE 754 binary16 (_Float16, __fp16); see Float16
+	LongDoubleType // x87 80-bit extended precision (long double on x86-64 System V); see LongDouble
+	BoolType       // C bool (_Bool): one byte holding 0 or 1; a Go bool
+	SInt128Type    // __int128; see Int128
+	UInt128Type    // unsigned __int128; see UInt128
+	VectorType     // 128-bit SIMD vector (__m128, float32x4_t); see VectorOf
 )
 
 // TypeDescriptor describes FFI type characteristics
@@ -77,8 +86,30 @@ type TypeDescriptor struct {
 	Alignment uintptr           // Alignment requirement
 	Kind      TypeKind          // Type category
 	Members   []*TypeDescriptor // For composite types
+	CString   bool              // PointerType return value decoded into a Go string; see CStringTypeDescriptor
+	WString   bool              // Like CString for wchar_t strings; see WStringTypeDescriptor
+	MaxLen    int               // With CString/WString: decode at most MaxLen bytes/wchar_t units; 0 = up to the NUL
+	Elem      *TypeDescriptor   // PointerType: the pointed-to element, for marshaling; nil = untyped (see PointerTo). VectorType: the lane type
+
+	// PackedAlignment caps the alignment of a StructType's members, and so
+	// of the struct, as #pragma pack(PackedAlignment) does in C. It must be
+	// a power of two; 0 keeps natural alignment. See MemberAlignment.
+	PackedAlignment uintptr
 }
 
+// MemberAlignment returns the boundary the struct t places its member m
+// on: m's own alignment, capped at t.PackedAlignment when that is set.
+func (t *TypeDescriptor) MemberAlignment(m *TypeDescriptor) uintptr {
+	if t.PackedAlignment != 0 && t.PackedAlignment < m.Alignment {
+		return t.PackedAlignment
+	}
+	return m.Alignment
+}
+
+// ptrSize is the size of a C pointer on the target: 8 on 64-bit platforms,
+// 4 on 32-bit ones such as linux/arm.
+const ptrSize = unsafe.Sizeof(uintptr(0))
+
 // Predefined type descriptors
 var (
 	VoidTypeDescriptor    = &TypeDescriptor{Size: 1, Alignment: 1, Kind: VoidType}
@@ -93,18 +124,146 @@ var (
 	SInt32TypeDescriptor  = &TypeDescriptor{Size: 4, Alignment: 4, Kind: SInt32Type}
 	UInt64TypeDescriptor  = &TypeDescriptor{Size: 8, Alignment: 8, Kind: UInt64Type}
 	SInt64TypeDescriptor  = &TypeDescriptor{Size: 8, Alignment: 8, Kind: SInt64Type}
-	PointerTypeDescriptor = &TypeDescriptor{Size: 8, Alignment: 8, Kind: PointerType}
+	PointerTypeDescriptor = &TypeDescriptor{Size: ptrSize, Alignment: ptrSize, Kind: PointerType}
+	Float16TypeDescriptor = &TypeDescriptor{Size: 2, Alignment: 2, Kind: Float16Type}
+
+	// BoolTypeDescriptor is C's bool. Arguments and return values are Go
+	// bools: an argument is passed as 0 or 1, zero-extended, and a returned
+	// byte is true when it is nonzero, whatever the callee left in the rest
+	// of the register.
+	BoolTypeDescriptor = &TypeDescriptor{Size: 1, Alignment: 1, Kind: BoolType}
+
+	// LongDoubleTypeDescriptor is C's long double on x86-64 System V (Linux,
+	// macOS and FreeBSD on amd64): an x87 80-bit value padded to 16 bytes.
+	// Calls with it can only be prepared there; on other targets long double
+	// is double, or a 128-bit type goffi does not support.
+	LongDoubleTypeDescriptor = &TypeDescriptor{Size: 16, Alignment: 16, Kind: LongDoubleType}
+
+	// SInt128TypeDescriptor and UInt128TypeDescriptor are __int128 and
+	// unsigned __int128, passed and returned in a pair of integer registers
+	// (RDX:RAX on x86-64 System V, an even-numbered X register pair on
+	// arm64). Calls with them can only be prepared there.
+	SInt128TypeDescriptor = &TypeDescriptor{Size: 16, Alignment: 16, Kind: SInt128Type}
+	UInt128TypeDescriptor = &TypeDescriptor{Size: 16, Alignment: 16, Kind: UInt128Type}
+
+	// Float32x4TypeDescriptor, Float64x2TypeDescriptor and
+	// Int32x4TypeDescriptor are the 128-bit vectors __m128 and float32x4_t,
+	// __m128d and float64x2_t, and int32x4_t; __m128i is any 16-byte
+	// integer vector. See VectorOf.
+	Float32x4TypeDescriptor = VectorOf(FloatTypeDescriptor, 4)
+	Float64x2TypeDescriptor = VectorOf(DoubleTypeDescriptor, 2)
+	Int32x4TypeDescriptor   = VectorOf(SInt32TypeDescriptor, 4)
+
+	// SizeTTypeDescriptor and SSizeTTypeDescriptor are size_t and ssize_t
+	// (also ptrdiff_t), and UIntPtrTypeDescriptor and IntPtrTypeDescriptor
+	// uintptr_t and intptr_t: integers as wide as a pointer. Each is the
+	// predefined 32- or 64-bit descriptor matching the target.
+	SizeTTypeDescriptor   = unsignedOfSize(ptrSize)
+	SSizeTTypeDescriptor  = signedOfSize(ptrSize)
+	UIntPtrTypeDescriptor = unsignedOfSize(ptrSize)
+	IntPtrTypeDescriptor  = signedOfSize(ptrSize)
+
+	// LongTypeDescriptor and ULongTypeDescriptor are C long and unsigned
+	// long: 4 bytes on Windows (LLP64) and 32-bit targets, 8 on other 64-bit
+	// ones (LP64).
+	LongTypeDescriptor  = signedOfSize(longSize())
+	ULongTypeDescriptor = unsignedOfSize(longSize())
+
+	// CStringTypeDescriptor is a char* return type that the call decodes:
+	// rvalue must point to a Go string, which receives a copy of the
+	// NUL-terminated data ("" for NULL). The C memory is not freed. As an
+	// argument type it is a plain pointer.
+	CStringTypeDescriptor = &TypeDescriptor{Size: ptrSize, Alignment: ptrSize, Kind: PointerType, CString: true}
+
+	// WStringTypeDescriptor is the wchar_t* counterpart of
+	// CStringTypeDescriptor: UTF-16 on Windows, UTF-32 elsewhere. As an
+	// argument type it is a plain pointer marked as wide text.
+	WStringTypeDescriptor = &TypeDescriptor{Size: ptrSize, Alignment: ptrSize, Kind: PointerType, WString: true}
 )
 
+// longSize returns the size of C long on the target.
+func longSize() uintptr {
+	if runtime.GOOS == "windows" {
+		return 4
+	}
+	return ptrSize
+}
+
+// signedOfSize returns the predefined signed integer descriptor of size
+// bytes, 4 or 8.
+func signedOfSize(size uintptr) *TypeDescriptor {
+	if size == 4 {
+		return SInt32TypeDescriptor
+	}
+	return SInt64TypeDescriptor
+}
+
+// unsignedOfSize returns the predefined unsigned integer descriptor of size
+// bytes, 4 or 8.
+func unsignedOfSize(size uintptr) *TypeDescriptor {
+	if size == 4 {
+		return UInt32TypeDescriptor
+	}
+	return UInt64TypeDescriptor
+}
+
+// CStringType returns a CStringTypeDescriptor variant that decodes at most
+// maxLen bytes, for strings that may lack a terminating NUL. maxLen <= 0
+// means no limit.
+func CStringType(maxLen int) *TypeDescriptor {
+	if maxLen < 0 {
+		maxLen = 0
+	}
+	return &TypeDescriptor{Size: ptrSize, Alignment: ptrSize, Kind: PointerType, CString: true, MaxLen: maxLen}
+}
+
+// PointerTo returns a pointer type whose pointee is elem. Calls treat it as
+// a plain pointer; ffi.Marshal uses elem to lay out the struct or array the
+// pointer refers to.
+func PointerTo(elem *TypeDescriptor) *TypeDescriptor {
+	return &TypeDescriptor{Size: ptrSize, Alignment: ptrSize, Kind: PointerType, Elem: elem}
+}
+
+// VectorOf returns the SIMD vector type of n lanes of the scalar type elem.
+// Vectors travel whole in one XMM register on x86-64 System V, or one V
+// register on arm64, and are returned in XMM0 or V0; calls with them can
+// only be prepared there, and only for 16-byte vectors. Arguments and
+// return values are the lanes in memory order, such as a [4]float32.
+func VectorOf(elem *TypeDescriptor, n int) *TypeDescriptor {
+	size := elem.Size * uintptr(n)
+	return &TypeDescriptor{Size: size, Alignment: size, Kind: VectorType, Elem: elem}
+}
+
+// WStringType returns a WStringTypeDescriptor variant that decodes at most
+// maxLen wchar_t units. maxLen <= 0 means no limit.
+func WStringType(maxLen int) *TypeDescriptor {
+	if maxLen < 0 {
+		maxLen = 0
+	}
+	return &TypeDescriptor{Size: ptrSize, Alignment: ptrSize, Kind: PointerType, WString: true, MaxLen: maxLen}
+}
+
 // CallInterface represents a prepared function call interface.
 type CallInterface struct {
-	Convention    CallingConvention
-	ArgCount      int
-	ArgTypes      []*TypeDescriptor
-	ReturnType    *TypeDescriptor
-	Flags         int     // Return flags.
-	StackBytes    uintptr // Required stack space.
-	FixedArgCount int     // 0 = non-variadic; >0 = number of fixed args before '...'
+	Convention     CallingConvention
+	ArgCount       int
+	ArgTypes       []*TypeDescriptor
+	ReturnType     *TypeDescriptor
+	Flags          int     // Return flags.
+	StackBytes     uintptr // Required stack space.
+	FixedArgCount  int     // 0 = non-variadic; >0 = number of fixed args before '...'
+	Blocking       bool    // Call may block for long: hand off the P up front (Unix amd64).
+	Backend        string  // Registered ABI backend executing the call; "" = built-in.
+	CheckRegisters bool    // Diagnostic: verify the callee preserved callee-saved registers.
+}
+
+// Clone returns a copy of cif that can be modified without affecting the
+// original. The ArgTypes slice is copied; the type descriptors it points to
+// are shared.
+func (cif *CallInterface) Clone() *CallInterface {
+	c := *cif
+	c.ArgTypes = slices.Clone(cif.ArgTypes)
+	return &c
 }
 
 // Return flags constants
@@ -126,6 +285,8 @@ const (
 	ReturnStRaxXmm0  = 11 // {INTEGER, SSE}     — eightbyte0 in RAX,  eightbyte1 in XMM0
 	ReturnStXmm0Rax  = 12 // {SSE, INTEGER}     — eightbyte0 in XMM0, eightbyte1 in RAX
 	ReturnStXmm0Xmm1 = 13 // {SSE, SSE}         — eightbyte0 in XMM0, eightbyte1 in XMM1 (e.g. NSPoint/NSSize)
+	ReturnInST0      = 14 // X87 class (long double) — x87 register ST0
+	ReturnInXMM128   = 15 // 128-bit vector — all of XMM0 (amd64) or V0 (arm64)
 	ReturnViaPointer = 1 << 10
 	// ARM64 HFA (Homogeneous Floating-point Aggregate) return flags.
 	// HFA structs with 2-4 float/double members are returned in D0-D3.
@@ -142,3 +303,15 @@ var (
 	ErrInvalidTypeDefinition        = errors.New("invalid type definition")
 	ErrUnsupportedReturnType        = errors.New("unsupported return type")
 )
+
+// RegisterClobberError reports callee-saved registers that a native function
+// did not preserve, detected by a call with CallInterface.CheckRegisters set.
+// goffi restores the registers before returning to Go, but the library
+// violates its ABI and is likely to corrupt its own callers as well.
+type RegisterClobberError struct {
+	Registers []string // Register names, e.g. "RBX", "X19", "D8"
+}
+
+func (e *RegisterClobberError) Error() string {
+	return "native function clobbered callee-saved registers: " + strings.Join(e.Registers, ", ")
+}
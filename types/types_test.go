@@ -3,6 +3,7 @@ package types
 import (
 	"runtime"
 	"testing"
+	"unsafe"
 )
 
 func TestRuntimeEnvironment(t *testing.T) {
@@ -49,6 +50,11 @@ func TestTypeDescriptors(t *testing.T) {
 		{"UInt64", UInt64TypeDescriptor, 8, 8, UInt64Type},
 		{"SInt64", SInt64TypeDescriptor, 8, 8, SInt64Type},
 		{"Pointer", PointerTypeDescriptor, 8, 8, PointerType},
+		{"Float16", Float16TypeDescriptor, 2, 2, Float16Type},
+		{"SInt128", SInt128TypeDescriptor, 16, 16, SInt128Type},
+		{"UInt128", UInt128TypeDescriptor, 16, 16, UInt128Type},
+		{"Float32x4", Float32x4TypeDescriptor, 16, 16, VectorType},
+		{"Float64x2", Float64x2TypeDescriptor, 16, 16, VectorType},
 	}
 
 	for _, tt := range tests {
@@ -85,6 +91,76 @@ func TestCallInterfaceZeroValue(t *testing.T) {
 	}
 }
 
+func TestCallInterfaceClone(t *testing.T) {
+	orig := &CallInterface{
+		Convention:    UnixCallingConvention,
+		ArgCount:      2,
+		ArgTypes:      []*TypeDescriptor{IntTypeDescriptor, DoubleTypeDescriptor},
+		ReturnType:    VoidTypeDescriptor,
+		Flags:         ReturnVoid,
+		FixedArgCount: 1,
+		Backend:       "custom",
+	}
+	c := orig.Clone()
+	if c == orig {
+		t.Fatal("Clone returned the same pointer")
+	}
+	if c.Convention != orig.Convention || c.ArgCount != orig.ArgCount || c.FixedArgCount != orig.FixedArgCount || c.Backend != orig.Backend {
+		t.Errorf("Clone = %+v, want copy of %+v", c, orig)
+	}
+
+	c.ArgTypes[0] = FloatTypeDescriptor
+	if orig.ArgTypes[0] != IntTypeDescriptor {
+		t.Error("modifying the clone's ArgTypes changed the original")
+	}
+}
+
+func TestMemberAlignment(t *testing.T) {
+	natural := &TypeDescriptor{Kind: StructType}
+	packed := &TypeDescriptor{Kind: StructType, PackedAlignment: 2}
+	for _, tt := range []struct {
+		s, m *TypeDescriptor
+		want uintptr
+	}{
+		{natural, DoubleTypeDescriptor, 8},
+		{packed, DoubleTypeDescriptor, 2},
+		{packed, SInt32TypeDescriptor, 2},
+		{packed, UInt8TypeDescriptor, 1},
+	} {
+		if got := tt.s.MemberAlignment(tt.m); got != tt.want {
+			t.Errorf("MemberAlignment(size %d) with PackedAlignment %d = %d, want %d",
+				tt.m.Size, tt.s.PackedAlignment, got, tt.want)
+		}
+	}
+}
+
+func TestPlatformSizedDescriptors(t *testing.T) {
+	ptr := unsafe.Sizeof(uintptr(0))
+	long := ptr
+	if runtime.GOOS == "windows" {
+		long = 4
+	}
+	for _, tt := range []struct {
+		name   string
+		d      *TypeDescriptor
+		size   uintptr
+		signed bool
+	}{
+		{"size_t", SizeTTypeDescriptor, ptr, false},
+		{"ssize_t", SSizeTTypeDescriptor, ptr, true},
+		{"uintptr_t", UIntPtrTypeDescriptor, ptr, false},
+		{"intptr_t", IntPtrTypeDescriptor, ptr, true},
+		{"long", LongTypeDescriptor, long, true},
+		{"unsigned long", ULongTypeDescriptor, long, false},
+	} {
+		signed := tt.d.Kind == SInt32Type || tt.d.Kind == SInt64Type
+		if tt.d.Size != tt.size || tt.d.Alignment != tt.size || signed != tt.signed {
+			t.Errorf("%s: size %d, align %d, kind %d; want size %d, signed %v",
+				tt.name, tt.d.Size, tt.d.Alignment, tt.d.Kind, tt.size, tt.signed)
+		}
+	}
+}
+
 func TestCallingConventionConstants(t *testing.T) {
 	if UnixCallingConvention != 1 {
 		t.Errorf("UnixCallingConvention = %d, want 1", UnixCallingConvention)